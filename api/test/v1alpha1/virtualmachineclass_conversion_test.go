@@ -104,6 +104,26 @@ func TestVirtualMachineClassConversion(t *testing.T) {
 			}
 			hubSpokeHub(g, &hub, &vmopv1.VirtualMachineClass{}, &vmopv1a1.VirtualMachineClass{})
 		})
+		t.Run("class w deprecation and consumers", func(t *testing.T) {
+			g := NewWithT(t)
+			hub := vmopv1.VirtualMachineClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-vm-class",
+					Namespace: "my-namespace",
+				},
+				Spec: vmopv1.VirtualMachineClassSpec{
+					Deprecation: &vmopv1.VirtualMachineClassDeprecation{
+						ReplacementClassName: "my-new-vm-class",
+						Policy:               vmopv1.VirtualMachineClassDeprecationPolicyDeny,
+						Migrate:              true,
+					},
+				},
+				Status: vmopv1.VirtualMachineClassStatus{
+					Consumers: []string{"my-vm-1", "my-vm-2"},
+				},
+			}
+			hubSpokeHub(g, &hub, &vmopv1.VirtualMachineClass{}, &vmopv1a1.VirtualMachineClass{})
+		})
 	})
 
 	t.Run("VirtualMachineClass spoke-hub", func(t *testing.T) {
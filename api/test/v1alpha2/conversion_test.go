@@ -68,6 +68,9 @@ var _ = Describe("FuzzyConversion", Label("api", "fuzz"), func() {
 				Scheme: scheme,
 				Hub:    &vmopv1.VirtualMachineClass{},
 				Spoke:  &vmopv1a2.VirtualMachineClass{},
+				FuzzerFuncs: []fuzzer.FuzzerFuncs{
+					overrideVirtualMachineClassFieldsFuncs,
+				},
 			}
 		})
 		Context("Spoke-Hub-Spoke", func() {
@@ -295,6 +298,25 @@ func overrideVirtualMachineImageFieldsFuncs(codecs runtimeserializer.CodecFactor
 	}
 }
 
+func overrideVirtualMachineClassFieldsFuncs(codecs runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(classSpec *vmopv1.VirtualMachineClassSpec, c randfill.Continue) {
+			c.Fill(classSpec)
+
+			// Since all random byte arrays are not valid JSON
+			// Passing an empty string as a valid input
+			classSpec.ConfigSpec = []byte("")
+		},
+		func(classSpec *vmopv1a2.VirtualMachineClassSpec, c randfill.Continue) {
+			c.Fill(classSpec)
+
+			// Since all random byte arrays are not valid JSON
+			// Passing an empty string as a valid input
+			classSpec.ConfigSpec = []byte("")
+		},
+	}
+}
+
 func ptrOf[T any](v T) *T {
 	return &v
 }
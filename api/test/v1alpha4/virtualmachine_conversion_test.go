@@ -94,6 +94,13 @@ func TestVirtualMachineConversion(t *testing.T) {
 									Identification: &vmopv1sysprep.Identification{
 										DomainAdmin: "my-admin",
 										DomainOU:    "my-ou",
+										DomainJoin: &vmopv1sysprep.DomainJoin{
+											Credentials: vmopv1sysprep.DomainJoinCredentialsSecretKeySelector{
+												Name:        "my-domain-join-secret",
+												UsernameKey: "username",
+												PasswordKey: "password",
+											},
+										},
 									},
 									UserData: vmopv1sysprep.UserData{
 										FullName: "vmware",
@@ -309,6 +316,163 @@ func TestVirtualMachineConversion(t *testing.T) {
 					},
 				},
 			},
+			{
+				name: "spec.bootstrap.cloudInit.userDataParts",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						Bootstrap: &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+									{
+										Name:        "base",
+										ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig,
+										Value:       ptrOf("#cloud-config\n"),
+									},
+									{
+										Name:        "setup.sh",
+										ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript,
+										ConfigMap: &vmopv1common.ConfigMapKeySelector{
+											Name: "my-configmap",
+											Key:  "setup.sh",
+										},
+									},
+									{
+										Name:        "secrets",
+										ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig,
+										Secret: &vmopv1common.SecretKeySelector{
+											Name: "my-secret",
+											Key:  "user-data",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "spec.maintenanceWindow",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						MaintenanceWindow: &vmopv1.VirtualMachineMaintenanceWindow{
+							Schedule: "0 2 * * 6",
+							Duration: metav1.Duration{Duration: 4 * time.Hour},
+						},
+					},
+				},
+			},
+			{
+				name: "spec.driftPolicy",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						DriftPolicy: vmopv1.VirtualMachineDriftPolicyRevert,
+					},
+				},
+			},
+			{
+				name: "spec.network.interfaces[].adapterType, physicalFunction, and ipPoolName",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						Network: &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{
+									Name:             "eth0",
+									AdapterType:      vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV,
+									PhysicalFunction: "0000:3b:00.0",
+								},
+								{
+									Name:        "eth1",
+									AdapterType: vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath,
+									IPPoolName:  "my-ip-pool",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "spec.network.vlans and spec.network.bonds",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						Network: &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{Name: "eth0"},
+								{Name: "eth1"},
+							},
+							Bonds: []vmopv1.VirtualMachineNetworkBondSpec{
+								{
+									Name:       "bond0",
+									Interfaces: []string{"eth0", "eth1"},
+									Mode:       vmopv1.VirtualMachineNetworkBondModeActiveBackup,
+									Primary:    "eth0",
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "bond0.100",
+									ID:   100,
+									Link: "bond0",
+									VirtualMachineNetworkGuestDeviceIPSpec: vmopv1.VirtualMachineNetworkGuestDeviceIPSpec{
+										Addresses: []string{"192.168.100.10/24"},
+										Gateway4:  "192.168.100.1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "spec.network.firewall",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						Network: &vmopv1.VirtualMachineNetworkSpec{
+							Firewall: &vmopv1.VirtualMachineNetworkFirewallSpec{
+								Ingress: []vmopv1.VirtualMachineNetworkFirewallRule{
+									{
+										Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolTCP,
+										Port:     ptrOf[int32](22),
+										CIDRs:    []string{"192.168.1.0/24"},
+									},
+									{
+										Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolUDP,
+										Port:     ptrOf[int32](8000),
+										EndPort:  ptrOf[int32](8080),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "spec.hardware.cpus and spec.hardware.memory",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						Hardware: &vmopv1.VirtualMachineHardwareSpec{
+							CPUs:   ptrOf[int64](4),
+							Memory: ptrOf(resource.MustParse("8Gi")),
+						},
+					},
+				},
+			},
+			{
+				name: "spec.bootstrap.ignition",
+				hub: &vmopv1.VirtualMachine{
+					Spec: vmopv1.VirtualMachineSpec{
+						Bootstrap: &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								RawConfig: &vmopv1common.SecretKeySelector{
+									Name: "my-ignition-secret",
+									Key:  "config.ign",
+								},
+								Encoding:           vmopv1.VirtualMachineBootstrapIgnitionEncodingBase64,
+								MergeNetworkConfig: ptrOf(false),
+							},
+						},
+					},
+				},
+			},
 			{
 				name: "spec.affinity",
 				hub: &vmopv1.VirtualMachine{
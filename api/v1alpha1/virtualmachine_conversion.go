@@ -876,6 +876,12 @@ func restore_v1alpha5_VirtualMachineBootstrapSpec(
 				LinuxPrep: srcBootstrap.LinuxPrep,
 			}
 		}
+		// Likewise, v1a1 doesn't have a way to represent Ignition.
+		if srcBootstrap.Ignition != nil {
+			dst.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				Ignition: srcBootstrap.Ignition,
+			}
+		}
 		return
 	}
 
@@ -899,6 +905,7 @@ func restore_v1alpha5_VirtualMachineBootstrapSpec(
 			dstCloudInit.UseGlobalSearchDomainsAsDefault = srcCloudInit.UseGlobalSearchDomainsAsDefault
 			dstCloudInit.WaitOnNetwork4 = srcCloudInit.WaitOnNetwork4
 			dstCloudInit.WaitOnNetwork6 = srcCloudInit.WaitOnNetwork6
+			dstCloudInit.UserDataParts = srcCloudInit.UserDataParts
 		}
 	}
 
@@ -1285,6 +1292,40 @@ func restore_v1alpha5_VirtualMachinePolicies(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Policies = slices.Clone(src.Spec.Policies)
 }
 
+func restore_v1alpha5_VirtualMachineMaintenanceWindow(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.MaintenanceWindow = src.Spec.MaintenanceWindow
+}
+
+func restore_v1alpha5_VirtualMachineDriftPolicy(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.DriftPolicy = src.Spec.DriftPolicy
+}
+
+func restore_v1alpha5_VirtualMachineNetworkGuestDevices(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil ||
+		(len(src.Spec.Network.VLANs) == 0 && len(src.Spec.Network.Bonds) == 0) {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.VLANs = src.Spec.Network.VLANs
+	dst.Spec.Network.Bonds = src.Spec.Network.Bonds
+}
+
+func restore_v1alpha5_VirtualMachineNetworkFirewall(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil || src.Spec.Network.Firewall == nil {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.Firewall = src.Spec.Network.Firewall
+}
+
 // ConvertTo converts this VirtualMachine to the Hub version.
 func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachine)
@@ -1319,6 +1360,10 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	restore_v1alpha5_VirtualMachineVolumes(dst, restored)
 	restore_v1alpha5_VirtualMachineHardware(dst, restored)
 	restore_v1alpha5_VirtualMachinePolicies(dst, restored)
+	restore_v1alpha5_VirtualMachineMaintenanceWindow(dst, restored)
+	restore_v1alpha5_VirtualMachineDriftPolicy(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkGuestDevices(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkFirewall(dst, restored)
 
 	// END RESTORE
 
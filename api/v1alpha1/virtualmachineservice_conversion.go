@@ -5,21 +5,68 @@
 package v1alpha1
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 )
 
+func Convert_v1alpha5_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(
+	in *v1alpha5.VirtualMachineServiceSpec, out *VirtualMachineServiceSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineServicePort(
+	in *v1alpha5.VirtualMachineServicePort, out *VirtualMachineServicePort, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineServicePort(in, out, s)
+}
+
+func restore_v1alpha5_VirtualMachineServiceInterfaceAndIPFamilies(dst, src *v1alpha5.VirtualMachineService) {
+	dst.Spec.InterfaceName = src.Spec.InterfaceName
+	dst.Spec.IPFamilies = src.Spec.IPFamilies
+	dst.Spec.IPFamilyPolicy = src.Spec.IPFamilyPolicy
+}
+
+func restore_v1alpha5_VirtualMachineServicePortTargetPortName(dst, src *v1alpha5.VirtualMachineService) {
+	if len(dst.Spec.Ports) != len(src.Spec.Ports) {
+		return
+	}
+	for i := range dst.Spec.Ports {
+		dst.Spec.Ports[i].TargetPortName = src.Spec.Ports[i].TargetPortName
+	}
+}
+
 // ConvertTo converts this VirtualMachineService to the Hub version.
 func (src *VirtualMachineService) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*v1alpha5.VirtualMachineService)
-	return Convert_v1alpha1_VirtualMachineService_To_v1alpha5_VirtualMachineService(src, dst, nil)
+	if err := Convert_v1alpha1_VirtualMachineService_To_v1alpha5_VirtualMachineService(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &v1alpha5.VirtualMachineService{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restore_v1alpha5_VirtualMachineServiceInterfaceAndIPFamilies(dst, restored)
+	restore_v1alpha5_VirtualMachineServicePortTargetPortName(dst, restored)
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this VirtualMachineService.
 func (dst *VirtualMachineService) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*v1alpha5.VirtualMachineService)
-	return Convert_v1alpha5_VirtualMachineService_To_v1alpha1_VirtualMachineService(src, dst, nil)
+	if err := Convert_v1alpha5_VirtualMachineService_To_v1alpha1_VirtualMachineService(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this VirtualMachineServiceList to the Hub version.
@@ -247,21 +247,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha5.VirtualMachineClassSpec)(nil), (*VirtualMachineClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha5_VirtualMachineClassSpec_To_v1alpha1_VirtualMachineClassSpec(a.(*v1alpha5.VirtualMachineClassSpec), b.(*VirtualMachineClassSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineClassStatus)(nil), (*v1alpha5.VirtualMachineClassStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualMachineClassStatus_To_v1alpha5_VirtualMachineClassStatus(a.(*VirtualMachineClassStatus), b.(*v1alpha5.VirtualMachineClassStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha5.VirtualMachineClassStatus)(nil), (*VirtualMachineClassStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha5_VirtualMachineClassStatus_To_v1alpha1_VirtualMachineClassStatus(a.(*v1alpha5.VirtualMachineClassStatus), b.(*VirtualMachineClassStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineImage)(nil), (*v1alpha5.VirtualMachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualMachineImage_To_v1alpha5_VirtualMachineImage(a.(*VirtualMachineImage), b.(*v1alpha5.VirtualMachineImage), scope)
 	}); err != nil {
@@ -417,21 +407,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha5.VirtualMachineServicePort)(nil), (*VirtualMachineServicePort)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineServicePort(a.(*v1alpha5.VirtualMachineServicePort), b.(*VirtualMachineServicePort), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineServiceSpec)(nil), (*v1alpha5.VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineServiceSpec(a.(*VirtualMachineServiceSpec), b.(*v1alpha5.VirtualMachineServiceSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1alpha5.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha5_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(a.(*v1alpha5.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VirtualMachineServiceStatus)(nil), (*v1alpha5.VirtualMachineServiceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VirtualMachineServiceStatus_To_v1alpha5_VirtualMachineServiceStatus(a.(*VirtualMachineServiceStatus), b.(*v1alpha5.VirtualMachineServiceStatus), scope)
 	}); err != nil {
@@ -542,6 +522,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha5.VirtualMachineClassSpec)(nil), (*VirtualMachineClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha5_VirtualMachineClassSpec_To_v1alpha1_VirtualMachineClassSpec(a.(*v1alpha5.VirtualMachineClassSpec), b.(*VirtualMachineClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha5.VirtualMachineClassStatus)(nil), (*VirtualMachineClassStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha5_VirtualMachineClassStatus_To_v1alpha1_VirtualMachineClassStatus(a.(*v1alpha5.VirtualMachineClassStatus), b.(*VirtualMachineClassStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha5.VirtualMachineImageOSInfo)(nil), (*VirtualMachineImageOSInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineImageOSInfo_To_v1alpha1_VirtualMachineImageOSInfo(a.(*v1alpha5.VirtualMachineImageOSInfo), b.(*VirtualMachineImageOSInfo), scope)
 	}); err != nil {
@@ -567,6 +557,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1alpha5.VirtualMachineServicePort)(nil), (*VirtualMachineServicePort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineServicePort(a.(*v1alpha5.VirtualMachineServicePort), b.(*VirtualMachineServicePort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha5.VirtualMachineServiceSpec)(nil), (*VirtualMachineServiceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha5_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(a.(*v1alpha5.VirtualMachineServiceSpec), b.(*VirtualMachineServiceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1alpha5.VirtualMachineSetResourcePolicySpec)(nil), (*VirtualMachineSetResourcePolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha5_VirtualMachineSetResourcePolicySpec_To_v1alpha1_VirtualMachineSetResourcePolicySpec(a.(*v1alpha5.VirtualMachineSetResourcePolicySpec), b.(*VirtualMachineSetResourcePolicySpec), scope)
 	}); err != nil {
@@ -1268,14 +1268,10 @@ func autoConvert_v1alpha5_VirtualMachineClassSpec_To_v1alpha1_VirtualMachineClas
 	out.ConfigSpec = *(*json.RawMessage)(unsafe.Pointer(&in.ConfigSpec))
 	out.ReservedProfileID = in.ReservedProfileID
 	out.ReservedSlots = in.ReservedSlots
+	// WARNING: in.Deprecation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha5_VirtualMachineClassSpec_To_v1alpha1_VirtualMachineClassSpec is an autogenerated conversion function.
-func Convert_v1alpha5_VirtualMachineClassSpec_To_v1alpha1_VirtualMachineClassSpec(in *v1alpha5.VirtualMachineClassSpec, out *VirtualMachineClassSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha5_VirtualMachineClassSpec_To_v1alpha1_VirtualMachineClassSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_VirtualMachineClassStatus_To_v1alpha5_VirtualMachineClassStatus(in *VirtualMachineClassStatus, out *v1alpha5.VirtualMachineClassStatus, s conversion.Scope) error {
 	return nil
 }
@@ -1286,14 +1282,10 @@ func Convert_v1alpha1_VirtualMachineClassStatus_To_v1alpha5_VirtualMachineClassS
 }
 
 func autoConvert_v1alpha5_VirtualMachineClassStatus_To_v1alpha1_VirtualMachineClassStatus(in *v1alpha5.VirtualMachineClassStatus, out *VirtualMachineClassStatus, s conversion.Scope) error {
+	// WARNING: in.Consumers requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha5_VirtualMachineClassStatus_To_v1alpha1_VirtualMachineClassStatus is an autogenerated conversion function.
-func Convert_v1alpha5_VirtualMachineClassStatus_To_v1alpha1_VirtualMachineClassStatus(in *v1alpha5.VirtualMachineClassStatus, out *VirtualMachineClassStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha5_VirtualMachineClassStatus_To_v1alpha1_VirtualMachineClassStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_VirtualMachineImage_To_v1alpha5_VirtualMachineImage(in *VirtualMachineImage, out *v1alpha5.VirtualMachineImage, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_VirtualMachineImageSpec_To_v1alpha5_VirtualMachineImageSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1835,7 +1827,17 @@ func Convert_v1alpha5_VirtualMachineService_To_v1alpha1_VirtualMachineService(in
 
 func autoConvert_v1alpha1_VirtualMachineServiceList_To_v1alpha5_VirtualMachineServiceList(in *VirtualMachineServiceList, out *v1alpha5.VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1alpha5.VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha5.VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VirtualMachineService_To_v1alpha5_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1846,7 +1848,17 @@ func Convert_v1alpha1_VirtualMachineServiceList_To_v1alpha5_VirtualMachineServic
 
 func autoConvert_v1alpha5_VirtualMachineServiceList_To_v1alpha1_VirtualMachineServiceList(in *v1alpha5.VirtualMachineServiceList, out *VirtualMachineServiceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]VirtualMachineService)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineService, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha5_VirtualMachineService_To_v1alpha1_VirtualMachineService(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1873,17 +1885,23 @@ func autoConvert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineSe
 	out.Protocol = in.Protocol
 	out.Port = in.Port
 	out.TargetPort = in.TargetPort
+	// WARNING: in.TargetPortName requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineServicePort is an autogenerated conversion function.
-func Convert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineServicePort(in *v1alpha5.VirtualMachineServicePort, out *VirtualMachineServicePort, s conversion.Scope) error {
-	return autoConvert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineServicePort(in, out, s)
-}
-
 func autoConvert_v1alpha1_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineServiceSpec(in *VirtualMachineServiceSpec, out *v1alpha5.VirtualMachineServiceSpec, s conversion.Scope) error {
 	out.Type = v1alpha5.VirtualMachineServiceType(in.Type)
-	out.Ports = *(*[]v1alpha5.VirtualMachineServicePort)(unsafe.Pointer(&in.Ports))
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]v1alpha5.VirtualMachineServicePort, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VirtualMachineServicePort_To_v1alpha5_VirtualMachineServicePort(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ports = nil
+	}
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.LoadBalancerIP = in.LoadBalancerIP
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
@@ -1899,20 +1917,28 @@ func Convert_v1alpha1_VirtualMachineServiceSpec_To_v1alpha5_VirtualMachineServic
 
 func autoConvert_v1alpha5_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(in *v1alpha5.VirtualMachineServiceSpec, out *VirtualMachineServiceSpec, s conversion.Scope) error {
 	out.Type = VirtualMachineServiceType(in.Type)
-	out.Ports = *(*[]VirtualMachineServicePort)(unsafe.Pointer(&in.Ports))
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]VirtualMachineServicePort, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha5_VirtualMachineServicePort_To_v1alpha1_VirtualMachineServicePort(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ports = nil
+	}
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.LoadBalancerIP = in.LoadBalancerIP
 	out.LoadBalancerSourceRanges = *(*[]string)(unsafe.Pointer(&in.LoadBalancerSourceRanges))
 	out.ClusterIP = in.ClusterIP
 	out.ExternalName = in.ExternalName
+	// WARNING: in.InterfaceName requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilies requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPFamilyPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha5_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec is an autogenerated conversion function.
-func Convert_v1alpha5_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(in *v1alpha5.VirtualMachineServiceSpec, out *VirtualMachineServiceSpec, s conversion.Scope) error {
-	return autoConvert_v1alpha5_VirtualMachineServiceSpec_To_v1alpha1_VirtualMachineServiceSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_VirtualMachineServiceStatus_To_v1alpha5_VirtualMachineServiceStatus(in *VirtualMachineServiceStatus, out *v1alpha5.VirtualMachineServiceStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha1_LoadBalancerStatus_To_v1alpha5_LoadBalancerStatus(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
 		return err
@@ -2104,6 +2130,8 @@ func autoConvert_v1alpha5_VirtualMachineSpec_To_v1alpha1_VirtualMachineSpec(in *
 	out.SuspendMode = VirtualMachinePowerOpMode(in.SuspendMode)
 	out.NextRestartTime = in.NextRestartTime
 	out.RestartMode = VirtualMachinePowerOpMode(in.RestartMode)
+	// WARNING: in.MaintenanceWindow requires manual conversion: does not exist in peer-type
+	// WARNING: in.DriftPolicy requires manual conversion: does not exist in peer-type
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]VirtualMachineVolume, len(*in))
@@ -2219,6 +2247,7 @@ func autoConvert_v1alpha5_VirtualMachineStatus_To_v1alpha1_VirtualMachineStatus(
 	// WARNING: in.Guest requires manual conversion: does not exist in peer-type
 	// WARNING: in.Hardware requires manual conversion: does not exist in peer-type
 	// WARNING: in.Policies requires manual conversion: does not exist in peer-type
+	// WARNING: in.PendingResize requires manual conversion: does not exist in peer-type
 	return nil
 }
 
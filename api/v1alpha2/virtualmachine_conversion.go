@@ -21,6 +21,12 @@ func Convert_v1alpha5_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVo
 	return autoConvert_v1alpha5_PersistentVolumeClaimVolumeSource_To_v1alpha2_PersistentVolumeClaimVolumeSource(in, out, s)
 }
 
+func Convert_v1alpha5_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec(
+	in *vmopv1.VirtualMachineBootstrapSpec, out *VirtualMachineBootstrapSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineBootstrapSpec_To_v1alpha2_VirtualMachineBootstrapSpec(in, out, s)
+}
+
 func Convert_v1alpha5_VirtualMachineBootstrapCloudInitSpec_To_v1alpha2_VirtualMachineBootstrapCloudInitSpec(
 	in *vmopv1.VirtualMachineBootstrapCloudInitSpec, out *VirtualMachineBootstrapCloudInitSpec, s apiconversion.Scope) error {
 
@@ -51,6 +57,12 @@ func Convert_v1alpha5_VirtualMachineNetworkSpec_To_v1alpha2_VirtualMachineNetwor
 	return autoConvert_v1alpha5_VirtualMachineNetworkSpec_To_v1alpha2_VirtualMachineNetworkSpec(in, out, s)
 }
 
+func Convert_v1alpha5_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(
+	in *vmopv1.VirtualMachineNetworkInterfaceSpec, out *VirtualMachineNetworkInterfaceSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkInterfaceSpec_To_v1alpha2_VirtualMachineNetworkInterfaceSpec(in, out, s)
+}
+
 func Convert_v1alpha5_VirtualMachineSpec_To_v1alpha2_VirtualMachineSpec(
 	in *vmopv1.VirtualMachineSpec, out *VirtualMachineSpec, s apiconversion.Scope) error {
 
@@ -177,6 +189,18 @@ func Convert_v1alpha5_VirtualMachine_To_v1alpha2_VirtualMachine(
 	return nil
 }
 
+func Convert_v1alpha5_VirtualMachineNetworkConfigStatus_To_v1alpha2_VirtualMachineNetworkConfigStatus(
+	in *vmopv1.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkConfigStatus_To_v1alpha2_VirtualMachineNetworkConfigStatus(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineNetworkConfigInterfaceIPStatus_To_v1alpha2_VirtualMachineNetworkConfigInterfaceIPStatus(
+	in *vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus, out *VirtualMachineNetworkConfigInterfaceIPStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkConfigInterfaceIPStatus_To_v1alpha2_VirtualMachineNetworkConfigInterfaceIPStatus(in, out, s)
+}
+
 func restore_v1alpha5_VirtualMachineImage(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Image = src.Spec.Image
 	dst.Spec.ImageName = src.Spec.ImageName
@@ -313,6 +337,17 @@ func restore_v1alpha5_VirtualMachineBootstrapCloudInitWaitOnNetwork(dst, src *vm
 	}
 }
 
+func restore_v1alpha5_VirtualMachineBootstrapCloudInitUserDataParts(dst, src *vmopv1.VirtualMachine) {
+	if bs := src.Spec.Bootstrap; bs != nil {
+		if ci := bs.CloudInit; ci != nil && len(ci.UserDataParts) > 0 {
+			// Only restore the parts if dst still has a CloudInit spec.
+			if dst.Spec.Bootstrap != nil && dst.Spec.Bootstrap.CloudInit != nil {
+				dst.Spec.Bootstrap.CloudInit.UserDataParts = ci.UserDataParts
+			}
+		}
+	}
+}
+
 func restore_v1alpha5_VirtualMachineBootstrapLinuxPrep(dst, src *vmopv1.VirtualMachine) {
 	if bs := src.Spec.Bootstrap; bs != nil {
 		if lp := bs.LinuxPrep; lp != nil {
@@ -333,6 +368,10 @@ func restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, src *vmopv1.VirtualMac
 				if sp.Sysprep != nil && dst.Spec.Bootstrap.Sysprep.Sysprep != nil {
 					dst.Spec.Bootstrap.Sysprep.Sysprep.ExpirePasswordAfterNextLogin = sp.Sysprep.ExpirePasswordAfterNextLogin
 					dst.Spec.Bootstrap.Sysprep.Sysprep.ScriptText = sp.Sysprep.ScriptText
+
+					if id := sp.Sysprep.Identification; id != nil && dst.Spec.Bootstrap.Sysprep.Sysprep.Identification != nil {
+						dst.Spec.Bootstrap.Sysprep.Sysprep.Identification.DomainJoin = id.DomainJoin
+					}
 				}
 
 				dst.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn = sp.CustomizeAtNextPowerOn
@@ -341,6 +380,15 @@ func restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, src *vmopv1.VirtualMac
 	}
 }
 
+func restore_v1alpha5_VirtualMachineBootstrapIgnition(dst, src *vmopv1.VirtualMachine) {
+	if bs := src.Spec.Bootstrap; bs != nil && bs.Ignition != nil {
+		// Only restore Ignition if dst still has a bootstrap spec.
+		if dst.Spec.Bootstrap != nil {
+			dst.Spec.Bootstrap.Ignition = bs.Ignition
+		}
+	}
+}
+
 func restore_v1alpha5_VirtualMachineGuestID(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.GuestID = src.Spec.GuestID
 }
@@ -392,6 +440,61 @@ func restore_v1alpha5_VirtualMachinePolicies(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Policies = slices.Clone(src.Spec.Policies)
 }
 
+func restore_v1alpha5_VirtualMachineMaintenanceWindow(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.MaintenanceWindow = src.Spec.MaintenanceWindow
+}
+
+func restore_v1alpha5_VirtualMachineDriftPolicy(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.DriftPolicy = src.Spec.DriftPolicy
+}
+
+func restore_v1alpha5_VirtualMachineNetworkGuestDevices(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil ||
+		(len(src.Spec.Network.VLANs) == 0 && len(src.Spec.Network.Bonds) == 0) {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.VLANs = src.Spec.Network.VLANs
+	dst.Spec.Network.Bonds = src.Spec.Network.Bonds
+}
+
+func restore_v1alpha5_VirtualMachineNetworkFirewall(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil || src.Spec.Network.Firewall == nil {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.Firewall = src.Spec.Network.Firewall
+}
+
+func restore_v1alpha5_VirtualMachineNetworkInterfaceFields(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil || dst.Spec.Network == nil {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	for i := range dst.Spec.Network.Interfaces {
+		dstInterface := &dst.Spec.Network.Interfaces[i]
+
+		// Find the matching interface from the source.
+		for _, srcInterface := range src.Spec.Network.Interfaces {
+			if srcInterface.Name == dstInterface.Name {
+				dstInterface.AdapterType = srcInterface.AdapterType
+				dstInterface.PhysicalFunction = srcInterface.PhysicalFunction
+				dstInterface.IPPoolName = srcInterface.IPPoolName
+				break
+			}
+		}
+	}
+}
+
 func restore_v1alpha5_VirtualMachineCryptoSpec(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Crypto = src.Spec.Crypto
 }
@@ -416,8 +519,10 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	restore_v1alpha5_VirtualMachineBiosUUID(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapCloudInitInstanceID(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapCloudInitWaitOnNetwork(dst, restored)
+	restore_v1alpha5_VirtualMachineBootstrapCloudInitUserDataParts(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapLinuxPrep(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, restored)
+	restore_v1alpha5_VirtualMachineBootstrapIgnition(dst, restored)
 	restore_v1alpha5_VirtualMachineSpecNetworkDomainName(dst, restored)
 	restore_v1alpha5_VirtualMachineGuestID(dst, restored)
 	restore_v1alpha5_VirtualMachinePromoteDisksMode(dst, restored)
@@ -426,6 +531,11 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	restore_v1alpha5_VirtualMachineVolumes(dst, restored)
 	restore_v1alpha5_VirtualMachineHardware(dst, restored)
 	restore_v1alpha5_VirtualMachinePolicies(dst, restored)
+	restore_v1alpha5_VirtualMachineMaintenanceWindow(dst, restored)
+	restore_v1alpha5_VirtualMachineDriftPolicy(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkGuestDevices(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkFirewall(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkInterfaceFields(dst, restored)
 	restore_v1alpha5_VirtualMachineCryptoSpec(dst, restored)
 
 	// END RESTORE
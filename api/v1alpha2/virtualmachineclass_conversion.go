@@ -5,21 +5,57 @@
 package v1alpha2
 
 import (
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
 	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
 
+	"github.com/vmware-tanzu/vm-operator/api/utilconversion"
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 )
 
+func Convert_v1alpha5_VirtualMachineClassSpec_To_v1alpha2_VirtualMachineClassSpec(
+	in *vmopv1.VirtualMachineClassSpec, out *VirtualMachineClassSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineClassSpec_To_v1alpha2_VirtualMachineClassSpec(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineClassStatus_To_v1alpha2_VirtualMachineClassStatus(
+	in *vmopv1.VirtualMachineClassStatus, out *VirtualMachineClassStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineClassStatus_To_v1alpha2_VirtualMachineClassStatus(in, out, s)
+}
+
+func restore_v1alpha5_VirtualMachineClassDeprecation(dst, src *vmopv1.VirtualMachineClass) {
+	dst.Spec.Deprecation = src.Spec.Deprecation
+	dst.Status.Consumers = src.Status.Consumers
+}
+
 // ConvertTo converts this VirtualMachineClass to the Hub version.
 func (src *VirtualMachineClass) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachineClass)
-	return Convert_v1alpha2_VirtualMachineClass_To_v1alpha5_VirtualMachineClass(src, dst, nil)
+	if err := Convert_v1alpha2_VirtualMachineClass_To_v1alpha5_VirtualMachineClass(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &vmopv1.VirtualMachineClass{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restore_v1alpha5_VirtualMachineClassDeprecation(dst, restored)
+
+	return nil
 }
 
 // ConvertFrom converts the hub version to this VirtualMachineClass.
 func (dst *VirtualMachineClass) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	src := srcRaw.(*vmopv1.VirtualMachineClass)
-	return Convert_v1alpha5_VirtualMachineClass_To_v1alpha2_VirtualMachineClass(src, dst, nil)
+	if err := Convert_v1alpha5_VirtualMachineClass_To_v1alpha2_VirtualMachineClass(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this VirtualMachineClassList to the Hub version.
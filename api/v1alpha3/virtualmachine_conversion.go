@@ -110,6 +110,12 @@ func Convert_v1alpha5_VirtualMachineCdromSpec_To_v1alpha3_VirtualMachineCdromSpe
 	return autoConvert_v1alpha5_VirtualMachineCdromSpec_To_v1alpha3_VirtualMachineCdromSpec(in, out, s)
 }
 
+func Convert_v1alpha5_VirtualMachineBootstrapSpec_To_v1alpha3_VirtualMachineBootstrapSpec(
+	in *vmopv1.VirtualMachineBootstrapSpec, out *VirtualMachineBootstrapSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineBootstrapSpec_To_v1alpha3_VirtualMachineBootstrapSpec(in, out, s)
+}
+
 func Convert_v1alpha5_VirtualMachineBootstrapCloudInitSpec_To_v1alpha3_VirtualMachineBootstrapCloudInitSpec(
 	in *vmopv1.VirtualMachineBootstrapCloudInitSpec, out *VirtualMachineBootstrapCloudInitSpec, s apiconversion.Scope) error {
 
@@ -195,6 +201,30 @@ func Convert_v1alpha3_VirtualMachineStatus_To_v1alpha5_VirtualMachineStatus(
 	return nil
 }
 
+func Convert_v1alpha5_VirtualMachineNetworkSpec_To_v1alpha3_VirtualMachineNetworkSpec(
+	in *vmopv1.VirtualMachineNetworkSpec, out *VirtualMachineNetworkSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkSpec_To_v1alpha3_VirtualMachineNetworkSpec(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineNetworkInterfaceSpec_To_v1alpha3_VirtualMachineNetworkInterfaceSpec(
+	in *vmopv1.VirtualMachineNetworkInterfaceSpec, out *VirtualMachineNetworkInterfaceSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkInterfaceSpec_To_v1alpha3_VirtualMachineNetworkInterfaceSpec(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(
+	in *vmopv1.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkConfigStatus_To_v1alpha3_VirtualMachineNetworkConfigStatus(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineNetworkConfigInterfaceIPStatus_To_v1alpha3_VirtualMachineNetworkConfigInterfaceIPStatus(
+	in *vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus, out *VirtualMachineNetworkConfigInterfaceIPStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkConfigInterfaceIPStatus_To_v1alpha3_VirtualMachineNetworkConfigInterfaceIPStatus(in, out, s)
+}
+
 func restore_v1alpha5_VirtualMachinePromoteDisksMode(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.PromoteDisksMode = src.Spec.PromoteDisksMode
 }
@@ -298,6 +328,17 @@ func restore_v1alpha5_VirtualMachineBootstrapCloudInitWaitOnNetwork(dst, src *vm
 	}
 }
 
+func restore_v1alpha5_VirtualMachineBootstrapCloudInitUserDataParts(dst, src *vmopv1.VirtualMachine) {
+	if bs := src.Spec.Bootstrap; bs != nil {
+		if ci := bs.CloudInit; ci != nil && len(ci.UserDataParts) > 0 {
+			// Only restore the parts if dst still has a CloudInit spec.
+			if dst.Spec.Bootstrap != nil && dst.Spec.Bootstrap.CloudInit != nil {
+				dst.Spec.Bootstrap.CloudInit.UserDataParts = ci.UserDataParts
+			}
+		}
+	}
+}
+
 func restore_v1alpha5_VirtualMachineBootstrapLinuxPrep(dst, src *vmopv1.VirtualMachine) {
 	if bs := src.Spec.Bootstrap; bs != nil {
 		if lp := bs.LinuxPrep; lp != nil {
@@ -318,6 +359,10 @@ func restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, src *vmopv1.VirtualMac
 				if sp.Sysprep != nil && dst.Spec.Bootstrap.Sysprep.Sysprep != nil {
 					dst.Spec.Bootstrap.Sysprep.Sysprep.ExpirePasswordAfterNextLogin = sp.Sysprep.ExpirePasswordAfterNextLogin
 					dst.Spec.Bootstrap.Sysprep.Sysprep.ScriptText = sp.Sysprep.ScriptText
+
+					if id := sp.Sysprep.Identification; id != nil && dst.Spec.Bootstrap.Sysprep.Sysprep.Identification != nil {
+						dst.Spec.Bootstrap.Sysprep.Sysprep.Identification.DomainJoin = id.DomainJoin
+					}
 				}
 
 				dst.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn = sp.CustomizeAtNextPowerOn
@@ -326,10 +371,74 @@ func restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, src *vmopv1.VirtualMac
 	}
 }
 
+func restore_v1alpha5_VirtualMachineBootstrapIgnition(dst, src *vmopv1.VirtualMachine) {
+	if bs := src.Spec.Bootstrap; bs != nil && bs.Ignition != nil {
+		// Only restore Ignition if dst still has a bootstrap spec.
+		if dst.Spec.Bootstrap != nil {
+			dst.Spec.Bootstrap.Ignition = bs.Ignition
+		}
+	}
+}
+
 func restore_v1alpha5_VirtualMachinePolicies(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Policies = slices.Clone(src.Spec.Policies)
 }
 
+func restore_v1alpha5_VirtualMachineMaintenanceWindow(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.MaintenanceWindow = src.Spec.MaintenanceWindow
+}
+
+func restore_v1alpha5_VirtualMachineDriftPolicy(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.DriftPolicy = src.Spec.DriftPolicy
+}
+
+func restore_v1alpha5_VirtualMachineNetworkGuestDevices(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil ||
+		(len(src.Spec.Network.VLANs) == 0 && len(src.Spec.Network.Bonds) == 0) {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.VLANs = src.Spec.Network.VLANs
+	dst.Spec.Network.Bonds = src.Spec.Network.Bonds
+}
+
+func restore_v1alpha5_VirtualMachineNetworkFirewall(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil || src.Spec.Network.Firewall == nil {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.Firewall = src.Spec.Network.Firewall
+}
+
+func restore_v1alpha5_VirtualMachineNetworkInterfaceFields(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil || dst.Spec.Network == nil {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	for i := range dst.Spec.Network.Interfaces {
+		dstInterface := &dst.Spec.Network.Interfaces[i]
+
+		// Find the matching interface from the source.
+		for _, srcInterface := range src.Spec.Network.Interfaces {
+			if srcInterface.Name == dstInterface.Name {
+				dstInterface.AdapterType = srcInterface.AdapterType
+				dstInterface.PhysicalFunction = srcInterface.PhysicalFunction
+				dstInterface.IPPoolName = srcInterface.IPPoolName
+				break
+			}
+		}
+	}
+}
+
 func restore_v1alpha5_VirtualMachineCryptoSpec(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Crypto = src.Spec.Crypto
 }
@@ -350,14 +459,21 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	// BEGIN RESTORE
 
 	restore_v1alpha5_VirtualMachineBootstrapCloudInitWaitOnNetwork(dst, restored)
+	restore_v1alpha5_VirtualMachineBootstrapCloudInitUserDataParts(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapLinuxPrep(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, restored)
+	restore_v1alpha5_VirtualMachineBootstrapIgnition(dst, restored)
 	restore_v1alpha5_VirtualMachinePromoteDisksMode(dst, restored)
 	restore_v1alpha5_VirtualMachineBootOptions(dst, restored)
 	restore_v1alpha5_AffinitySpec(dst, restored)
 	restore_v1alpha5_VirtualMachineVolumes(dst, restored)
 	restore_v1alpha5_VirtualMachineHardware(dst, restored)
 	restore_v1alpha5_VirtualMachinePolicies(dst, restored)
+	restore_v1alpha5_VirtualMachineMaintenanceWindow(dst, restored)
+	restore_v1alpha5_VirtualMachineDriftPolicy(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkGuestDevices(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkFirewall(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkInterfaceFields(dst, restored)
 	restore_v1alpha5_VirtualMachineCryptoSpec(dst, restored)
 
 	// END RESTORE
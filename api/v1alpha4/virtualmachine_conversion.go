@@ -35,6 +35,12 @@ func Convert_v1alpha4_VirtualMachineImageDiskInfo_To_v1alpha5_VirtualMachineImag
 	return autoConvert_v1alpha4_VirtualMachineImageDiskInfo_To_v1alpha5_VirtualMachineImageDiskInfo(in, out, s)
 }
 
+func Convert_v1alpha5_VirtualMachineBootstrapSpec_To_v1alpha4_VirtualMachineBootstrapSpec(
+	in *vmopv1.VirtualMachineBootstrapSpec, out *VirtualMachineBootstrapSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineBootstrapSpec_To_v1alpha4_VirtualMachineBootstrapSpec(in, out, s)
+}
+
 func Convert_v1alpha5_VirtualMachineBootstrapCloudInitSpec_To_v1alpha4_VirtualMachineBootstrapCloudInitSpec(
 	in *vmopv1.VirtualMachineBootstrapCloudInitSpec, out *VirtualMachineBootstrapCloudInitSpec, s apiconversion.Scope) error {
 
@@ -147,6 +153,30 @@ func Convert_v1alpha5_VirtualMachineCryptoStatus_To_v1alpha4_VirtualMachineCrypt
 	return autoConvert_v1alpha5_VirtualMachineCryptoStatus_To_v1alpha4_VirtualMachineCryptoStatus(in, out, s)
 }
 
+func Convert_v1alpha5_VirtualMachineNetworkSpec_To_v1alpha4_VirtualMachineNetworkSpec(
+	in *vmopv1.VirtualMachineNetworkSpec, out *VirtualMachineNetworkSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkSpec_To_v1alpha4_VirtualMachineNetworkSpec(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineNetworkInterfaceSpec_To_v1alpha4_VirtualMachineNetworkInterfaceSpec(
+	in *vmopv1.VirtualMachineNetworkInterfaceSpec, out *VirtualMachineNetworkInterfaceSpec, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkInterfaceSpec_To_v1alpha4_VirtualMachineNetworkInterfaceSpec(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineNetworkConfigStatus_To_v1alpha4_VirtualMachineNetworkConfigStatus(
+	in *vmopv1.VirtualMachineNetworkConfigStatus, out *VirtualMachineNetworkConfigStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkConfigStatus_To_v1alpha4_VirtualMachineNetworkConfigStatus(in, out, s)
+}
+
+func Convert_v1alpha5_VirtualMachineNetworkConfigInterfaceIPStatus_To_v1alpha4_VirtualMachineNetworkConfigInterfaceIPStatus(
+	in *vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus, out *VirtualMachineNetworkConfigInterfaceIPStatus, s apiconversion.Scope) error {
+
+	return autoConvert_v1alpha5_VirtualMachineNetworkConfigInterfaceIPStatus_To_v1alpha4_VirtualMachineNetworkConfigInterfaceIPStatus(in, out, s)
+}
+
 func restore_v1alpha5_VirtualMachineBootOptions(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.BootOptions = src.Spec.BootOptions
 }
@@ -201,6 +231,61 @@ func restore_v1alpha5_VirtualMachinePolicies(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Policies = slices.Clone(src.Spec.Policies)
 }
 
+func restore_v1alpha5_VirtualMachineMaintenanceWindow(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.MaintenanceWindow = src.Spec.MaintenanceWindow
+}
+
+func restore_v1alpha5_VirtualMachineDriftPolicy(dst, src *vmopv1.VirtualMachine) {
+	dst.Spec.DriftPolicy = src.Spec.DriftPolicy
+}
+
+func restore_v1alpha5_VirtualMachineNetworkGuestDevices(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil ||
+		(len(src.Spec.Network.VLANs) == 0 && len(src.Spec.Network.Bonds) == 0) {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.VLANs = src.Spec.Network.VLANs
+	dst.Spec.Network.Bonds = src.Spec.Network.Bonds
+}
+
+func restore_v1alpha5_VirtualMachineNetworkFirewall(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil || src.Spec.Network.Firewall == nil {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	if dst.Spec.Network == nil {
+		dst.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	}
+	dst.Spec.Network.Firewall = src.Spec.Network.Firewall
+}
+
+func restore_v1alpha5_VirtualMachineNetworkInterfaceFields(dst, src *vmopv1.VirtualMachine) {
+	if src.Spec.Network == nil || dst.Spec.Network == nil {
+		// There is nothing to restore so return early.
+		return
+	}
+
+	for i := range dst.Spec.Network.Interfaces {
+		dstInterface := &dst.Spec.Network.Interfaces[i]
+
+		// Find the matching interface from the source.
+		for _, srcInterface := range src.Spec.Network.Interfaces {
+			if srcInterface.Name == dstInterface.Name {
+				dstInterface.AdapterType = srcInterface.AdapterType
+				dstInterface.PhysicalFunction = srcInterface.PhysicalFunction
+				dstInterface.IPPoolName = srcInterface.IPPoolName
+				break
+			}
+		}
+	}
+}
+
 func restore_v1alpha5_VirtualMachineCryptoSpec(dst, src *vmopv1.VirtualMachine) {
 	dst.Spec.Crypto = src.Spec.Crypto
 }
@@ -233,6 +318,17 @@ func Convert_v1alpha5_VirtualMachineSnapshotReference_To_common_LocalObjectRef(
 	return nil
 }
 
+func restore_v1alpha5_VirtualMachineBootstrapCloudInitUserDataParts(dst, src *vmopv1.VirtualMachine) {
+	if bs := src.Spec.Bootstrap; bs != nil {
+		if ci := bs.CloudInit; ci != nil && len(ci.UserDataParts) > 0 {
+			// Only restore the parts if dst still has a CloudInit spec.
+			if dst.Spec.Bootstrap != nil && dst.Spec.Bootstrap.CloudInit != nil {
+				dst.Spec.Bootstrap.CloudInit.UserDataParts = ci.UserDataParts
+			}
+		}
+	}
+}
+
 func restore_v1alpha5_VirtualMachineBootstrapLinuxPrep(dst, src *vmopv1.VirtualMachine) {
 	if bs := src.Spec.Bootstrap; bs != nil {
 		if lp := bs.LinuxPrep; lp != nil {
@@ -253,6 +349,10 @@ func restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, src *vmopv1.VirtualMac
 				if sp.Sysprep != nil && dst.Spec.Bootstrap.Sysprep.Sysprep != nil {
 					dst.Spec.Bootstrap.Sysprep.Sysprep.ExpirePasswordAfterNextLogin = sp.Sysprep.ExpirePasswordAfterNextLogin
 					dst.Spec.Bootstrap.Sysprep.Sysprep.ScriptText = sp.Sysprep.ScriptText
+
+					if id := sp.Sysprep.Identification; id != nil && dst.Spec.Bootstrap.Sysprep.Sysprep.Identification != nil {
+						dst.Spec.Bootstrap.Sysprep.Sysprep.Identification.DomainJoin = id.DomainJoin
+					}
 				}
 
 				dst.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn = sp.CustomizeAtNextPowerOn
@@ -261,6 +361,15 @@ func restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, src *vmopv1.VirtualMac
 	}
 }
 
+func restore_v1alpha5_VirtualMachineBootstrapIgnition(dst, src *vmopv1.VirtualMachine) {
+	if bs := src.Spec.Bootstrap; bs != nil && bs.Ignition != nil {
+		// Only restore Ignition if dst still has a bootstrap spec.
+		if dst.Spec.Bootstrap != nil {
+			dst.Spec.Bootstrap.Ignition = bs.Ignition
+		}
+	}
+}
+
 // ConvertTo converts this VirtualMachine to the Hub version.
 func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	dst := dstRaw.(*vmopv1.VirtualMachine)
@@ -278,11 +387,18 @@ func (src *VirtualMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 
 	restore_v1alpha5_VirtualMachineHardware(dst, restored)
 	restore_v1alpha5_VirtualMachinePolicies(dst, restored)
+	restore_v1alpha5_VirtualMachineMaintenanceWindow(dst, restored)
+	restore_v1alpha5_VirtualMachineDriftPolicy(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkGuestDevices(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkFirewall(dst, restored)
+	restore_v1alpha5_VirtualMachineNetworkInterfaceFields(dst, restored)
 	restore_v1alpha5_VirtualMachineBootOptions(dst, restored)
 	restore_v1alpha5_VirtualMachineCryptoSpec(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapCloudInitWaitOnNetwork(dst, restored)
+	restore_v1alpha5_VirtualMachineBootstrapCloudInitUserDataParts(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapLinuxPrep(dst, restored)
 	restore_v1alpha5_VirtualMachineBootstrapSysprep(dst, restored)
+	restore_v1alpha5_VirtualMachineBootstrapIgnition(dst, restored)
 
 	// END RESTORE
 
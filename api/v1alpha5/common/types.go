@@ -53,6 +53,16 @@ type SecretKeySelector struct {
 	Key string `json:"key"`
 }
 
+// ConfigMapKeySelector references data from a ConfigMap resource by a
+// specific key.
+type ConfigMapKeySelector struct {
+	// Name is the name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Key is the key in the ConfigMap that specifies the requested data.
+	Key string `json:"key"`
+}
+
 // ValueOrSecretKeySelector describes a value from either a SecretKeySelector
 // or value directly in this object.
 type ValueOrSecretKeySelector struct {
@@ -171,6 +171,53 @@ type Identification struct {
 	// spec.bootstrap.sysprep.identification.domainAdmin, and
 	// spec.bootstrap.sysprep.identification.domainAdminPassword must be empty.
 	JoinWorkgroup string `json:"joinWorkgroup,omitempty"`
+
+	// +optional
+
+	// DomainJoin describes the credentials used to join the domain specified
+	// by spec.network.domainName.
+	//
+	// Unlike DomainAdmin and DomainAdminPassword, rotating the credentials in
+	// the referenced Secret does not cause a VM that has already joined the
+	// domain to be customized again. A VM that has not yet joined the domain
+	// is customized again at its next power on with the new credentials.
+	//
+	// Whether or not the VM has joined the domain is reported by the guest
+	// with the guestinfo.vmservice.sysprep.domainjoin.condition key.
+	//
+	// Please note this field is mutually exclusive with DomainAdmin,
+	// DomainAdminPassword, and JoinWorkgroup.
+	DomainJoin *DomainJoin `json:"domainJoin,omitempty"`
+}
+
+// DomainJoin describes how a virtual machine joins a domain.
+type DomainJoin struct {
+	// Credentials references the Secret resource that contains the domain user
+	// account and password used to join the domain.
+	Credentials DomainJoinCredentialsSecretKeySelector `json:"credentials"`
+}
+
+// DomainJoinCredentialsSecretKeySelector references the domain user account
+// and password from a Secret resource.
+type DomainJoinCredentialsSecretKeySelector struct {
+	// Name is the name of the secret.
+	Name string `json:"name"`
+
+	// +optional
+	// +kubebuilder:default=username
+
+	// UsernameKey is the key in the secret that specifies the domain user
+	// account. The user does not need to be a domain administrator, but the
+	// account must have the privileges required to add computers to the
+	// domain.
+	UsernameKey string `json:"usernameKey,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=password
+
+	// PasswordKey is the key in the secret that specifies the password for the
+	// domain user account.
+	PasswordKey string `json:"passwordKey,omitempty"`
 }
 
 // DomainPasswordSecretKeySelector references the password value from a Secret resource.
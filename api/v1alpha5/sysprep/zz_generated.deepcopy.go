@@ -12,6 +12,37 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainJoin) DeepCopyInto(out *DomainJoin) {
+	*out = *in
+	out.Credentials = in.Credentials
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainJoin.
+func (in *DomainJoin) DeepCopy() *DomainJoin {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainJoin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainJoinCredentialsSecretKeySelector) DeepCopyInto(out *DomainJoinCredentialsSecretKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainJoinCredentialsSecretKeySelector.
+func (in *DomainJoinCredentialsSecretKeySelector) DeepCopy() *DomainJoinCredentialsSecretKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainJoinCredentialsSecretKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainPasswordSecretKeySelector) DeepCopyInto(out *DomainPasswordSecretKeySelector) {
 	*out = *in
@@ -75,6 +106,11 @@ func (in *Identification) DeepCopyInto(out *Identification) {
 		*out = new(DomainPasswordSecretKeySelector)
 		**out = **in
 	}
+	if in.DomainJoin != nil {
+		in, out := &in.DomainJoin, &out.DomainJoin
+		*out = new(DomainJoin)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Identification.
@@ -81,6 +81,20 @@ type VirtualMachineBootstrapSpec struct {
 	// This bootstrap provider may not be used in conjunction with the CloudInit
 	// bootstrap provider.
 	VAppConfig *VirtualMachineBootstrapVAppConfigSpec `json:"vAppConfig,omitempty"`
+
+	// +optional
+
+	// Ignition may be used to bootstrap immutable Linux guests that use
+	// Ignition, such as Fedora CoreOS and Flatcar Container Linux.
+	//
+	// The Ignition config is sent into the guest with the guestinfo key
+	// guestinfo.ignition.config.data. Unless disabled, the guest's networking
+	// stack is configured by systemd-networkd units that are merged into the
+	// provided Ignition config.
+	//
+	// Please note this bootstrap provider may not be used in conjunction with
+	// the other bootstrap providers.
+	Ignition *VirtualMachineBootstrapIgnitionSpec `json:"ignition,omitempty"`
 }
 
 // VirtualMachineBootstrapCloudInitSpec describes the CloudInit configuration
@@ -111,6 +125,23 @@ type VirtualMachineBootstrapCloudInitSpec struct {
 	// Please note this field and CloudConfig are mutually exclusive.
 	RawCloudConfig *vmopv1common.SecretKeySelector `json:"rawCloudConfig,omitempty"`
 
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// UserDataParts is a list of parts that are assembled, in order, into a
+	// MIME multi-part user data document used to bootstrap the VM.
+	//
+	// This makes it possible to layer a shared, base configuration, such as
+	// CA certificates or agents, under an application's configuration.
+	//
+	// The VM is bootstrapped again with the new document when the data from
+	// any of the parts changes.
+	//
+	// Please note this field is mutually exclusive with CloudConfig and
+	// RawCloudConfig.
+	UserDataParts []VirtualMachineBootstrapCloudInitUserDataPart `json:"userDataParts,omitempty"`
+
 	// +optional
 
 	// SSHAuthorizedKeys is a list of public keys that CloudInit will apply to
@@ -156,6 +187,145 @@ type VirtualMachineBootstrapCloudInitSpec struct {
 	WaitOnNetwork6 *bool `json:"waitOnNetwork6,omitempty"`
 }
 
+// VirtualMachineBootstrapCloudInitUserDataPartContentType is the MIME type of a
+// part of a multi-part user data document.
+//
+// +kubebuilder:validation:Enum=text/cloud-config;text/x-shellscript;text/cloud-boothook;text/jinja2;text/x-include-url;text/part-handler;text/cloud-config-archive
+type VirtualMachineBootstrapCloudInitUserDataPartContentType string
+
+const (
+	// VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig
+	// indicates the part is a Cloud-Init CloudConfig document.
+	VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig VirtualMachineBootstrapCloudInitUserDataPartContentType = "text/cloud-config"
+
+	// VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript
+	// indicates the part is a script that is run once per instance.
+	VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript VirtualMachineBootstrapCloudInitUserDataPartContentType = "text/x-shellscript"
+
+	// VirtualMachineBootstrapCloudInitUserDataPartContentTypeBoothook
+	// indicates the part is a script that is run on every boot.
+	VirtualMachineBootstrapCloudInitUserDataPartContentTypeBoothook VirtualMachineBootstrapCloudInitUserDataPartContentType = "text/cloud-boothook"
+
+	// VirtualMachineBootstrapCloudInitUserDataPartContentTypeJinja2
+	// indicates the part is a Jinja template.
+	VirtualMachineBootstrapCloudInitUserDataPartContentTypeJinja2 VirtualMachineBootstrapCloudInitUserDataPartContentType = "text/jinja2"
+
+	// VirtualMachineBootstrapCloudInitUserDataPartContentTypeIncludeURL
+	// indicates the part is a list of URLs from which user data is read.
+	VirtualMachineBootstrapCloudInitUserDataPartContentTypeIncludeURL VirtualMachineBootstrapCloudInitUserDataPartContentType = "text/x-include-url"
+
+	// VirtualMachineBootstrapCloudInitUserDataPartContentTypePartHandler
+	// indicates the part is a custom part handler.
+	VirtualMachineBootstrapCloudInitUserDataPartContentTypePartHandler VirtualMachineBootstrapCloudInitUserDataPartContentType = "text/part-handler"
+
+	// VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfigArchive
+	// indicates the part is a Cloud-Init CloudConfig archive.
+	VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfigArchive VirtualMachineBootstrapCloudInitUserDataPartContentType = "text/cloud-config-archive"
+)
+
+// VirtualMachineBootstrapCloudInitUserDataPart describes a part of a MIME
+// multi-part user data document.
+//
+// Exactly one of Value, ConfigMap, or Secret must be specified.
+type VirtualMachineBootstrapCloudInitUserDataPart struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+
+	// Name uniquely identifies the part and is used as the part's filename
+	// in the MIME document.
+	Name string `json:"name"`
+
+	// +optional
+	// +kubebuilder:default="text/cloud-config"
+
+	// ContentType is the MIME type of the part.
+	//
+	// Defaults to text/cloud-config.
+	ContentType VirtualMachineBootstrapCloudInitUserDataPartContentType `json:"contentType,omitempty"`
+
+	// +optional
+
+	// Value is used to directly specify the part's data.
+	Value *string `json:"value,omitempty"`
+
+	// +optional
+
+	// ConfigMap references the part's data from a key in a ConfigMap
+	// resource.
+	ConfigMap *vmopv1common.ConfigMapKeySelector `json:"configMap,omitempty"`
+
+	// +optional
+
+	// Secret references the part's data from a key in a Secret resource.
+	//
+	// The data specified by the Secret key may be plain-text, base64-encoded,
+	// or gzipped and base64-encoded.
+	Secret *vmopv1common.SecretKeySelector `json:"secret,omitempty"`
+}
+
+// VirtualMachineBootstrapIgnitionEncoding is the encoding used to send the
+// Ignition config into the guest.
+//
+// +kubebuilder:validation:Enum=Base64;GzipBase64
+type VirtualMachineBootstrapIgnitionEncoding string
+
+const (
+	// VirtualMachineBootstrapIgnitionEncodingBase64 indicates the Ignition
+	// config is base64-encoded.
+	VirtualMachineBootstrapIgnitionEncodingBase64 VirtualMachineBootstrapIgnitionEncoding = "Base64"
+
+	// VirtualMachineBootstrapIgnitionEncodingGzipBase64 indicates the Ignition
+	// config is gzipped and base64-encoded.
+	VirtualMachineBootstrapIgnitionEncodingGzipBase64 VirtualMachineBootstrapIgnitionEncoding = "GzipBase64"
+)
+
+// VirtualMachineBootstrapIgnitionSpec describes the Ignition configuration
+// used to bootstrap the VM.
+type VirtualMachineBootstrapIgnitionSpec struct {
+	// +optional
+
+	// Config is an inline Ignition config in the JSON format, ex.:
+	//
+	//   {"ignition":{"version":"3.4.0"}}
+	//
+	// Please note this field and RawConfig are mutually exclusive.
+	Config string `json:"config,omitempty"`
+
+	// +optional
+
+	// RawConfig describes a key in a Secret resource that contains the
+	// Ignition config used to bootstrap the VM.
+	//
+	// The Ignition config specified by the key may be plain-text,
+	// base64-encoded, or gzipped and base64-encoded.
+	//
+	// When not explicitly specified, the Key field for the selector defaults
+	// to `config.ign`.
+	//
+	// Please note this field and Config are mutually exclusive.
+	RawConfig *vmopv1common.SecretKeySelector `json:"rawConfig,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=GzipBase64
+
+	// Encoding describes how the Ignition config is encoded when it is sent
+	// into the guest.
+	//
+	// Defaults to GzipBase64 if omitted.
+	Encoding VirtualMachineBootstrapIgnitionEncoding `json:"encoding,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=true
+
+	// MergeNetworkConfig indicates whether systemd-networkd units for the VM's
+	// network interfaces, as well as the file /etc/hostname, are merged into
+	// the Ignition config. Files already present in the Ignition config are
+	// never replaced.
+	//
+	// Defaults to true if omitted.
+	MergeNetworkConfig *bool `json:"mergeNetworkConfig,omitempty"`
+}
+
 // VirtualMachineBootstrapLinuxPrepSpec describes the LinuxPrep configuration
 // used to bootstrap the VM.
 type VirtualMachineBootstrapLinuxPrepSpec struct {
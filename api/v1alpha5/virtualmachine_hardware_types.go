@@ -410,6 +410,33 @@ type VirtualMachineHardwareSpec struct {
 	// SCSIControllers describes the desired list of SCSI controllers for the
 	// VM.
 	SCSIControllers []SCSIControllerSpec `json:"scsiControllers,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+
+	// CPUs describes the number of virtual processors for the VM. When set,
+	// this value overrides the number of processors from the VM's class.
+	//
+	// If the VM is powered on, an increase is applied immediately when CPU
+	// hot-add is enabled for the VM. Otherwise the change is applied the next
+	// time the VM is power cycled.
+	//
+	// The value may not exceed the limit described by the
+	// vmoperator.vmware.com/max-cpus annotation on the VM's namespace.
+	CPUs *int64 `json:"cpus,omitempty"`
+
+	// +optional
+
+	// Memory describes the amount of memory for the VM. When set, this value
+	// overrides the amount of memory from the VM's class.
+	//
+	// If the VM is powered on, an increase is applied immediately when memory
+	// hot-add is enabled for the VM. Otherwise the change is applied the next
+	// time the VM is power cycled.
+	//
+	// The value may not exceed the limit described by the
+	// vmoperator.vmware.com/max-memory annotation on the VM's namespace.
+	Memory *resource.Quantity `json:"memory,omitempty"`
 }
 
 type VirtualMachineCPUAllocationStatus struct {
@@ -179,6 +179,290 @@ type VirtualMachineNetworkInterfaceSpec struct {
 	// or true, if search domains is not provided, the global search domains
 	// will be used instead.
 	SearchDomains []string `json:"searchDomains,omitempty"`
+
+	// +optional
+
+	// AdapterType describes the type of the network adapter used for this
+	// interface.
+	//
+	// Please note the SRIOV and DirectPath adapter types require the VM's
+	// memory to be fully reserved.
+	//
+	// If omitted, the adapter type is Vmxnet3.
+	AdapterType VirtualMachineNetworkInterfaceAdapterType `json:"adapterType,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Pattern="^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}[.][0-7]$"
+
+	// PhysicalFunction is the PCI ID of the SR-IOV physical function, ex.
+	// 0000:3b:00.0, that backs this interface's virtual function.
+	//
+	// If omitted, the virtual function is allocated from any SR-IOV physical
+	// function on the host that is connected to the interface's network.
+	//
+	// Please note this field is only supported when AdapterType is SRIOV.
+	PhysicalFunction string `json:"physicalFunction,omitempty"`
+
+	// +optional
+
+	// IPPoolName is the name of a VirtualMachineIPPool in the same namespace
+	// as the VM from which static addresses are allocated for this interface.
+	//
+	// The allocated IP addresses are used instead of Addresses, and the
+	// allocated MAC address, if any, is used when MACAddr is omitted. The
+	// pool's gateways are used when Gateway4 and Gateway6 are omitted. The
+	// addresses are released when the VM is deleted or the interface no
+	// longer references the pool.
+	//
+	// Please note this field is only supported with the VDS and named network
+	// providers.
+	IPPoolName string `json:"ipPoolName,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Vmxnet3;SRIOV;DirectPath
+
+// VirtualMachineNetworkInterfaceAdapterType is the type of a network
+// interface's network adapter.
+type VirtualMachineNetworkInterfaceAdapterType string
+
+const (
+	// VirtualMachineNetworkInterfaceAdapterTypeVmxnet3 indicates the interface
+	// uses a paravirtualized VMXNET3 adapter.
+	VirtualMachineNetworkInterfaceAdapterTypeVmxnet3 VirtualMachineNetworkInterfaceAdapterType = "Vmxnet3"
+
+	// VirtualMachineNetworkInterfaceAdapterTypeSRIOV indicates the interface
+	// uses an SR-IOV virtual function of a physical NIC on the host.
+	VirtualMachineNetworkInterfaceAdapterTypeSRIOV VirtualMachineNetworkInterfaceAdapterType = "SRIOV"
+
+	// VirtualMachineNetworkInterfaceAdapterTypeDirectPath indicates the
+	// interface uses a VMXNET3 adapter with DirectPath I/O, also known as
+	// Uniform Passthrough (UPT), enabled.
+	VirtualMachineNetworkInterfaceAdapterTypeDirectPath VirtualMachineNetworkInterfaceAdapterType = "DirectPath"
+)
+
+// VirtualMachineNetworkGuestDeviceIPSpec describes the desired IP
+// configuration of a network device that exists only inside the guest, such
+// as a VLAN sub-interface or a bond.
+type VirtualMachineNetworkGuestDeviceIPSpec struct {
+	// +optional
+
+	// Addresses is an optional list of IP4 or IP6 addresses to assign to this
+	// device.
+	//
+	// Please note IP4 and IP6 addresses must include the network prefix length,
+	// ex. 192.168.0.10/24 or 2001:db8:101::a/64.
+	//
+	// Please note this field may not contain IP4 addresses if DHCP4 is set
+	// to true or IP6 addresses if DHCP6 is set to true.
+	Addresses []string `json:"addresses,omitempty"`
+
+	// +optional
+
+	// DHCP4 indicates whether or not this device uses DHCP for IP4 networking.
+	//
+	// Please note this field is mutually exclusive with IP4 addresses in the
+	// Addresses field and the Gateway4 field.
+	DHCP4 bool `json:"dhcp4,omitempty"`
+
+	// +optional
+
+	// DHCP6 indicates whether or not this device uses DHCP for IP6 networking.
+	//
+	// Please note this field is mutually exclusive with IP6 addresses in the
+	// Addresses field and the Gateway6 field.
+	DHCP6 bool `json:"dhcp6,omitempty"`
+
+	// +optional
+
+	// Gateway4 is the default, IP4 gateway for this device.
+	//
+	// Please note this field is mutually exclusive with DHCP4.
+	Gateway4 string `json:"gateway4,omitempty"`
+
+	// +optional
+
+	// Gateway6 is the primary IP6 gateway for this device.
+	//
+	// Please note this field is mutually exclusive with DHCP6.
+	Gateway6 string `json:"gateway6,omitempty"`
+
+	// +optional
+
+	// MTU is the Maximum Transmission Unit size in bytes.
+	MTU *int64 `json:"mtu,omitempty"`
+
+	// +optional
+
+	// Nameservers is a list of IP4 and/or IP6 addresses used as DNS
+	// nameservers.
+	//
+	// Please note that Linux allows only three nameservers
+	// (https://linux.die.net/man/5/resolv.conf).
+	Nameservers []string `json:"nameservers,omitempty"`
+
+	// +optional
+
+	// Routes is a list of optional, static routes.
+	Routes []VirtualMachineNetworkRouteSpec `json:"routes,omitempty"`
+
+	// +optional
+
+	// SearchDomains is a list of search domains used when resolving IP
+	// addresses with DNS.
+	SearchDomains []string `json:"searchDomains,omitempty"`
+}
+
+// VirtualMachineNetworkVLANSpec describes the desired state of an 802.1Q VLAN
+// sub-interface inside the guest.
+type VirtualMachineNetworkVLANSpec struct {
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9][a-zA-Z0-9._-]{0,14}$"
+
+	// Name describes the name of the VLAN device inside the guest, ex.
+	// eth0.100.
+	//
+	// The name must be unique across the VM's network interfaces, VLANs, and
+	// bonds, and may not exceed 15 characters.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+
+	// ID is the VLAN ID.
+	ID int32 `json:"id"`
+
+	// Link is the name of the network interface or bond on which this VLAN is
+	// created. The value must match the name of an entry in either
+	// spec.network.interfaces or spec.network.bonds.
+	Link string `json:"link"`
+
+	VirtualMachineNetworkGuestDeviceIPSpec `json:",inline"`
+}
+
+// +kubebuilder:validation:Enum=active-backup;balance-rr;balance-xor;broadcast;"802.3ad";balance-tlb;balance-alb
+
+// VirtualMachineNetworkBondMode describes the bonding mode of a bond inside
+// the guest.
+type VirtualMachineNetworkBondMode string
+
+const (
+	VirtualMachineNetworkBondModeActiveBackup VirtualMachineNetworkBondMode = "active-backup"
+	VirtualMachineNetworkBondModeBalanceRR    VirtualMachineNetworkBondMode = "balance-rr"
+	VirtualMachineNetworkBondModeBalanceXOR   VirtualMachineNetworkBondMode = "balance-xor"
+	VirtualMachineNetworkBondModeBroadcast    VirtualMachineNetworkBondMode = "broadcast"
+	VirtualMachineNetworkBondMode8023AD       VirtualMachineNetworkBondMode = "802.3ad"
+	VirtualMachineNetworkBondModeBalanceTLB   VirtualMachineNetworkBondMode = "balance-tlb"
+	VirtualMachineNetworkBondModeBalanceALB   VirtualMachineNetworkBondMode = "balance-alb"
+)
+
+// VirtualMachineNetworkBondSpec describes the desired state of a bond inside
+// the guest.
+type VirtualMachineNetworkBondSpec struct {
+	// +kubebuilder:validation:Pattern="^[a-zA-Z0-9][a-zA-Z0-9._-]{0,14}$"
+
+	// Name describes the name of the bond device inside the guest, ex. bond0.
+	//
+	// The name must be unique across the VM's network interfaces, VLANs, and
+	// bonds, and may not exceed 15 characters.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinItems=1
+
+	// Interfaces is the list of names of the network interfaces from
+	// spec.network.interfaces that are members of this bond.
+	//
+	// Please note a network interface may be a member of at most one bond, and
+	// a member interface may not have its own IP configuration.
+	Interfaces []string `json:"interfaces"`
+
+	// +optional
+	// +kubebuilder:default=active-backup
+
+	// Mode is the bonding mode.
+	//
+	// Defaults to active-backup.
+	Mode VirtualMachineNetworkBondMode `json:"mode,omitempty"`
+
+	// +optional
+
+	// Primary is the name of the member interface that is preferred as the
+	// active interface. The value must match one of the names in Interfaces.
+	//
+	// Please note this field is only valid when Mode is active-backup.
+	Primary string `json:"primary,omitempty"`
+
+	VirtualMachineNetworkGuestDeviceIPSpec `json:",inline"`
+}
+
+// +kubebuilder:validation:Enum=TCP;UDP
+
+// VirtualMachineNetworkFirewallProtocol is the Layer 4 transport protocol
+// matched by a firewall rule.
+type VirtualMachineNetworkFirewallProtocol string
+
+const (
+	// VirtualMachineNetworkFirewallProtocolTCP indicates the rule matches TCP
+	// traffic.
+	VirtualMachineNetworkFirewallProtocolTCP VirtualMachineNetworkFirewallProtocol = "TCP"
+
+	// VirtualMachineNetworkFirewallProtocolUDP indicates the rule matches UDP
+	// traffic.
+	VirtualMachineNetworkFirewallProtocolUDP VirtualMachineNetworkFirewallProtocol = "UDP"
+)
+
+// VirtualMachineNetworkFirewallRule describes ingress traffic that is allowed
+// to reach the VM.
+type VirtualMachineNetworkFirewallRule struct {
+	// +optional
+	// +kubebuilder:default=TCP
+
+	// Protocol is the Layer 4 transport protocol matched by this rule.
+	//
+	// Defaults to TCP.
+	Protocol VirtualMachineNetworkFirewallProtocol `json:"protocol,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// Port is the destination port matched by this rule.
+	//
+	// When omitted, this rule matches all ports.
+	Port *int32 `json:"port,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+
+	// EndPort is the last destination port in the range that starts with
+	// Port.
+	//
+	// This field may only be set when Port is set, and must not be less than
+	// Port.
+	EndPort *int32 `json:"endPort,omitempty"`
+
+	// +optional
+	// +listType=set
+
+	// CIDRs is the list of source networks, in IPv4 or IPv6 CIDR notation,
+	// matched by this rule, ex. 192.168.1.0/24.
+	//
+	// When omitted, this rule matches traffic from any source.
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+// VirtualMachineNetworkFirewallSpec describes the ingress traffic that is
+// allowed to reach the VM.
+type VirtualMachineNetworkFirewallSpec struct {
+	// +optional
+	// +listType=atomic
+
+	// Ingress is the list of rules that describe the ingress traffic allowed
+	// to reach the VM. Ingress traffic that does not match any of these rules
+	// is dropped, except for traffic that belongs to a connection initiated by
+	// the VM.
+	//
+	// When this list is empty, all ingress traffic is dropped.
+	Ingress []VirtualMachineNetworkFirewallRule `json:"ingress,omitempty"`
 }
 
 // VirtualMachineNetworkSpec defines a VM's desired network configuration.
@@ -292,6 +576,43 @@ type VirtualMachineNetworkSpec struct {
 	// The maximum number of network interface allowed is 10 because a vSphere
 	// virtual machine may not have more than 10 virtual ethernet card devices.
 	Interfaces []VirtualMachineNetworkInterfaceSpec `json:"interfaces,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// VLANs is the list of 802.1Q VLAN sub-interfaces to create inside the
+	// guest on top of the VM's network interfaces or bonds.
+	//
+	// Please note this feature is available only with the following bootstrap
+	// providers: CloudInit.
+	VLANs []VirtualMachineNetworkVLANSpec `json:"vlans,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+
+	// Bonds is the list of bonds to create inside the guest from the VM's
+	// network interfaces.
+	//
+	// Please note this feature is available only with the following bootstrap
+	// providers: CloudInit.
+	Bonds []VirtualMachineNetworkBondSpec `json:"bonds,omitempty"`
+
+	// +optional
+
+	// Firewall describes the ingress traffic that is allowed to reach the VM.
+	//
+	// With the NSX-T and VPC network providers, the rules are enforced by the
+	// distributed firewall using a security policy that is created for the
+	// VM. With the VDS and named network providers, the rules are rendered
+	// into the guest's firewall configuration by the following bootstrap
+	// providers: CloudInit and Sysprep.
+	//
+	// Please note, with CloudInit the rules are applied with nftables, and
+	// with Sysprep the rules are applied with the Windows Firewall when the
+	// guest is first logged into.
+	Firewall *VirtualMachineNetworkFirewallSpec `json:"firewall,omitempty"`
 }
 
 // VirtualMachineNetworkDNSStatus describes the observed state of the guest's
@@ -546,9 +867,39 @@ type VirtualMachineNetworkInterfaceIPStatus struct {
 	MACAddr string `json:"macAddr,omitempty"`
 }
 
+// VirtualMachineNetworkIPAssignmentMode describes how the IP addresses of a
+// network interface are assigned.
+type VirtualMachineNetworkIPAssignmentMode string
+
+const (
+	// VirtualMachineNetworkIPAssignmentModeIPAM indicates the interface's IP
+	// addresses were allocated by the network provider.
+	VirtualMachineNetworkIPAssignmentModeIPAM VirtualMachineNetworkIPAssignmentMode = "IPAM"
+
+	// VirtualMachineNetworkIPAssignmentModeDHCP indicates the interface's IP
+	// addresses are obtained by the guest via DHCP.
+	VirtualMachineNetworkIPAssignmentModeDHCP VirtualMachineNetworkIPAssignmentMode = "DHCP"
+
+	// VirtualMachineNetworkIPAssignmentModeStatic indicates the interface's
+	// IP addresses were specified in the interface's spec.
+	VirtualMachineNetworkIPAssignmentModeStatic VirtualMachineNetworkIPAssignmentMode = "Static"
+
+	// VirtualMachineNetworkIPAssignmentModeNone indicates no IP addresses are
+	// assigned to the interface.
+	VirtualMachineNetworkIPAssignmentModeNone VirtualMachineNetworkIPAssignmentMode = "None"
+)
+
 // VirtualMachineNetworkConfigInterfaceIPStatus describes the configured state
 // of a VM's network interface's IP configuration.
 type VirtualMachineNetworkConfigInterfaceIPStatus struct {
+	// +optional
+	// +kubebuilder:validation:Enum=IPAM;DHCP;Static;None
+
+	// AssignmentMode describes how the interface's IP addresses are assigned.
+	// When DHCP, the addresses are obtained by the guest via DHCP and the
+	// network provider is asked not to reserve an address for the interface.
+	AssignmentMode VirtualMachineNetworkIPAssignmentMode `json:"assignmentMode,omitempty"`
+
 	// +optional
 
 	// DHCP describes the interface's configured DHCP options.
@@ -738,4 +1089,56 @@ type VirtualMachineNetworkConfigStatus struct {
 
 	// DNS describes the configured state of client-side DNS.
 	DNS *VirtualMachineNetworkConfigDNSStatus `json:"dns,omitempty"`
+
+	// +optional
+
+	// GuestDevices describes the configured state of the VLANs and bonds
+	// created inside the guest.
+	GuestDevices []VirtualMachineNetworkConfigGuestDeviceStatus `json:"guestDevices,omitempty"`
+}
+
+// VirtualMachineNetworkGuestDeviceType describes the type of a network device
+// that exists only inside the guest.
+type VirtualMachineNetworkGuestDeviceType string
+
+const (
+	VirtualMachineNetworkGuestDeviceTypeVLAN VirtualMachineNetworkGuestDeviceType = "VLAN"
+	VirtualMachineNetworkGuestDeviceTypeBond VirtualMachineNetworkGuestDeviceType = "Bond"
+)
+
+// VirtualMachineNetworkConfigGuestDeviceStatus describes the configured state
+// of a VLAN or bond inside the guest.
+type VirtualMachineNetworkConfigGuestDeviceStatus struct {
+	// Name describes the name of the device inside the guest.
+	Name string `json:"name"`
+
+	// Type describes the type of the device.
+	Type VirtualMachineNetworkGuestDeviceType `json:"type"`
+
+	// +optional
+
+	// Links describes the names of the devices on which this device is
+	// created. For a VLAN this is the VLAN's parent device, and for a bond
+	// these are the bond's member devices.
+	Links []string `json:"links,omitempty"`
+
+	// +optional
+
+	// VLANID describes the VLAN ID when the device is a VLAN.
+	VLANID int32 `json:"vlanID,omitempty"`
+
+	// +optional
+
+	// BondMode describes the bonding mode when the device is a bond.
+	BondMode VirtualMachineNetworkBondMode `json:"bondMode,omitempty"`
+
+	// +optional
+
+	// IP describes the device's configured IP information.
+	IP *VirtualMachineNetworkConfigInterfaceIPStatus `json:"ip,omitempty"`
+
+	// +optional
+
+	// DNS describes the device's configured DNS information.
+	DNS *VirtualMachineNetworkConfigDNSStatus `json:"dns,omitempty"`
 }
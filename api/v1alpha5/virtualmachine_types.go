@@ -39,6 +39,11 @@ const (
 	// VirtualMachineConditionNetworkReady indicates that the network prerequisites for the VM are ready.
 	VirtualMachineConditionNetworkReady = "VirtualMachineNetworkReady"
 
+	// VirtualMachineWaitingForNetworkReason is the reason used for the
+	// VirtualMachineConditionNetworkReady condition when the VM's network
+	// interfaces have not yet been reconciled by the network provider.
+	VirtualMachineWaitingForNetworkReason = "WaitingForNetwork"
+
 	// VirtualMachineConditionPlacementReady indicates that the placement decision for the VM is ready.
 	VirtualMachineConditionPlacementReady = "VirtualMachineConditionPlacementReady"
 
@@ -73,6 +78,11 @@ const (
 	// VirtualMachineHardwareCDROMVerified indicates that the VM's hardware
 	// CD-ROM devices match the desired state specified in the spec.
 	VirtualMachineHardwareCDROMVerified = "VirtualMachineHardwareCDROMVerified"
+
+	// VirtualMachineConfigDriftVerified indicates that the VM's configuration
+	// in vSphere has not drifted from what is implied by the VM's spec and
+	// class, ex. because the VM was changed directly in vCenter.
+	VirtualMachineConfigDriftVerified = "VirtualMachineConfigDriftVerified"
 )
 
 const (
@@ -116,6 +126,28 @@ const (
 	// hardware device configuration does not match the desired state specified
 	// in the spec. This is used for the aggregated condition.
 	VirtualMachineHardwareDeviceConfigMismatchReason = "HardwareDeviceConfigMismatch"
+
+	// VirtualMachineHardwareCPUMismatchReason indicates that the VM's number
+	// of CPUs does not match the desired state specified in the spec or class.
+	VirtualMachineHardwareCPUMismatchReason = "HardwareCPUMismatch"
+
+	// VirtualMachineHardwareMemoryMismatchReason indicates that the VM's
+	// memory does not match the desired state specified in the spec or class.
+	VirtualMachineHardwareMemoryMismatchReason = "HardwareMemoryMismatch"
+
+	// VirtualMachineHardwareNetworkInterfacesMismatchReason indicates that the
+	// VM's network interfaces, or their networks, MAC addresses, or adapter
+	// types, do not match the desired state specified in the spec.
+	VirtualMachineHardwareNetworkInterfacesMismatchReason = "HardwareNetworkInterfacesMismatch"
+
+	// VirtualMachineConfigDriftedReason indicates that the VM's configuration
+	// has drifted from its desired state. This is used for the aggregated
+	// condition.
+	VirtualMachineConfigDriftedReason = "ConfigDrifted"
+
+	// VirtualMachineConfigDriftAdoptedReason indicates that the VM's drifted
+	// configuration was adopted into the VM's spec.
+	VirtualMachineConfigDriftAdoptedReason = "ConfigDriftAdopted"
 )
 
 const (
@@ -123,6 +155,19 @@ const (
 	// the guest OS, when available.
 	GuestBootstrapCondition = "GuestBootstrap"
 
+	// GuestDomainJoinCondition exposes the status of joining the domain from
+	// within the guest OS for a VM bootstrapped with Sysprep that specifies
+	// spec.bootstrap.sysprep.sysprep.identification.domainJoin.
+	GuestDomainJoinCondition = "GuestDomainJoin"
+
+	// GuestDomainJoinPendingReason documents that the guest has not yet
+	// reported whether or not it joined the domain.
+	GuestDomainJoinPendingReason = "Pending"
+
+	// GuestDomainJoinFailedReason documents that the guest failed to join the
+	// domain and did not report a more specific reason.
+	GuestDomainJoinFailedReason = "Failed"
+
 	// GuestIDReconfiguredCondition exposes the status of guest ID
 	// reconfiguration after a VM has been created, when available.
 	GuestIDReconfiguredCondition = "GuestIDReconfigured"
@@ -154,6 +199,20 @@ const (
 	GuestCustomizationFailedReason = "GuestCustomizationFailed"
 )
 
+const (
+	// GuestNetworkConfigSyncedCondition exposes whether the guest OS has
+	// applied the network configuration after network interfaces were
+	// hot-added to or hot-removed from the powered on VirtualMachine.
+	//
+	// This condition is only present after a network interface change while
+	// the VirtualMachine was powered on.
+	GuestNetworkConfigSyncedCondition = "GuestNetworkConfigSynced"
+
+	// GuestNetworkConfigPendingReason documents that the guest OS has not yet
+	// applied the updated network configuration.
+	GuestNetworkConfigPendingReason = "GuestNetworkConfigPending"
+)
+
 const (
 	// VirtualMachineToolsCondition exposes the status of VMware Tools running
 	// in the guest OS, when available.
@@ -215,6 +274,45 @@ const (
 	VirtualMachineSameVMClassResizeAnnotation = GroupName + "/same-vm-class-resize"
 )
 
+const (
+	// MaintenanceWindowScheduleAnnotation is an annotation that may be set on
+	// a Namespace to specify the maintenance window used for VMs in that
+	// Namespace that do not specify spec.maintenanceWindow. The value is a
+	// standard five field cron expression that is evaluated in UTC.
+	//
+	// This annotation has no effect unless the Namespace also has the
+	// MaintenanceWindowDurationAnnotation.
+	MaintenanceWindowScheduleAnnotation = GroupName + "/maintenance-window-schedule"
+
+	// MaintenanceWindowDurationAnnotation is an annotation that may be set on
+	// a Namespace to specify how long the window described by the
+	// MaintenanceWindowScheduleAnnotation remains open, ex. "4h".
+	MaintenanceWindowDurationAnnotation = GroupName + "/maintenance-window-duration"
+)
+
+const (
+	// MaxCPUsAnnotation is an annotation that may be set on a Namespace to
+	// limit the number of virtual processors a VM in that Namespace may
+	// specify with spec.hardware.cpus, ex. "16".
+	MaxCPUsAnnotation = GroupName + "/max-cpus"
+
+	// MaxMemoryAnnotation is an annotation that may be set on a Namespace to
+	// limit the amount of memory a VM in that Namespace may specify with
+	// spec.hardware.memory, ex. "64Gi".
+	MaxMemoryAnnotation = GroupName + "/max-memory"
+)
+
+const (
+	// NamedPortsAnnotation is an annotation that may be set on a VM to declare
+	// the named ports on which the VM listens. A VirtualMachineService port
+	// that specifies targetPortName is mapped to the VM's port with that name.
+	//
+	// The value is a comma-separated list of name=port[/protocol] entries,
+	// where the protocol is TCP, UDP, or SCTP and defaults to TCP, ex.
+	// "http=8080,dns=53/UDP".
+	NamedPortsAnnotation = GroupName + "/named-ports"
+)
+
 const (
 	// checkAnnotationSubDomain is the sub-domain to be used for all check-style
 	// annotations that enable external components to participate in a VM's
@@ -365,6 +463,29 @@ const (
 	VirtualMachinePowerStateSuspended VirtualMachinePowerState = "Suspended"
 )
 
+// +kubebuilder:validation:Enum=Report;Revert;Adopt
+
+// VirtualMachineDriftPolicy describes what happens when a VM's configuration
+// in vSphere drifts from what is implied by the VM's spec and class.
+type VirtualMachineDriftPolicy string
+
+const (
+	// VirtualMachineDriftPolicyReport indicates drift is reported with the
+	// VM's VirtualMachineConfigDriftVerified condition and events, but is
+	// otherwise left alone.
+	VirtualMachineDriftPolicyReport VirtualMachineDriftPolicy = "Report"
+
+	// VirtualMachineDriftPolicyRevert indicates drift is reported and the
+	// VM's number of CPUs and memory are reverted to the values from the VM's
+	// spec or class.
+	VirtualMachineDriftPolicyRevert VirtualMachineDriftPolicy = "Revert"
+
+	// VirtualMachineDriftPolicyAdopt indicates drift is reported and the VM's
+	// number of CPUs and memory from vSphere are adopted into the VM's
+	// spec.hardware.cpus and spec.hardware.memory fields.
+	VirtualMachineDriftPolicyAdopt VirtualMachineDriftPolicy = "Adopt"
+)
+
 // +kubebuilder:validation:Enum=Hard;Soft;TrySoft
 
 // VirtualMachinePowerOpMode represents the various power operation modes when
@@ -911,6 +1032,44 @@ type VirtualMachineSpec struct {
 	// If omitted, the mode defaults to TrySoft.
 	RestartMode VirtualMachinePowerOpMode `json:"restartMode,omitempty"`
 
+	// +optional
+
+	// MaintenanceWindow describes when the VM may be power cycled in order to
+	// apply a pending resize, ex. after spec.className is changed while the VM
+	// is powered on.
+	//
+	// When omitted, the maintenance window from the VM's namespace is used, if
+	// one is specified with the annotations
+	// vmoperator.vmware.com/maintenance-window-schedule and
+	// vmoperator.vmware.com/maintenance-window-duration. If there is no
+	// maintenance window, a pending resize is not applied until the VM is
+	// next powered on.
+	//
+	// Please note a maintenance window has no effect on VMs that are powered
+	// off since such VMs are resized immediately.
+	MaintenanceWindow *VirtualMachineMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=Report
+
+	// DriftPolicy describes what happens when the VM's configuration in
+	// vSphere drifts from what is implied by the VM's spec and class, ex.
+	// because an administrator changed the VM's memory directly in vCenter.
+	//
+	// There are three, supported policies: Report, Revert, and Adopt. All
+	// policies report drift with the VirtualMachineConfigDriftVerified
+	// condition and an event for each drifted field. The Revert policy also
+	// reverts the VM's number of CPUs and memory to the values from the VM's
+	// spec or class, while the Adopt policy updates spec.hardware.cpus and
+	// spec.hardware.memory to match the VM. Drift of the VM's devices, such as
+	// network interfaces or CD-ROM devices, is only reported.
+	//
+	// Please note values from spec.hardware.cpus and spec.hardware.memory are
+	// always applied to the VM, regardless of this policy.
+	//
+	// If omitted, the policy defaults to Report.
+	DriftPolicy VirtualMachineDriftPolicy `json:"driftPolicy,omitempty"`
+
 	// +optional
 	// +listType=map
 	// +listMapKey=name
@@ -1327,6 +1486,42 @@ type VirtualMachineStatus struct {
 
 	// Policies describes the observed policies applied to this VM.
 	Policies []PolicyStatus `json:"policies,omitempty"`
+
+	// +optional
+
+	// PendingResize describes a resize of the VM that will not be applied
+	// until the VM is power cycled.
+	PendingResize *VirtualMachinePendingResizeStatus `json:"pendingResize,omitempty"`
+}
+
+// VirtualMachinePendingResizeStatus describes a resize of a powered on VM
+// that is deferred until the VM is power cycled.
+type VirtualMachinePendingResizeStatus struct {
+	// ClassName is the name of the VirtualMachineClass to which the VM will be
+	// resized.
+	ClassName string `json:"className"`
+
+	// +optional
+
+	// CPUs is the number of virtual processors from spec.hardware.cpus that
+	// will be applied to the VM.
+	CPUs *int64 `json:"cpus,omitempty"`
+
+	// +optional
+
+	// Memory is the amount of memory from spec.hardware.memory that will be
+	// applied to the VM.
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// +optional
+
+	// ScheduledTime is when the VM will be power cycled to apply the resize.
+	// This is the start of the current or next occurrence of the VM's
+	// maintenance window.
+	//
+	// This field is empty if the VM does not have a maintenance window, in
+	// which case the resize is applied the next time the VM is powered on.
+	ScheduledTime *metav1.Time `json:"scheduledTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
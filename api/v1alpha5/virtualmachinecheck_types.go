@@ -0,0 +1,234 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha5
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineCheckConditionSatisfied is the Type for a
+	// VirtualMachineCheck resource's status condition that indicates whether
+	// or not the check's condition is satisfied.
+	//
+	// The condition's status is True when the check's condition is satisfied
+	// and the check annotation has been removed from all of the selected VMs.
+	VirtualMachineCheckConditionSatisfied = "Satisfied"
+
+	// VirtualMachineCheckConditionNotSatisfiedReason documents that the
+	// check's condition is not satisfied.
+	VirtualMachineCheckConditionNotSatisfiedReason = "NotSatisfied"
+
+	// VirtualMachineCheckConditionErrorReason documents that the check's
+	// condition could not be evaluated.
+	VirtualMachineCheckConditionErrorReason = "Error"
+)
+
+// +kubebuilder:validation:Enum=PowerOn;Delete
+
+// VirtualMachineCheckType describes the VM lifecycle event gated by a
+// VirtualMachineCheck.
+type VirtualMachineCheckType string
+
+const (
+	// VirtualMachineCheckTypePowerOn indicates the check gates powering on
+	// the selected VMs by using the CheckAnnotationPowerOn annotation.
+	VirtualMachineCheckTypePowerOn VirtualMachineCheckType = "PowerOn"
+
+	// VirtualMachineCheckTypeDelete indicates the check gates deleting the
+	// selected VMs by using the CheckAnnotationDelete annotation.
+	VirtualMachineCheckTypeDelete VirtualMachineCheckType = "Delete"
+)
+
+// VirtualMachineCheckJobCondition describes a condition that is satisfied
+// when a Job has completed successfully.
+type VirtualMachineCheckJobCondition struct {
+	// Name is the name of the Job resource in the same namespace as the
+	// VirtualMachineCheck.
+	Name string `json:"name"`
+}
+
+// VirtualMachineCheckConfigMapCondition describes a condition that is
+// satisfied when a ConfigMap has a key.
+type VirtualMachineCheckConfigMapCondition struct {
+	// Name is the name of the ConfigMap resource in the same namespace as the
+	// VirtualMachineCheck.
+	Name string `json:"name"`
+
+	// Key is the key that must be present in the ConfigMap's data.
+	Key string `json:"key"`
+
+	// +optional
+
+	// Value is the value the key must have for the condition to be satisfied.
+	//
+	// If omitted, the condition is satisfied as soon as the key is present,
+	// regardless of its value.
+	Value string `json:"value,omitempty"`
+}
+
+// VirtualMachineCheckHTTPCondition describes a condition that is satisfied
+// when an HTTP GET request to a URL returns 200 OK.
+type VirtualMachineCheckHTTPCondition struct {
+	// +kubebuilder:validation:Pattern=`^https?://`
+
+	// URL is the http or https URL that is requested.
+	//
+	// Please note, the request is sent from VM Operator, so the URL must be
+	// reachable from the Supervisor's control plane.
+	URL string `json:"url"`
+}
+
+// VirtualMachineCheckCondition describes the condition that must be satisfied
+// before the check annotation is removed from the selected VMs.
+//
+// Exactly one of the fields must be set.
+type VirtualMachineCheckCondition struct {
+	// +optional
+
+	// Job describes a condition that is satisfied when the named Job has
+	// completed successfully.
+	Job *VirtualMachineCheckJobCondition `json:"job,omitempty"`
+
+	// +optional
+
+	// ConfigMap describes a condition that is satisfied when the named
+	// ConfigMap has the specified key.
+	ConfigMap *VirtualMachineCheckConfigMapCondition `json:"configMap,omitempty"`
+
+	// +optional
+
+	// HTTP describes a condition that is satisfied when a GET request to the
+	// specified URL returns 200 OK.
+	HTTP *VirtualMachineCheckHTTPCondition `json:"http,omitempty"`
+}
+
+// VirtualMachineCheckSpec defines the desired state of VirtualMachineCheck.
+type VirtualMachineCheckSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`
+
+	// Key is the name of the check, i.e. the <COMPONENT> part of the check
+	// annotation <TYPE>.check.vmoperator.vmware.com/<COMPONENT>.
+	//
+	// The check manages this annotation on the VMs in its namespace. The
+	// annotation is only removed from the VMs to which it was added by the
+	// check, or on which it has the check's reason as its value, so an
+	// annotation with the same key added by someone else is left as-is.
+	//
+	// The annotation key must be unique across the VirtualMachineChecks in
+	// the namespace.
+	Key string `json:"key"`
+
+	// +optional
+	// +kubebuilder:default=PowerOn
+
+	// Type describes the VM lifecycle event gated by this check.
+	//
+	// Defaults to PowerOn.
+	Type VirtualMachineCheckType `json:"type,omitempty"`
+
+	// Selector is a label query over the VMs in the same namespace as the
+	// check. An empty selector selects all of the VMs in the namespace.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// +optional
+
+	// Reason is the value of the check annotation applied to the selected
+	// VMs.
+	//
+	// Defaults to the name of the VirtualMachineCheck.
+	Reason string `json:"reason,omitempty"`
+
+	// Condition describes the condition that must be satisfied before the
+	// check annotation is removed from the selected VMs.
+	Condition VirtualMachineCheckCondition `json:"condition"`
+
+	// +optional
+	// +kubebuilder:default="30s"
+
+	// PollInterval is how often the condition is evaluated while it is not
+	// satisfied.
+	//
+	// Defaults to 30s.
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+}
+
+// VirtualMachineCheckStatus defines the observed state of
+// VirtualMachineCheck.
+type VirtualMachineCheckStatus struct {
+	// +optional
+
+	// VirtualMachines is the sorted list of the names of the VMs that
+	// currently have the check annotation added by this check.
+	VirtualMachines []string `json:"virtualMachines,omitempty"`
+
+	// +optional
+
+	// LastEvaluationTime is the last time the condition was evaluated.
+	LastEvaluationTime *metav1.Time `json:"lastEvaluationTime,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the VirtualMachineCheck.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmcheck
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Key",type="string",JSONPath=".spec.key"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Satisfied",type="string",JSONPath=".status.conditions[?(.type=='Satisfied')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineCheck is the schema for the virtualmachinechecks API and
+// represents a declarative approval gate for powering on or deleting VMs.
+//
+// While the check's condition is not satisfied, the check annotation
+// described by the check's type and key is added to the selected VMs. Once
+// the condition is satisfied, the annotation is removed.
+type VirtualMachineCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineCheckSpec   `json:"spec,omitempty"`
+	Status VirtualMachineCheckStatus `json:"status,omitempty"`
+}
+
+func (c VirtualMachineCheck) NamespacedName() string {
+	return c.Namespace + "/" + c.Name
+}
+
+func (c VirtualMachineCheck) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+func (c *VirtualMachineCheck) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
+}
+
+// AnnotationKey returns the check annotation key managed by this check.
+func (c VirtualMachineCheck) AnnotationKey() string {
+	if c.Spec.Type == VirtualMachineCheckTypeDelete {
+		return CheckAnnotationDelete + "/" + c.Spec.Key
+	}
+	return CheckAnnotationPowerOn + "/" + c.Spec.Key
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineCheckList contains a list of VirtualMachineCheck.
+type VirtualMachineCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineCheck `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &VirtualMachineCheck{}, &VirtualMachineCheckList{})
+}
@@ -167,10 +167,69 @@ type VirtualMachineClassSpec struct {
 	// this VirtualMachineClass.
 	// This field is only valid in conjunction with reservedProfileID.
 	ReservedSlots int32 `json:"reservedSlots,omitempty"`
+
+	// +optional
+
+	// Deprecation describes whether the VirtualMachineClass is deprecated
+	// and, if so, how new and existing VMs that use the class are handled.
+	Deprecation *VirtualMachineClassDeprecation `json:"deprecation,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Warn;Deny
+
+// VirtualMachineClassDeprecationPolicy describes how the creation of VMs that
+// use a deprecated VirtualMachineClass is handled.
+type VirtualMachineClassDeprecationPolicy string
+
+const (
+	// VirtualMachineClassDeprecationPolicyWarn allows new VMs to use the
+	// deprecated class, but returns a warning to the client.
+	VirtualMachineClassDeprecationPolicyWarn VirtualMachineClassDeprecationPolicy = "Warn"
+
+	// VirtualMachineClassDeprecationPolicyDeny denies new VMs from using the
+	// deprecated class.
+	VirtualMachineClassDeprecationPolicyDeny VirtualMachineClassDeprecationPolicy = "Deny"
+)
+
+// VirtualMachineClassDeprecation describes the deprecation of a
+// VirtualMachineClass.
+type VirtualMachineClassDeprecation struct {
+	// +optional
+
+	// ReplacementClassName is the name of the VirtualMachineClass in the same
+	// namespace that should be used instead of the deprecated class.
+	ReplacementClassName string `json:"replacementClassName,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=Warn
+
+	// Policy describes how a VM that is created with, or updated to use, the
+	// deprecated class is handled.
+	//
+	// Defaults to Warn.
+	Policy VirtualMachineClassDeprecationPolicy `json:"policy,omitempty"`
+
+	// +optional
+
+	// Migrate describes whether the VMs that use the deprecated class are
+	// updated to use the class specified by ReplacementClassName. The VMs are
+	// then resized to the replacement class the same way as when a VM's
+	// spec.className is changed by its owner.
+	//
+	// This field has no effect unless ReplacementClassName is set.
+	Migrate bool `json:"migrate,omitempty"`
 }
 
 // VirtualMachineClassStatus defines the observed state of VirtualMachineClass.
 type VirtualMachineClassStatus struct {
+	// +optional
+	// +listType=set
+
+	// Consumers is the list of names of the VMs in the namespace that use this
+	// VirtualMachineClass.
+	//
+	// This field is only populated for a deprecated class.
+	Consumers []string `json:"consumers,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,254 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha5
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineClassRecommendationConditionRecommended is the Type for a
+	// VirtualMachineClassRecommendation resource's status condition that
+	// indicates whether or not a class has been recommended for the VM.
+	VirtualMachineClassRecommendationConditionRecommended = "Recommended"
+
+	// VirtualMachineClassRecommendationConditionApplied is the Type for a
+	// VirtualMachineClassRecommendation resource's status condition that
+	// indicates whether or not the recommended class has been applied to the
+	// VM.
+	//
+	// This condition is only present when spec.apply is set.
+	VirtualMachineClassRecommendationConditionApplied = "Applied"
+
+	// VirtualMachineClassRecommendationInsufficientSamplesReason documents
+	// that not enough usage samples have been collected to recommend a class.
+	VirtualMachineClassRecommendationInsufficientSamplesReason = "InsufficientSamples"
+
+	// VirtualMachineClassRecommendationNoClassFitsReason documents that none
+	// of the classes in the namespace fit the VM's usage.
+	VirtualMachineClassRecommendationNoClassFitsReason = "NoClassFits"
+
+	// VirtualMachineClassRecommendationVMNotFoundReason documents that the
+	// VM does not exist.
+	VirtualMachineClassRecommendationVMNotFoundReason = "VirtualMachineNotFound"
+
+	// VirtualMachineClassRecommendationPendingMaintenanceWindowReason
+	// documents that the recommended class will be applied during the next
+	// maintenance window.
+	VirtualMachineClassRecommendationPendingMaintenanceWindowReason = "PendingMaintenanceWindow"
+
+	// VirtualMachineClassRecommendationResizeDisabledReason documents that
+	// the recommended class cannot be applied because resizing VMs is not
+	// enabled.
+	VirtualMachineClassRecommendationResizeDisabledReason = "ResizeDisabled"
+
+	// VirtualMachineClassRecommendationErrorReason documents that an error
+	// occurred while sampling the VM's usage or applying the recommendation.
+	VirtualMachineClassRecommendationErrorReason = "Error"
+)
+
+// VirtualMachineMaintenanceWindow describes a recurring window of time during
+// which disruptive changes may be made to a VM.
+type VirtualMachineMaintenanceWindow struct {
+	// +kubebuilder:validation:MinLength=1
+
+	// Schedule is a standard five field cron expression, i.e.
+	// "minute hour day-of-month month day-of-week", that describes when the
+	// window starts. The schedule is evaluated in UTC.
+	//
+	// For example, "0 2 * * 6" starts the window every Saturday at 02:00.
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window remains open after it starts.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// VirtualMachineClassRecommendationApply describes how a recommended class is
+// applied to the VM.
+type VirtualMachineClassRecommendationApply struct {
+	// MaintenanceWindow describes when the recommended class may be applied
+	// to the VM.
+	//
+	// The VM's spec.className is updated to the recommended class during the
+	// window and the VM is resized using the same flow as any other change to
+	// its class.
+	MaintenanceWindow VirtualMachineMaintenanceWindow `json:"maintenanceWindow"`
+}
+
+// VirtualMachineClassRecommendationSpec defines the desired state of
+// VirtualMachineClassRecommendation.
+type VirtualMachineClassRecommendationSpec struct {
+	// +kubebuilder:validation:MinLength=1
+
+	// VirtualMachineName is the name of the VM in the same namespace for
+	// which a class is recommended.
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	// +optional
+	// +kubebuilder:default="5m"
+
+	// SampleInterval is how often the VM's CPU and memory usage is sampled.
+	//
+	// Defaults to 5m.
+	SampleInterval *metav1.Duration `json:"sampleInterval,omitempty"`
+
+	// +optional
+	// +kubebuilder:default="720h"
+
+	// Window is the period over which the VM's usage is considered. Each time
+	// a window elapses, the weight of the samples collected so far is halved
+	// so recent usage has more influence on the recommendation.
+	//
+	// Defaults to 720h, i.e. 30 days.
+	Window *metav1.Duration `json:"window,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=95
+	// +kubebuilder:validation:Minimum=50
+	// +kubebuilder:validation:Maximum=100
+
+	// Percentile is the percentile of the VM's usage that the recommended
+	// class must fit.
+	//
+	// Defaults to 95.
+	Percentile int32 `json:"percentile,omitempty"`
+
+	// +optional
+	// +kubebuilder:default=288
+	// +kubebuilder:validation:Minimum=1
+
+	// MinSamples is the number of samples that must be collected before a
+	// class is recommended.
+	//
+	// Defaults to 288, i.e. one day of samples at the default sample
+	// interval.
+	MinSamples int32 `json:"minSamples,omitempty"`
+
+	// +optional
+
+	// Apply describes how the recommended class is applied to the VM.
+	//
+	// If omitted, the recommendation is only reported in the status.
+	Apply *VirtualMachineClassRecommendationApply `json:"apply,omitempty"`
+}
+
+// VirtualMachineResourceUsage describes the observed usage of a resource as a
+// percentage of the resource allocated to a VM.
+type VirtualMachineResourceUsage struct {
+	// +optional
+
+	// Histogram is the weighted number of samples in each five percent
+	// bucket of usage, i.e. the first element is the number of samples with
+	// a usage between 0 and 5%, and the last element is the number of
+	// samples with a usage between 95 and 100%.
+	Histogram []int32 `json:"histogram,omitempty"`
+
+	// +optional
+
+	// Percentile is the usage, as a percentage of the allocation, at the
+	// percentile described by spec.percentile.
+	Percentile int32 `json:"percentile,omitempty"`
+}
+
+// VirtualMachineClassRecommendationStatus defines the observed state of
+// VirtualMachineClassRecommendation.
+type VirtualMachineClassRecommendationStatus struct {
+	// +optional
+
+	// ClassName is the name of the VM's class when its usage was sampled.
+	//
+	// The collected samples are discarded when the VM's class changes since
+	// they are relative to the allocation of the previous class.
+	ClassName string `json:"className,omitempty"`
+
+	// +optional
+
+	// CPU describes the observed CPU usage of the VM.
+	CPU VirtualMachineResourceUsage `json:"cpu,omitempty"`
+
+	// +optional
+
+	// Memory describes the observed guest memory usage of the VM.
+	Memory VirtualMachineResourceUsage `json:"memory,omitempty"`
+
+	// +optional
+
+	// Samples is the weighted number of samples collected.
+	Samples int32 `json:"samples,omitempty"`
+
+	// +optional
+
+	// WindowStartTime is when the current window started.
+	WindowStartTime *metav1.Time `json:"windowStartTime,omitempty"`
+
+	// +optional
+
+	// LastSampleTime is the last time the VM's usage was sampled.
+	LastSampleTime *metav1.Time `json:"lastSampleTime,omitempty"`
+
+	// +optional
+
+	// RecommendedClassName is the name of the smallest class in the
+	// namespace that fits the VM's usage.
+	RecommendedClassName string `json:"recommendedClassName,omitempty"`
+
+	// +optional
+
+	// LastAppliedTime is the last time the recommended class was applied to
+	// the VM.
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the
+	// VirtualMachineClassRecommendation.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmclassrec
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="VirtualMachine",type="string",JSONPath=".spec.virtualMachineName"
+// +kubebuilder:printcolumn:name="Class",type="string",JSONPath=".status.className"
+// +kubebuilder:printcolumn:name="Recommended",type="string",JSONPath=".status.recommendedClassName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineClassRecommendation is the schema for the
+// virtualmachineclassrecommendations API and represents a recommendation of
+// the smallest VirtualMachineClass that fits a VM's observed usage.
+type VirtualMachineClassRecommendation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineClassRecommendationSpec   `json:"spec,omitempty"`
+	Status VirtualMachineClassRecommendationStatus `json:"status,omitempty"`
+}
+
+func (r VirtualMachineClassRecommendation) NamespacedName() string {
+	return r.Namespace + "/" + r.Name
+}
+
+func (r VirtualMachineClassRecommendation) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+func (r *VirtualMachineClassRecommendation) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineClassRecommendationList contains a list of
+// VirtualMachineClassRecommendation.
+type VirtualMachineClassRecommendationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineClassRecommendation `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &VirtualMachineClassRecommendation{}, &VirtualMachineClassRecommendationList{})
+}
@@ -49,6 +49,18 @@ const (
 	// VirtualMachineImageV1Alpha1CompatibleCondition denotes that an image was prepared by
 	// VMware specifically for compatibility with VMService.
 	VirtualMachineImageV1Alpha1CompatibleCondition = "VirtualMachineImageV1Alpha1Compatible"
+
+	// VirtualMachineImageSignatureVerifiedCondition denotes that the image's
+	// OVF manifest is signed by a certificate issued by one of the image trust
+	// anchors.
+	VirtualMachineImageSignatureVerifiedCondition = "VirtualMachineImageSignatureVerified"
+)
+
+const (
+	// RequireVerifiedImagesAnnotation is an annotation that may be set on a
+	// Namespace to require VMs in that Namespace to be deployed from images
+	// with a True VirtualMachineImageSignatureVerified condition, ex. "true".
+	RequireVerifiedImagesAnnotation = GroupName + "/require-verified-images"
 )
 
 // Condition reasons for VirtualMachineImages.
@@ -64,6 +76,24 @@ const (
 	// VirtualMachineImageProviderSecurityNotCompliantReason documents that the
 	// VirtualMachineImage provider doesn't meet security compliance requirements.
 	VirtualMachineImageProviderSecurityNotCompliantReason = "VirtualMachineImageProviderSecurityNotCompliant"
+
+	// VirtualMachineImageSignatureMissingReason documents that the
+	// VirtualMachineImage does not have an OVF manifest and certificate.
+	VirtualMachineImageSignatureMissingReason = "VirtualMachineImageSignatureMissing"
+
+	// VirtualMachineImageSignatureInvalidReason documents that the
+	// VirtualMachineImage's signature or manifest does not match its content.
+	VirtualMachineImageSignatureInvalidReason = "VirtualMachineImageSignatureInvalid"
+
+	// VirtualMachineImageSignatureUntrustedReason documents that the
+	// VirtualMachineImage's signing certificate is not issued by one of the
+	// image trust anchors.
+	VirtualMachineImageSignatureUntrustedReason = "VirtualMachineImageSignatureUntrusted"
+
+	// VirtualMachineImageSignatureVerificationFailedReason documents that the
+	// VirtualMachineImage's signature could not be verified, ex. because its
+	// files could not be downloaded.
+	VirtualMachineImageSignatureVerificationFailedReason = "VirtualMachineImageSignatureVerificationFailed"
 )
 
 // VirtualMachineImageProductInfo describes product information for an image.
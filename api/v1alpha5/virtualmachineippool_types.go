@@ -0,0 +1,183 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha5
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VirtualMachineIPPoolConditionReady is the Type for a
+	// VirtualMachineIPPool resource's status condition that indicates whether
+	// or not addresses have been allocated for all of the network interfaces
+	// that reference the pool.
+	VirtualMachineIPPoolConditionReady = "Ready"
+
+	// VirtualMachineIPPoolConditionExhaustedReason documents that the pool
+	// does not have any free addresses for one or more of the network
+	// interfaces that reference the pool.
+	VirtualMachineIPPoolConditionExhaustedReason = "Exhausted"
+)
+
+// VirtualMachineIPPoolRange describes a range of IP addresses from which
+// addresses are allocated.
+type VirtualMachineIPPoolRange struct {
+	// CIDR is the IPv4 or IPv6 network, in CIDR notation, from which
+	// addresses are allocated, ex. 192.168.1.0/24.
+	//
+	// The network address, the IPv4 broadcast address, and the gateway are
+	// never allocated.
+	CIDR string `json:"cidr"`
+
+	// +optional
+
+	// Gateway is the IP address of the network's gateway.
+	//
+	// If omitted, the network interface uses the gateway from its network
+	// provider or from its spec.
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// VirtualMachineIPPoolAllocation describes the addresses allocated for a
+// VM's network interface.
+type VirtualMachineIPPoolAllocation struct {
+	// VirtualMachineName is the name of the VM in the same namespace as the
+	// pool.
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	// InterfaceName is the name of the VM's network interface, i.e.
+	// spec.network.interfaces[].name.
+	InterfaceName string `json:"interfaceName"`
+
+	// +optional
+
+	// Addresses are the IP addresses, in CIDR notation, allocated for the
+	// network interface. At most one address is allocated per IP family.
+	Addresses []string `json:"addresses,omitempty"`
+
+	// +optional
+
+	// Gateway4 is the IPv4 gateway of the range from which the IPv4 address
+	// was allocated.
+	Gateway4 string `json:"gateway4,omitempty"`
+
+	// +optional
+
+	// Gateway6 is the IPv6 gateway of the range from which the IPv6 address
+	// was allocated.
+	Gateway6 string `json:"gateway6,omitempty"`
+
+	// +optional
+
+	// MACAddr is the MAC address allocated for the network interface.
+	MACAddr string `json:"macAddr,omitempty"`
+}
+
+// VirtualMachineIPPoolSpec defines the desired state of VirtualMachineIPPool.
+type VirtualMachineIPPoolSpec struct {
+	// +optional
+	// +listType=map
+	// +listMapKey=cidr
+
+	// Ranges describes the IP address ranges from which addresses are
+	// allocated.
+	//
+	// An interface is allocated one address from the first range of each IP
+	// family that has a free address, so a pool with both IPv4 and IPv6
+	// ranges allocates dual-stack addresses.
+	Ranges []VirtualMachineIPPoolRange `json:"ranges,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{2}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}$`
+
+	// MACAddrPrefix is the organizationally unique identifier (OUI), ex.
+	// 00:50:56, used as the first three octets of the MAC addresses allocated
+	// from the pool.
+	//
+	// If omitted, MAC addresses are not allocated and the MAC address is
+	// assigned by the network provider or vSphere.
+	MACAddrPrefix string `json:"macAddrPrefix,omitempty"`
+}
+
+// VirtualMachineIPPoolStatus defines the observed state of
+// VirtualMachineIPPool.
+type VirtualMachineIPPoolStatus struct {
+	// +optional
+	// +listType=map
+	// +listMapKey=virtualMachineName
+	// +listMapKey=interfaceName
+
+	// Allocations describes the addresses allocated for the network
+	// interfaces that reference the pool.
+	//
+	// An allocation is released when the VM is deleted or the interface no
+	// longer references the pool.
+	Allocations []VirtualMachineIPPoolAllocation `json:"allocations,omitempty"`
+
+	// +optional
+
+	// Conditions describes the observed conditions of the
+	// VirtualMachineIPPool.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=vmippool
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="MAC-Prefix",type="string",JSONPath=".spec.macAddrPrefix"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VirtualMachineIPPool is the schema for the virtualmachineippools API and
+// represents a pool of static IP and MAC addresses that are allocated for
+// the network interfaces of the VMs in the same namespace.
+//
+// A network interface references a pool with
+// spec.network.interfaces[].ipPoolName.
+type VirtualMachineIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineIPPoolSpec   `json:"spec,omitempty"`
+	Status VirtualMachineIPPoolStatus `json:"status,omitempty"`
+}
+
+func (p VirtualMachineIPPool) NamespacedName() string {
+	return p.Namespace + "/" + p.Name
+}
+
+func (p VirtualMachineIPPool) GetConditions() []metav1.Condition {
+	return p.Status.Conditions
+}
+
+func (p *VirtualMachineIPPool) SetConditions(conditions []metav1.Condition) {
+	p.Status.Conditions = conditions
+}
+
+// Allocation returns the addresses allocated for the VM's network interface,
+// or nil if no addresses have been allocated.
+func (p VirtualMachineIPPool) Allocation(vmName, interfaceName string) *VirtualMachineIPPoolAllocation {
+	for i := range p.Status.Allocations {
+		a := &p.Status.Allocations[i]
+		if a.VirtualMachineName == vmName && a.InterfaceName == interfaceName {
+			return a
+		}
+	}
+	return nil
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineIPPoolList contains a list of VirtualMachineIPPool.
+type VirtualMachineIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineIPPool `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &VirtualMachineIPPool{}, &VirtualMachineIPPoolList{})
+}
@@ -29,6 +29,37 @@ const (
 	VirtualMachineServiceTypeExternalName VirtualMachineServiceType = "ExternalName"
 )
 
+// VirtualMachineServiceIPFamily is the IP family of a service's endpoints.
+// +kubebuilder:validation:Enum=IPv4;IPv6
+type VirtualMachineServiceIPFamily string
+
+const (
+	// VirtualMachineServiceIPFamilyIPv4 is the IPv4 family.
+	VirtualMachineServiceIPFamilyIPv4 VirtualMachineServiceIPFamily = "IPv4"
+
+	// VirtualMachineServiceIPFamilyIPv6 is the IPv6 family.
+	VirtualMachineServiceIPFamilyIPv6 VirtualMachineServiceIPFamily = "IPv6"
+)
+
+// VirtualMachineServiceIPFamilyPolicy describes the dual-stack-ness requested
+// of a service.
+type VirtualMachineServiceIPFamilyPolicy string
+
+const (
+	// VirtualMachineServiceIPFamilyPolicySingleStack indicates the service
+	// uses a single IP family.
+	VirtualMachineServiceIPFamilyPolicySingleStack VirtualMachineServiceIPFamilyPolicy = "SingleStack"
+
+	// VirtualMachineServiceIPFamilyPolicyPreferDualStack indicates the
+	// service uses two IP families on dual-stack clusters, and a single IP
+	// family on single-stack clusters.
+	VirtualMachineServiceIPFamilyPolicyPreferDualStack VirtualMachineServiceIPFamilyPolicy = "PreferDualStack"
+
+	// VirtualMachineServiceIPFamilyPolicyRequireDualStack indicates the
+	// service uses two IP families, and fails on single-stack clusters.
+	VirtualMachineServiceIPFamilyPolicyRequireDualStack VirtualMachineServiceIPFamilyPolicy = "RequireDualStack"
+)
+
 // VirtualMachineServicePort describes the specification of a service port to
 // be exposed by a VirtualMachineService. This VirtualMachineServicePort
 // specification includes attributes that define the external and internal
@@ -45,9 +76,26 @@ type VirtualMachineServicePort struct {
 	// Port describes the external port that will be exposed by the service.
 	Port int32 `json:"port"`
 
+	// +optional
+
 	// TargetPort describes the internal port open on a VirtualMachine that
 	// should be mapped to the external Port.
-	TargetPort int32 `json:"targetPort"`
+	//
+	// Exactly one of TargetPort or TargetPortName must be specified.
+	TargetPort int32 `json:"targetPort,omitempty"`
+
+	// +optional
+
+	// TargetPortName describes the name of the internal port open on a
+	// VirtualMachine that should be mapped to the external Port. The port
+	// number is resolved separately for each VirtualMachine from the ports
+	// the VirtualMachine declares with the NamedPortsAnnotation, allowing the
+	// selected VirtualMachines to listen on different ports. A VirtualMachine
+	// that does not declare a port with this name and Protocol is not
+	// included in the service's endpoints for this port.
+	//
+	// Exactly one of TargetPort or TargetPortName must be specified.
+	TargetPortName string `json:"targetPortName,omitempty"`
 }
 
 // LoadBalancerStatus represents the status of a load balancer.
@@ -126,6 +174,8 @@ type VirtualMachineServiceSpec struct {
 	// of the service will fail. This field can not be changed through updates.
 	// Valid values are "None", empty string (""), or a valid IP address. "None"
 	// can be specified for headless services when proxying is not required.
+	// The endpoints of a headless service include the hostname of each
+	// VirtualMachine so a DNS record is published for each VirtualMachine.
 	// Only applies to types ClusterIP and LoadBalancer.
 	// Ignored if type is ExternalName.
 	// More info: https://kubernetes.io/docs/concepts/services-networking/service/#virtual-ips-and-service-proxies
@@ -138,6 +188,36 @@ type VirtualMachineServiceSpec struct {
 	// Must be a valid RFC-1123 hostname (https://tools.ietf.org/html/rfc1123)
 	// and requires Type to be ExternalName.
 	ExternalName string `json:"externalName,omitempty"`
+
+	// +optional
+
+	// InterfaceName is the name of the network interface, i.e.
+	// spec.network.interfaces[].name, of the selected VirtualMachines whose IP
+	// addresses are used as the service's endpoints. This allows a service to
+	// be created for each of a VM's network interfaces, for example, one for a
+	// frontend interface and one for a backend interface.
+	//
+	// When omitted, the VM's primary IP addresses are used.
+	InterfaceName string `json:"interfaceName,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=2
+
+	// IPFamilies is the list of IP families, in order of preference, used by
+	// this service. A VM's address from each of these families is published
+	// as an endpoint. This field is passed through to the Kubernetes Service
+	// and has the same semantics. Must not be set if type is ExternalName.
+	// The first family, i.e. the primary family, may not be changed once set.
+	IPFamilies []VirtualMachineServiceIPFamily `json:"ipFamilies,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:Enum=SingleStack;PreferDualStack;RequireDualStack
+
+	// IPFamilyPolicy represents the dual-stack-ness requested of this service.
+	// This field is passed through to the Kubernetes Service and has the same
+	// semantics. Must not be set if type is ExternalName.
+	IPFamilyPolicy *VirtualMachineServiceIPFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
 }
 
 // VirtualMachineServiceStatus defines the observed state of
@@ -820,6 +820,13 @@ func (in *VirtualMachineBootstrapCloudInitSpec) DeepCopyInto(out *VirtualMachine
 		*out = new(common.SecretKeySelector)
 		**out = **in
 	}
+	if in.UserDataParts != nil {
+		in, out := &in.UserDataParts, &out.UserDataParts
+		*out = make([]VirtualMachineBootstrapCloudInitUserDataPart, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SSHAuthorizedKeys != nil {
 		in, out := &in.SSHAuthorizedKeys, &out.SSHAuthorizedKeys
 		*out = make([]string, len(*in))
@@ -857,6 +864,61 @@ func (in *VirtualMachineBootstrapCloudInitSpec) DeepCopy() *VirtualMachineBootst
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBootstrapCloudInitUserDataPart) DeepCopyInto(out *VirtualMachineBootstrapCloudInitUserDataPart) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(common.ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBootstrapCloudInitUserDataPart.
+func (in *VirtualMachineBootstrapCloudInitUserDataPart) DeepCopy() *VirtualMachineBootstrapCloudInitUserDataPart {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBootstrapCloudInitUserDataPart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineBootstrapIgnitionSpec) DeepCopyInto(out *VirtualMachineBootstrapIgnitionSpec) {
+	*out = *in
+	if in.RawConfig != nil {
+		in, out := &in.RawConfig, &out.RawConfig
+		*out = new(common.SecretKeySelector)
+		**out = **in
+	}
+	if in.MergeNetworkConfig != nil {
+		in, out := &in.MergeNetworkConfig, &out.MergeNetworkConfig
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBootstrapIgnitionSpec.
+func (in *VirtualMachineBootstrapIgnitionSpec) DeepCopy() *VirtualMachineBootstrapIgnitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineBootstrapIgnitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineBootstrapLinuxPrepSpec) DeepCopyInto(out *VirtualMachineBootstrapLinuxPrepSpec) {
 	*out = *in
@@ -915,6 +977,11 @@ func (in *VirtualMachineBootstrapSpec) DeepCopyInto(out *VirtualMachineBootstrap
 		*out = new(VirtualMachineBootstrapVAppConfigSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(VirtualMachineBootstrapIgnitionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineBootstrapSpec.
@@ -1030,13 +1097,200 @@ func (in *VirtualMachineCdromSpec) DeepCopy() *VirtualMachineCdromSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCheck) DeepCopyInto(out *VirtualMachineCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCheck.
+func (in *VirtualMachineCheck) DeepCopy() *VirtualMachineCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCheckCondition) DeepCopyInto(out *VirtualMachineCheckCondition) {
+	*out = *in
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(VirtualMachineCheckJobCondition)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(VirtualMachineCheckConfigMapCondition)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(VirtualMachineCheckHTTPCondition)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCheckCondition.
+func (in *VirtualMachineCheckCondition) DeepCopy() *VirtualMachineCheckCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCheckCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCheckConfigMapCondition) DeepCopyInto(out *VirtualMachineCheckConfigMapCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCheckConfigMapCondition.
+func (in *VirtualMachineCheckConfigMapCondition) DeepCopy() *VirtualMachineCheckConfigMapCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCheckConfigMapCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCheckHTTPCondition) DeepCopyInto(out *VirtualMachineCheckHTTPCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCheckHTTPCondition.
+func (in *VirtualMachineCheckHTTPCondition) DeepCopy() *VirtualMachineCheckHTTPCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCheckHTTPCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCheckJobCondition) DeepCopyInto(out *VirtualMachineCheckJobCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCheckJobCondition.
+func (in *VirtualMachineCheckJobCondition) DeepCopy() *VirtualMachineCheckJobCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCheckJobCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCheckList) DeepCopyInto(out *VirtualMachineCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCheckList.
+func (in *VirtualMachineCheckList) DeepCopy() *VirtualMachineCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCheckSpec) DeepCopyInto(out *VirtualMachineCheckSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.Condition.DeepCopyInto(&out.Condition)
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCheckSpec.
+func (in *VirtualMachineCheckSpec) DeepCopy() *VirtualMachineCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCheckStatus) DeepCopyInto(out *VirtualMachineCheckStatus) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastEvaluationTime != nil {
+		in, out := &in.LastEvaluationTime, &out.LastEvaluationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCheckStatus.
+func (in *VirtualMachineCheckStatus) DeepCopy() *VirtualMachineCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineClass) DeepCopyInto(out *VirtualMachineClass) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClass.
@@ -1057,6 +1311,21 @@ func (in *VirtualMachineClass) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassDeprecation) DeepCopyInto(out *VirtualMachineClassDeprecation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassDeprecation.
+func (in *VirtualMachineClassDeprecation) DeepCopy() *VirtualMachineClassDeprecation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassDeprecation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineClassHardware) DeepCopyInto(out *VirtualMachineClassHardware) {
 	*out = *in
@@ -1213,6 +1482,147 @@ func (in *VirtualMachineClassPolicies) DeepCopy() *VirtualMachineClassPolicies {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassRecommendation) DeepCopyInto(out *VirtualMachineClassRecommendation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassRecommendation.
+func (in *VirtualMachineClassRecommendation) DeepCopy() *VirtualMachineClassRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineClassRecommendation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassRecommendationApply) DeepCopyInto(out *VirtualMachineClassRecommendationApply) {
+	*out = *in
+	out.MaintenanceWindow = in.MaintenanceWindow
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassRecommendationApply.
+func (in *VirtualMachineClassRecommendationApply) DeepCopy() *VirtualMachineClassRecommendationApply {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassRecommendationApply)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassRecommendationList) DeepCopyInto(out *VirtualMachineClassRecommendationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineClassRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassRecommendationList.
+func (in *VirtualMachineClassRecommendationList) DeepCopy() *VirtualMachineClassRecommendationList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassRecommendationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineClassRecommendationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassRecommendationSpec) DeepCopyInto(out *VirtualMachineClassRecommendationSpec) {
+	*out = *in
+	if in.SampleInterval != nil {
+		in, out := &in.SampleInterval, &out.SampleInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Apply != nil {
+		in, out := &in.Apply, &out.Apply
+		*out = new(VirtualMachineClassRecommendationApply)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassRecommendationSpec.
+func (in *VirtualMachineClassRecommendationSpec) DeepCopy() *VirtualMachineClassRecommendationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassRecommendationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineClassRecommendationStatus) DeepCopyInto(out *VirtualMachineClassRecommendationStatus) {
+	*out = *in
+	in.CPU.DeepCopyInto(&out.CPU)
+	in.Memory.DeepCopyInto(&out.Memory)
+	if in.WindowStartTime != nil {
+		in, out := &in.WindowStartTime, &out.WindowStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSampleTime != nil {
+		in, out := &in.LastSampleTime, &out.LastSampleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAppliedTime != nil {
+		in, out := &in.LastAppliedTime, &out.LastAppliedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassRecommendationStatus.
+func (in *VirtualMachineClassRecommendationStatus) DeepCopy() *VirtualMachineClassRecommendationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineClassRecommendationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineClassResources) DeepCopyInto(out *VirtualMachineClassResources) {
 	*out = *in
@@ -1240,6 +1650,11 @@ func (in *VirtualMachineClassSpec) DeepCopyInto(out *VirtualMachineClassSpec) {
 		*out = make(json.RawMessage, len(*in))
 		copy(*out, *in)
 	}
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(VirtualMachineClassDeprecation)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassSpec.
@@ -1255,6 +1670,11 @@ func (in *VirtualMachineClassSpec) DeepCopy() *VirtualMachineClassSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineClassStatus) DeepCopyInto(out *VirtualMachineClassStatus) {
 	*out = *in
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineClassStatus.
@@ -1691,66 +2111,219 @@ func (in *VirtualMachineHardwareSpec) DeepCopyInto(out *VirtualMachineHardwareSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CPUs != nil {
+		in, out := &in.CPUs, &out.CPUs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareSpec.
+func (in *VirtualMachineHardwareSpec) DeepCopy() *VirtualMachineHardwareSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineHardwareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineHardwareStatus) DeepCopyInto(out *VirtualMachineHardwareStatus) {
+	*out = *in
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]VirtualControllerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(VirtualMachineCPUAllocationStatus)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(VirtualMachineMemoryAllocationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VGPUs != nil {
+		in, out := &in.VGPUs, &out.VGPUs
+		*out = make([]VirtualMachineHardwareVGPUStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareStatus.
+func (in *VirtualMachineHardwareStatus) DeepCopy() *VirtualMachineHardwareStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineHardwareStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineHardwareVGPUStatus) DeepCopyInto(out *VirtualMachineHardwareVGPUStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareVGPUStatus.
+func (in *VirtualMachineHardwareVGPUStatus) DeepCopy() *VirtualMachineHardwareVGPUStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineHardwareVGPUStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineIPPool) DeepCopyInto(out *VirtualMachineIPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPool.
+func (in *VirtualMachineIPPool) DeepCopy() *VirtualMachineIPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineIPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineIPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineIPPoolAllocation) DeepCopyInto(out *VirtualMachineIPPoolAllocation) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPoolAllocation.
+func (in *VirtualMachineIPPoolAllocation) DeepCopy() *VirtualMachineIPPoolAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineIPPoolAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineIPPoolList) DeepCopyInto(out *VirtualMachineIPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareSpec.
-func (in *VirtualMachineHardwareSpec) DeepCopy() *VirtualMachineHardwareSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPoolList.
+func (in *VirtualMachineIPPoolList) DeepCopy() *VirtualMachineIPPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineHardwareSpec)
+	out := new(VirtualMachineIPPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineIPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineHardwareStatus) DeepCopyInto(out *VirtualMachineHardwareStatus) {
+func (in *VirtualMachineIPPoolRange) DeepCopyInto(out *VirtualMachineIPPoolRange) {
 	*out = *in
-	if in.Controllers != nil {
-		in, out := &in.Controllers, &out.Controllers
-		*out = make([]VirtualControllerStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.CPU != nil {
-		in, out := &in.CPU, &out.CPU
-		*out = new(VirtualMachineCPUAllocationStatus)
-		**out = **in
-	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
-		*out = new(VirtualMachineMemoryAllocationStatus)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPoolRange.
+func (in *VirtualMachineIPPoolRange) DeepCopy() *VirtualMachineIPPoolRange {
+	if in == nil {
+		return nil
 	}
-	if in.VGPUs != nil {
-		in, out := &in.VGPUs, &out.VGPUs
-		*out = make([]VirtualMachineHardwareVGPUStatus, len(*in))
+	out := new(VirtualMachineIPPoolRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineIPPoolSpec) DeepCopyInto(out *VirtualMachineIPPoolSpec) {
+	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]VirtualMachineIPPoolRange, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareStatus.
-func (in *VirtualMachineHardwareStatus) DeepCopy() *VirtualMachineHardwareStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPoolSpec.
+func (in *VirtualMachineIPPoolSpec) DeepCopy() *VirtualMachineIPPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineHardwareStatus)
+	out := new(VirtualMachineIPPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMachineHardwareVGPUStatus) DeepCopyInto(out *VirtualMachineHardwareVGPUStatus) {
+func (in *VirtualMachineIPPoolStatus) DeepCopyInto(out *VirtualMachineIPPoolStatus) {
 	*out = *in
+	if in.Allocations != nil {
+		in, out := &in.Allocations, &out.Allocations
+		*out = make([]VirtualMachineIPPoolAllocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineHardwareVGPUStatus.
-func (in *VirtualMachineHardwareVGPUStatus) DeepCopy() *VirtualMachineHardwareVGPUStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineIPPoolStatus.
+func (in *VirtualMachineIPPoolStatus) DeepCopy() *VirtualMachineIPPoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VirtualMachineHardwareVGPUStatus)
+	out := new(VirtualMachineIPPoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2174,6 +2747,22 @@ func (in *VirtualMachineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineMaintenanceWindow) DeepCopyInto(out *VirtualMachineMaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineMaintenanceWindow.
+func (in *VirtualMachineMaintenanceWindow) DeepCopy() *VirtualMachineMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineMemoryAllocationStatus) DeepCopyInto(out *VirtualMachineMemoryAllocationStatus) {
 	*out = *in
@@ -2199,6 +2788,27 @@ func (in *VirtualMachineMemoryAllocationStatus) DeepCopy() *VirtualMachineMemory
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkBondSpec) DeepCopyInto(out *VirtualMachineNetworkBondSpec) {
+	*out = *in
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.VirtualMachineNetworkGuestDeviceIPSpec.DeepCopyInto(&out.VirtualMachineNetworkGuestDeviceIPSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkBondSpec.
+func (in *VirtualMachineNetworkBondSpec) DeepCopy() *VirtualMachineNetworkBondSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkBondSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineNetworkConfigDHCPOptionsStatus) DeepCopyInto(out *VirtualMachineNetworkConfigDHCPOptionsStatus) {
 	*out = *in
@@ -2264,6 +2874,36 @@ func (in *VirtualMachineNetworkConfigDNSStatus) DeepCopy() *VirtualMachineNetwor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkConfigGuestDeviceStatus) DeepCopyInto(out *VirtualMachineNetworkConfigGuestDeviceStatus) {
+	*out = *in
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(VirtualMachineNetworkConfigInterfaceIPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(VirtualMachineNetworkConfigDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigGuestDeviceStatus.
+func (in *VirtualMachineNetworkConfigGuestDeviceStatus) DeepCopy() *VirtualMachineNetworkConfigGuestDeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkConfigGuestDeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineNetworkConfigInterfaceIPStatus) DeepCopyInto(out *VirtualMachineNetworkConfigInterfaceIPStatus) {
 	*out = *in
@@ -2329,6 +2969,13 @@ func (in *VirtualMachineNetworkConfigStatus) DeepCopyInto(out *VirtualMachineNet
 		*out = new(VirtualMachineNetworkConfigDNSStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestDevices != nil {
+		in, out := &in.GuestDevices, &out.GuestDevices
+		*out = make([]VirtualMachineNetworkConfigGuestDeviceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkConfigStatus.
@@ -2403,6 +3050,98 @@ func (in *VirtualMachineNetworkDNSStatus) DeepCopy() *VirtualMachineNetworkDNSSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkFirewallRule) DeepCopyInto(out *VirtualMachineNetworkFirewallRule) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkFirewallRule.
+func (in *VirtualMachineNetworkFirewallRule) DeepCopy() *VirtualMachineNetworkFirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkFirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkFirewallSpec) DeepCopyInto(out *VirtualMachineNetworkFirewallSpec) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]VirtualMachineNetworkFirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkFirewallSpec.
+func (in *VirtualMachineNetworkFirewallSpec) DeepCopy() *VirtualMachineNetworkFirewallSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkFirewallSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkGuestDeviceIPSpec) DeepCopyInto(out *VirtualMachineNetworkGuestDeviceIPSpec) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]VirtualMachineNetworkRouteSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkGuestDeviceIPSpec.
+func (in *VirtualMachineNetworkGuestDeviceIPSpec) DeepCopy() *VirtualMachineNetworkGuestDeviceIPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkGuestDeviceIPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineNetworkIPRouteGatewayStatus) DeepCopyInto(out *VirtualMachineNetworkIPRouteGatewayStatus) {
 	*out = *in
@@ -2642,6 +3381,25 @@ func (in *VirtualMachineNetworkSpec) DeepCopyInto(out *VirtualMachineNetworkSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VLANs != nil {
+		in, out := &in.VLANs, &out.VLANs
+		*out = make([]VirtualMachineNetworkVLANSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bonds != nil {
+		in, out := &in.Bonds, &out.Bonds
+		*out = make([]VirtualMachineNetworkBondSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(VirtualMachineNetworkFirewallSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkSpec.
@@ -2688,6 +3446,51 @@ func (in *VirtualMachineNetworkStatus) DeepCopy() *VirtualMachineNetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineNetworkVLANSpec) DeepCopyInto(out *VirtualMachineNetworkVLANSpec) {
+	*out = *in
+	in.VirtualMachineNetworkGuestDeviceIPSpec.DeepCopyInto(&out.VirtualMachineNetworkGuestDeviceIPSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineNetworkVLANSpec.
+func (in *VirtualMachineNetworkVLANSpec) DeepCopy() *VirtualMachineNetworkVLANSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineNetworkVLANSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePendingResizeStatus) DeepCopyInto(out *VirtualMachinePendingResizeStatus) {
+	*out = *in
+	if in.CPUs != nil {
+		in, out := &in.CPUs, &out.CPUs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ScheduledTime != nil {
+		in, out := &in.ScheduledTime, &out.ScheduledTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePendingResizeStatus.
+func (in *VirtualMachinePendingResizeStatus) DeepCopy() *VirtualMachinePendingResizeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePendingResizeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePlacementStatus) DeepCopyInto(out *VirtualMachinePlacementStatus) {
 	*out = *in
@@ -3057,6 +3860,26 @@ func (in *VirtualMachineResourceSpec) DeepCopy() *VirtualMachineResourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineResourceUsage) DeepCopyInto(out *VirtualMachineResourceUsage) {
+	*out = *in
+	if in.Histogram != nil {
+		in, out := &in.Histogram, &out.Histogram
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResourceUsage.
+func (in *VirtualMachineResourceUsage) DeepCopy() *VirtualMachineResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineService) DeepCopyInto(out *VirtualMachineService) {
 	*out = *in
@@ -3151,6 +3974,16 @@ func (in *VirtualMachineServiceSpec) DeepCopyInto(out *VirtualMachineServiceSpec
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]VirtualMachineServiceIPFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPFamilyPolicy != nil {
+		in, out := &in.IPFamilyPolicy, &out.IPFamilyPolicy
+		*out = new(VirtualMachineServiceIPFamilyPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineServiceSpec.
@@ -3490,6 +4323,11 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(VirtualMachineNetworkSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(VirtualMachineMaintenanceWindow)
+		**out = **in
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]VirtualMachineVolume, len(*in))
@@ -3610,6 +4448,11 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = make([]PolicyStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.PendingResize != nil {
+		in, out := &in.PendingResize, &out.PendingResize
+		*out = new(VirtualMachinePendingResizeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineStatus.
@@ -0,0 +1,265 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+// Command bootstrap-preview renders the bootstrap payload for a VM from its
+// YAML, without a Kubernetes or vSphere endpoint. It is used to catch template
+// and data errors before the guest boots.
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	apiyaml "k8s.io/apimachinery/pkg/util/yaml"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/yaml"
+
+	vmopapis "github.com/vmware-tanzu/vm-operator/api"
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+)
+
+func main() {
+	flag.Parse()
+
+	if vmPath == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	switch out {
+	case "yaml", "json":
+	default:
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	preview, err := run()
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if err := write(os.Stdout, preview); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if len(preview.TemplateErrors) > 0 {
+		for _, e := range preview.TemplateErrors {
+			_, _ = fmt.Fprintln(os.Stderr, e)
+		}
+		os.Exit(2)
+	}
+}
+
+var (
+	out           string
+	vmPath        string
+	resourcePaths string
+	showSensitive bool
+)
+
+func init() {
+	flag.StringVar(
+		&out,
+		"out",
+		"yaml",
+		"The output format of the command. Valid values include: yaml, json.",
+	)
+
+	flag.StringVar(
+		&vmPath,
+		"vm",
+		"",
+		"The path to the VirtualMachine YAML. Use - for stdin. Required.",
+	)
+
+	flag.StringVar(
+		&resourcePaths,
+		"resources",
+		"",
+		"A comma-separated list of paths to YAML files with the Secrets and "+
+			"ConfigMaps referenced by the VM's bootstrap spec.",
+	)
+
+	flag.BoolVar(
+		&showSensitive,
+		"show-sensitive",
+		false,
+		"Do not redact values that originate from Secrets.",
+	)
+}
+
+func run() (vmlifecycle.BootstrapPreview, error) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = vmopapis.AddToScheme(scheme)
+
+	vmObjs, err := readObjects(scheme, vmPath)
+	if err != nil {
+		return vmlifecycle.BootstrapPreview{}, err
+	}
+	if len(vmObjs) != 1 {
+		return vmlifecycle.BootstrapPreview{},
+			fmt.Errorf("expected one VirtualMachine in %s, got %d", vmPath, len(vmObjs))
+	}
+	vm, err := toHubVM(vmObjs[0])
+	if err != nil {
+		return vmlifecycle.BootstrapPreview{}, err
+	}
+
+	var objs []ctrlclient.Object
+	if resourcePaths != "" {
+		for _, p := range strings.Split(resourcePaths, ",") {
+			o, err := readObjects(scheme, p)
+			if err != nil {
+				return vmlifecycle.BootstrapPreview{}, err
+			}
+			for i := range o {
+				if o[i].GetNamespace() == "" {
+					o[i].SetNamespace(vm.Namespace)
+				}
+				if s, ok := o[i].(*corev1.Secret); ok {
+					// The API server merges stringData into data, so do the
+					// same here since there is no API server.
+					for k, v := range s.StringData {
+						if s.Data == nil {
+							s.Data = map[string][]byte{}
+						}
+						s.Data[k] = []byte(v)
+					}
+				}
+			}
+			objs = append(objs, o...)
+		}
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		Build()
+
+	cfg := pkgcfg.FromEnv()
+	cfg.LogSensitiveData = showSensitive
+
+	vmCtx := pkgctx.VirtualMachineContext{
+		Context: pkgcfg.WithContext(context.Background(), cfg),
+		Logger:  logr.Discard(),
+		VM:      vm,
+	}
+
+	bootstrapData, err := vsphere.GetVirtualMachineBootstrap(vmCtx, k8sClient)
+	if err != nil {
+		return vmlifecycle.BootstrapPreview{}, err
+	}
+
+	bsArgs, err := vmlifecycle.GetBootstrapArgs(
+		vmCtx,
+		k8sClient,
+		network.PreviewNetworkInterfaces(vm),
+		bootstrapData)
+	if err != nil {
+		return vmlifecycle.BootstrapPreview{}, err
+	}
+
+	return vmlifecycle.PreviewBootstrap(vmCtx, bsArgs)
+}
+
+func readObjects(scheme *runtime.Scheme, path string) ([]ctrlclient.Object, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := apiyaml.NewYAMLReader(bufio.NewReader(r))
+
+	var objs []ctrlclient.Object
+	for {
+		data, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if len(strings.TrimSpace(string(data))) == 0 {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(data, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode object in %s: %w", path, err)
+		}
+		cobj, ok := obj.(ctrlclient.Object)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object %T in %s", obj, path)
+		}
+		objs = append(objs, cobj)
+	}
+
+	return objs, nil
+}
+
+func toHubVM(obj ctrlclient.Object) (*vmopv1.VirtualMachine, error) {
+	if vm, ok := obj.(*vmopv1.VirtualMachine); ok {
+		return vm, nil
+	}
+
+	if c, ok := obj.(ctrlconversion.Convertible); ok {
+		vm := &vmopv1.VirtualMachine{}
+		if err := c.ConvertTo(vm); err != nil {
+			return nil, fmt.Errorf("failed to convert VirtualMachine: %w", err)
+		}
+		return vm, nil
+	}
+
+	return nil, fmt.Errorf("unexpected object %T, expected a VirtualMachine", obj)
+}
+
+func write(w io.Writer, preview vmlifecycle.BootstrapPreview) error {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch out {
+	case "json":
+		data, err = json.MarshalIndent(preview, "", "  ")
+		data = append(data, '\n')
+	default:
+		data, err = yaml.Marshal(preview)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -87,6 +88,22 @@ func AddToManager(
 			))
 	}
 
+	// Watch the metadata of the image trust anchors ConfigMap so the
+	// signatures of the images are verified again when the trust anchors
+	// change. Only the metadata is watched since ConfigMaps are not cached.
+	if pkgcfg.FromContext(ctx).ImageTrustAnchorsConfigMapName != "" {
+		builder = builder.WatchesMetadata(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(
+				TrustAnchorsConfigMapToItemMapper(
+					ctx,
+					r.Logger.WithName("TrustAnchorsConfigMapToItemMapper"),
+					r.Client,
+					imgregv1a1.GroupVersion,
+					controlledItemTypeName),
+			))
+	}
+
 	return builder.Complete(r)
 }
 
@@ -268,6 +285,7 @@ func (r *Reconciler) ReconcileNormal(
 	var (
 		didSync     bool
 		syncErr     error
+		verifyErr   error
 		savedStatus *vmopv1.VirtualMachineImageStatus
 	)
 
@@ -324,6 +342,18 @@ func (r *Reconciler) ReconcileNormal(
 				return nil
 			}
 
+			// Verify the image's signature before syncing its content. The
+			// image is still synced if it cannot be verified since it is up
+			// to each namespace whether unverified images may be deployed.
+			verifyErr = VerifyImageSignature(
+				ctx,
+				r.Client,
+				r.VMProvider,
+				cliObj,
+				cliStatus.ContentVersion,
+				vmiObj,
+				vmiStatus)
+
 			// If the sync is successful then the VMI resource is ready.
 			if syncErr = r.syncImageContent(
 				ctx,
@@ -379,6 +409,11 @@ func (r *Reconciler) ReconcileNormal(
 		return syncErr
 	}
 
+	if verifyErr != nil {
+		logger.Error(verifyErr, "Failed to verify image signature")
+		return verifyErr
+	}
+
 	logger.Info(
 		"Successfully reconciled library item",
 		"contentVersion", savedStatus.ProviderContentVersion)
@@ -6,6 +6,7 @@ package utils_test
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 
@@ -31,6 +32,7 @@ import (
 	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	imgutil "github.com/vmware-tanzu/vm-operator/pkg/util/image"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
@@ -396,6 +398,45 @@ var _ = Describe("Reconcile",
 						})
 					})
 
+					When("Image trust anchors are configured", func() {
+
+						JustBeforeEach(func() {
+							caPEM, _, err := builder.GenerateSelfSignedCertificate("ca")
+							Expect(err).ToNot(HaveOccurred())
+
+							pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+								config.ImageTrustAnchorsConfigMapName = "image-trust-anchors"
+							})
+							Expect(ctx.Client.Create(ctx, &corev1.ConfigMap{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: pkgcfg.FromContext(ctx).PodNamespace,
+									Name:      "image-trust-anchors",
+								},
+								Data: map[string]string{"ca.pem": string(caPEM)},
+							})).To(Succeed())
+
+							fakeVMProvider.VerifyVirtualMachineImageSignatureFn = func(
+								_ context.Context, _ client.Object, _ *x509.CertPool) error {
+
+								return fmt.Errorf("%w: unknown authority", imgutil.ErrSignatureUntrusted)
+							}
+						})
+
+						It("should set the signature condition and still sync the image", func() {
+							_, err := reconciler.Reconcile(context.Background(), req)
+							Expect(err).ToNot(HaveOccurred())
+
+							_, _, vmiStatus := getVMI(ctx, req.Namespace, vmiName)
+							Expect(vmiStatus.Firmware).To(Equal(firmwareValue))
+							Expect(pkgcnd.IsTrue(vmiStatus, vmopv1.ReadyConditionType)).To(BeTrue())
+
+							condition := pkgcnd.Get(vmiStatus, vmopv1.VirtualMachineImageSignatureVerifiedCondition)
+							Expect(condition).ToNot(BeNil())
+							Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+							Expect(condition.Reason).To(Equal(vmopv1.VirtualMachineImageSignatureUntrustedReason))
+						})
+					})
+
 					When("Image resource is exists but not up-to-date", func() {
 
 						JustBeforeEach(func() {
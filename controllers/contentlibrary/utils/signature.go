@@ -0,0 +1,239 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	imgutil "github.com/vmware-tanzu/vm-operator/pkg/util/image"
+)
+
+// VerifyImageSignature verifies the signature of the library item with the
+// trust anchors from the ConfigMap named by ImageTrustAnchorsConfigMapName,
+// and sets the outcome in the image's SignatureVerified condition. The hash of
+// the trust anchors the outcome depends on is recorded in the image's
+// ImageTrustAnchorsHashAnnotationKey annotation. The condition and annotation
+// are removed if there is no trust anchors ConfigMap.
+//
+// The signature is not verified again until the item's content version or the
+// trust anchors change, unless the previous verification failed.
+func VerifyImageSignature(
+	ctx context.Context,
+	k8sClient client.Client,
+	vmProvider providers.VirtualMachineProviderInterface,
+	cliObj client.Object,
+	contentVersion string,
+	vmiObj client.Object,
+	vmiStatus *vmopv1.VirtualMachineImageStatus) error {
+
+	const (
+		condType = vmopv1.VirtualMachineImageSignatureVerifiedCondition
+		hashKey  = pkgconst.ImageTrustAnchorsHashAnnotationKey
+	)
+
+	cmName := pkgcfg.FromContext(ctx).ImageTrustAnchorsConfigMapName
+	if cmName == "" {
+		pkgcnd.Delete(vmiStatus, condType)
+		deleteAnnotation(vmiObj, hashKey)
+		return nil
+	}
+
+	var cm corev1.ConfigMap
+	if err := k8sClient.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: pkgcfg.FromContext(ctx).PodNamespace,
+			Name:      cmName,
+		},
+		&cm); err != nil {
+
+		pkgcnd.MarkFalse(
+			vmiStatus,
+			condType,
+			vmopv1.VirtualMachineImageSignatureVerificationFailedReason,
+			"Failed to get trust anchors")
+		return fmt.Errorf(
+			"failed to get image trust anchors ConfigMap %s: %w", cmName, err)
+	}
+
+	trustAnchorsHash := imgutil.TrustAnchorsHash(cm.Data)
+
+	if vmiStatus.ProviderContentVersion == contentVersion &&
+		vmiObj.GetAnnotations()[hashKey] == trustAnchorsHash {
+
+		if c := pkgcnd.Get(vmiStatus, condType); c != nil &&
+			c.Reason != vmopv1.VirtualMachineImageSignatureVerificationFailedReason {
+
+			return nil
+		}
+	}
+
+	roots, err := imgutil.ParseTrustAnchors(cm.Data)
+	if err != nil {
+		pkgcnd.MarkFalse(
+			vmiStatus,
+			condType,
+			vmopv1.VirtualMachineImageSignatureVerificationFailedReason,
+			"Invalid trust anchors")
+		return fmt.Errorf(
+			"failed to parse image trust anchors ConfigMap %s: %w", cmName, err)
+	}
+
+	err = vmProvider.VerifyVirtualMachineImageSignature(ctx, cliObj, roots)
+	if err == nil ||
+		errors.Is(err, imgutil.ErrSignatureMissing) ||
+		errors.Is(err, imgutil.ErrSignatureInvalid) ||
+		errors.Is(err, imgutil.ErrSignatureUntrusted) {
+
+		setAnnotation(vmiObj, hashKey, trustAnchorsHash)
+	}
+
+	switch {
+	case err == nil:
+		pkgcnd.MarkTrue(vmiStatus, condType)
+	case errors.Is(err, imgutil.ErrSignatureMissing):
+		pkgcnd.MarkFalse(
+			vmiStatus,
+			condType,
+			vmopv1.VirtualMachineImageSignatureMissingReason,
+			"%v", err)
+	case errors.Is(err, imgutil.ErrSignatureInvalid):
+		pkgcnd.MarkFalse(
+			vmiStatus,
+			condType,
+			vmopv1.VirtualMachineImageSignatureInvalidReason,
+			"%v", err)
+	case errors.Is(err, imgutil.ErrSignatureUntrusted):
+		pkgcnd.MarkFalse(
+			vmiStatus,
+			condType,
+			vmopv1.VirtualMachineImageSignatureUntrustedReason,
+			"%v", err)
+	default:
+		pkgcnd.MarkFalse(
+			vmiStatus,
+			condType,
+			vmopv1.VirtualMachineImageSignatureVerificationFailedReason,
+			"Failed to verify signature")
+		return err
+	}
+
+	return nil
+}
+
+// TrustAnchorsConfigMapToItemMapper returns a mapper function that enqueues
+// all the library items of the provided kind when the image trust anchors
+// ConfigMap changes, so their signatures are verified with the new trust
+// anchors. The mapper may be used with a metadata-only watch.
+func TrustAnchorsConfigMapToItemMapper(
+	ctx context.Context,
+	logger logr.Logger,
+	k8sClient client.Client,
+	groupVersion schema.GroupVersion,
+	kind string) handler.MapFunc {
+
+	if ctx == nil {
+		panic("context is nil")
+	}
+	if k8sClient == nil {
+		panic("k8sClient is nil")
+	}
+	if groupVersion.Empty() {
+		panic("groupVersion is empty")
+	}
+	if kind == "" {
+		panic("kind is empty")
+	}
+
+	var (
+		cmKey = client.ObjectKey{
+			Namespace: pkgcfg.FromContext(ctx).PodNamespace,
+			Name:      pkgcfg.FromContext(ctx).ImageTrustAnchorsConfigMapName,
+		}
+		gvkString = groupVersion.WithKind(kind).String()
+		listGVK   = groupVersion.WithKind(kind + "List")
+	)
+
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		if ctx == nil {
+			panic("context is nil")
+		}
+		if o == nil {
+			panic("object is nil")
+		}
+
+		if cmKey.Name == "" || client.ObjectKeyFromObject(o) != cmKey {
+			return nil
+		}
+
+		logger := logger.WithValues(
+			"name", o.GetName(),
+			"namespace", o.GetNamespace())
+		logger.V(4).Info(
+			"Reconciling all library items due to image trust anchors change",
+			"resourceGVK", gvkString)
+
+		list := unstructured.UnstructuredList{
+			Object: map[string]interface{}{},
+		}
+		list.SetGroupVersionKind(listGVK)
+
+		if err := k8sClient.List(ctx, &list); err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.Error(
+					err,
+					"Failed to list resources due to image trust anchors watch",
+					"resourceGVK", gvkString)
+			}
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(list.Items))
+		for i := range list.Items {
+			requests = append(
+				requests,
+				reconcile.Request{
+					NamespacedName: client.ObjectKey{
+						Namespace: list.Items[i].GetNamespace(),
+						Name:      list.Items[i].GetName(),
+					},
+				})
+		}
+
+		return requests
+	}
+}
+
+func setAnnotation(obj client.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
+}
+
+func deleteAnnotation(obj client.Object, key string) {
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		delete(annotations, key)
+		obj.SetAnnotations(annotations)
+	}
+}
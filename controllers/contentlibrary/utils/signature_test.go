@@ -0,0 +1,349 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/controllers/contentlibrary/utils"
+	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	imgutil "github.com/vmware-tanzu/vm-operator/pkg/util/image"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("VerifyImageSignature", func() {
+
+	const (
+		trustAnchorsName = "image-trust-anchors"
+		contentVersion   = "v2"
+	)
+
+	var (
+		ctx            *builder.UnitTestContextForController
+		fakeVMProvider *providerfake.VMProvider
+
+		trustAnchors map[string]string
+
+		cliObj    client.Object
+		vmiObj    *vmopv1.VirtualMachineImage
+		vmiStatus *vmopv1.VirtualMachineImageStatus
+
+		verifyErr   error
+		verifyCalls int
+		err         error
+	)
+
+	BeforeEach(func() {
+		caPEM, _, genErr := builder.GenerateSelfSignedCertificate("ca")
+		Expect(genErr).ToNot(HaveOccurred())
+
+		trustAnchors = map[string]string{
+			"ca.pem": string(caPEM),
+		}
+
+		cliObj = utils.DummyV1A2ContentLibraryItem(utils.ItemFieldNamePrefix+"-dummy", "dummy-ns")
+		vmiObj = &vmopv1.VirtualMachineImage{}
+		vmiStatus = &vmiObj.Status
+		vmiStatus.ProviderContentVersion = "v1"
+
+		verifyErr = nil
+		verifyCalls = 0
+	})
+
+	JustBeforeEach(func() {
+		ctx = builder.NewUnitTestContextForController(nil)
+		pkgcfg.UpdateContext(ctx, func(config *pkgcfg.Config) {
+			config.ImageTrustAnchorsConfigMapName = trustAnchorsName
+		})
+
+		if trustAnchors != nil {
+			Expect(ctx.Client.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: pkgcfg.FromContext(ctx).PodNamespace,
+					Name:      trustAnchorsName,
+				},
+				Data: trustAnchors,
+			})).To(Succeed())
+		}
+
+		fakeVMProvider = ctx.VMProvider.(*providerfake.VMProvider)
+		fakeVMProvider.VerifyVirtualMachineImageSignatureFn = func(
+			_ context.Context,
+			obj client.Object,
+			roots *x509.CertPool) error {
+
+			Expect(obj).To(Equal(cliObj))
+			Expect(roots).ToNot(BeNil())
+			verifyCalls++
+			return verifyErr
+		}
+	})
+
+	AfterEach(func() {
+		fakeVMProvider.Reset()
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	verify := func() {
+		err = utils.VerifyImageSignature(
+			ctx,
+			ctx.Client,
+			ctx.VMProvider,
+			cliObj,
+			contentVersion,
+			vmiObj,
+			vmiStatus)
+	}
+
+	assertCondition := func(status metav1.ConditionStatus, reason string) {
+		GinkgoHelper()
+		c := pkgcnd.Get(vmiStatus, vmopv1.VirtualMachineImageSignatureVerifiedCondition)
+		Expect(c).ToNot(BeNil())
+		Expect(c.Status).To(Equal(status))
+		Expect(c.Reason).To(Equal(reason))
+	}
+
+	When("the trust anchors ConfigMap is not configured", func() {
+		JustBeforeEach(func() {
+			pkgcfg.UpdateContext(ctx, func(config *pkgcfg.Config) {
+				config.ImageTrustAnchorsConfigMapName = ""
+			})
+			pkgcnd.MarkTrue(vmiStatus, vmopv1.VirtualMachineImageSignatureVerifiedCondition)
+			vmiObj.Annotations = map[string]string{
+				pkgconst.ImageTrustAnchorsHashAnnotationKey: "hash",
+			}
+		})
+		It("should remove the condition and annotation", func() {
+			verify()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verifyCalls).To(BeZero())
+			Expect(pkgcnd.Has(vmiStatus, vmopv1.VirtualMachineImageSignatureVerifiedCondition)).To(BeFalse())
+			Expect(vmiObj.Annotations).ToNot(HaveKey(pkgconst.ImageTrustAnchorsHashAnnotationKey))
+		})
+	})
+
+	When("the trust anchors ConfigMap does not exist", func() {
+		BeforeEach(func() {
+			trustAnchors = nil
+		})
+		It("should return an error", func() {
+			verify()
+			Expect(err).To(MatchError(ContainSubstring(
+				fmt.Sprintf("failed to get image trust anchors ConfigMap %s", trustAnchorsName))))
+			Expect(verifyCalls).To(BeZero())
+			assertCondition(metav1.ConditionFalse, vmopv1.VirtualMachineImageSignatureVerificationFailedReason)
+		})
+	})
+
+	When("the trust anchors ConfigMap does not have any certificates", func() {
+		BeforeEach(func() {
+			trustAnchors = map[string]string{"ca.pem": "hello"}
+		})
+		It("should return an error", func() {
+			verify()
+			Expect(err).To(MatchError(ContainSubstring(
+				fmt.Sprintf("failed to parse image trust anchors ConfigMap %s", trustAnchorsName))))
+			Expect(verifyCalls).To(BeZero())
+			assertCondition(metav1.ConditionFalse, vmopv1.VirtualMachineImageSignatureVerificationFailedReason)
+		})
+	})
+
+	When("the signature is verified", func() {
+		It("should mark the condition true and record the trust anchors", func() {
+			verify()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verifyCalls).To(Equal(1))
+			assertCondition(metav1.ConditionTrue, "True")
+			Expect(vmiObj.Annotations).To(HaveKeyWithValue(
+				pkgconst.ImageTrustAnchorsHashAnnotationKey, imgutil.TrustAnchorsHash(trustAnchors)))
+		})
+	})
+
+	DescribeTable("the signature is not verified",
+		func(providerErr error, expectedReason string, expectErr bool) {
+			verifyErr = providerErr
+			verify()
+			if expectErr {
+				Expect(err).To(MatchError(providerErr))
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(verifyCalls).To(Equal(1))
+			assertCondition(metav1.ConditionFalse, expectedReason)
+			if expectErr {
+				Expect(vmiObj.Annotations).ToNot(HaveKey(pkgconst.ImageTrustAnchorsHashAnnotationKey))
+			} else {
+				Expect(vmiObj.Annotations).To(HaveKeyWithValue(
+					pkgconst.ImageTrustAnchorsHashAnnotationKey, imgutil.TrustAnchorsHash(trustAnchors)))
+			}
+		},
+		Entry("missing", fmt.Errorf("%w: no .mf", imgutil.ErrSignatureMissing),
+			vmopv1.VirtualMachineImageSignatureMissingReason, false),
+		Entry("invalid", fmt.Errorf("%w: bad digest", imgutil.ErrSignatureInvalid),
+			vmopv1.VirtualMachineImageSignatureInvalidReason, false),
+		Entry("untrusted", fmt.Errorf("%w: unknown authority", imgutil.ErrSignatureUntrusted),
+			vmopv1.VirtualMachineImageSignatureUntrustedReason, false),
+		Entry("failed", errors.New("download failed"),
+			vmopv1.VirtualMachineImageSignatureVerificationFailedReason, true),
+	)
+
+	When("the content version and trust anchors have not changed", func() {
+		BeforeEach(func() {
+			vmiStatus.ProviderContentVersion = contentVersion
+			vmiObj.Annotations = map[string]string{
+				pkgconst.ImageTrustAnchorsHashAnnotationKey: imgutil.TrustAnchorsHash(trustAnchors),
+			}
+		})
+
+		When("the signature was verified", func() {
+			BeforeEach(func() {
+				pkgcnd.MarkTrue(vmiStatus, vmopv1.VirtualMachineImageSignatureVerifiedCondition)
+			})
+			It("should not verify the signature again", func() {
+				verify()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(verifyCalls).To(BeZero())
+				assertCondition(metav1.ConditionTrue, "True")
+			})
+
+			When("the trust anchors have changed", func() {
+				BeforeEach(func() {
+					caPEM, _, genErr := builder.GenerateSelfSignedCertificate("another-ca")
+					Expect(genErr).ToNot(HaveOccurred())
+					trustAnchors = map[string]string{
+						"ca.pem": string(caPEM),
+					}
+				})
+				It("should verify the signature again", func() {
+					verifyErr = fmt.Errorf("%w: unknown authority", imgutil.ErrSignatureUntrusted)
+					verify()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(verifyCalls).To(Equal(1))
+					assertCondition(metav1.ConditionFalse, vmopv1.VirtualMachineImageSignatureUntrustedReason)
+					Expect(vmiObj.Annotations).To(HaveKeyWithValue(
+						pkgconst.ImageTrustAnchorsHashAnnotationKey, imgutil.TrustAnchorsHash(trustAnchors)))
+				})
+			})
+		})
+
+		When("the signature was invalid", func() {
+			BeforeEach(func() {
+				pkgcnd.MarkFalse(vmiStatus, vmopv1.VirtualMachineImageSignatureVerifiedCondition,
+					vmopv1.VirtualMachineImageSignatureInvalidReason, "bad digest")
+			})
+			It("should not verify the signature again", func() {
+				verify()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(verifyCalls).To(BeZero())
+				assertCondition(metav1.ConditionFalse, vmopv1.VirtualMachineImageSignatureInvalidReason)
+			})
+		})
+
+		When("the signature was untrusted", func() {
+			BeforeEach(func() {
+				pkgcnd.MarkFalse(vmiStatus, vmopv1.VirtualMachineImageSignatureVerifiedCondition,
+					vmopv1.VirtualMachineImageSignatureUntrustedReason, "unknown authority")
+			})
+			It("should not verify the signature again", func() {
+				verify()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(verifyCalls).To(BeZero())
+				assertCondition(metav1.ConditionFalse, vmopv1.VirtualMachineImageSignatureUntrustedReason)
+			})
+		})
+
+		When("the verification failed", func() {
+			BeforeEach(func() {
+				pkgcnd.MarkFalse(vmiStatus, vmopv1.VirtualMachineImageSignatureVerifiedCondition,
+					vmopv1.VirtualMachineImageSignatureVerificationFailedReason, "Failed to verify signature")
+			})
+			It("should verify the signature again", func() {
+				verify()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(verifyCalls).To(Equal(1))
+				assertCondition(metav1.ConditionTrue, "True")
+			})
+		})
+	})
+})
+
+var _ = Describe("TrustAnchorsConfigMapToItemMapper", func() {
+
+	const trustAnchorsName = "image-trust-anchors"
+
+	var (
+		ctx      *builder.UnitTestContextForController
+		mapFn    handler.MapFunc
+		obj      client.Object
+		requests []reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctx = builder.NewUnitTestContextForController([]client.Object{
+			utils.DummyContentLibraryItem("item-1", "ns-1"),
+			utils.DummyContentLibraryItem("item-2", "ns-2"),
+		})
+		pkgcfg.UpdateContext(ctx, func(config *pkgcfg.Config) {
+			config.ImageTrustAnchorsConfigMapName = trustAnchorsName
+		})
+
+		obj = &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: pkgcfg.FromContext(ctx).PodNamespace,
+				Name:      trustAnchorsName,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		mapFn = utils.TrustAnchorsConfigMapToItemMapper(
+			ctx,
+			ctx.Logger,
+			ctx.Client,
+			imgregv1a1.GroupVersion,
+			"ContentLibraryItem")
+		requests = mapFn(ctx, obj)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("should return requests for all the library items", func() {
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "ns-1", Name: "item-1"}},
+			reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "ns-2", Name: "item-2"}},
+		))
+	})
+
+	When("the ConfigMap is not the trust anchors ConfigMap", func() {
+		BeforeEach(func() {
+			obj.SetName("other-config-map")
+		})
+		It("should not return any requests", func() {
+			Expect(requests).To(BeEmpty())
+		})
+	})
+})
@@ -29,6 +29,7 @@ import (
 
 type provider interface {
 	UpdateVcCreds(ctx context.Context, data map[string][]byte) error
+	UpdateVCenterCreds(ctx context.Context, secretName string, data map[string][]byte) error
 }
 
 // AddToManager adds this package's controller to the provided manager.
@@ -45,7 +46,8 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		Namespace: ctx.Namespace,
 	}
 
-	// This controller only watches Secrets in the pod namespace.
+	// This controller only watches Secrets in the pod namespace. Any of them
+	// may be the credentials for the vCenter that backs an availability zone.
 	cache, err := pkgmgr.NewNamespacedCacheForObject(
 		mgr,
 		&ctx.SyncPeriod,
@@ -78,12 +80,6 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		controlledType,
 		&handler.TypedEnqueueRequestForObject[*corev1.Secret]{},
 		predicate.TypedFuncs[*corev1.Secret]{
-			CreateFunc: func(e event.TypedCreateEvent[*corev1.Secret]) bool {
-				return e.Object.GetName() == vcCredsKey.Name
-			},
-			UpdateFunc: func(e event.TypedUpdateEvent[*corev1.Secret]) bool {
-				return e.ObjectOld.GetName() == vcCredsKey.Name
-			},
 			DeleteFunc: func(e event.TypedDeleteEvent[*corev1.Secret]) bool {
 				return false
 			},
@@ -127,20 +123,28 @@ type Reconciler struct {
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ctx = pkgcfg.JoinContext(ctx, r.Context)
 
-	if req.NamespacedName == r.vcCredsKey {
-		return ctrl.Result{}, r.reconcileVcCreds(ctx)
+	if req.Namespace != r.vcCredsKey.Namespace {
+		pkglog.FromContextOrDefault(ctx).Error(nil, "Reconciling unexpected object")
+		return ctrl.Result{}, nil
 	}
 
-	pkglog.FromContextOrDefault(ctx).Error(nil, "Reconciling unexpected object")
-	return ctrl.Result{}, nil
-}
-
-func (r *Reconciler) reconcileVcCreds(ctx context.Context) error {
 	secret := corev1.Secret{}
-	if err := r.SecretReader.Get(ctx, r.vcCredsKey, &secret); err != nil {
-		return ctrlclient.IgnoreNotFound(err)
+	if err := r.SecretReader.Get(ctx, req.NamespacedName, &secret); err != nil {
+		return ctrl.Result{}, ctrlclient.IgnoreNotFound(err)
 	}
 
+	if req.NamespacedName == r.vcCredsKey {
+		if err := r.reconcileVcCreds(ctx, secret); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The vCenters that back availability zones may use their own
+	// credentials Secret or the default one.
+	return ctrl.Result{}, r.provider.UpdateVCenterCreds(ctx, secret.Name, secret.Data)
+}
+
+func (r *Reconciler) reconcileVcCreds(ctx context.Context, secret corev1.Secret) error {
 	pkglog.FromContextOrDefault(ctx).Info("Reconciling updated VM Operator credentials")
 	return r.provider.UpdateVcCreds(ctx, secret.Data)
 }
@@ -47,6 +47,42 @@ func intgTestsReconcile() {
 		provider.Reset()
 	})
 
+	Context("vCenter credentials Secret for a zone", func() {
+		var (
+			obj         *corev1.Secret
+			calledNames chan string
+		)
+
+		BeforeEach(func() {
+			calledNames = make(chan string, 10)
+			obj = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ctx.PodNamespace,
+					Name:      "zone-vc-creds",
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			provider.Lock()
+			provider.UpdateVCenterCredsFn = func(_ context.Context, secretName string, _ map[string][]byte) error {
+				calledNames <- secretName
+				return nil
+			}
+			provider.Unlock()
+			Expect(ctx.Client.Create(ctx, obj)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			err := ctx.Client.Delete(ctx, obj)
+			Expect(err == nil || apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should be reconciled", func() {
+			Eventually(calledNames).Should(Receive(Equal(obj.Name)))
+		})
+	})
+
 	Context("VcCredsSecret", func() {
 		var (
 			obj    *corev1.Secret
@@ -10,9 +10,14 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/vmware/govmomi/session"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -24,7 +29,9 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/watcher"
 )
 
@@ -34,6 +41,10 @@ import (
 // managers.
 var SkipNameValidation *bool
 
+// VCenterHealthCheckInterval is how often the health of the vCenter that backs
+// a zone is checked. It is a variable so tests may shorten it.
+var VCenterHealthCheckInterval = 1 * time.Minute
+
 // AddToManager adds this package's controller to the provided manager.
 func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
 	var (
@@ -49,6 +60,7 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		mgr.GetClient(),
 		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
 		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		ctx.VMProvider,
 	)
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -64,29 +76,53 @@ func NewReconciler(
 	ctx context.Context,
 	client client.Client,
 	logger logr.Logger,
-	recorder record.Recorder) *Reconciler {
+	recorder record.Recorder,
+	vmProvider providers.VirtualMachineProviderInterface) *Reconciler {
 
 	return &Reconciler{
-		Context:  ctx,
-		Client:   client,
-		Logger:   logger,
-		Recorder: recorder,
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		Recorder:   recorder,
+		VMProvider: vmProvider,
 	}
 }
 
 // Finalizer is the finalizer placed on Zone objects by VM Operator.
 const Finalizer = "vmoperator.vmware.com/zone-finalizer"
 
-// Reconciler reconciles a StoragePolicyQuota object.
+const (
+	// ConditionVCenterReady is the condition on a Zone that reports the health
+	// of the vCenter that backs the zone.
+	ConditionVCenterReady = "VCenterReady"
+
+	// ConditionReasonVCenterClientFailed is the reason for the
+	// ConditionVCenterReady condition when a client for the vCenter could not
+	// be created.
+	ConditionReasonVCenterClientFailed = "ClientFailed"
+
+	// ConditionReasonVCenterSessionInvalid is the reason for the
+	// ConditionVCenterReady condition when the client does not have a valid
+	// session with the vCenter.
+	ConditionReasonVCenterSessionInvalid = "SessionInvalid"
+
+	// ConditionReasonVCenterConnected is the reason for the
+	// ConditionVCenterReady condition when the client has a valid session with
+	// the vCenter.
+	ConditionReasonVCenterConnected = "Connected"
+)
+
+// Reconciler reconciles a Zone object.
 type Reconciler struct {
 	client.Client
-	Context  context.Context
-	Logger   logr.Logger
-	Recorder record.Recorder
+	Context    context.Context
+	Logger     logr.Logger
+	Recorder   record.Recorder
+	VMProvider providers.VirtualMachineProviderInterface
 }
 
 // +kubebuilder:rbac:groups=topology.tanzu.vmware.com,resources=zones,verbs=get;list;watch;update;patch
-// +kubebuilder:rbac:groups=topology.tanzu.vmware.com,resources=zones/status,verbs=get
+// +kubebuilder:rbac:groups=topology.tanzu.vmware.com,resources=zones/status,verbs=get;update;patch
 
 func (r *Reconciler) Reconcile(
 	ctx context.Context,
@@ -126,6 +162,11 @@ func (r *Reconciler) ReconcileDelete(
 	obj *topologyv1.Zone) (ctrl.Result, error) {
 
 	if val := obj.Spec.ManagedVMs.FolderMoID; val != "" {
+		ctx, err := r.withVCenterWatcher(ctx, obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
 		if err := watcher.Remove(
 			ctx,
 			vimtypes.ManagedObjectReference{
@@ -157,7 +198,14 @@ func (r *Reconciler) ReconcileNormal(
 		return ctrl.Result{}, nil
 	}
 
+	r.reconcileVCenterHealth(ctx, obj)
+
 	if val := obj.Spec.ManagedVMs.FolderMoID; val != "" {
+		ctx, err := r.withVCenterWatcher(ctx, obj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
 		if err := watcher.Add(
 			ctx,
 			vimtypes.ManagedObjectReference{
@@ -172,5 +220,52 @@ func (r *Reconciler) ReconcileNormal(
 		}
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue so the vCenter health is reported again after an outage of, or
+	// a recovery from an outage of, the vCenter.
+	return ctrl.Result{RequeueAfter: VCenterHealthCheckInterval}, nil
+}
+
+// withVCenterWatcher returns a context for the watcher of the vCenter that
+// backs the zone.
+func (r *Reconciler) withVCenterWatcher(
+	ctx context.Context,
+	obj *topologyv1.Zone) (context.Context, error) {
+
+	vc, _, err := topology.GetAvailabilityZoneVCenter(ctx, r.Client, obj.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	return watcher.WithVCenter(ctx, vc.PNID), nil
+}
+
+// reconcileVCenterHealth reports the health of the vCenter that backs the zone
+// with the ConditionVCenterReady condition.
+func (r *Reconciler) reconcileVCenterHealth(
+	ctx context.Context,
+	obj *topologyv1.Zone) {
+
+	cond := metav1.Condition{
+		Type:               ConditionVCenterReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ConditionReasonVCenterConnected,
+		ObservedGeneration: obj.Generation,
+	}
+
+	if c, err := r.VMProvider.VSphereClientForZone(ctx, obj.Name); err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = ConditionReasonVCenterClientFailed
+		cond.Message = err.Error()
+	} else if !c.Valid() {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = ConditionReasonVCenterSessionInvalid
+	} else if us, err := session.NewManager(c.VimClient()).UserSession(ctx); err != nil || us == nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = ConditionReasonVCenterSessionInvalid
+		if err != nil {
+			cond.Message = err.Error()
+		}
+	}
+
+	meta.SetStatusCondition(&obj.Status.Conditions, cond)
 }
@@ -6,6 +6,7 @@ package zone_test
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -14,6 +15,8 @@ import (
 
 	"github.com/vmware/govmomi/object"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
@@ -116,6 +119,63 @@ var _ = Describe(
 				})
 			})
 
+			Specify("zones should report the health of their vCenter", func() {
+				Eventually(func(g Gomega) {
+					var obj topologyv1.ZoneList
+					g.Expect(vcSimCtx.Client.List(ctx, &obj, ctrlclient.InNamespace(nsInfo.Namespace))).To(Succeed())
+					g.Expect(obj.Items).ToNot(BeEmpty())
+					for i := range obj.Items {
+						c := meta.FindStatusCondition(obj.Items[i].Status.Conditions, zone.ConditionVCenterReady)
+						g.Expect(c).ToNot(BeNil())
+						g.Expect(c.Status).To(Equal(metav1.ConditionTrue))
+						g.Expect(c.Reason).To(Equal(zone.ConditionReasonVCenterConnected))
+					}
+				}).Should(Succeed())
+			})
+
+			When("the vCenter becomes unavailable and then recovers", func() {
+				BeforeEach(func() {
+					interval := zone.VCenterHealthCheckInterval
+					zone.VCenterHealthCheckInterval = time.Second
+					DeferCleanup(func() {
+						zone.VCenterHealthCheckInterval = interval
+					})
+				})
+
+				Specify("zones should report the current health of their vCenter", func() {
+					assertVCenterReady := func(status metav1.ConditionStatus, reason string) {
+						GinkgoHelper()
+						Eventually(func(g Gomega) {
+							var obj topologyv1.ZoneList
+							g.Expect(vcSimCtx.Client.List(ctx, &obj, ctrlclient.InNamespace(nsInfo.Namespace))).To(Succeed())
+							g.Expect(obj.Items).ToNot(BeEmpty())
+							for i := range obj.Items {
+								c := meta.FindStatusCondition(obj.Items[i].Status.Conditions, zone.ConditionVCenterReady)
+								g.Expect(c).ToNot(BeNil())
+								g.Expect(c.Status).To(Equal(status))
+								g.Expect(c.Reason).To(Equal(reason))
+							}
+						}).Should(Succeed())
+					}
+
+					assertVCenterReady(metav1.ConditionTrue, zone.ConditionReasonVCenterConnected)
+
+					provider.Lock()
+					provider.VSphereClientForZoneFn = func(_ context.Context, _ string) (*vsclient.Client, error) {
+						return nil, errors.New("vCenter is unavailable")
+					}
+					provider.Unlock()
+
+					assertVCenterReady(metav1.ConditionFalse, zone.ConditionReasonVCenterClientFailed)
+
+					provider.Lock()
+					provider.VSphereClientForZoneFn = nil
+					provider.Unlock()
+
+					assertVCenterReady(metav1.ConditionTrue, zone.ConditionReasonVCenterConnected)
+				})
+			})
+
 			When("no vms exist in the zone's vm service folder", func() {
 
 				const (
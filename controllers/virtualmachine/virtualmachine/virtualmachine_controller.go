@@ -13,7 +13,9 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -23,8 +25,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	netopv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	byokv1 "github.com/vmware-tanzu/vm-operator/external/byok/api/v1alpha1"
+	ncpv1alpha1 "github.com/vmware-tanzu/vm-operator/external/ncp/api/v1alpha1"
 	cnsv1alpha1 "github.com/vmware-tanzu/vm-operator/external/vsphere-csi-driver/api/v1alpha1"
 	pkgcond "github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
@@ -38,6 +44,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/prober"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
@@ -102,6 +109,49 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 	builder = builder.Watches(&vmopv1.VirtualMachineClass{},
 		handler.EnqueueRequestsFromMapFunc(classToVMMapperFn(ctx, r.Client)))
 
+	// Watch the network interface resources for the configured network
+	// provider so VMs waiting for their interfaces to be reconciled are
+	// requeued when the interface status is updated.
+	var networkInterfaceType client.Object
+	switch pkgcfg.FromContext(ctx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeVDS:
+		networkInterfaceType = &netopv1alpha1.NetworkInterface{}
+	case pkgcfg.NetworkProviderTypeNSXT:
+		networkInterfaceType = &ncpv1alpha1.VirtualNetworkInterface{}
+	case pkgcfg.NetworkProviderTypeVPC:
+		networkInterfaceType = &vpcv1alpha1.SubnetPort{}
+	}
+	if networkInterfaceType != nil {
+		builder = builder.Watches(
+			networkInterfaceType,
+			handler.EnqueueRequestsFromMapFunc(networkInterfaceToVMMapperFn(ctx)))
+	}
+
+	// Watch VirtualMachineIPPools so VMs waiting for addresses to be allocated
+	// for their network interfaces are requeued when the allocations change.
+	switch pkgcfg.FromContext(ctx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeVDS, pkgcfg.NetworkProviderTypeNamed:
+		builder = builder.Watches(
+			&vmopv1.VirtualMachineIPPool{},
+			handler.EnqueueRequestsFromMapFunc(ipPoolToVMMapperFn(ctx)))
+	}
+
+	// Watch the metadata of Secrets and ConfigMaps so VMs are requeued when a
+	// resource referenced by their bootstrap spec changes, ex. when the
+	// Sysprep domain join credentials are rotated or a CloudInit user data
+	// part is updated. Only the metadata is watched since Secrets and
+	// ConfigMaps are not cached.
+	builder = builder.WatchesMetadata(
+		&corev1.Secret{},
+		handler.EnqueueRequestsFromMapFunc(
+			vmopv1util.BootstrapSecretToVirtualMachineMapper(ctx, r.Client),
+		))
+	builder = builder.WatchesMetadata(
+		&corev1.ConfigMap{},
+		handler.EnqueueRequestsFromMapFunc(
+			vmopv1util.BootstrapConfigMapToVirtualMachineMapper(ctx, r.Client),
+		))
+
 	if pkgcfg.FromContext(ctx).Features.BringYourOwnEncryptionKey {
 		builder = builder.Watches(
 			&byokv1.EncryptionClass{},
@@ -216,6 +266,70 @@ func classToVMMapperFn(
 	}
 }
 
+// networkInterfaceToVMMapperFn returns a mapper function that can be used to
+// queue a reconcile request for the VirtualMachine that owns a network
+// interface resource, i.e. a NetOP NetworkInterface, NCP
+// VirtualNetworkInterface, or VPC SubnetPort.
+func networkInterfaceToVMMapperFn(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		vmName := o.GetLabels()[network.VMNameLabel]
+		if vmName == "" {
+			if ref := metav1.GetControllerOf(o); ref != nil &&
+				ref.Kind == "VirtualMachine" &&
+				strings.HasPrefix(ref.APIVersion, vmopv1.GroupName+"/") {
+
+				vmName = ref.Name
+			}
+		}
+		if vmName == "" {
+			return nil
+		}
+
+		ctx.Logger.V(4).Info(
+			"Reconciling VM because of a network interface watch",
+			"name", o.GetName(), "namespace", o.GetNamespace(), "vmName", vmName)
+
+		return []reconcile.Request{
+			{
+				NamespacedName: client.ObjectKey{
+					Namespace: o.GetNamespace(),
+					Name:      vmName,
+				},
+			},
+		}
+	}
+}
+
+// ipPoolToVMMapperFn returns a mapper function that can be used to queue
+// reconcile requests for the VirtualMachines that have addresses allocated by
+// a VirtualMachineIPPool.
+func ipPoolToVMMapperFn(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		pool := o.(*vmopv1.VirtualMachineIPPool)
+
+		var reconcileRequests []reconcile.Request
+		for _, a := range pool.Status.Allocations {
+			key := client.ObjectKey{Namespace: pool.Namespace, Name: a.VirtualMachineName}
+			if len(reconcileRequests) > 0 &&
+				reconcileRequests[len(reconcileRequests)-1].NamespacedName == key {
+				// The allocations are sorted by VM name.
+				continue
+			}
+			reconcileRequests = append(reconcileRequests, reconcile.Request{NamespacedName: key})
+		}
+
+		if len(reconcileRequests) > 0 {
+			ctx.Logger.V(4).Info("Returning VM reconcile requests due to VirtualMachineIPPool watch",
+				"name", pool.Name, "namespace", pool.Namespace, "requests", reconcileRequests)
+		}
+		return reconcileRequests
+	}
+}
+
 func NewReconciler(
 	ctx context.Context,
 	client client.Client,
@@ -249,14 +363,18 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclasses,verbs=get;list
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineippools,verbs=get;list;watch
 // +kubebuilder:rbac:groups=vmware.com,resources=virtualnetworkinterfaces;virtualnetworkinterfaces/status,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=netoperator.vmware.com,resources=networkinterfaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cns.vmware.com,resources=storagepolicyquotas,verbs=get;list;watch
 // +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=subnetports,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=subnetports/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=crd.nsx.vmware.com,resources=securitypolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=nsx.vmware.com,resources=securitypolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events;configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=resourcequotas;namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=encryption.vmware.com,resources=encryptionclasses,verbs=get;list;watch
 
 // Reconcile the object.
@@ -399,6 +517,14 @@ func requeueDelay(
 		return 0
 	}
 
+	// Do not requeue if the network interfaces are not ready. Instead allow
+	// the watcher to trigger the reconcile when the interfaces are updated.
+	if pkgcond.GetReason(ctx.VM, vmopv1.VirtualMachineConditionNetworkReady) ==
+		vmopv1.VirtualMachineWaitingForNetworkReason {
+
+		return 0
+	}
+
 	// If there were too many concurrent create operations or if the VM is in
 	// Creating phase, the reconciler has run out of threads or goroutines to
 	// Create VMs on the provider. Do not queue immediately to avoid exponential
@@ -554,6 +680,14 @@ func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineContext) (reterr
 	}
 
 	switch {
+	case pkgerr.IsNetworkNotReadyError(err):
+
+		// If the network interfaces are not yet ready then do not return an
+		// error or emit an event, but simultaneously, do not reflect a
+		// successful create or update. The VM will be requeued by the watch
+		// on the network interface resources.
+		err = nil
+
 	case ctxop.IsCreate(ctx) && !ignoredCreateErr(err):
 
 		if chanErr == nil {
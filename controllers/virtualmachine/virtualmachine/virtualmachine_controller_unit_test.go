@@ -20,6 +20,7 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 
 	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachine/virtualmachine"
+	pkgcond "github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
@@ -157,6 +158,33 @@ func unitTestsReconcile() {
 				Expect(res.RequeueAfter).To(Equal(pkgcfg.FromContext(ctx).CreateVMRequeueDelay))
 			})
 		})
+
+		When("reconcile fails with NetworkNotReadyError error", func() {
+			It("should succeed without requeue delay or events", func() {
+				providerfake.SetCreateOrUpdateFunction(
+					ctx,
+					fakeVMProvider,
+					func(ctx context.Context, vm *vmopv1.VirtualMachine) error {
+						ctxop.MarkCreate(ctx)
+						pkgcond.MarkFalse(
+							vm,
+							vmopv1.VirtualMachineConditionNetworkReady,
+							vmopv1.VirtualMachineWaitingForNetworkReason,
+							"not ready")
+						return pkgerr.NetworkNotReadyError{Name: "eth0"}
+					},
+				)
+
+				req := ctrl.Request{}
+				req.Namespace = vm.Namespace
+				req.Name = vm.Name
+
+				res, err := reconciler.Reconcile(ctx, req)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.RequeueAfter).To(BeZero())
+				expectEvents(ctx)
+			})
+		})
 	})
 
 	Context("ReconcileNormal", func() {
@@ -0,0 +1,458 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinecheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+const (
+	// Finalizer is the finalizer used to remove the check annotation from
+	// the VMs when a VirtualMachineCheck is deleted.
+	Finalizer = "vmoperator.vmware.com/virtualmachinecheck"
+
+	// defaultPollInterval is how often an unsatisfied condition is evaluated
+	// when spec.pollInterval is not set.
+	defaultPollInterval = 30 * time.Second
+
+	// httpTimeout is the timeout for the request sent to evaluate an HTTP
+	// condition.
+	httpTimeout = 10 * time.Second
+)
+
+// SkipNameValidation is used for testing to allow multiple controllers with the
+// same name since Controller-Runtime has a global singleton registry to
+// prevent controllers with the same name, even if attached to different
+// managers.
+var SkipNameValidation *bool
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineCheck{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.VMToChecks(ctx)),
+		).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.MaxConcurrentReconciles,
+			SkipNameValidation:      SkipNameValidation,
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+// VMToChecks is a mapper function to be used to enqueue requests for
+// reconciliation for the VirtualMachineChecks that select a VM or whose check
+// annotation is present on the VM.
+func (r *Reconciler) VMToChecks(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		vm, ok := o.(*vmopv1.VirtualMachine)
+		if !ok {
+			panic(fmt.Sprintf("Expected a VirtualMachine, but got a %T", o))
+		}
+
+		list := &vmopv1.VirtualMachineCheckList{}
+		if err := r.Client.List(ctx, list, client.InNamespace(vm.Namespace)); err != nil {
+			ctx.Logger.Error(err, "Failed listing VirtualMachineChecks for VM")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range list.Items {
+			check := &list.Items[i]
+			_, hasAnnotation := vm.Annotations[check.AnnotationKey()]
+			if hasAnnotation || selects(check, vm) {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(check),
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		Recorder:   recorder,
+		HTTPClient: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+// Reconciler reconciles a VirtualMachineCheck object.
+type Reconciler struct {
+	client.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+
+	// HTTPClient is used to evaluate HTTP conditions.
+	HTTPClient *http.Client
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinechecks,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinechecks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	check := &vmopv1.VirtualMachineCheck{}
+	if err := r.Get(ctx, req.NamespacedName, check); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	checkCtx := &pkgctx.VirtualMachineCheckContext{
+		Context:             ctx,
+		Logger:              pkglog.FromContextOrDefault(ctx),
+		VirtualMachineCheck: check,
+	}
+
+	patchHelper, err := patch.NewHelper(check, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", checkCtx, err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, check); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			checkCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	if !check.DeletionTimestamp.IsZero() {
+		if err := r.ReconcileDelete(checkCtx); err != nil {
+			return ctrl.Result{},
+				fmt.Errorf("failed to delete VirtualMachineCheck: %w", err)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	return r.ReconcileNormal(checkCtx)
+}
+
+// ReconcileDelete removes the check annotation from all of the VMs in the
+// check's namespace before removing the finalizer.
+func (r *Reconciler) ReconcileDelete(ctx *pkgctx.VirtualMachineCheckContext) error {
+	ctx.Logger.Info("Reconciling VirtualMachineCheck deletion")
+
+	if !controllerutil.ContainsFinalizer(ctx.VirtualMachineCheck, Finalizer) {
+		return nil
+	}
+
+	if _, err := r.reconcileAnnotations(ctx, func(*vmopv1.VirtualMachine) bool {
+		return false
+	}); err != nil {
+		return err
+	}
+
+	controllerutil.RemoveFinalizer(ctx.VirtualMachineCheck, Finalizer)
+	return nil
+}
+
+// ReconcileNormal evaluates the check's condition and adds or removes the
+// check annotation from the selected VMs.
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineCheckContext) (ctrl.Result, error) {
+	ctx.Logger.V(4).Info("Reconciling VirtualMachineCheck")
+
+	check := ctx.VirtualMachineCheck
+
+	// If the finalizer is not present, add it. Return so the object is
+	// patched immediately.
+	if controllerutil.AddFinalizer(check, Finalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(&check.Spec.Selector); err != nil {
+		return ctrl.Result{}, fmt.Errorf("invalid selector: %w", err)
+	}
+
+	// An error evaluating the condition is treated as the condition not
+	// being satisfied so the selected VMs remain gated.
+	satisfied, message, evalErr := r.evaluate(ctx)
+	check.Status.LastEvaluationTime = ptr.To(metav1.Now())
+
+	vmNames, err := r.reconcileAnnotations(ctx, func(vm *vmopv1.VirtualMachine) bool {
+		return !satisfied && selects(check, vm)
+	})
+	check.Status.VirtualMachines = vmNames
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	switch {
+	case evalErr != nil:
+		pkgcnd.MarkError(
+			check,
+			vmopv1.VirtualMachineCheckConditionSatisfied,
+			vmopv1.VirtualMachineCheckConditionErrorReason,
+			evalErr)
+	case satisfied:
+		pkgcnd.MarkTrue(check, vmopv1.VirtualMachineCheckConditionSatisfied)
+		return ctrl.Result{}, nil
+	default:
+		pkgcnd.MarkFalse(
+			check,
+			vmopv1.VirtualMachineCheckConditionSatisfied,
+			vmopv1.VirtualMachineCheckConditionNotSatisfiedReason,
+			"%s",
+			message)
+	}
+
+	pollInterval := defaultPollInterval
+	if pi := check.Spec.PollInterval; pi != nil && pi.Duration > 0 {
+		pollInterval = pi.Duration
+	}
+
+	return ctrl.Result{RequeueAfter: pollInterval}, nil
+}
+
+// reconcileAnnotations adds the check annotation to the VMs in the check's
+// namespace for which shouldHave returns true, and removes it from the
+// others. The sorted names of the VMs that have the annotation are returned,
+// including when an error is returned, so they may be recorded in the check's
+// status.
+//
+// The annotation is only updated or removed on the VMs to which it was added
+// by this check, i.e. the VMs in the check's status, or on which it has the
+// check's reason as its value. Otherwise the annotation was added by someone
+// else, ex. an admin, and is left as-is.
+func (r *Reconciler) reconcileAnnotations(
+	ctx *pkgctx.VirtualMachineCheckContext,
+	shouldHave func(*vmopv1.VirtualMachine) bool) ([]string, error) {
+
+	check := ctx.VirtualMachineCheck
+	key := check.AnnotationKey()
+
+	reason := check.Spec.Reason
+	if reason == "" {
+		reason = check.Name
+	}
+
+	vmList := &vmopv1.VirtualMachineList{}
+	if err := r.Client.List(ctx, vmList, client.InNamespace(check.Namespace)); err != nil {
+		return check.Status.VirtualMachines, fmt.Errorf("failed to list VirtualMachines: %w", err)
+	}
+
+	managed := sets.New(check.Status.VirtualMachines...)
+
+	var vmNames []string
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+
+		want := shouldHave(vm)
+		val, has := vm.Annotations[key]
+
+		if has && val != reason && !managed.Has(vm.Name) {
+			continue
+		}
+
+		if (want && has && val == reason) || (!want && !has) {
+			if has {
+				vmNames = append(vmNames, vm.Name)
+			}
+			continue
+		}
+
+		orig := vm.DeepCopy()
+		vmPatch := client.MergeFrom(orig)
+		if want {
+			if vm.Annotations == nil {
+				vm.Annotations = map[string]string{}
+			}
+			vm.Annotations[key] = reason
+		} else {
+			delete(vm.Annotations, key)
+		}
+
+		if err := r.Client.Patch(ctx, vm, vmPatch); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			// Keep the remaining VMs that have the annotation so it is still
+			// removed from them later.
+			vmList.Items[i] = *orig
+			for _, item := range vmList.Items[i:] {
+				if v, ok := item.Annotations[key]; ok && (v == reason || managed.Has(item.Name)) {
+					vmNames = append(vmNames, item.Name)
+				}
+			}
+			slices.Sort(vmNames)
+			return vmNames, fmt.Errorf("failed to patch VirtualMachine %s: %w", vm.Name, err)
+		}
+
+		if want {
+			ctx.Logger.Info("Added check annotation to VM", "vmName", vm.Name, "key", key)
+			vmNames = append(vmNames, vm.Name)
+		} else {
+			ctx.Logger.Info("Removed check annotation from VM", "vmName", vm.Name, "key", key)
+		}
+	}
+
+	slices.Sort(vmNames)
+	return vmNames, nil
+}
+
+// evaluate returns whether or not the check's condition is satisfied. When
+// the condition is not satisfied, a message describing why is also returned.
+func (r *Reconciler) evaluate(
+	ctx *pkgctx.VirtualMachineCheckContext) (bool, string, error) {
+
+	check := ctx.VirtualMachineCheck
+	cond := check.Spec.Condition
+
+	switch {
+	case cond.Job != nil:
+		return r.evaluateJob(ctx, check.Namespace, cond.Job)
+	case cond.ConfigMap != nil:
+		return r.evaluateConfigMap(ctx, check.Namespace, cond.ConfigMap)
+	case cond.HTTP != nil:
+		return r.evaluateHTTP(ctx, cond.HTTP)
+	}
+
+	return false, "", fmt.Errorf("no condition specified")
+}
+
+func (r *Reconciler) evaluateJob(
+	ctx context.Context,
+	namespace string,
+	cond *vmopv1.VirtualMachineCheckJobCondition) (bool, string, error) {
+
+	var obj batchv1.Job
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: cond.Name}, &obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, fmt.Sprintf("Job %s not found", cond.Name), nil
+		}
+		return false, "", fmt.Errorf("failed to get Job %s: %w", cond.Name, err)
+	}
+
+	for _, c := range obj.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return true, "", nil
+		case batchv1.JobFailed:
+			return false, fmt.Sprintf("Job %s failed: %s", cond.Name, c.Message), nil
+		}
+	}
+
+	return false, fmt.Sprintf("Job %s has not completed", cond.Name), nil
+}
+
+func (r *Reconciler) evaluateConfigMap(
+	ctx context.Context,
+	namespace string,
+	cond *vmopv1.VirtualMachineCheckConfigMapCondition) (bool, string, error) {
+
+	var obj corev1.ConfigMap
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: cond.Name}, &obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, fmt.Sprintf("ConfigMap %s not found", cond.Name), nil
+		}
+		return false, "", fmt.Errorf("failed to get ConfigMap %s: %w", cond.Name, err)
+	}
+
+	val, ok := obj.Data[cond.Key]
+	if !ok {
+		return false, fmt.Sprintf("ConfigMap %s does not have key %s", cond.Name, cond.Key), nil
+	}
+	if cond.Value != "" && val != cond.Value {
+		return false, fmt.Sprintf("ConfigMap %s key %s does not have the expected value", cond.Name, cond.Key), nil
+	}
+
+	return true, "", nil
+}
+
+func (r *Reconciler) evaluateHTTP(
+	ctx context.Context,
+	cond *vmopv1.VirtualMachineCheckHTTPCondition) (bool, string, error) {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cond.URL, nil)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get %s: %w", cond.URL, err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Sprintf("GET %s returned %s", cond.URL, resp.Status), nil
+	}
+
+	return true, "", nil
+}
+
+// selects returns true if the check's selector matches the VM's labels.
+func selects(check *vmopv1.VirtualMachineCheck, vm *vmopv1.VirtualMachine) bool {
+	selector, err := metav1.LabelSelectorAsSelector(&check.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(vm.Labels))
+}
@@ -0,0 +1,105 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinecheck_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinecheck"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	var (
+		ctx *builder.IntegrationTestContext
+
+		check *vmopv1.VirtualMachineCheck
+		vm    *vmopv1.VirtualMachine
+	)
+
+	const checkKey = "intg-approval"
+
+	annotationKey := vmopv1.CheckAnnotationPowerOn + "/" + checkKey
+
+	getAnnotations := func() map[string]string {
+		obj := &vmopv1.VirtualMachine{}
+		if err := ctx.Client.Get(ctx, client.ObjectKeyFromObject(vm), obj); err != nil {
+			return nil
+		}
+		return obj.Annotations
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		vm = builder.DummyBasicVirtualMachine("check-vm", ctx.Namespace)
+		vm.Labels = map[string]string{"app": "my-app"}
+		Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+
+		check = builder.DummyVirtualMachineCheck(ctx.Namespace, "my-check", checkKey)
+		Expect(ctx.Client.Create(ctx, check)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("should gate the VM until the condition is satisfied", func() {
+		By("adding the finalizer", func() {
+			Eventually(func(g Gomega) {
+				obj := &vmopv1.VirtualMachineCheck{}
+				g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(check), obj)).To(Succeed())
+				g.Expect(obj.Finalizers).To(ContainElement(virtualmachinecheck.Finalizer))
+			}).Should(Succeed())
+		})
+
+		By("adding the annotation to the VM", func() {
+			Eventually(getAnnotations).Should(HaveKeyWithValue(annotationKey, check.Name))
+		})
+
+		By("creating the ConfigMap", func() {
+			Expect(ctx.Client.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ctx.Namespace,
+					Name:      check.Spec.Condition.ConfigMap.Name,
+				},
+				Data: map[string]string{
+					checkKey: "approved",
+				},
+			})).To(Succeed())
+		})
+
+		By("touching the VM to trigger a reconcile", func() {
+			obj := &vmopv1.VirtualMachine{}
+			Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(vm), obj)).To(Succeed())
+			obj.Labels["touched"] = "true"
+			Expect(ctx.Client.Update(ctx, obj)).To(Succeed())
+		})
+
+		By("removing the annotation from the VM", func() {
+			Eventually(getAnnotations).ShouldNot(HaveKey(annotationKey))
+		})
+	})
+}
@@ -0,0 +1,391 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachinecheck_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachinecheck"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace = "test-namespace"
+		checkKey  = "approval"
+	)
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachinecheck.Reconciler
+		check      *vmopv1.VirtualMachineCheck
+		selectedVM *vmopv1.VirtualMachine
+		otherVM    *vmopv1.VirtualMachine
+
+		result ctrl.Result
+		err    error
+	)
+
+	getVM := func(name string) *vmopv1.VirtualMachine {
+		vm := &vmopv1.VirtualMachine{}
+		ExpectWithOffset(1, ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, vm)).To(Succeed())
+		return vm
+	}
+
+	getCheck := func() *vmopv1.VirtualMachineCheck {
+		obj := &vmopv1.VirtualMachineCheck{}
+		ExpectWithOffset(1, ctx.Client.Get(ctx, client.ObjectKeyFromObject(check), obj)).To(Succeed())
+		return obj
+	}
+
+	powerOnKey := vmopv1.CheckAnnotationPowerOn + "/" + checkKey
+
+	BeforeEach(func() {
+		check = builder.DummyVirtualMachineCheck(namespace, "my-check", checkKey)
+		check.Finalizers = []string{virtualmachinecheck.Finalizer}
+
+		selectedVM = builder.DummyBasicVirtualMachine("selected-vm", namespace)
+		selectedVM.Labels = map[string]string{"app": "my-app"}
+
+		otherVM = builder.DummyBasicVirtualMachine("other-vm", namespace)
+		otherVM.Labels = map[string]string{"app": "other-app"}
+
+		initObjects = []client.Object{selectedVM, otherVM}
+	})
+
+	JustBeforeEach(func() {
+		initObjects = append(initObjects, check)
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		reconciler = virtualmachinecheck.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+
+		result, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(check),
+		})
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	When("the finalizer is not present", func() {
+		BeforeEach(func() {
+			check.Finalizers = nil
+		})
+		It("should add the finalizer", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getCheck().Finalizers).To(ConsistOf(virtualmachinecheck.Finalizer))
+			Expect(getVM(selectedVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+		})
+	})
+
+	Context("ConfigMap condition", func() {
+		When("the ConfigMap does not exist", func() {
+			It("should add the annotation to the selected VMs", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+				Expect(getVM(selectedVM.Name).Annotations).To(HaveKeyWithValue(powerOnKey, check.Name))
+				Expect(getVM(otherVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+
+				obj := getCheck()
+				Expect(obj.Status.VirtualMachines).To(Equal([]string{selectedVM.Name}))
+				Expect(obj.Status.LastEvaluationTime).ToNot(BeNil())
+				c := conditions.Get(obj, vmopv1.VirtualMachineCheckConditionSatisfied)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineCheckConditionNotSatisfiedReason))
+				Expect(c.Message).To(Equal("ConfigMap my-approvals not found"))
+			})
+		})
+
+		When("the check has a reason, type Delete, and poll interval", func() {
+			BeforeEach(func() {
+				check.Spec.Reason = "waiting on approval"
+				check.Spec.Type = vmopv1.VirtualMachineCheckTypeDelete
+				check.Spec.PollInterval = &metav1.Duration{Duration: time.Minute}
+			})
+			It("should add the delete check annotation with the reason", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(time.Minute))
+				Expect(getVM(selectedVM.Name).Annotations).To(HaveKeyWithValue(
+					vmopv1.CheckAnnotationDelete+"/"+checkKey, "waiting on approval"))
+				Expect(getVM(selectedVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+			})
+		})
+
+		When("an unselected VM has the annotation added by the check", func() {
+			BeforeEach(func() {
+				otherVM.Annotations = map[string]string{powerOnKey: "stale"}
+				check.Status.VirtualMachines = []string{otherVM.Name}
+			})
+			It("should remove the annotation", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(otherVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+				Expect(getVM(selectedVM.Name).Annotations).To(HaveKey(powerOnKey))
+				Expect(getCheck().Status.VirtualMachines).To(Equal([]string{selectedVM.Name}))
+			})
+		})
+
+		When("an unselected VM has the annotation with the check's reason", func() {
+			BeforeEach(func() {
+				otherVM.Annotations = map[string]string{powerOnKey: check.Name}
+			})
+			It("should remove the annotation", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(otherVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+			})
+		})
+
+		When("VMs have the annotation added by someone else", func() {
+			BeforeEach(func() {
+				selectedVM.Annotations = map[string]string{powerOnKey: "admin"}
+				otherVM.Annotations = map[string]string{powerOnKey: "admin"}
+			})
+			It("should not change or remove the annotation", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(selectedVM.Name).Annotations).To(HaveKeyWithValue(powerOnKey, "admin"))
+				Expect(getVM(otherVM.Name).Annotations).To(HaveKeyWithValue(powerOnKey, "admin"))
+				Expect(getCheck().Status.VirtualMachines).To(BeEmpty())
+			})
+		})
+
+		When("the ConfigMap has the key", func() {
+			BeforeEach(func() {
+				selectedVM.Annotations = map[string]string{powerOnKey: check.Name}
+				initObjects = append(initObjects, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      "my-approvals",
+					},
+					Data: map[string]string{
+						checkKey: "approved",
+					},
+				})
+			})
+
+			It("should remove the annotation from the selected VMs", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(ctrl.Result{}))
+				Expect(getVM(selectedVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+
+				obj := getCheck()
+				Expect(obj.Status.VirtualMachines).To(BeEmpty())
+				Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineCheckConditionSatisfied)).To(BeTrue())
+			})
+
+			When("the value does not match", func() {
+				BeforeEach(func() {
+					check.Spec.Condition.ConfigMap.Value = "yes"
+				})
+				It("should not remove the annotation", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(getVM(selectedVM.Name).Annotations).To(HaveKey(powerOnKey))
+					Expect(conditions.IsFalse(getCheck(), vmopv1.VirtualMachineCheckConditionSatisfied)).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Context("Job condition", func() {
+		var job *batchv1.Job
+
+		BeforeEach(func() {
+			check.Spec.Condition = vmopv1.VirtualMachineCheckCondition{
+				Job: &vmopv1.VirtualMachineCheckJobCondition{
+					Name: "my-job",
+				},
+			}
+			job = &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-job",
+				},
+			}
+			initObjects = append(initObjects, job)
+		})
+
+		When("the Job has not completed", func() {
+			It("should not be satisfied", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(selectedVM.Name).Annotations).To(HaveKey(powerOnKey))
+				Expect(conditions.GetMessage(getCheck(), vmopv1.VirtualMachineCheckConditionSatisfied)).To(
+					Equal("Job my-job has not completed"))
+			})
+		})
+
+		When("the Job failed", func() {
+			BeforeEach(func() {
+				job.Status.Conditions = []batchv1.JobCondition{
+					{
+						Type:    batchv1.JobFailed,
+						Status:  corev1.ConditionTrue,
+						Message: "backoff limit exceeded",
+					},
+				}
+			})
+			It("should not be satisfied", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(selectedVM.Name).Annotations).To(HaveKey(powerOnKey))
+				Expect(conditions.GetMessage(getCheck(), vmopv1.VirtualMachineCheckConditionSatisfied)).To(
+					Equal("Job my-job failed: backoff limit exceeded"))
+			})
+		})
+
+		When("the Job completed", func() {
+			BeforeEach(func() {
+				job.Status.Conditions = []batchv1.JobCondition{
+					{
+						Type:   batchv1.JobComplete,
+						Status: corev1.ConditionTrue,
+					},
+				}
+			})
+			It("should be satisfied", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(selectedVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+				Expect(conditions.IsTrue(getCheck(), vmopv1.VirtualMachineCheckConditionSatisfied)).To(BeTrue())
+			})
+		})
+	})
+
+	Context("HTTP condition", func() {
+		var (
+			server     *httptest.Server
+			statusCode int
+		)
+
+		BeforeEach(func() {
+			statusCode = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(statusCode)
+			}))
+			check.Spec.Condition = vmopv1.VirtualMachineCheckCondition{
+				HTTP: &vmopv1.VirtualMachineCheckHTTPCondition{
+					URL: server.URL,
+				},
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		When("the URL returns 200", func() {
+			It("should be satisfied", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(selectedVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+				Expect(conditions.IsTrue(getCheck(), vmopv1.VirtualMachineCheckConditionSatisfied)).To(BeTrue())
+			})
+		})
+
+		When("the URL returns 503", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusServiceUnavailable
+			})
+			It("should not be satisfied", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(selectedVM.Name).Annotations).To(HaveKey(powerOnKey))
+				Expect(conditions.GetMessage(getCheck(), vmopv1.VirtualMachineCheckConditionSatisfied)).To(
+					Equal("GET " + server.URL + " returned 503 Service Unavailable"))
+			})
+		})
+
+		When("the URL is not reachable", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should keep the VMs gated and report the error", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM(selectedVM.Name).Annotations).To(HaveKey(powerOnKey))
+				Expect(conditions.GetReason(getCheck(), vmopv1.VirtualMachineCheckConditionSatisfied)).To(
+					Equal(vmopv1.VirtualMachineCheckConditionErrorReason))
+			})
+		})
+	})
+
+	When("the check is being deleted", func() {
+		BeforeEach(func() {
+			check.DeletionTimestamp = ptr.To(metav1.Now())
+			selectedVM.Annotations = map[string]string{powerOnKey: check.Name}
+			otherVM.Annotations = map[string]string{powerOnKey: "admin"}
+		})
+		It("should remove the annotation it added and the finalizer", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getVM(selectedVM.Name).Annotations).ToNot(HaveKey(powerOnKey))
+			Expect(getVM(otherVM.Name).Annotations).To(HaveKeyWithValue(powerOnKey, "admin"))
+
+			obj := &vmopv1.VirtualMachineCheck{}
+			err := ctx.Client.Get(ctx, client.ObjectKeyFromObject(check), obj)
+			if err == nil {
+				Expect(obj.Finalizers).ToNot(ContainElement(virtualmachinecheck.Finalizer))
+			}
+		})
+	})
+
+	Context("VMToChecks", func() {
+		var mapperFn func(*vmopv1.VirtualMachine) []reconcile.Request
+
+		JustBeforeEach(func() {
+			mapperFn = func(vm *vmopv1.VirtualMachine) []reconcile.Request {
+				return reconciler.VMToChecks(&pkgctx.ControllerManagerContext{
+					Context: ctx,
+					Logger:  ctx.Logger,
+				})(ctx, vm)
+			}
+		})
+
+		It("should return the checks that select the VM", func() {
+			Expect(mapperFn(selectedVM)).To(ConsistOf(reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(check),
+			}))
+			Expect(mapperFn(otherVM)).To(BeEmpty())
+		})
+
+		It("should return the checks whose annotation is present on the VM", func() {
+			otherVM.Annotations = map[string]string{powerOnKey: check.Name}
+			Expect(mapperFn(otherVM)).To(ConsistOf(reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(check),
+			}))
+		})
+	})
+}
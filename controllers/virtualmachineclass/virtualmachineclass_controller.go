@@ -9,17 +9,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/cespare/xxhash/v2"
 	"github.com/go-logr/logr"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
@@ -50,6 +56,7 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(controlledType).
+		Watches(&vmopv1.VirtualMachine{}, vmToClassHandler()).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 1,
 			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
@@ -57,6 +64,65 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 		Complete(r)
 }
 
+// vmToClassHandler returns an event handler that enqueues a request for the
+// VirtualMachineClass used by a VM so the class's consumers are kept current.
+// When a VM's class changes, requests are enqueued for both the old and new
+// classes so the VM is also removed from the old class's consumers.
+func vmToClassHandler() handler.Funcs {
+	return handler.Funcs{
+		CreateFunc: func(
+			_ context.Context,
+			e event.CreateEvent,
+			q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+
+			enqueueClassForVM(q, e.Object)
+		},
+		UpdateFunc: func(
+			_ context.Context,
+			e event.UpdateEvent,
+			q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+
+			enqueueClassForVM(q, e.ObjectOld)
+			enqueueClassForVM(q, e.ObjectNew)
+		},
+		DeleteFunc: func(
+			_ context.Context,
+			e event.DeleteEvent,
+			q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+
+			enqueueClassForVM(q, e.Object)
+		},
+		GenericFunc: func(
+			_ context.Context,
+			e event.GenericEvent,
+			q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+
+			enqueueClassForVM(q, e.Object)
+		},
+	}
+}
+
+func enqueueClassForVM(
+	q workqueue.TypedRateLimitingInterface[reconcile.Request],
+	o client.Object) {
+
+	vm, ok := o.(*vmopv1.VirtualMachine)
+	if !ok {
+		panic(fmt.Sprintf("Expected a VirtualMachine, but got a %T", o))
+	}
+
+	if vm.Spec.ClassName == "" {
+		return
+	}
+
+	q.Add(reconcile.Request{
+		NamespacedName: client.ObjectKey{
+			Namespace: vm.Namespace,
+			Name:      vm.Spec.ClassName,
+		},
+	})
+}
+
 func NewReconciler(
 	ctx context.Context,
 	client client.Client,
@@ -82,6 +148,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclasses/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclassinstances,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclassinstances/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch;patch
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx = pkgcfg.JoinContext(ctx, r.Context)
@@ -132,6 +199,81 @@ func (r *Reconciler) ReconcileNormal(vmClassCtx *pkgctx.VirtualMachineClassConte
 		}
 	}
 
+	return r.reconcileDeprecation(vmClassCtx)
+}
+
+// reconcileDeprecation updates the consumers of a deprecated VM class and,
+// when requested, migrates the consumers to the replacement class.
+func (r *Reconciler) reconcileDeprecation(ctx *pkgctx.VirtualMachineClassContext) error {
+	deprecation := ctx.VMClass.Spec.Deprecation
+	if deprecation == nil {
+		ctx.VMClass.Status.Consumers = nil
+		return nil
+	}
+
+	var list vmopv1.VirtualMachineList
+	if err := r.Client.List(ctx, &list,
+		client.InNamespace(ctx.VMClass.Namespace)); err != nil {
+
+		return fmt.Errorf("failed to list VMs for VM class: %w", err)
+	}
+
+	var consumers []*vmopv1.VirtualMachine
+	for i := range list.Items {
+		if list.Items[i].Spec.ClassName == ctx.VMClass.Name {
+			consumers = append(consumers, &list.Items[i])
+		}
+	}
+
+	ctx.VMClass.Status.Consumers = nil
+	for _, vm := range consumers {
+		ctx.VMClass.Status.Consumers = append(ctx.VMClass.Status.Consumers, vm.Name)
+	}
+	slices.Sort(ctx.VMClass.Status.Consumers)
+
+	if !deprecation.Migrate || deprecation.ReplacementClassName == "" || len(consumers) == 0 {
+		return nil
+	}
+
+	if f := pkgcfg.FromContext(ctx).Features; !f.VMResize && !f.VMResizeCPUMemory {
+		ctx.Logger.Info("Skipping migration of VMs since resize is not enabled")
+		return nil
+	}
+
+	replacement := &vmopv1.VirtualMachineClass{}
+	if err := r.Client.Get(ctx, client.ObjectKey{
+		Namespace: ctx.VMClass.Namespace,
+		Name:      deprecation.ReplacementClassName,
+	}, replacement); err != nil {
+
+		if apierrors.IsNotFound(err) {
+			r.Recorder.Warnf(ctx.VMClass, "MigrateFailure",
+				"replacement class %s does not exist", deprecation.ReplacementClassName)
+			return nil
+		}
+		return fmt.Errorf("failed to get replacement VM class: %w", err)
+	}
+
+	if replacement.Spec.Deprecation != nil {
+		r.Recorder.Warnf(ctx.VMClass, "MigrateFailure",
+			"replacement class %s is deprecated", deprecation.ReplacementClassName)
+		return nil
+	}
+
+	var migrated []string
+	for _, vm := range consumers {
+		patch := client.MergeFrom(vm.DeepCopy())
+		vm.Spec.ClassName = replacement.Name
+		if err := r.Client.Patch(ctx, vm, patch); err != nil {
+			return fmt.Errorf("failed to migrate VM %s to class %s: %w", vm.Name, replacement.Name, err)
+		}
+		migrated = append(migrated, vm.Name)
+	}
+
+	ctx.VMClass.Status.Consumers = nil
+	r.Recorder.Eventf(ctx.VMClass, "MigrateSuccess",
+		"migrated VMs %s to class %s", strings.Join(migrated, ", "), replacement.Name)
+
 	return nil
 }
 
@@ -143,5 +143,43 @@ func intgTestsReconcile() {
 				}).Should(Succeed())
 			})
 		})
+
+		When("a consumer of a deprecated class changes its class", func() {
+			var vm *vmopv1.VirtualMachine
+
+			BeforeEach(func() {
+				objPatch := client.MergeFrom(vmClass.DeepCopy())
+				vmClass.Spec.Deprecation = &vmopv1.VirtualMachineClassDeprecation{}
+				Expect(ctx.Client.Patch(ctx, vmClass, objPatch)).To(Succeed())
+			})
+
+			JustBeforeEach(func() {
+				vm = builder.DummyBasicVirtualMachine("my-vm", vmClass.Namespace)
+				vm.Spec.ClassName = vmClass.Name
+				Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				err := ctx.Client.Delete(ctx, vm)
+				Expect(err == nil || apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("Should remove the VM from the old class's consumers", func() {
+				Eventually(func(g Gomega) {
+					obj := &vmopv1.VirtualMachineClass{}
+					g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(vmClass), obj)).To(Succeed())
+					g.Expect(obj.Status.Consumers).To(Equal([]string{vm.Name}))
+				}).Should(Succeed())
+
+				vm.Spec.ClassName = "other-class"
+				Expect(ctx.Client.Update(ctx, vm)).To(Succeed())
+
+				Eventually(func(g Gomega) {
+					obj := &vmopv1.VirtualMachineClass{}
+					g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(vmClass), obj)).To(Succeed())
+					g.Expect(obj.Status.Consumers).To(BeEmpty())
+				}).Should(Succeed())
+			})
+		})
 	})
 }
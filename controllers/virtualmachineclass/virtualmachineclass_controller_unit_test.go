@@ -196,5 +196,115 @@ func unitTestsReconcile() {
 				})
 			})
 		})
+
+		Context("when VirtualMachineClass is deprecated", func() {
+			var (
+				vm1, vm2, vm3 *vmopv1.VirtualMachine
+				replacement   *vmopv1.VirtualMachineClass
+			)
+
+			BeforeEach(func() {
+				vmClass.Spec.Deprecation = &vmopv1.VirtualMachineClassDeprecation{
+					ReplacementClassName: "replacement-vmclass",
+				}
+
+				newVM := func(name, className string) *vmopv1.VirtualMachine {
+					vm := builder.DummyBasicVirtualMachine(name, vmClass.Namespace)
+					vm.Spec.ClassName = className
+					return vm
+				}
+				vm1 = newVM("vm-b", vmClass.Name)
+				vm2 = newVM("vm-a", vmClass.Name)
+				vm3 = newVM("vm-c", "other-vmclass")
+
+				replacement = &vmopv1.VirtualMachineClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "replacement-vmclass",
+						Namespace: vmClass.Namespace,
+					},
+				}
+
+				initObjects = append(initObjects, vm1, vm2, vm3, replacement)
+			})
+
+			It("should report the consumers of the class", func() {
+				Expect(reconciler.ReconcileNormal(vmClassCtx)).To(Succeed())
+				Expect(vmClass.Status.Consumers).To(Equal([]string{"vm-a", "vm-b"}))
+			})
+
+			Context("when the class is no longer deprecated", func() {
+				BeforeEach(func() {
+					vmClass.Spec.Deprecation = nil
+					vmClass.Status.Consumers = []string{"vm-a"}
+				})
+
+				It("should clear the consumers", func() {
+					Expect(reconciler.ReconcileNormal(vmClassCtx)).To(Succeed())
+					Expect(vmClass.Status.Consumers).To(BeEmpty())
+				})
+			})
+
+			Context("when migrate is enabled", func() {
+				BeforeEach(func() {
+					vmClass.Spec.Deprecation.Migrate = true
+					pkgcfg.SetContext(vmClassCtx, func(config *pkgcfg.Config) {
+						config.Features.VMResize = true
+					})
+				})
+
+				assertClassName := func(vm *vmopv1.VirtualMachine, className string) {
+					GinkgoHelper()
+					obj := &vmopv1.VirtualMachine{}
+					Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(vm), obj)).To(Succeed())
+					Expect(obj.Spec.ClassName).To(Equal(className))
+				}
+
+				It("should migrate the consumers to the replacement class", func() {
+					Expect(reconciler.ReconcileNormal(vmClassCtx)).To(Succeed())
+					assertClassName(vm1, replacement.Name)
+					assertClassName(vm2, replacement.Name)
+					assertClassName(vm3, "other-vmclass")
+					Expect(vmClass.Status.Consumers).To(BeEmpty())
+				})
+
+				Context("when resize is not enabled", func() {
+					BeforeEach(func() {
+						pkgcfg.SetContext(vmClassCtx, func(config *pkgcfg.Config) {
+							config.Features.VMResize = false
+							config.Features.VMResizeCPUMemory = false
+						})
+					})
+
+					It("should not migrate the consumers", func() {
+						Expect(reconciler.ReconcileNormal(vmClassCtx)).To(Succeed())
+						assertClassName(vm1, vmClass.Name)
+						Expect(vmClass.Status.Consumers).To(Equal([]string{"vm-a", "vm-b"}))
+					})
+				})
+
+				Context("when the replacement class is deprecated", func() {
+					BeforeEach(func() {
+						replacement.Spec.Deprecation = &vmopv1.VirtualMachineClassDeprecation{}
+					})
+
+					It("should not migrate the consumers", func() {
+						Expect(reconciler.ReconcileNormal(vmClassCtx)).To(Succeed())
+						assertClassName(vm1, vmClass.Name)
+						assertClassName(vm2, vmClass.Name)
+					})
+				})
+
+				Context("when the replacement class does not exist", func() {
+					BeforeEach(func() {
+						vmClass.Spec.Deprecation.ReplacementClassName = "missing-vmclass"
+					})
+
+					It("should not migrate the consumers", func() {
+						Expect(reconciler.ReconcileNormal(vmClassCtx)).To(Succeed())
+						assertClassName(vm1, vmClass.Name)
+					})
+				})
+			})
+		})
 	})
 }
@@ -0,0 +1,590 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineclassrecommendation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/patch"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cron"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+const (
+	defaultSampleInterval = 5 * time.Minute
+	defaultWindow         = 720 * time.Hour
+	defaultPercentile     = 95
+	defaultMinSamples     = 288
+
+	// bucketSize is the width, in percent, of each bucket in the usage
+	// histograms.
+	bucketSize = 5
+
+	// numBuckets is the number of buckets in the usage histograms.
+	numBuckets = 100 / bucketSize
+
+	quickStatsPropPath   = "summary.quickStats"
+	maxCPUUsagePropPath  = "summary.runtime.maxCpuUsage"
+	memorySizeMBPropPath = "summary.config.memorySizeMB"
+)
+
+// SkipNameValidation is used for testing to allow multiple controllers with the
+// same name since Controller-Runtime has a global singleton registry to
+// prevent controllers with the same name, even if attached to different
+// managers.
+var SkipNameValidation *bool
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineClassRecommendation{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)),
+		ctx.VMProvider,
+	)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.VMToRecommendations(ctx)),
+		).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.MaxConcurrentReconciles,
+			SkipNameValidation:      SkipNameValidation,
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+// VMToRecommendations is a mapper function to be used to enqueue requests
+// for reconciliation for the VirtualMachineClassRecommendations for a VM.
+func (r *Reconciler) VMToRecommendations(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		vm, ok := o.(*vmopv1.VirtualMachine)
+		if !ok {
+			panic(fmt.Sprintf("Expected a VirtualMachine, but got a %T", o))
+		}
+
+		list := &vmopv1.VirtualMachineClassRecommendationList{}
+		if err := r.Client.List(ctx, list, client.InNamespace(vm.Namespace)); err != nil {
+			ctx.Logger.Error(err, "Failed listing VirtualMachineClassRecommendations for VM")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range list.Items {
+			rec := &list.Items[i]
+			if rec.Spec.VirtualMachineName == vm.Name {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(rec),
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder,
+	vmProvider providers.VirtualMachineProviderInterface) *Reconciler {
+
+	return &Reconciler{
+		Context:    ctx,
+		Client:     client,
+		Logger:     logger,
+		Recorder:   recorder,
+		VMProvider: vmProvider,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineClassRecommendation object.
+type Reconciler struct {
+	client.Client
+	Context    context.Context
+	Logger     logr.Logger
+	Recorder   record.Recorder
+	VMProvider providers.VirtualMachineProviderInterface
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclassrecommendations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclassrecommendations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch;update;patch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	rec := &vmopv1.VirtualMachineClassRecommendation{}
+	if err := r.Get(ctx, req.NamespacedName, rec); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !rec.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	recCtx := &pkgctx.VirtualMachineClassRecommendationContext{
+		Context:        ctx,
+		Logger:         pkglog.FromContextOrDefault(ctx),
+		Recommendation: rec,
+	}
+
+	patchHelper, err := patch.NewHelper(rec, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper for %s: %w", recCtx, err)
+	}
+
+	defer func() {
+		if err := patchHelper.Patch(ctx, rec); err != nil {
+			if reterr == nil {
+				reterr = err
+			}
+			recCtx.Logger.Error(err, "patch failed")
+		}
+	}()
+
+	return r.ReconcileNormal(recCtx)
+}
+
+// ReconcileNormal samples the VM's usage, recommends the smallest class in
+// the namespace that fits the usage, and applies the recommendation if
+// requested.
+func (r *Reconciler) ReconcileNormal(
+	ctx *pkgctx.VirtualMachineClassRecommendationContext) (ctrl.Result, error) {
+
+	ctx.Logger.V(4).Info("Reconciling VirtualMachineClassRecommendation")
+
+	rec := ctx.Recommendation
+
+	vm := &vmopv1.VirtualMachine{}
+	vmKey := client.ObjectKey{Namespace: rec.Namespace, Name: rec.Spec.VirtualMachineName}
+	if err := r.Client.Get(ctx, vmKey, vm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to get VirtualMachine %s: %w", vmKey.Name, err)
+		}
+		pkgcnd.MarkFalse(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionRecommended,
+			vmopv1.VirtualMachineClassRecommendationVMNotFoundReason,
+			"VirtualMachine %s not found",
+			vmKey.Name)
+		return ctrl.Result{}, nil
+	}
+	ctx.VM = vm
+
+	now := time.Now()
+	resetOrDecaySamples(rec, vm.Spec.ClassName, now)
+
+	if err := r.sample(ctx, now); err != nil {
+		pkgcnd.MarkError(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionRecommended,
+			vmopv1.VirtualMachineClassRecommendationErrorReason,
+			err)
+		return ctrl.Result{}, err
+	}
+
+	p := percentile(rec)
+	rec.Status.CPU.Percentile = usageAtPercentile(rec.Status.CPU.Histogram, p)
+	rec.Status.Memory.Percentile = usageAtPercentile(rec.Status.Memory.Histogram, p)
+
+	if err := r.recommend(ctx); err != nil {
+		pkgcnd.MarkError(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionRecommended,
+			vmopv1.VirtualMachineClassRecommendationErrorReason,
+			err)
+		return ctrl.Result{}, err
+	}
+
+	requeueAfter := sampleInterval(rec)
+	if last := rec.Status.LastSampleTime; last != nil {
+		if d := last.Add(requeueAfter).Sub(now); d > 0 {
+			requeueAfter = d
+		}
+	}
+
+	if d, err := r.apply(ctx, now); err != nil {
+		pkgcnd.MarkError(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionApplied,
+			vmopv1.VirtualMachineClassRecommendationErrorReason,
+			err)
+		return ctrl.Result{}, err
+	} else if d > 0 && d < requeueAfter {
+		requeueAfter = d
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// resetOrDecaySamples discards the collected samples if the VM's class has
+// changed, and halves the weight of the collected samples each time the
+// window elapses.
+func resetOrDecaySamples(
+	rec *vmopv1.VirtualMachineClassRecommendation,
+	className string,
+	now time.Time) {
+
+	if rec.Status.ClassName != className {
+		rec.Status.ClassName = className
+		rec.Status.CPU = vmopv1.VirtualMachineResourceUsage{}
+		rec.Status.Memory = vmopv1.VirtualMachineResourceUsage{}
+		rec.Status.Samples = 0
+		rec.Status.RecommendedClassName = ""
+		rec.Status.WindowStartTime = ptr.To(metav1.NewTime(now))
+		return
+	}
+
+	if rec.Status.WindowStartTime == nil {
+		rec.Status.WindowStartTime = ptr.To(metav1.NewTime(now))
+		return
+	}
+
+	if now.Sub(rec.Status.WindowStartTime.Time) < window(rec) {
+		return
+	}
+
+	var samples int32
+	for i := range rec.Status.CPU.Histogram {
+		rec.Status.CPU.Histogram[i] /= 2
+		samples += rec.Status.CPU.Histogram[i]
+	}
+	for i := range rec.Status.Memory.Histogram {
+		rec.Status.Memory.Histogram[i] /= 2
+	}
+	rec.Status.Samples = samples
+	rec.Status.WindowStartTime = ptr.To(metav1.NewTime(now))
+}
+
+// sample adds the VM's current CPU and memory usage to the histograms if the
+// sample interval has elapsed since the last sample.
+func (r *Reconciler) sample(
+	ctx *pkgctx.VirtualMachineClassRecommendationContext,
+	now time.Time) error {
+
+	rec, vm := ctx.Recommendation, ctx.VM
+
+	if last := rec.Status.LastSampleTime; last != nil &&
+		now.Sub(last.Time) < sampleInterval(rec) {
+
+		return nil
+	}
+
+	// The usage of a VM that is not powered on does not reflect its needs.
+	if vm.Status.UniqueID == "" ||
+		vm.Status.PowerState != vmopv1.VirtualMachinePowerStateOn {
+
+		return nil
+	}
+
+	props, err := r.VMProvider.GetVirtualMachineProperties(
+		ctx,
+		vm,
+		[]string{quickStatsPropPath, maxCPUUsagePropPath, memorySizeMBPropPath})
+	if err != nil {
+		return fmt.Errorf("failed to get usage of VirtualMachine %s: %w", vm.Name, err)
+	}
+
+	qs, _ := props[quickStatsPropPath].(vimtypes.VirtualMachineQuickStats)
+	maxCPUUsage, _ := props[maxCPUUsagePropPath].(int32)
+	memorySizeMB, _ := props[memorySizeMBPropPath].(int32)
+	if maxCPUUsage <= 0 || memorySizeMB <= 0 {
+		ctx.Logger.V(4).Info("Skipping sample with no allocation",
+			"maxCpuUsage", maxCPUUsage, "memorySizeMB", memorySizeMB)
+		return nil
+	}
+
+	addSample(&rec.Status.CPU, 100*int64(qs.OverallCpuUsage)/int64(maxCPUUsage))
+	addSample(&rec.Status.Memory, 100*int64(qs.GuestMemoryUsage)/int64(memorySizeMB))
+	rec.Status.Samples++
+	rec.Status.LastSampleTime = ptr.To(metav1.NewTime(now))
+
+	return nil
+}
+
+// recommend sets the recommended class to the smallest class in the
+// namespace that fits the VM's usage at the requested percentile.
+func (r *Reconciler) recommend(
+	ctx *pkgctx.VirtualMachineClassRecommendationContext) error {
+
+	rec, vm := ctx.Recommendation, ctx.VM
+
+	if minSamples := minSamples(rec); rec.Status.Samples < minSamples {
+		rec.Status.RecommendedClassName = ""
+		pkgcnd.MarkFalse(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionRecommended,
+			vmopv1.VirtualMachineClassRecommendationInsufficientSamplesReason,
+			"%d of %d samples collected",
+			rec.Status.Samples, minSamples)
+		return nil
+	}
+
+	classList := &vmopv1.VirtualMachineClassList{}
+	if err := r.Client.List(ctx, classList, client.InNamespace(rec.Namespace)); err != nil {
+		return fmt.Errorf("failed to list VirtualMachineClasses: %w", err)
+	}
+
+	var current *vmopv1.VirtualMachineClass
+	for i := range classList.Items {
+		if classList.Items[i].Name == vm.Spec.ClassName {
+			current = &classList.Items[i]
+			break
+		}
+	}
+	if current == nil {
+		rec.Status.RecommendedClassName = ""
+		pkgcnd.MarkFalse(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionRecommended,
+			vmopv1.VirtualMachineClassRecommendationNoClassFitsReason,
+			"VirtualMachineClass %s not found",
+			vm.Spec.ClassName)
+		return nil
+	}
+
+	// The required CPU and memory are rounded up using the upper bound of the
+	// bucket that contains the percentile.
+	hw := current.Spec.Hardware
+	cpus := (hw.Cpus*int64(rec.Status.CPU.Percentile) + 99) / 100
+	memory := resource.NewQuantity(
+		(hw.Memory.Value()*int64(rec.Status.Memory.Percentile)+99)/100,
+		resource.BinarySI)
+
+	var best *vmopv1.VirtualMachineClass
+	for i := range classList.Items {
+		c := &classList.Items[i]
+		if !c.DeletionTimestamp.IsZero() || !compatible(current, c) {
+			continue
+		}
+		if c.Spec.Hardware.Cpus < cpus || c.Spec.Hardware.Memory.Cmp(*memory) < 0 {
+			continue
+		}
+		if best == nil || smaller(c, best) {
+			best = c
+		}
+	}
+
+	if best == nil {
+		rec.Status.RecommendedClassName = ""
+		pkgcnd.MarkFalse(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionRecommended,
+			vmopv1.VirtualMachineClassRecommendationNoClassFitsReason,
+			"No VirtualMachineClass fits %d CPUs and %s of memory",
+			cpus, memory.String())
+		return nil
+	}
+
+	if rec.Status.RecommendedClassName != best.Name {
+		ctx.Logger.Info("Recommending VirtualMachineClass",
+			"vmName", vm.Name,
+			"currentClassName", vm.Spec.ClassName,
+			"recommendedClassName", best.Name)
+	}
+	rec.Status.RecommendedClassName = best.Name
+	pkgcnd.MarkTrue(rec, vmopv1.VirtualMachineClassRecommendationConditionRecommended)
+
+	return nil
+}
+
+// apply updates the VM's class to the recommended class if requested and the
+// maintenance window is open. If the window is not open, the duration until
+// it opens is returned.
+func (r *Reconciler) apply(
+	ctx *pkgctx.VirtualMachineClassRecommendationContext,
+	now time.Time) (time.Duration, error) {
+
+	rec, vm := ctx.Recommendation, ctx.VM
+
+	if rec.Spec.Apply == nil || rec.Status.RecommendedClassName == "" {
+		pkgcnd.Delete(rec, vmopv1.VirtualMachineClassRecommendationConditionApplied)
+		return 0, nil
+	}
+
+	if rec.Status.RecommendedClassName == vm.Spec.ClassName {
+		pkgcnd.MarkTrue(rec, vmopv1.VirtualMachineClassRecommendationConditionApplied)
+		return 0, nil
+	}
+
+	if !pkgcfg.FromContext(ctx).Features.VMResize {
+		pkgcnd.MarkFalse(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionApplied,
+			vmopv1.VirtualMachineClassRecommendationResizeDisabledReason,
+			"Resizing VMs is not enabled")
+		return 0, nil
+	}
+
+	mw := rec.Spec.Apply.MaintenanceWindow
+	w, err := cron.ParseWindow(mw.Schedule, mw.Duration.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maintenance window: %w", err)
+	}
+
+	open, start := w.Open(now)
+	if !open {
+		if start.IsZero() {
+			pkgcnd.MarkFalse(
+				rec,
+				vmopv1.VirtualMachineClassRecommendationConditionApplied,
+				vmopv1.VirtualMachineClassRecommendationPendingMaintenanceWindowReason,
+				"The maintenance window does not open in the foreseeable future")
+			return 0, nil
+		}
+		pkgcnd.MarkFalse(
+			rec,
+			vmopv1.VirtualMachineClassRecommendationConditionApplied,
+			vmopv1.VirtualMachineClassRecommendationPendingMaintenanceWindowReason,
+			"VirtualMachineClass %s will be applied at %s",
+			rec.Status.RecommendedClassName, start.Format(time.RFC3339))
+		return start.Sub(now), nil
+	}
+
+	vmPatch := client.MergeFrom(vm.DeepCopy())
+	vm.Spec.ClassName = rec.Status.RecommendedClassName
+	if err := r.Client.Patch(ctx, vm, vmPatch); err != nil {
+		return 0, fmt.Errorf("failed to patch VirtualMachine %s: %w", vm.Name, err)
+	}
+
+	ctx.Logger.Info("Applied recommended VirtualMachineClass",
+		"vmName", vm.Name, "className", vm.Spec.ClassName)
+	r.Recorder.EmitEvent(rec, "Apply", nil, false)
+
+	rec.Status.LastAppliedTime = ptr.To(metav1.NewTime(now))
+	pkgcnd.MarkTrue(rec, vmopv1.VirtualMachineClassRecommendationConditionApplied)
+
+	return 0, nil
+}
+
+// compatible returns true if a VM may be resized from the current class to
+// the candidate class without a change to anything other than its CPU and
+// memory.
+func compatible(current, candidate *vmopv1.VirtualMachineClass) bool {
+	return current.Spec.ControllerName == candidate.Spec.ControllerName &&
+		apiequality.Semantic.DeepEqual(
+			current.Spec.Hardware.Devices,
+			candidate.Spec.Hardware.Devices) &&
+		apiequality.Semantic.DeepEqual(
+			current.Spec.Hardware.InstanceStorage,
+			candidate.Spec.Hardware.InstanceStorage)
+}
+
+// smaller returns true if class a is smaller than class b. Classes are ordered
+// by their number of CPUs, then by their memory, and then by their name.
+func smaller(a, b *vmopv1.VirtualMachineClass) bool {
+	if a.Spec.Hardware.Cpus != b.Spec.Hardware.Cpus {
+		return a.Spec.Hardware.Cpus < b.Spec.Hardware.Cpus
+	}
+	if c := a.Spec.Hardware.Memory.Cmp(b.Spec.Hardware.Memory); c != 0 {
+		return c < 0
+	}
+	return a.Name < b.Name
+}
+
+// addSample adds a usage, as a percentage of the allocation, to the usage's
+// histogram.
+func addSample(u *vmopv1.VirtualMachineResourceUsage, pct int64) {
+	if len(u.Histogram) != numBuckets {
+		u.Histogram = make([]int32, numBuckets)
+	}
+	i := int(pct / bucketSize)
+	if i < 0 {
+		i = 0
+	} else if i >= numBuckets {
+		i = numBuckets - 1
+	}
+	u.Histogram[i]++
+}
+
+// usageAtPercentile returns the upper bound of the bucket in the histogram
+// that contains the specified percentile of the samples.
+func usageAtPercentile(histogram []int32, p int32) int32 {
+	var total int64
+	for _, n := range histogram {
+		total += int64(n)
+	}
+	if total == 0 {
+		return 0
+	}
+
+	target := (total*int64(p) + 99) / 100
+	var count int64
+	for i, n := range histogram {
+		count += int64(n)
+		if count >= target {
+			return int32((i + 1) * bucketSize)
+		}
+	}
+	return 100
+}
+
+func sampleInterval(rec *vmopv1.VirtualMachineClassRecommendation) time.Duration {
+	if d := rec.Spec.SampleInterval; d != nil && d.Duration > 0 {
+		return d.Duration
+	}
+	return defaultSampleInterval
+}
+
+func window(rec *vmopv1.VirtualMachineClassRecommendation) time.Duration {
+	if d := rec.Spec.Window; d != nil && d.Duration > 0 {
+		return d.Duration
+	}
+	return defaultWindow
+}
+
+func percentile(rec *vmopv1.VirtualMachineClassRecommendation) int32 {
+	if p := rec.Spec.Percentile; p > 0 && p <= 100 {
+		return p
+	}
+	return defaultPercentile
+}
+
+func minSamples(rec *vmopv1.VirtualMachineClassRecommendation) int32 {
+	if n := rec.Spec.MinSamples; n > 0 {
+		return n
+	}
+	return defaultMinSamples
+}
@@ -0,0 +1,71 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineclassrecommendation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	var (
+		ctx *builder.IntegrationTestContext
+
+		rec *vmopv1.VirtualMachineClassRecommendation
+		vm  *vmopv1.VirtualMachine
+	)
+
+	getRec := func(g Gomega) *vmopv1.VirtualMachineClassRecommendation {
+		obj := &vmopv1.VirtualMachineClassRecommendation{}
+		g.Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(rec), obj)).To(Succeed())
+		return obj
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		vm = builder.DummyBasicVirtualMachine("rec-vm", ctx.Namespace)
+		vm.Spec.ClassName = "large"
+		Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+
+		rec = builder.DummyVirtualMachineClassRecommendation(ctx.Namespace, "my-rec", vm.Name)
+		Expect(ctx.Client.Create(ctx, rec)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("should wait for enough samples of the VM's usage", func() {
+		Eventually(func(g Gomega) {
+			obj := getRec(g)
+			g.Expect(obj.Status.ClassName).To(Equal("large"))
+			g.Expect(obj.Status.WindowStartTime).ToNot(BeNil())
+			g.Expect(obj.Status.RecommendedClassName).To(BeEmpty())
+			g.Expect(conditions.GetReason(obj, vmopv1.VirtualMachineClassRecommendationConditionRecommended)).To(
+				Equal(vmopv1.VirtualMachineClassRecommendationInsufficientSamplesReason))
+		}).Should(Succeed())
+	})
+}
@@ -0,0 +1,387 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineclassrecommendation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineclassrecommendation"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	providerfake "github.com/vmware-tanzu/vm-operator/pkg/providers/fake"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func unitTestsReconcile() {
+	const namespace = "test-namespace"
+
+	var (
+		initObjects []client.Object
+		ctx         *builder.UnitTestContextForController
+
+		reconciler     *virtualmachineclassrecommendation.Reconciler
+		fakeVMProvider *providerfake.VMProvider
+		rec            *vmopv1.VirtualMachineClassRecommendation
+		vm             *vmopv1.VirtualMachine
+
+		props         map[string]any
+		propsErr      error
+		propsCalls    int
+		resizeEnabled bool
+
+		result ctrl.Result
+		err    error
+	)
+
+	newClass := func(name string, cpus int64, memory string) *vmopv1.VirtualMachineClass {
+		c := builder.DummyVirtualMachineClass(name)
+		c.Namespace = namespace
+		c.Spec.Hardware.Cpus = cpus
+		c.Spec.Hardware.Memory = resource.MustParse(memory)
+		return c
+	}
+
+	// usage returns the properties for a VM with 8000 MHz of CPU and 32000 MB
+	// of memory that uses the specified percentages of each.
+	usage := func(cpuPct, memPct int32) map[string]any {
+		return map[string]any{
+			"summary.quickStats": vimtypes.VirtualMachineQuickStats{
+				OverallCpuUsage:  80 * cpuPct,
+				GuestMemoryUsage: 320 * memPct,
+			},
+			"summary.runtime.maxCpuUsage": int32(8000),
+			"summary.config.memorySizeMB": int32(32000),
+		}
+	}
+
+	getRec := func() *vmopv1.VirtualMachineClassRecommendation {
+		obj := &vmopv1.VirtualMachineClassRecommendation{}
+		ExpectWithOffset(1, ctx.Client.Get(ctx, client.ObjectKeyFromObject(rec), obj)).To(Succeed())
+		return obj
+	}
+
+	getVM := func() *vmopv1.VirtualMachine {
+		obj := &vmopv1.VirtualMachine{}
+		ExpectWithOffset(1, ctx.Client.Get(ctx, client.ObjectKeyFromObject(vm), obj)).To(Succeed())
+		return obj
+	}
+
+	BeforeEach(func() {
+		vm = builder.DummyBasicVirtualMachine("my-vm", namespace)
+		vm.Spec.ClassName = "large"
+		vm.Status.UniqueID = "vm-1"
+		vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOn
+
+		rec = builder.DummyVirtualMachineClassRecommendation(namespace, "my-rec", vm.Name)
+		rec.Spec.MinSamples = 1
+
+		gpuClass := newClass("gpu-small", 2, "8Gi")
+		gpuClass.Spec.Hardware.Devices.VGPUDevices = []vmopv1.VGPUDevice{
+			{ProfileName: "grid-profile"},
+		}
+
+		initObjects = []client.Object{
+			vm,
+			newClass("small", 2, "4Gi"),
+			newClass("medium", 4, "8Gi"),
+			newClass("large", 8, "32Gi"),
+			gpuClass,
+		}
+
+		props = usage(10, 20)
+		propsErr = nil
+		propsCalls = 0
+		resizeEnabled = true
+	})
+
+	JustBeforeEach(func() {
+		initObjects = append(initObjects, rec)
+		ctx = suite.NewUnitTestContextForController(initObjects...)
+		pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+			config.Features.VMResize = resizeEnabled
+		})
+
+		fakeVMProvider = ctx.VMProvider.(*providerfake.VMProvider)
+		fakeVMProvider.GetVirtualMachinePropertiesFn = func(
+			_ context.Context,
+			_ *vmopv1.VirtualMachine,
+			_ []string) (map[string]any, error) {
+
+			propsCalls++
+			return props, propsErr
+		}
+
+		reconciler = virtualmachineclassrecommendation.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+			ctx.VMProvider,
+		)
+
+		result, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(rec),
+		})
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		reconciler = nil
+	})
+
+	When("the VM does not exist", func() {
+		BeforeEach(func() {
+			rec.Spec.VirtualMachineName = "missing-vm"
+		})
+		It("should report the VM is not found", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			obj := getRec()
+			Expect(conditions.IsFalse(obj, vmopv1.VirtualMachineClassRecommendationConditionRecommended)).To(BeTrue())
+			Expect(conditions.GetReason(obj, vmopv1.VirtualMachineClassRecommendationConditionRecommended)).To(
+				Equal(vmopv1.VirtualMachineClassRecommendationVMNotFoundReason))
+		})
+	})
+
+	When("the VM is powered on", func() {
+		It("should sample the VM's usage and recommend the smallest class that fits", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(propsCalls).To(Equal(1))
+			Expect(result.RequeueAfter).To(BeNumerically("~", 5*time.Minute, time.Second))
+
+			obj := getRec()
+			Expect(obj.Status.ClassName).To(Equal("large"))
+			Expect(obj.Status.Samples).To(BeEquivalentTo(1))
+			Expect(obj.Status.LastSampleTime).ToNot(BeNil())
+			Expect(obj.Status.CPU.Histogram).To(HaveLen(20))
+			Expect(obj.Status.CPU.Histogram[2]).To(BeEquivalentTo(1))
+			Expect(obj.Status.CPU.Percentile).To(BeEquivalentTo(15))
+			Expect(obj.Status.Memory.Histogram[4]).To(BeEquivalentTo(1))
+			Expect(obj.Status.Memory.Percentile).To(BeEquivalentTo(25))
+
+			// 15% of 8 CPUs rounds up to 2 CPUs and 25% of 32Gi is 8Gi. The
+			// gpu-small class fits, but it has different devices.
+			Expect(obj.Status.RecommendedClassName).To(Equal("medium"))
+			Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineClassRecommendationConditionRecommended)).To(BeTrue())
+			Expect(conditions.Has(obj, vmopv1.VirtualMachineClassRecommendationConditionApplied)).To(BeFalse())
+			Expect(getVM().Spec.ClassName).To(Equal("large"))
+		})
+
+		When("the VM's usage is high", func() {
+			BeforeEach(func() {
+				props = usage(99, 99)
+			})
+			It("should recommend the VM's current class", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getRec().Status.RecommendedClassName).To(Equal("large"))
+			})
+		})
+
+		When("not enough samples have been collected", func() {
+			BeforeEach(func() {
+				rec.Spec.MinSamples = 2
+			})
+			It("should not recommend a class", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getRec()
+				Expect(obj.Status.Samples).To(BeEquivalentTo(1))
+				Expect(obj.Status.RecommendedClassName).To(BeEmpty())
+				Expect(conditions.GetReason(obj, vmopv1.VirtualMachineClassRecommendationConditionRecommended)).To(
+					Equal(vmopv1.VirtualMachineClassRecommendationInsufficientSamplesReason))
+			})
+		})
+
+		When("the sample interval has not elapsed", func() {
+			BeforeEach(func() {
+				rec.Status.ClassName = vm.Spec.ClassName
+				rec.Status.WindowStartTime = ptr.To(metav1.Now())
+				rec.Status.LastSampleTime = ptr.To(metav1.NewTime(time.Now().Add(-time.Minute)))
+			})
+			It("should not sample the VM's usage", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(propsCalls).To(BeZero())
+				Expect(result.RequeueAfter).To(BeNumerically("~", 4*time.Minute, time.Second))
+			})
+		})
+
+		When("getting the VM's usage fails", func() {
+			BeforeEach(func() {
+				propsErr = errors.New("fake error")
+			})
+			It("should return an error", func() {
+				Expect(err).To(MatchError(ContainSubstring("fake error")))
+				Expect(conditions.GetReason(getRec(), vmopv1.VirtualMachineClassRecommendationConditionRecommended)).To(
+					Equal(vmopv1.VirtualMachineClassRecommendationErrorReason))
+			})
+		})
+
+		When("the VM's class is not found", func() {
+			BeforeEach(func() {
+				vm.Spec.ClassName = "missing"
+			})
+			It("should not recommend a class", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getRec()
+				Expect(obj.Status.RecommendedClassName).To(BeEmpty())
+				Expect(conditions.GetReason(obj, vmopv1.VirtualMachineClassRecommendationConditionRecommended)).To(
+					Equal(vmopv1.VirtualMachineClassRecommendationNoClassFitsReason))
+			})
+		})
+	})
+
+	When("the VM is powered off", func() {
+		BeforeEach(func() {
+			vm.Status.PowerState = vmopv1.VirtualMachinePowerStateOff
+		})
+		It("should not sample the VM's usage", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(propsCalls).To(BeZero())
+			Expect(getRec().Status.Samples).To(BeZero())
+		})
+	})
+
+	When("samples have been collected", func() {
+		BeforeEach(func() {
+			histogram := make([]int32, 20)
+			histogram[0] = 10
+			histogram[19] = 3
+
+			rec.Status.ClassName = vm.Spec.ClassName
+			rec.Status.CPU.Histogram = histogram
+			rec.Status.Memory.Histogram = append([]int32(nil), histogram...)
+			rec.Status.Samples = 13
+			rec.Status.WindowStartTime = ptr.To(metav1.Now())
+		})
+
+		When("the VM's class has changed", func() {
+			BeforeEach(func() {
+				rec.Status.ClassName = "medium"
+			})
+			It("should discard the samples", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getRec()
+				Expect(obj.Status.ClassName).To(Equal("large"))
+				Expect(obj.Status.Samples).To(BeEquivalentTo(1))
+				Expect(obj.Status.CPU.Histogram[0]).To(BeZero())
+				Expect(obj.Status.CPU.Histogram[19]).To(BeZero())
+			})
+		})
+
+		When("the window has elapsed", func() {
+			BeforeEach(func() {
+				rec.Status.WindowStartTime = ptr.To(metav1.NewTime(time.Now().Add(-721 * time.Hour)))
+			})
+			It("should halve the weight of the samples", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getRec()
+				Expect(obj.Status.Samples).To(BeEquivalentTo(5 + 1 + 1))
+				Expect(obj.Status.CPU.Histogram[0]).To(BeEquivalentTo(5))
+				Expect(obj.Status.CPU.Histogram[2]).To(BeEquivalentTo(1))
+				Expect(obj.Status.CPU.Histogram[19]).To(BeEquivalentTo(1))
+				Expect(obj.Status.WindowStartTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+			})
+		})
+
+		When("the percentile is in the highest bucket", func() {
+			It("should recommend the VM's current class", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getRec()
+				Expect(obj.Status.CPU.Percentile).To(BeEquivalentTo(100))
+				Expect(obj.Status.RecommendedClassName).To(Equal("large"))
+			})
+		})
+	})
+
+	When("the recommendation is applied", func() {
+		BeforeEach(func() {
+			rec.Spec.Apply = &vmopv1.VirtualMachineClassRecommendationApply{
+				MaintenanceWindow: vmopv1.VirtualMachineMaintenanceWindow{
+					Schedule: "* * * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			}
+		})
+
+		When("the maintenance window is open", func() {
+			It("should update the VM's class", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM().Spec.ClassName).To(Equal("medium"))
+				obj := getRec()
+				Expect(obj.Status.LastAppliedTime).ToNot(BeNil())
+				Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineClassRecommendationConditionApplied)).To(BeTrue())
+			})
+		})
+
+		When("the maintenance window is not open", func() {
+			BeforeEach(func() {
+				hour := (time.Now().UTC().Hour() + 12) % 24
+				rec.Spec.Apply.MaintenanceWindow.Schedule = fmt.Sprintf("0 %d * * *", hour)
+			})
+			It("should not update the VM's class", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM().Spec.ClassName).To(Equal("large"))
+				obj := getRec()
+				Expect(obj.Status.LastAppliedTime).To(BeNil())
+				Expect(conditions.GetReason(obj, vmopv1.VirtualMachineClassRecommendationConditionApplied)).To(
+					Equal(vmopv1.VirtualMachineClassRecommendationPendingMaintenanceWindowReason))
+				Expect(conditions.GetMessage(obj, vmopv1.VirtualMachineClassRecommendationConditionApplied)).To(
+					ContainSubstring("VirtualMachineClass medium will be applied at"))
+			})
+		})
+
+		When("resizing VMs is not enabled", func() {
+			BeforeEach(func() {
+				resizeEnabled = false
+			})
+			It("should not update the VM's class", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(getVM().Spec.ClassName).To(Equal("large"))
+				Expect(conditions.GetReason(getRec(), vmopv1.VirtualMachineClassRecommendationConditionApplied)).To(
+					Equal(vmopv1.VirtualMachineClassRecommendationResizeDisabledReason))
+			})
+		})
+
+		When("the VM already has the recommended class", func() {
+			BeforeEach(func() {
+				props = usage(99, 99)
+			})
+			It("should mark the recommendation as applied", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getRec()
+				Expect(obj.Status.LastAppliedTime).To(BeNil())
+				Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineClassRecommendationConditionApplied)).To(BeTrue())
+			})
+		})
+	})
+}
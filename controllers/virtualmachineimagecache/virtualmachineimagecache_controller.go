@@ -42,6 +42,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	clprov "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/contentlibrary"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
@@ -179,8 +180,12 @@ func (r *reconciler) ReconcileNormal(
 		"providerVersion", obj.Spec.ProviderVersion)
 	ctx = logr.NewContext(ctx, logger)
 
-	// Get a vSphere client.
-	c, err := r.VMProvider.VSphereClient(ctx)
+	// Get a vSphere client for the vCenter where the image is cached.
+	zoneName, err := r.getZoneNameForLocations(ctx, obj)
+	if err != nil {
+		return fmt.Errorf("failed to get zone for image cache locations: %w", err)
+	}
+	c, err := r.VMProvider.VSphereClientForZone(ctx, zoneName)
 	if err != nil {
 		return fmt.Errorf("failed to get vSphere client: %w", err)
 	}
@@ -246,6 +251,37 @@ func (r *reconciler) ReconcileNormal(
 	return nil
 }
 
+// getZoneNameForLocations returns the name of a zone backed by the vCenter with
+// the datacenter of the image cache's locations. An empty string, which refers
+// to the default vCenter, is returned if no such zone exists.
+//
+// The provider ID of an image cache refers to an item on a single vCenter, so
+// all of its locations belong to that vCenter.
+func (r *reconciler) getZoneNameForLocations(
+	ctx context.Context,
+	obj *vmopv1.VirtualMachineImageCache) (string, error) {
+
+	if len(obj.Spec.Locations) == 0 {
+		return "", nil
+	}
+
+	zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, r.Client)
+	if err != nil {
+		return "", err
+	}
+
+	for vc, zones := range zonesByVCenter {
+		if vc != (topology.VCenter{}) &&
+			vc.Datacenter == obj.Spec.Locations[0].DatacenterID &&
+			len(zones) > 0 {
+
+			return zones[0], nil
+		}
+	}
+
+	return "", nil
+}
+
 func (r *reconciler) reconcileFiles(
 	ctx context.Context,
 	vcClient *client.Client,
@@ -753,6 +753,11 @@ type fakeClient struct {
 		ctx context.Context,
 		itemID string) (*ovf.Envelope, error)
 
+	downloadLibraryItemFilesFn func(
+		ctx context.Context,
+		item *library.Item,
+		fn clprov.DownloadLibraryItemFilesFunc) error
+
 	syncLibraryItemFn func(
 		ctx context.Context,
 		item *library.Item,
@@ -792,6 +797,7 @@ func (m *fakeClient) reset() {
 	m.updateLibraryItemFn = nil
 	m.retrieveOvfEnvelopeFromLibraryItemFn = nil
 	m.retrieveOvfEnvelopeByLibraryItemIDFn = nil
+	m.downloadLibraryItemFilesFn = nil
 	m.syncLibraryItemFn = nil
 	m.listLibraryItemStorageFn = nil
 	m.resolveLibraryItemStorageFn = nil
@@ -989,6 +995,20 @@ func (m *fakeClient) RetrieveOvfEnvelopeByLibraryItemID(
 	return nil, nil
 }
 
+func (m *fakeClient) DownloadLibraryItemFiles(
+	ctx context.Context,
+	item *library.Item,
+	fn clprov.DownloadLibraryItemFilesFunc) error {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	if f := m.downloadLibraryItemFilesFn; f != nil {
+		return f(ctx, item, fn)
+	}
+	return nil
+}
+
 func (m *fakeClient) SyncLibraryItem(
 	ctx context.Context,
 	item *library.Item,
@@ -0,0 +1,444 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineippool
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/record"
+)
+
+// maxMACAddrSuffix is the largest value of the last three octets of a MAC
+// address allocated from a pool.
+const maxMACAddrSuffix = 0xFFFFFF
+
+// SkipNameValidation is used for testing to allow multiple controllers with the
+// same name since Controller-Runtime has a global singleton registry to
+// prevent controllers with the same name, even if attached to different
+// managers.
+var SkipNameValidation *bool
+
+// AddToManager adds this package's controller to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) error {
+	var (
+		controlledType     = &vmopv1.VirtualMachineIPPool{}
+		controlledTypeName = reflect.TypeOf(controlledType).Elem().Name()
+
+		controllerNameShort = fmt.Sprintf("%s-controller", strings.ToLower(controlledTypeName))
+		controllerNameLong  = fmt.Sprintf("%s/%s/%s", ctx.Namespace, ctx.Name, controllerNameShort)
+	)
+
+	r := NewReconciler(
+		ctx,
+		mgr.GetClient(),
+		ctrl.Log.WithName("controllers").WithName(controlledTypeName),
+		record.New(mgr.GetEventRecorderFor(controllerNameLong)))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(controlledType).
+		Watches(&vmopv1.VirtualMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.VMToIPPools(ctx)),
+		).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ctx.MaxConcurrentReconciles,
+			SkipNameValidation:      SkipNameValidation,
+			LogConstructor:          pkglog.ControllerLogConstructor(controllerNameShort, controlledType, mgr.GetScheme()),
+		}).
+		Complete(r)
+}
+
+// VMToIPPools is a mapper function to be used to enqueue requests for
+// reconciliation for the VirtualMachineIPPools that are referenced by a VM's
+// network interfaces or that have addresses allocated for the VM.
+func (r *Reconciler) VMToIPPools(
+	ctx *pkgctx.ControllerManagerContext) func(_ context.Context, o client.Object) []reconcile.Request {
+
+	return func(_ context.Context, o client.Object) []reconcile.Request {
+		vm, ok := o.(*vmopv1.VirtualMachine)
+		if !ok {
+			panic(fmt.Sprintf("Expected a VirtualMachine, but got a %T", o))
+		}
+
+		list := &vmopv1.VirtualMachineIPPoolList{}
+		if err := r.Client.List(ctx, list, client.InNamespace(vm.Namespace)); err != nil {
+			ctx.Logger.Error(err, "Failed listing VirtualMachineIPPools for VM")
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range list.Items {
+			pool := &list.Items[i]
+			if references(vm, pool.Name) || hasAllocation(pool, vm.Name) {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: client.ObjectKeyFromObject(pool),
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+func NewReconciler(
+	ctx context.Context,
+	client client.Client,
+	logger logr.Logger,
+	recorder record.Recorder) *Reconciler {
+
+	return &Reconciler{
+		Context:  ctx,
+		Client:   client,
+		Logger:   logger,
+		Recorder: recorder,
+	}
+}
+
+// Reconciler reconciles a VirtualMachineIPPool object.
+type Reconciler struct {
+	client.Client
+	Context  context.Context
+	Logger   logr.Logger
+	Recorder record.Recorder
+}
+
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineippools,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineippools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = pkgcfg.JoinContext(ctx, r.Context)
+
+	pool := &vmopv1.VirtualMachineIPPool{}
+	if err := r.Get(ctx, req.NamespacedName, pool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !pool.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	poolCtx := &pkgctx.VirtualMachineIPPoolContext{
+		Context:              ctx,
+		Logger:               pkglog.FromContextOrDefault(ctx),
+		VirtualMachineIPPool: pool,
+	}
+
+	// The allocations are computed from the pool's current status, so the
+	// status must be patched with the resourceVersion that was read. Otherwise
+	// a concurrent reconcile working from a stale copy of the pool could
+	// overwrite allocations and hand out the same address twice.
+	origPool := pool.DeepCopy()
+
+	if err := r.ReconcileNormal(poolCtx); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if apiequality.Semantic.DeepEqual(origPool.Status, pool.Status) {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.Status().Patch(ctx, pool, client.MergeFromWithOptions(
+		origPool, client.MergeFromWithOptimisticLock{})); err != nil {
+
+		if apierrors.IsConflict(err) {
+			poolCtx.Logger.V(4).Info("Conflict patching status, requeuing")
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to patch status for %s: %w", poolCtx, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ReconcileNormal releases the allocations for the network interfaces that no
+// longer reference the pool and allocates addresses for the network
+// interfaces that do.
+func (r *Reconciler) ReconcileNormal(ctx *pkgctx.VirtualMachineIPPoolContext) error {
+	ctx.Logger.V(4).Info("Reconciling VirtualMachineIPPool")
+
+	pool := ctx.VirtualMachineIPPool
+
+	vmList := &vmopv1.VirtualMachineList{}
+	if err := r.Client.List(ctx, vmList, client.InNamespace(pool.Namespace)); err != nil {
+		return fmt.Errorf("failed to list VirtualMachines: %w", err)
+	}
+
+	type vmInterface struct {
+		vmName, interfaceName string
+	}
+
+	// Only allocate addresses for VMs that are not being deleted, but do not
+	// release the addresses of a VM until the VM no longer exists, since its
+	// network interfaces may still be in use until then.
+	var (
+		desired  []vmInterface
+		existing = map[vmInterface]struct{}{}
+	)
+	for i := range vmList.Items {
+		vm := &vmList.Items[i]
+		if vm.Spec.Network == nil {
+			continue
+		}
+		for _, iface := range vm.Spec.Network.Interfaces {
+			if iface.IPPoolName != pool.Name {
+				continue
+			}
+			key := vmInterface{vm.Name, iface.Name}
+			existing[key] = struct{}{}
+			if vm.DeletionTimestamp.IsZero() {
+				desired = append(desired, key)
+			}
+		}
+	}
+
+	alloc := newAllocator(pool)
+
+	var allocations []vmopv1.VirtualMachineIPPoolAllocation
+	for _, a := range pool.Status.Allocations {
+		if _, ok := existing[vmInterface{a.VirtualMachineName, a.InterfaceName}]; !ok {
+			ctx.Logger.Info("Released addresses",
+				"vmName", a.VirtualMachineName,
+				"interfaceName", a.InterfaceName,
+				"addresses", a.Addresses,
+				"macAddr", a.MACAddr)
+			continue
+		}
+		alloc.reserve(a)
+		allocations = append(allocations, a)
+	}
+
+	var exhausted []string
+	for _, key := range desired {
+		if pool.Allocation(key.vmName, key.interfaceName) != nil {
+			continue
+		}
+
+		a, ok := alloc.allocate(key.vmName, key.interfaceName)
+		if !ok {
+			exhausted = append(exhausted, key.vmName+"/"+key.interfaceName)
+			continue
+		}
+
+		ctx.Logger.Info("Allocated addresses",
+			"vmName", a.VirtualMachineName,
+			"interfaceName", a.InterfaceName,
+			"addresses", a.Addresses,
+			"macAddr", a.MACAddr)
+		allocations = append(allocations, a)
+	}
+
+	slices.SortFunc(allocations, func(a, b vmopv1.VirtualMachineIPPoolAllocation) int {
+		if c := strings.Compare(a.VirtualMachineName, b.VirtualMachineName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.InterfaceName, b.InterfaceName)
+	})
+	pool.Status.Allocations = allocations
+
+	if len(exhausted) > 0 {
+		pkgcnd.MarkFalse(
+			pool,
+			vmopv1.VirtualMachineIPPoolConditionReady,
+			vmopv1.VirtualMachineIPPoolConditionExhaustedReason,
+			"No free addresses for network interfaces: %s",
+			strings.Join(exhausted, ", "))
+	} else {
+		pkgcnd.MarkTrue(pool, vmopv1.VirtualMachineIPPoolConditionReady)
+	}
+
+	return nil
+}
+
+// allocator allocates addresses from a pool's ranges.
+type allocator struct {
+	ranges    []poolRange
+	macPrefix string
+	usedIPs   map[netip.Addr]struct{}
+	usedMACs  map[string]struct{}
+}
+
+type poolRange struct {
+	prefix  netip.Prefix
+	gateway netip.Addr
+}
+
+func newAllocator(pool *vmopv1.VirtualMachineIPPool) *allocator {
+	a := &allocator{
+		macPrefix: strings.ToLower(pool.Spec.MACAddrPrefix),
+		usedIPs:   map[netip.Addr]struct{}{},
+		usedMACs:  map[string]struct{}{},
+	}
+
+	// Invalid ranges are rejected by the webhook, but skip them here in case
+	// the webhook was bypassed.
+	for _, r := range pool.Spec.Ranges {
+		prefix, err := netip.ParsePrefix(r.CIDR)
+		if err != nil {
+			continue
+		}
+		pr := poolRange{prefix: prefix.Masked()}
+		if r.Gateway != "" {
+			if gw, err := netip.ParseAddr(r.Gateway); err == nil {
+				pr.gateway = gw
+			}
+		}
+		a.ranges = append(a.ranges, pr)
+	}
+
+	return a
+}
+
+// reserve marks an existing allocation's addresses as used.
+func (a *allocator) reserve(alloc vmopv1.VirtualMachineIPPoolAllocation) {
+	for _, addr := range alloc.Addresses {
+		if p, err := netip.ParsePrefix(addr); err == nil {
+			a.usedIPs[p.Addr()] = struct{}{}
+		}
+	}
+	if alloc.MACAddr != "" {
+		a.usedMACs[strings.ToLower(alloc.MACAddr)] = struct{}{}
+	}
+}
+
+// allocate returns an allocation with one address from the first range of
+// each IP family that has a free address, and a MAC address if the pool has
+// a MAC address prefix. False is returned if the pool does not have a free
+// address for the network interface.
+func (a *allocator) allocate(vmName, interfaceName string) (vmopv1.VirtualMachineIPPoolAllocation, bool) {
+	alloc := vmopv1.VirtualMachineIPPoolAllocation{
+		VirtualMachineName: vmName,
+		InterfaceName:      interfaceName,
+	}
+
+	var (
+		has4, has6   bool
+		ips          []netip.Addr
+		macAddr      string
+		allocatedIPs []string
+	)
+
+	for _, r := range a.ranges {
+		is4 := r.prefix.Addr().Is4()
+		if (is4 && has4) || (!is4 && has6) {
+			continue
+		}
+		ip, ok := a.freeIP(r)
+		if !ok {
+			continue
+		}
+		ips = append(ips, ip)
+		allocatedIPs = append(allocatedIPs, netip.PrefixFrom(ip, r.prefix.Bits()).String())
+		var gw string
+		if r.gateway.IsValid() {
+			gw = r.gateway.String()
+		}
+		if is4 {
+			has4 = true
+			alloc.Gateway4 = gw
+		} else {
+			has6 = true
+			alloc.Gateway6 = gw
+		}
+	}
+
+	if len(a.ranges) > 0 && len(ips) == 0 {
+		return alloc, false
+	}
+
+	if a.macPrefix != "" {
+		var ok bool
+		if macAddr, ok = a.freeMAC(); !ok {
+			return alloc, false
+		}
+	}
+
+	for _, ip := range ips {
+		a.usedIPs[ip] = struct{}{}
+	}
+	if macAddr != "" {
+		a.usedMACs[macAddr] = struct{}{}
+	}
+
+	alloc.Addresses = allocatedIPs
+	alloc.MACAddr = macAddr
+	return alloc, true
+}
+
+// freeIP returns the first address in the range that is not the network
+// address, the IPv4 broadcast address, the gateway, or already allocated.
+func (a *allocator) freeIP(r poolRange) (netip.Addr, bool) {
+	for ip := r.prefix.Addr().Next(); ip.IsValid() && r.prefix.Contains(ip); ip = ip.Next() {
+		if ip == r.gateway {
+			continue
+		}
+		if ip.Is4() && !r.prefix.Contains(ip.Next()) {
+			// The last address in an IPv4 range is the broadcast address.
+			break
+		}
+		if _, used := a.usedIPs[ip]; used {
+			continue
+		}
+		return ip, true
+	}
+	return netip.Addr{}, false
+}
+
+// freeMAC returns the first MAC address with the pool's prefix that is not
+// already allocated.
+func (a *allocator) freeMAC() (string, bool) {
+	for i := 1; i <= maxMACAddrSuffix; i++ {
+		mac := net.HardwareAddr{0, 0, 0, byte(i >> 16), byte(i >> 8), byte(i)}
+		macAddr := a.macPrefix + mac.String()[8:]
+		if _, used := a.usedMACs[macAddr]; !used {
+			return macAddr, true
+		}
+	}
+	return "", false
+}
+
+// references returns true if any of the VM's network interfaces reference the
+// pool.
+func references(vm *vmopv1.VirtualMachine, poolName string) bool {
+	if vm.Spec.Network == nil {
+		return false
+	}
+	for _, iface := range vm.Spec.Network.Interfaces {
+		if iface.IPPoolName == poolName {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAllocation returns true if the pool has addresses allocated for the VM.
+func hasAllocation(pool *vmopv1.VirtualMachineIPPool, vmName string) bool {
+	for _, a := range pool.Status.Allocations {
+		if a.VirtualMachineName == vmName {
+			return true
+		}
+	}
+	return false
+}
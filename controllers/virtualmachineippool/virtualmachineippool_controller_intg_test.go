@@ -0,0 +1,80 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineippool_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.EnvTest,
+			testlabels.API,
+		),
+		intgTestsReconcile,
+	)
+}
+
+func intgTestsReconcile() {
+	var (
+		ctx *builder.IntegrationTestContext
+
+		pool *vmopv1.VirtualMachineIPPool
+		vm   *vmopv1.VirtualMachine
+	)
+
+	getAllocations := func() []vmopv1.VirtualMachineIPPoolAllocation {
+		obj := &vmopv1.VirtualMachineIPPool{}
+		if err := ctx.Client.Get(ctx, client.ObjectKeyFromObject(pool), obj); err != nil {
+			return nil
+		}
+		return obj.Status.Allocations
+	}
+
+	BeforeEach(func() {
+		ctx = suite.NewIntegrationTestContext()
+
+		pool = builder.DummyVirtualMachineIPPool(ctx.Namespace, "my-pool")
+		Expect(ctx.Client.Create(ctx, pool)).To(Succeed())
+
+		vm = vmWithIPPool(ctx.Namespace, "ippool-vm", pool.Name, "eth0")
+		Expect(ctx.Client.Create(ctx, vm)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+	})
+
+	It("should allocate and release addresses for the VM", func() {
+		By("allocating addresses for the VM's interface", func() {
+			Eventually(getAllocations).Should(ConsistOf(vmopv1.VirtualMachineIPPoolAllocation{
+				VirtualMachineName: vm.Name,
+				InterfaceName:      "eth0",
+				Addresses:          []string{"192.168.1.2/24"},
+				Gateway4:           "192.168.1.1",
+				MACAddr:            "00:50:56:00:00:01",
+			}))
+		})
+
+		By("deleting the VM", func() {
+			Expect(ctx.Client.Delete(ctx, vm)).To(Succeed())
+		})
+
+		By("releasing the addresses", func() {
+			Eventually(getAllocations).Should(BeEmpty())
+		})
+	})
+}
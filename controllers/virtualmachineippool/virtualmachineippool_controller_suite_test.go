@@ -0,0 +1,33 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineippool_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineippool"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/manager"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var suite = builder.NewTestSuiteForControllerWithContext(
+	pkgcfg.NewContextWithDefaultConfig(),
+	virtualmachineippool.AddToManager,
+	manager.InitializeProvidersNoopFn)
+
+func TestVirtualMachineIPPool(t *testing.T) {
+	suite.Register(t, "VirtualMachineIPPool controller suite", intgTests, unitTests)
+}
+
+var _ = BeforeSuite(func() {
+	virtualmachineippool.SkipNameValidation = ptr.To(true)
+	suite.BeforeSuite()
+})
+
+var _ = AfterSuite(suite.AfterSuite)
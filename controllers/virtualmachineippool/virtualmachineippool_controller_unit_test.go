@@ -0,0 +1,329 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package virtualmachineippool_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/controllers/virtualmachineippool"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Reconcile",
+		Label(
+			testlabels.Controller,
+			testlabels.API,
+		),
+		unitTestsReconcile,
+	)
+}
+
+func vmWithIPPool(namespace, name, poolName string, interfaceNames ...string) *vmopv1.VirtualMachine {
+	vm := builder.DummyBasicVirtualMachine(name, namespace)
+	vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+	for _, n := range interfaceNames {
+		vm.Spec.Network.Interfaces = append(vm.Spec.Network.Interfaces,
+			vmopv1.VirtualMachineNetworkInterfaceSpec{
+				Name:       n,
+				IPPoolName: poolName,
+			})
+	}
+	return vm
+}
+
+func unitTestsReconcile() {
+	const (
+		namespace = "test-namespace"
+		poolName  = "my-pool"
+	)
+
+	var (
+		initObjects []client.Object
+		withFuncs   interceptor.Funcs
+		ctx         *builder.UnitTestContextForController
+
+		reconciler *virtualmachineippool.Reconciler
+		pool       *vmopv1.VirtualMachineIPPool
+
+		result reconcile.Result
+		err    error
+	)
+
+	getPool := func() *vmopv1.VirtualMachineIPPool {
+		obj := &vmopv1.VirtualMachineIPPool{}
+		ExpectWithOffset(1, ctx.Client.Get(ctx, client.ObjectKeyFromObject(pool), obj)).To(Succeed())
+		return obj
+	}
+
+	BeforeEach(func() {
+		pool = builder.DummyVirtualMachineIPPool(namespace, poolName)
+		initObjects = nil
+		withFuncs = interceptor.Funcs{}
+	})
+
+	JustBeforeEach(func() {
+		initObjects = append(initObjects, pool)
+		ctx = suite.NewUnitTestContextForControllerWithFuncs(withFuncs, initObjects...)
+		reconciler = virtualmachineippool.NewReconciler(
+			ctx,
+			ctx.Client,
+			ctx.Logger,
+			ctx.Recorder,
+		)
+
+		result, err = reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(pool),
+		})
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+		ctx = nil
+		initObjects = nil
+		withFuncs = interceptor.Funcs{}
+		reconciler = nil
+	})
+
+	When("no VMs reference the pool", func() {
+		BeforeEach(func() {
+			initObjects = append(initObjects,
+				vmWithIPPool(namespace, "other-vm", "other-pool", "eth0"))
+		})
+		It("should not allocate any addresses", func() {
+			Expect(err).ToNot(HaveOccurred())
+			obj := getPool()
+			Expect(obj.Status.Allocations).To(BeEmpty())
+			Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineIPPoolConditionReady)).To(BeTrue())
+		})
+	})
+
+	When("VMs reference the pool", func() {
+		BeforeEach(func() {
+			initObjects = append(initObjects,
+				vmWithIPPool(namespace, "vm-1", poolName, "eth0", "eth1"),
+				vmWithIPPool(namespace, "vm-2", poolName, "eth0"))
+		})
+
+		It("should allocate addresses for each interface", func() {
+			Expect(err).ToNot(HaveOccurred())
+			obj := getPool()
+			Expect(obj.Status.Allocations).To(Equal([]vmopv1.VirtualMachineIPPoolAllocation{
+				{
+					VirtualMachineName: "vm-1",
+					InterfaceName:      "eth0",
+					Addresses:          []string{"192.168.1.2/24"},
+					Gateway4:           "192.168.1.1",
+					MACAddr:            "00:50:56:00:00:01",
+				},
+				{
+					VirtualMachineName: "vm-1",
+					InterfaceName:      "eth1",
+					Addresses:          []string{"192.168.1.3/24"},
+					Gateway4:           "192.168.1.1",
+					MACAddr:            "00:50:56:00:00:02",
+				},
+				{
+					VirtualMachineName: "vm-2",
+					InterfaceName:      "eth0",
+					Addresses:          []string{"192.168.1.4/24"},
+					Gateway4:           "192.168.1.1",
+					MACAddr:            "00:50:56:00:00:03",
+				},
+			}))
+			Expect(conditions.IsTrue(obj, vmopv1.VirtualMachineIPPoolConditionReady)).To(BeTrue())
+		})
+
+		When("an interface already has an allocation", func() {
+			BeforeEach(func() {
+				pool.Status.Allocations = []vmopv1.VirtualMachineIPPoolAllocation{
+					{
+						VirtualMachineName: "vm-2",
+						InterfaceName:      "eth0",
+						Addresses:          []string{"192.168.1.2/24"},
+						Gateway4:           "192.168.1.1",
+						MACAddr:            "00:50:56:00:00:01",
+					},
+				}
+			})
+			It("should keep the allocation and not reuse its addresses", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getPool()
+				Expect(obj.Status.Allocations).To(HaveLen(3))
+				Expect(obj.Allocation("vm-2", "eth0")).To(Equal(&pool.Status.Allocations[0]))
+				Expect(obj.Allocation("vm-1", "eth0").Addresses).To(Equal([]string{"192.168.1.3/24"}))
+				Expect(obj.Allocation("vm-1", "eth0").MACAddr).To(Equal("00:50:56:00:00:02"))
+				Expect(obj.Allocation("vm-1", "eth1").Addresses).To(Equal([]string{"192.168.1.4/24"}))
+			})
+		})
+
+		When("the pool has IPv4 and IPv6 ranges", func() {
+			BeforeEach(func() {
+				pool.Spec.Ranges = append(pool.Spec.Ranges, vmopv1.VirtualMachineIPPoolRange{
+					CIDR:    "fd00::/64",
+					Gateway: "fd00::1",
+				})
+				pool.Spec.MACAddrPrefix = ""
+			})
+			It("should allocate dual-stack addresses", func() {
+				Expect(err).ToNot(HaveOccurred())
+				a := getPool().Allocation("vm-1", "eth0")
+				Expect(a).ToNot(BeNil())
+				Expect(a.Addresses).To(Equal([]string{"192.168.1.2/24", "fd00::2/64"}))
+				Expect(a.Gateway4).To(Equal("192.168.1.1"))
+				Expect(a.Gateway6).To(Equal("fd00::1"))
+				Expect(a.MACAddr).To(BeEmpty())
+			})
+		})
+
+		When("the pool does not have enough free addresses", func() {
+			BeforeEach(func() {
+				// A /30 has a single allocatable address after excluding the
+				// network address, the broadcast address, and the gateway.
+				pool.Spec.Ranges = []vmopv1.VirtualMachineIPPoolRange{
+					{
+						CIDR:    "192.168.1.0/30",
+						Gateway: "192.168.1.1",
+					},
+				}
+			})
+			It("should allocate the free addresses and mark the pool exhausted", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getPool()
+				Expect(obj.Status.Allocations).To(HaveLen(1))
+				Expect(obj.Status.Allocations[0].Addresses).To(Equal([]string{"192.168.1.2/30"}))
+
+				c := conditions.Get(obj, vmopv1.VirtualMachineIPPoolConditionReady)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineIPPoolConditionExhaustedReason))
+				Expect(c.Message).To(ContainSubstring("vm-1/eth1"))
+				Expect(c.Message).To(ContainSubstring("vm-2/eth0"))
+			})
+		})
+	})
+
+	When("the pool's status is updated after the pool is read", func() {
+		concurrentAllocation := vmopv1.VirtualMachineIPPoolAllocation{
+			VirtualMachineName: "vm-2",
+			InterfaceName:      "eth0",
+			Addresses:          []string{"192.168.1.2/24"},
+			Gateway4:           "192.168.1.1",
+			MACAddr:            "00:50:56:00:00:01",
+		}
+
+		BeforeEach(func() {
+			initObjects = append(initObjects,
+				vmWithIPPool(namespace, "vm-1", poolName, "eth0"),
+				vmWithIPPool(namespace, "vm-2", poolName, "eth0"))
+
+			var updated bool
+			withFuncs.Get = func(
+				ctx context.Context,
+				c client.WithWatch,
+				key client.ObjectKey,
+				obj client.Object,
+				opts ...client.GetOption) error {
+
+				if err := c.Get(ctx, key, obj, opts...); err != nil {
+					return err
+				}
+				if _, ok := obj.(*vmopv1.VirtualMachineIPPool); !ok || updated {
+					return nil
+				}
+				updated = true
+
+				// Simulate another reconcile allocating addresses after this
+				// reconcile read the pool.
+				latest := obj.DeepCopyObject().(*vmopv1.VirtualMachineIPPool)
+				latest.Status.Allocations = []vmopv1.VirtualMachineIPPoolAllocation{
+					concurrentAllocation,
+				}
+				return c.Status().Update(ctx, latest)
+			}
+		})
+
+		It("should not overwrite the allocations and should requeue", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+			Expect(getPool().Status.Allocations).To(Equal([]vmopv1.VirtualMachineIPPoolAllocation{
+				concurrentAllocation,
+			}))
+		})
+	})
+
+	When("an allocation is for a VM that no longer references the pool", func() {
+		BeforeEach(func() {
+			initObjects = append(initObjects,
+				vmWithIPPool(namespace, "vm-1", "other-pool", "eth0"))
+			pool.Status.Allocations = []vmopv1.VirtualMachineIPPoolAllocation{
+				{
+					VirtualMachineName: "vm-1",
+					InterfaceName:      "eth0",
+					Addresses:          []string{"192.168.1.2/24"},
+				},
+				{
+					VirtualMachineName: "deleted-vm",
+					InterfaceName:      "eth0",
+					Addresses:          []string{"192.168.1.3/24"},
+				},
+			}
+		})
+		It("should release the allocations", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getPool().Status.Allocations).To(BeEmpty())
+		})
+	})
+
+	Context("VMToIPPools", func() {
+		var vm *vmopv1.VirtualMachine
+
+		BeforeEach(func() {
+			vm = vmWithIPPool(namespace, "vm-1", poolName, "eth0")
+			initObjects = append(initObjects,
+				&vmopv1.VirtualMachineIPPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      "allocated-pool",
+					},
+					Status: vmopv1.VirtualMachineIPPoolStatus{
+						Allocations: []vmopv1.VirtualMachineIPPoolAllocation{
+							{VirtualMachineName: "vm-1", InterfaceName: "eth1"},
+						},
+					},
+				},
+				&vmopv1.VirtualMachineIPPool{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      "unrelated-pool",
+					},
+				})
+		})
+
+		It("should return the pools referenced by or allocated for the VM", func() {
+			requests := reconciler.VMToIPPools(&pkgctx.ControllerManagerContext{
+				Context: ctx,
+				Logger:  ctx.Logger,
+			})(ctx, vm)
+			Expect(requests).To(ConsistOf(
+				reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: poolName}},
+				reconcile.Request{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "allocated-pool"}},
+			))
+		})
+	})
+}
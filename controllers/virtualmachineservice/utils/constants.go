@@ -7,4 +7,8 @@ package utils
 const (
 	AnnotationServiceExternalTrafficPolicyKey = "virtualmachineservice.vmoperator.vmware.com/service.externalTrafficPolicy"
 	AnnotationServiceHealthCheckNodePortKey   = "virtualmachineservice.vmoperator.vmware.com/service.healthCheckNodePort"
+
+	// EndpointSliceManagedByValue is the value of the EndpointSlice managed-by label for the
+	// EndpointSlices created for a VirtualMachineService.
+	EndpointSliceManagedByValue = "vmoperator.vmware.com/virtualmachineservice-controller"
 )
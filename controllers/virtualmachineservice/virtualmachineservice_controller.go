@@ -7,15 +7,19 @@ package virtualmachineservice
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -34,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/patch"
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 )
 
 const (
@@ -84,6 +89,8 @@ func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr manager.Manager) err
 			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &vmopv1.VirtualMachineService{})).
 		Watches(&corev1.Endpoints{},
 			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &vmopv1.VirtualMachineService{})).
+		Watches(&discoveryv1.EndpointSlice{},
+			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &vmopv1.VirtualMachineService{})).
 		Watches(&vmopv1.VirtualMachine{},
 			handler.EnqueueRequestsFromMapFunc(r.virtualMachineToVirtualMachineServiceMapper())).
 		Complete(r)
@@ -123,6 +130,7 @@ type ReconcileVirtualMachineService struct {
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch;create;update;patch;delete
 
 func (r *ReconcileVirtualMachineService) Reconcile(ctx context.Context, request reconcile.Request) (_ reconcile.Result, reterr error) {
 	ctx = pkgcfg.JoinContext(ctx, r.Context)
@@ -415,6 +423,20 @@ func (r *ReconcileVirtualMachineService) createOrUpdateService(ctx *pkgctx.Virtu
 			service.Spec.AllocateLoadBalancerNodePorts = nil
 		}
 
+		// Only set the IP families when requested so the defaults k8s assigns to the
+		// Service are otherwise preserved.
+		if service.Spec.Type != corev1.ServiceTypeExternalName {
+			if families := vmService.Spec.IPFamilies; len(families) > 0 {
+				service.Spec.IPFamilies = make([]corev1.IPFamily, len(families))
+				for i := range families {
+					service.Spec.IPFamilies[i] = corev1.IPFamily(families[i])
+				}
+			}
+			if policy := vmService.Spec.IPFamilyPolicy; policy != nil {
+				service.Spec.IPFamilyPolicy = ptr.To(corev1.IPFamilyPolicy(*policy))
+			}
+		}
+
 		// Parts of the Service.Spec can be updated by k8s after creation, and we need to
 		// preserve those fields.
 		if service.ResourceVersion == "" {
@@ -438,6 +460,9 @@ func (r *ReconcileVirtualMachineService) createOrUpdateService(ctx *pkgctx.Virtu
 				TargetPort: intstr.FromInt(int(vmPort.TargetPort)),
 				NodePort:   nodePortMap[vmPort.Name],
 			}
+			if vmPort.TargetPortName != "" {
+				servicePort.TargetPort = intstr.FromString(vmPort.TargetPortName)
+			}
 			servicePorts = append(servicePorts, servicePort)
 		}
 		service.Spec.Ports = servicePorts
@@ -552,7 +577,7 @@ func (r *ReconcileVirtualMachineService) createOrUpdateEndpoints(ctx *pkgctx.Vir
 		return nil
 	}
 
-	unpackedSubsets, err := r.generateSubsetsForService(ctx, service)
+	unpackedSubsets, unpackedFamilySubsets, err := r.generateSubsetsForService(ctx, service)
 	if err != nil {
 		return err
 	}
@@ -572,7 +597,13 @@ func (r *ReconcileVirtualMachineService) createOrUpdateEndpoints(ctx *pkgctx.Vir
 
 		// NCP apparently needs the same Labels as what is present on the Service, and I'm not aware
 		// of anything else setting Labels, so just sync the Labels (and Annotations) with the Service.
-		endpoints.Labels = service.Labels
+		// The EndpointSlices are managed below so k8s must not mirror these Endpoints.
+		endpoints.Labels = make(map[string]string, len(service.Labels)+1)
+		for k, v := range service.Labels {
+			endpoints.Labels[k] = v
+		}
+		endpoints.Labels[discoveryv1.LabelSkipMirror] = "true"
+		setHeadlessServiceLabel(endpoints.Labels, service)
 		endpoints.Annotations = service.Annotations
 		endpoints.Subsets = subsets
 		return nil
@@ -589,13 +620,216 @@ func (r *ReconcileVirtualMachineService) createOrUpdateEndpoints(ctx *pkgctx.Vir
 		ctx.Logger.Info("Updating Service Endpoints", "endpoints", endpoints)
 	}
 
+	return r.createOrUpdateEndpointSlices(ctx, service, unpackedFamilySubsets)
+}
+
+// createOrUpdateEndpointSlices updates the EndpointSlices for the VirtualMachineService. There is
+// an EndpointSlice for each IP family and repacked subset, and stale EndpointSlices are deleted.
+func (r *ReconcileVirtualMachineService) createOrUpdateEndpointSlices(
+	ctx *pkgctx.VirtualMachineServiceContext,
+	service *corev1.Service,
+	unpackedFamilySubsets map[corev1.IPFamily][]corev1.EndpointSubset) error {
+
+	desired := map[string]struct{}{}
+
+	for _, family := range endpointIPFamilies(ctx.VMService, service) {
+		for i, subset := range utils.RepackSubsets(unpackedFamilySubsets[family]) {
+			endpointSlice := &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("%s-%s-%d", service.Name, strings.ToLower(string(family)), i),
+					Namespace: service.Namespace,
+				},
+			}
+			desired[endpointSlice.Name] = struct{}{}
+
+			result, err := controllerutil.CreateOrPatch(ctx, r.Client, endpointSlice, func() error {
+				if err := controllerutil.SetControllerReference(ctx.VMService, endpointSlice, r.Client.Scheme()); err != nil {
+					return err
+				}
+
+				endpointSlice.Labels = make(map[string]string, len(service.Labels)+2)
+				for k, v := range service.Labels {
+					endpointSlice.Labels[k] = v
+				}
+				endpointSlice.Labels[discoveryv1.LabelServiceName] = service.Name
+				endpointSlice.Labels[discoveryv1.LabelManagedBy] = utils.EndpointSliceManagedByValue
+				setHeadlessServiceLabel(endpointSlice.Labels, service)
+				endpointSlice.AddressType = discoveryv1.AddressType(family)
+				endpointSlice.Endpoints = endpointSliceEndpoints(subset)
+				endpointSlice.Ports = endpointSlicePorts(subset)
+				return nil
+			})
+
+			if err != nil {
+				return err
+			}
+
+			switch result {
+			case controllerutil.OperationResultCreated:
+				ctx.Logger.Info("Creating Service EndpointSlice", "endpointSlice", endpointSlice.Name)
+			case controllerutil.OperationResultUpdated:
+				ctx.Logger.Info("Updating Service EndpointSlice", "endpointSlice", endpointSlice.Name)
+			}
+		}
+	}
+
+	endpointSliceList := &discoveryv1.EndpointSliceList{}
+	if err := r.List(ctx, endpointSliceList,
+		client.InNamespace(service.Namespace),
+		client.MatchingLabels{
+			discoveryv1.LabelServiceName: service.Name,
+			discoveryv1.LabelManagedBy:   utils.EndpointSliceManagedByValue,
+		}); err != nil {
+		return err
+	}
+
+	for i := range endpointSliceList.Items {
+		endpointSlice := &endpointSliceList.Items[i]
+		if _, ok := desired[endpointSlice.Name]; ok {
+			continue
+		}
+		if !metav1.IsControlledBy(endpointSlice, ctx.VMService) {
+			continue
+		}
+
+		ctx.Logger.Info("Deleting stale Service EndpointSlice", "endpointSlice", endpointSlice.Name)
+		if err := r.Delete(ctx, endpointSlice); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func findVMPortNum(_ *vmopv1.VirtualMachine, port intstr.IntOrString, _ corev1.Protocol) (int, error) {
+// setHeadlessServiceLabel sets the label k8s uses to identify the Endpoints and EndpointSlices of
+// a headless Service, and otherwise removes it.
+func setHeadlessServiceLabel(labels map[string]string, service *corev1.Service) {
+	if service.Spec.ClusterIP == corev1.ClusterIPNone {
+		labels[corev1.IsHeadlessService] = ""
+	} else {
+		delete(labels, corev1.IsHeadlessService)
+	}
+}
+
+func endpointSliceEndpoints(subset corev1.EndpointSubset) []discoveryv1.Endpoint {
+	endpoints := make([]discoveryv1.Endpoint, 0, len(subset.Addresses)+len(subset.NotReadyAddresses))
+	appendEndpoints := func(addresses []corev1.EndpointAddress, ready bool) {
+		for _, addr := range addresses {
+			endpoint := discoveryv1.Endpoint{
+				Addresses: []string{addr.IP},
+				Conditions: discoveryv1.EndpointConditions{
+					Ready:       ptr.To(ready),
+					Serving:     ptr.To(ready),
+					Terminating: ptr.To(false),
+				},
+				TargetRef: addr.TargetRef,
+			}
+			if addr.Hostname != "" {
+				endpoint.Hostname = ptr.To(addr.Hostname)
+			}
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	appendEndpoints(subset.Addresses, true)
+	appendEndpoints(subset.NotReadyAddresses, false)
+	return endpoints
+}
+
+func endpointSlicePorts(subset corev1.EndpointSubset) []discoveryv1.EndpointPort {
+	if len(subset.Ports) == 0 {
+		return nil
+	}
+
+	ports := make([]discoveryv1.EndpointPort, 0, len(subset.Ports))
+	for _, port := range subset.Ports {
+		ports = append(ports, discoveryv1.EndpointPort{
+			Name:     ptr.To(port.Name),
+			Port:     ptr.To(port.Port),
+			Protocol: ptr.To(port.Protocol),
+		})
+	}
+	return ports
+}
+
+// endpointIPFamilies returns the IP families, in order of preference, whose addresses are
+// published for the Service. These are the families assigned to the Service by k8s, then the
+// families requested by the VirtualMachineService, and otherwise both IPv4 and IPv6.
+func endpointIPFamilies(vmService *vmopv1.VirtualMachineService, service *corev1.Service) []corev1.IPFamily {
+	if len(service.Spec.IPFamilies) > 0 {
+		return service.Spec.IPFamilies
+	}
+
+	if len(vmService.Spec.IPFamilies) > 0 {
+		families := make([]corev1.IPFamily, len(vmService.Spec.IPFamilies))
+		for i := range vmService.Spec.IPFamilies {
+			families[i] = corev1.IPFamily(vmService.Spec.IPFamilies[i])
+		}
+		return families
+	}
+
+	return []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+}
+
+// getVMIPsByFamily returns the VM's IP address for each IP family. When the VirtualMachineService
+// specifies an interface, the first non link-local address of each family observed on that
+// interface is used. Otherwise, the VM's primary IP addresses are used.
+func getVMIPsByFamily(vm *vmopv1.VirtualMachine, interfaceName string) map[corev1.IPFamily]string {
+	ips := map[corev1.IPFamily]string{}
+
+	if vm.Status.Network == nil {
+		return ips
+	}
+
+	if interfaceName == "" {
+		if ip := vm.Status.Network.PrimaryIP4; ip != "" {
+			ips[corev1.IPv4Protocol] = ip
+		}
+		if ip := vm.Status.Network.PrimaryIP6; ip != "" {
+			ips[corev1.IPv6Protocol] = ip
+		}
+		return ips
+	}
+
+	for _, iface := range vm.Status.Network.Interfaces {
+		if iface.Name != interfaceName || iface.IP == nil {
+			continue
+		}
+
+		for _, ipAddr := range iface.IP.Addresses {
+			addr, err := netip.ParseAddr(ipAddr.Address)
+			if err != nil {
+				prefix, err := netip.ParsePrefix(ipAddr.Address)
+				if err != nil {
+					continue
+				}
+				addr = prefix.Addr()
+			}
+
+			if addr.IsLinkLocalUnicast() || addr.IsLoopback() {
+				continue
+			}
+
+			family := corev1.IPv4Protocol
+			if !addr.Unmap().Is4() {
+				family = corev1.IPv6Protocol
+			}
+			if _, ok := ips[family]; !ok {
+				ips[family] = addr.Unmap().String()
+			}
+		}
+	}
+
+	return ips
+}
+
+func findVMPortNum(vm *vmopv1.VirtualMachine, port intstr.IntOrString, protocol corev1.Protocol) (int, error) {
 	switch port.Type {
 	case intstr.String:
-		// Not supported.
+		portNum, err := vmopv1util.GetNamedPort(*vm, port.StrVal, protocol)
+		if err != nil {
+			return 0, err
+		}
+		return int(portNum), nil
 	case intstr.Int:
 		return port.IntValue(), nil
 	}
@@ -603,18 +837,45 @@ func findVMPortNum(_ *vmopv1.VirtualMachine, port intstr.IntOrString, _ corev1.P
 	return 0, fmt.Errorf("no matching port on VM")
 }
 
-// generateSubsetsForService generates Endpoints subsets for a given Service.
+// getVMEndpointHostname returns the hostname of the VM's address in the Endpoints of a headless
+// Service. This is the VM's name, which is unique among the selected VMs, when it is a valid DNS
+// label so that a DNS record is published for the VM. The VM's spec.network.hostName is not used
+// since multiple VMs may have the same hostName.
+func getVMEndpointHostname(vm *vmopv1.VirtualMachine) string {
+	if len(validation.IsDNS1123Label(vm.Name)) == 0 {
+		return vm.Name
+	}
+
+	return ""
+}
+
+// generateSubsetsForService generates Endpoints subsets for a given Service. The first result
+// has a subset for each VM with the VM's IP of the most preferred family, and is used for the
+// Endpoints. The second result has the subsets for each IP family, and is used for the
+// EndpointSlices.
 func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 	ctx *pkgctx.VirtualMachineServiceContext,
-	service *corev1.Service) ([]corev1.EndpointSubset, error) {
+	service *corev1.Service) ([]corev1.EndpointSubset, map[corev1.IPFamily][]corev1.EndpointSubset, error) {
 
 	vmList, err := r.getVirtualMachinesSelectedByVMService(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// The Endpoints only have a single address for each VM so prefer the Service's families, but
+	// still fall back to the other family like before the Service had IP families.
+	families := endpointIPFamilies(ctx.VMService, service)
+	legacyFamilies := slices.Clone(families)
+	for _, family := range []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol} {
+		if !slices.Contains(legacyFamilies, family) {
+			legacyFamilies = append(legacyFamilies, family)
+		}
 	}
 
 	var subsets = make([]corev1.EndpointSubset, 0, len(vmList.Items))
+	var familySubsets = make(map[corev1.IPFamily][]corev1.EndpointSubset, len(families))
 	var vmInSubsetsMap map[types.UID]struct{}
+	headless := service.Spec.ClusterIP == corev1.ClusterIPNone
 
 	for i := range vmList.Items {
 		vm := vmList.Items[i]
@@ -625,19 +886,23 @@ func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 			continue
 		}
 
+		vmIPs := getVMIPsByFamily(&vm, ctx.VMService.Spec.InterfaceName)
+
 		var vmIP string
-		if vm.Status.Network != nil {
-			vmIP = vm.Status.Network.PrimaryIP4
-			if vmIP == "" {
-				vmIP = vm.Status.Network.PrimaryIP6
+		for _, family := range legacyFamilies {
+			if vmIP = vmIPs[family]; vmIP != "" {
+				break
 			}
 		}
 
 		if vmIP == "" {
 			// The EndpointAddress must have a valid IP so we cannot include this VM in the
 			// NotReadyAddresses.
-			// TODO: When we more fully support multiple NICs, we'll need someway to select which IP.
-			logger.Info("Skipping VM without primary IP assigned")
+			if interfaceName := ctx.VMService.Spec.InterfaceName; interfaceName != "" {
+				logger.Info("Skipping VM without IP assigned to interface", "interfaceName", interfaceName)
+			} else {
+				logger.Info("Skipping VM without primary IP assigned")
+			}
 			continue
 		}
 
@@ -663,8 +928,15 @@ func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 			}
 		}
 
+		// A headless Service publishes a DNS record for each VM with a hostname.
+		var hostname string
+		if headless {
+			hostname = getVMEndpointHostname(&vm)
+		}
+
 		epa := corev1.EndpointAddress{
-			IP: vmIP,
+			IP:       vmIP,
+			Hostname: hostname,
 			TargetRef: &corev1.ObjectReference{
 				APIVersion: vm.APIVersion,
 				Kind:       vm.Kind,
@@ -687,7 +959,6 @@ func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 			subset.NotReadyAddresses = []corev1.EndpointAddress{epa}
 		}
 
-		// TODO: Headless support
 		for _, servicePort := range service.Spec.Ports {
 			portName := servicePort.Name
 			portProto := servicePort.Protocol
@@ -711,9 +982,31 @@ func (r *ReconcileVirtualMachineService) generateSubsetsForService(
 		}
 
 		subsets = append(subsets, subset)
+
+		for _, family := range families {
+			if ip := vmIPs[family]; ip != "" {
+				familySubsets[family] = append(familySubsets[family], subsetWithIP(subset, ip))
+			}
+		}
 	}
 
-	return subsets, nil
+	return subsets, familySubsets, nil
+}
+
+// subsetWithIP returns a copy of the single VM subset with the address IP replaced.
+func subsetWithIP(subset corev1.EndpointSubset, ip string) corev1.EndpointSubset {
+	withIP := corev1.EndpointSubset{
+		Ports: subset.Ports,
+	}
+	for _, epa := range subset.Addresses {
+		epa.IP = ip
+		withIP.Addresses = append(withIP.Addresses, epa)
+	}
+	for _, epa := range subset.NotReadyAddresses {
+		epa.IP = ip
+		withIP.NotReadyAddresses = append(withIP.NotReadyAddresses, epa)
+	}
+	return withIP
 }
 
 // updateVMService syncs the VirtualMachineService Status from the Service status.
@@ -11,6 +11,7 @@ import (
 	"github.com/onsi/gomega/types"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apiEquality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -194,6 +195,26 @@ func unitTestsReconcile() {
 				Expect(*service.Spec.AllocateLoadBalancerNodePorts).To(BeFalse())
 			})
 
+			It("Without IP families when not requested", func() {
+				Expect(service.Spec.IPFamilies).To(BeEmpty())
+				Expect(service.Spec.IPFamilyPolicy).To(BeNil())
+			})
+
+			Context("With IP families", func() {
+				BeforeEach(func() {
+					vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+						vmopv1.VirtualMachineServiceIPFamilyIPv6,
+						vmopv1.VirtualMachineServiceIPFamilyIPv4,
+					}
+					vmService.Spec.IPFamilyPolicy = ptr.To(vmopv1.VirtualMachineServiceIPFamilyPolicyRequireDualStack)
+				})
+
+				It("Service IP families", func() {
+					Expect(service.Spec.IPFamilies).To(Equal([]corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}))
+					Expect(service.Spec.IPFamilyPolicy).To(HaveValue(Equal(corev1.IPFamilyPolicyRequireDualStack)))
+				})
+			})
+
 			Context("With Expected Spec.Ports", func() {
 				BeforeEach(func() {
 					vmService.Spec.Ports = []vmopv1.VirtualMachineServicePort{
@@ -503,6 +524,11 @@ func unitTestsReconcile() {
 				Expect(endpoints.Labels).To(HaveKeyWithValue(labelName1, "bar2"))
 			})
 
+			It("With skip mirror Label", func() {
+				Expect(endpoints.Labels).To(HaveKeyWithValue(discoveryv1.LabelSkipMirror, "true"))
+				Expect(vmService.Labels).ToNot(HaveKey(discoveryv1.LabelSkipMirror))
+			})
+
 			It("Empty Subsets when no VM matches", func() {
 				Expect(endpoints.Subsets).To(BeEmpty())
 			})
@@ -575,6 +601,234 @@ func unitTestsReconcile() {
 				})
 			})
 
+			Context("When VMs have multiple interfaces", func() {
+				BeforeEach(func() {
+					vm1.Status.Network.PrimaryIP6 = "fd00::1"
+					vm1.Status.Network.Interfaces = []vmopv1.VirtualMachineNetworkInterfaceStatus{
+						{
+							Name: "frontend",
+							IP: &vmopv1.VirtualMachineNetworkInterfaceIPStatus{
+								Addresses: []vmopv1.VirtualMachineNetworkInterfaceIPAddrStatus{
+									{Address: "1.1.1.1/24"},
+									{Address: "fd00::1/64"},
+								},
+							},
+						},
+						{
+							Name: "backend",
+							IP: &vmopv1.VirtualMachineNetworkInterfaceIPStatus{
+								Addresses: []vmopv1.VirtualMachineNetworkInterfaceIPAddrStatus{
+									{Address: "fe80::2/64"},
+									{Address: "10.0.0.2/24"},
+									{Address: "fd01::2/64"},
+								},
+							},
+						},
+					}
+					initObjects = append(initObjects, vm1)
+				})
+
+				getEndpointSlice := func(name string) *discoveryv1.EndpointSlice {
+					endpointSlice := &discoveryv1.EndpointSlice{}
+					ExpectWithOffset(1, ctx.Client.Get(ctx, client.ObjectKey{Namespace: objKey.Namespace, Name: name}, endpointSlice)).To(Succeed())
+					return endpointSlice
+				}
+
+				It("Uses the primary IPs when no interface is selected", func() {
+					Expect(endpoints.Subsets).To(HaveLen(1))
+					Expect(endpoints.Subsets[0].Addresses).To(HaveLen(1))
+					Expect(endpoints.Subsets[0].Addresses[0].IP).To(Equal("1.1.1.1"))
+
+					endpointSlice := getEndpointSlice(objKey.Name + "-ipv4-0")
+					Expect(endpointSlice.AddressType).To(Equal(discoveryv1.AddressTypeIPv4))
+					Expect(endpointSlice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, objKey.Name))
+					Expect(endpointSlice.Labels).To(HaveKeyWithValue(discoveryv1.LabelManagedBy, utils.EndpointSliceManagedByValue))
+					Expect(endpointSlice.Labels).To(HaveKeyWithValue(labelName1, "bar2"))
+					Expect(endpointSlice.OwnerReferences).To(HaveLen(1))
+					Expect(endpointSlice.OwnerReferences[0].Name).To(Equal(vmService.Name))
+					Expect(endpointSlice.Endpoints).To(HaveLen(1))
+					Expect(endpointSlice.Endpoints[0].Addresses).To(Equal([]string{"1.1.1.1"}))
+					Expect(endpointSlice.Endpoints[0].Conditions.Ready).To(HaveValue(BeTrue()))
+					Expect(endpointSlice.Endpoints[0].TargetRef).ToNot(BeNil())
+					Expect(endpointSlice.Endpoints[0].TargetRef.Name).To(Equal(vm1.Name))
+					Expect(endpointSlice.Ports).To(HaveLen(1))
+					Expect(endpointSlice.Ports[0].Name).To(HaveValue(Equal(vmServicePort1.Name)))
+					Expect(endpointSlice.Ports[0].Port).To(HaveValue(Equal(vmServicePort1.TargetPort)))
+
+					endpointSlice = getEndpointSlice(objKey.Name + "-ipv6-0")
+					Expect(endpointSlice.AddressType).To(Equal(discoveryv1.AddressTypeIPv6))
+					Expect(endpointSlice.Endpoints).To(HaveLen(1))
+					Expect(endpointSlice.Endpoints[0].Addresses).To(Equal([]string{"fd00::1"}))
+				})
+
+				Context("When the backend interface is selected", func() {
+					BeforeEach(func() {
+						vmService.Spec.InterfaceName = "backend"
+					})
+
+					It("Uses the interface IPs", func() {
+						Expect(endpoints.Subsets).To(HaveLen(1))
+						Expect(endpoints.Subsets[0].Addresses).To(HaveLen(1))
+						Expect(endpoints.Subsets[0].Addresses[0].IP).To(Equal("10.0.0.2"))
+
+						endpointSlice := getEndpointSlice(objKey.Name + "-ipv4-0")
+						Expect(endpointSlice.Endpoints).To(HaveLen(1))
+						Expect(endpointSlice.Endpoints[0].Addresses).To(Equal([]string{"10.0.0.2"}))
+
+						endpointSlice = getEndpointSlice(objKey.Name + "-ipv6-0")
+						Expect(endpointSlice.Endpoints).To(HaveLen(1))
+						Expect(endpointSlice.Endpoints[0].Addresses).To(Equal([]string{"fd01::2"}))
+					})
+
+					Context("When IPv6 is preferred", func() {
+						BeforeEach(func() {
+							vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+								vmopv1.VirtualMachineServiceIPFamilyIPv6,
+							}
+						})
+
+						It("Uses the interface IPv6 IP", func() {
+							Expect(endpoints.Subsets).To(HaveLen(1))
+							Expect(endpoints.Subsets[0].Addresses).To(HaveLen(1))
+							Expect(endpoints.Subsets[0].Addresses[0].IP).To(Equal("fd01::2"))
+
+							endpointSlice := getEndpointSlice(objKey.Name + "-ipv6-0")
+							Expect(endpointSlice.Endpoints[0].Addresses).To(Equal([]string{"fd01::2"}))
+
+							err := ctx.Client.Get(ctx, client.ObjectKey{Namespace: objKey.Namespace, Name: objKey.Name + "-ipv4-0"}, &discoveryv1.EndpointSlice{})
+							Expect(errors.IsNotFound(err)).To(BeTrue())
+						})
+					})
+				})
+
+				Context("When the selected interface does not exist", func() {
+					BeforeEach(func() {
+						vmService.Spec.InterfaceName = "missing"
+					})
+
+					It("Not included in Subsets", func() {
+						Expect(endpoints.Subsets).To(BeEmpty())
+					})
+				})
+
+				Context("When the VM Service no longer publishes IPv6", func() {
+					JustBeforeEach(func() {
+						getEndpointSlice(objKey.Name + "-ipv6-0")
+
+						vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+							vmopv1.VirtualMachineServiceIPFamilyIPv4,
+						}
+						service := &corev1.Service{}
+						Expect(ctx.Client.Get(ctx, objKey, service)).To(Succeed())
+						service.Spec.IPFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+						Expect(ctx.Client.Update(ctx, service)).To(Succeed())
+
+						Expect(reconciler.ReconcileNormal(vmServiceCtx)).To(Succeed())
+					})
+
+					It("Deletes the stale EndpointSlice", func() {
+						getEndpointSlice(objKey.Name + "-ipv4-0")
+
+						err := ctx.Client.Get(ctx, client.ObjectKey{Namespace: objKey.Namespace, Name: objKey.Name + "-ipv6-0"}, &discoveryv1.EndpointSlice{})
+						Expect(errors.IsNotFound(err)).To(BeTrue())
+					})
+				})
+			})
+
+			Context("When the target port is named", func() {
+				BeforeEach(func() {
+					vmService.Spec.Ports = []vmopv1.VirtualMachineServicePort{
+						{
+							Name:           "http",
+							Protocol:       "TCP",
+							Port:           80,
+							TargetPortName: "http",
+						},
+					}
+
+					vm1.Annotations = map[string]string{vmopv1.NamedPortsAnnotation: "http=8080"}
+					vm2.Annotations = map[string]string{vmopv1.NamedPortsAnnotation: "http=9090,dns=53/UDP"}
+					initObjects = append(initObjects, vm1, vm2)
+				})
+
+				It("Service has the named target port", func() {
+					service := &corev1.Service{}
+					Expect(ctx.Client.Get(ctx, objKey, service)).To(Succeed())
+					Expect(service.Spec.Ports).To(HaveLen(1))
+					Expect(service.Spec.Ports[0].TargetPort.StrVal).To(Equal("http"))
+				})
+
+				It("With a subset for each port number", func() {
+					Expect(endpoints.Subsets).To(HaveLen(2))
+
+					ports := map[int32]string{}
+					for _, subset := range endpoints.Subsets {
+						Expect(subset.Ports).To(HaveLen(1))
+						Expect(subset.Ports[0].Name).To(Equal("http"))
+						Expect(subset.Addresses).To(HaveLen(1))
+						ports[subset.Ports[0].Port] = subset.Addresses[0].TargetRef.Name
+					}
+					Expect(ports).To(Equal(map[int32]string{8080: vm1.Name, 9090: vm2.Name}))
+				})
+
+				Context("When a VM does not declare the named port", func() {
+					BeforeEach(func() {
+						vm2.Annotations = map[string]string{vmopv1.NamedPortsAnnotation: "http=9090/UDP"}
+					})
+
+					It("VM is included without the port", func() {
+						Expect(endpoints.Subsets).To(HaveLen(2))
+						for _, subset := range endpoints.Subsets {
+							Expect(subset.Addresses).To(HaveLen(1))
+							if subset.Addresses[0].TargetRef.Name == vm1.Name {
+								Expect(subset.Ports).To(HaveLen(1))
+								Expect(subset.Ports[0].Port).To(Equal(int32(8080)))
+							} else {
+								Expect(subset.Ports).To(BeEmpty())
+							}
+						}
+					})
+				})
+			})
+
+			Context("When the Service is headless", func() {
+				BeforeEach(func() {
+					vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeClusterIP
+					vmService.Spec.ClusterIP = corev1.ClusterIPNone
+					vm1.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{HostName: "member"}
+					vm2.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{HostName: "member"}
+					initObjects = append(initObjects, vm1, vm2)
+				})
+
+				It("With VM names as hostnames", func() {
+					Expect(endpoints.Labels).To(HaveKey(corev1.IsHeadlessService))
+					Expect(endpoints.Subsets).To(HaveLen(1))
+					Expect(endpoints.Subsets[0].Addresses).To(HaveLen(2))
+					Expect(endpoints.Subsets[0].Addresses[0].Hostname).To(Equal(vm1.Name))
+					Expect(endpoints.Subsets[0].Addresses[1].Hostname).To(Equal(vm2.Name))
+
+					endpointSlice := &discoveryv1.EndpointSlice{}
+					Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: objKey.Namespace, Name: objKey.Name + "-ipv4-0"}, endpointSlice)).To(Succeed())
+					Expect(endpointSlice.Labels).To(HaveKey(corev1.IsHeadlessService))
+					Expect(endpointSlice.Endpoints).To(HaveLen(2))
+					Expect(endpointSlice.Endpoints[0].Hostname).To(HaveValue(Equal(vm1.Name)))
+					Expect(endpointSlice.Endpoints[1].Hostname).To(HaveValue(Equal(vm2.Name)))
+				})
+			})
+
+			Context("When the Service is not headless", func() {
+				BeforeEach(func() {
+					initObjects = append(initObjects, vm1)
+				})
+
+				It("Without VM hostnames", func() {
+					Expect(endpoints.Labels).ToNot(HaveKey(corev1.IsHeadlessService))
+					Expect(endpoints.Subsets).To(HaveLen(1))
+					Expect(endpoints.Subsets[0].Addresses).To(HaveLen(1))
+					Expect(endpoints.Subsets[0].Addresses[0].Hostname).To(BeEmpty())
+				})
+			})
+
 			Context("When VMs have Readiness Probe", func() {
 				BeforeEach(func() {
 					vm1.Spec.ReadinessProbe = &vmopv1.VirtualMachineReadinessProbeSpec{
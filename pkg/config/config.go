@@ -152,15 +152,33 @@ type Config struct {
 	// VCCredsSecretName is the name of the secret in the pod namespace that
 	// contains the VC credentials.
 	//
+	// The secret contains either the username and password keys, or the
+	// tls.crt and tls.key keys for a solution user's client certificate and
+	// private key. With the latter, the optional token key specifies a SAML
+	// token that is exchanged for a holder-of-key token.
+	//
 	// Defaults to "wcp-vmop-sa-vc-auth".
 	VCCredsSecretName string
 
+	// ImageTrustAnchorsConfigMapName is the name of the ConfigMap in the pod
+	// namespace that contains the PEM encoded certificates used to verify the
+	// signatures of content library images.
+	//
+	// Image signatures are not verified when this is empty.
+	//
+	// Defaults to "".
+	ImageTrustAnchorsConfigMapName string
+
 	// CRDCleanupEnabled indicates to delete CRDs or remove their fields when a
 	// feature/capability is disabled.
 	//
 	// Please note, this field has no effect if a CRD is being installed for the
 	// first time.
 	CRDCleanupEnabled bool
+
+	// VMStatsExporter contains configuration details related to exporting
+	// per-VM statistics as metrics.
+	VMStatsExporter VMStatsExporter
 }
 
 // GetMaxDeployThreadsOnProvider returns MaxDeployThreadsOnProvider if it is >0
@@ -227,6 +245,29 @@ type InstanceStorage struct {
 	SeedRequeueDuration time.Duration
 }
 
+type VMStatsExporter struct {
+	// Enabled may be set to true to export the statistics of VMs, ex. CPU and
+	// memory usage, as metrics. The statistics are received from the
+	// vm-watcher service, so this flag has no impact if AsyncSignalEnabled is
+	// false.
+	//
+	// Defaults to false.
+	Enabled bool
+
+	// MaxVMs is the maximum number of VMs for which statistics are exported.
+	// Statistics for additional VMs are dropped until the number of exported
+	// VMs is below the limit.
+	//
+	// Defaults to 1000.
+	MaxVMs int
+
+	// MaxDisksPerVM is the maximum number of disks per VM for which datastore
+	// usage is exported.
+	//
+	// Defaults to 16.
+	MaxDisksPerVM int
+}
+
 type NetworkProviderType string
 
 const (
@@ -63,5 +63,10 @@ func Default() Config {
 		WebhookSecretNamespace:       defaultPrefix + "system",
 		WebhookSecretVolumeMountPath: "/tmp/k8s-webhook-server/serving-certs",
 		CRDCleanupEnabled:            false,
+		VMStatsExporter: VMStatsExporter{
+			Enabled:       false,
+			MaxVMs:        1000,
+			MaxDisksPerVM: 16,
+		},
 	}
 }
@@ -33,12 +33,17 @@ func FromEnv() Config {
 	setDuration(env.MemStatsPeriod, &config.MemStatsPeriod)
 	setString(env.FastDeployMode, &config.FastDeployMode)
 	setString(env.VCCredsSecretName, &config.VCCredsSecretName)
+	setString(env.ImageTrustAnchorsConfigMapName, &config.ImageTrustAnchorsConfigMapName)
 	setBool(env.CRDCleanupEnabled, &config.CRDCleanupEnabled)
 
 	setDuration(env.InstanceStoragePVPlacementFailedTTL, &config.InstanceStorage.PVPlacementFailedTTL)
 	setFloat64(env.InstanceStorageJitterMaxFactor, &config.InstanceStorage.JitterMaxFactor)
 	setDuration(env.InstanceStorageSeedRequeueDuration, &config.InstanceStorage.SeedRequeueDuration)
 
+	setBool(env.VMStatsExporterEnabled, &config.VMStatsExporter.Enabled)
+	setInt(env.VMStatsExporterMaxVMs, &config.VMStatsExporter.MaxVMs)
+	setInt(env.VMStatsExporterMaxDisksPerVM, &config.VMStatsExporter.MaxDisksPerVM)
+
 	setBool(env.ContainerNode, &config.ContainerNode)
 	setString(env.WatchNamespace, &config.WatchNamespace)
 	setString(env.ProfilerAddr, &config.ProfilerAddr)
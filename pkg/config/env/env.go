@@ -28,6 +28,7 @@ const (
 	AsyncCreateEnabled
 	FastDeployMode
 	VCCredsSecretName
+	ImageTrustAnchorsConfigMapName
 	InstanceStoragePVPlacementFailedTTL
 	InstanceStorageJitterMaxFactor
 	InstanceStorageSeedRequeueDuration
@@ -51,6 +52,9 @@ const (
 	WebhookSecretName
 	WebhookSecretNamespace
 	CRDCleanupEnabled
+	VMStatsExporterEnabled
+	VMStatsExporterMaxVMs
+	VMStatsExporterMaxDisksPerVM
 	FSSInstanceStorage
 	FSSK8sWorkloadMgmtAPI
 	FSSPodVMOnStretchedSupervisor
@@ -123,6 +127,8 @@ func (n VarName) String() string {
 		return "FAST_DEPLOY_MODE"
 	case VCCredsSecretName:
 		return "VC_CREDS_SECRET_NAME"
+	case ImageTrustAnchorsConfigMapName:
+		return "IMAGE_TRUST_ANCHORS_CONFIGMAP_NAME"
 	case InstanceStoragePVPlacementFailedTTL:
 		return "INSTANCE_STORAGE_PV_PLACEMENT_FAILED_TTL"
 	case InstanceStorageJitterMaxFactor:
@@ -169,6 +175,12 @@ func (n VarName) String() string {
 		return "WEBHOOK_SECRET_NAMESPACE"
 	case CRDCleanupEnabled:
 		return "CRD_CLEANUP_ENABLED"
+	case VMStatsExporterEnabled:
+		return "VM_STATS_EXPORTER_ENABLED"
+	case VMStatsExporterMaxVMs:
+		return "VM_STATS_EXPORTER_MAX_VMS"
+	case VMStatsExporterMaxDisksPerVM:
+		return "VM_STATS_EXPORTER_MAX_DISKS_PER_VM"
 
 	//
 	// Features/Capabilities
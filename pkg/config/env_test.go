@@ -82,6 +82,7 @@ var _ = Describe(
 					Expect(os.Setenv("ASYNC_CREATE_ENABLED", "false")).To(Succeed())
 					Expect(os.Setenv("FAST_DEPLOY_MODE", pkgconst.FastDeployModeDirect)).To(Succeed())
 					Expect(os.Setenv("VC_CREDS_SECRET_NAME", pkgconst.VCCredsSecretName)).To(Succeed())
+					Expect(os.Setenv("IMAGE_TRUST_ANCHORS_CONFIGMAP_NAME", "132")).To(Succeed())
 					Expect(os.Setenv("LEADER_ELECTION_ID", "115")).To(Succeed())
 					Expect(os.Setenv("POD_NAME", "116")).To(Succeed())
 					Expect(os.Setenv("POD_NAMESPACE", "117")).To(Succeed())
@@ -110,6 +111,9 @@ var _ = Describe(
 					Expect(os.Setenv("DEPLOYMENT_NAME", "129")).To(Succeed())
 					Expect(os.Setenv("SIGUSR2_RESTART_ENABLED", "true")).To(Succeed())
 					Expect(os.Setenv("CRD_CLEANUP_ENABLED", "true")).To(Succeed())
+					Expect(os.Setenv("VM_STATS_EXPORTER_ENABLED", "true")).To(Succeed())
+					Expect(os.Setenv("VM_STATS_EXPORTER_MAX_VMS", "130")).To(Succeed())
+					Expect(os.Setenv("VM_STATS_EXPORTER_MAX_DISKS_PER_VM", "131")).To(Succeed())
 				})
 				It("Should return a default config overridden by the environment", func() {
 					Expect(config).To(BeComparableTo(pkgcfg.Config{
@@ -125,28 +129,29 @@ var _ = Describe(
 							JitterMaxFactor:      108.0,
 							SeedRequeueDuration:  109 * time.Hour,
 						},
-						ContainerNode:                true,
-						ProfilerAddr:                 "110",
-						RateLimitQPS:                 111,
-						RateLimitBurst:               112,
-						SyncPeriod:                   113 * time.Hour,
-						MaxConcurrentReconciles:      114,
-						AsyncSignalEnabled:           false,
-						AsyncCreateEnabled:           false,
-						FastDeployMode:               pkgconst.FastDeployModeDirect,
-						VCCredsSecretName:            pkgconst.VCCredsSecretName,
-						LeaderElectionID:             "115",
-						PodName:                      "116",
-						PodNamespace:                 "117",
-						PodServiceAccountName:        "118",
-						WatchNamespace:               "119",
-						WebhookServiceContainerPort:  120,
-						WebhookServiceName:           "121",
-						WebhookServiceNamespace:      "122",
-						WebhookSecretName:            "123",
-						WebhookSecretNamespace:       "124",
-						WebhookSecretVolumeMountPath: pkgcfg.Default().WebhookSecretVolumeMountPath,
-						CRDCleanupEnabled:            true,
+						ContainerNode:                  true,
+						ProfilerAddr:                   "110",
+						RateLimitQPS:                   111,
+						RateLimitBurst:                 112,
+						SyncPeriod:                     113 * time.Hour,
+						MaxConcurrentReconciles:        114,
+						AsyncSignalEnabled:             false,
+						AsyncCreateEnabled:             false,
+						FastDeployMode:                 pkgconst.FastDeployModeDirect,
+						VCCredsSecretName:              pkgconst.VCCredsSecretName,
+						ImageTrustAnchorsConfigMapName: "132",
+						LeaderElectionID:               "115",
+						PodName:                        "116",
+						PodNamespace:                   "117",
+						PodServiceAccountName:          "118",
+						WatchNamespace:                 "119",
+						WebhookServiceContainerPort:    120,
+						WebhookServiceName:             "121",
+						WebhookServiceNamespace:        "122",
+						WebhookSecretName:              "123",
+						WebhookSecretNamespace:         "124",
+						WebhookSecretVolumeMountPath:   pkgcfg.Default().WebhookSecretVolumeMountPath,
+						CRDCleanupEnabled:              true,
 						Features: pkgcfg.FeatureStates{
 							InstanceStorage:           false,
 							K8sWorkloadMgmtAPI:        true,
@@ -165,6 +170,11 @@ var _ = Describe(
 						SyncImageRequeueDelay:        128 * time.Hour,
 						DeploymentName:               "129",
 						SIGUSR2RestartEnabled:        true,
+						VMStatsExporter: pkgcfg.VMStatsExporter{
+							Enabled:       true,
+							MaxVMs:        130,
+							MaxDisksPerVM: 131,
+						},
 					}))
 				})
 			})
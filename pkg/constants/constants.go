@@ -140,6 +140,16 @@ const (
 	// customization spec used to bootstrap a VM's guest information.
 	BootstrapHashCustomSpecAnnotationKey = "vmoperator.vmware.com/bootstrap-hash-customspec"
 
+	// SysprepDomainJoinCredentialsHashAnnotationKey is the annotation used to
+	// track the domain join credentials used to customize a Sysprep VM, so
+	// that rotating the credentials may be detected.
+	SysprepDomainJoinCredentialsHashAnnotationKey = "vmoperator.vmware.com/sysprep-domain-join-credentials-hash"
+
+	// ImageTrustAnchorsHashAnnotationKey is the annotation used to track the
+	// image trust anchors that an image's signature was verified with, so the
+	// signature may be verified again when the trust anchors change.
+	ImageTrustAnchorsHashAnnotationKey = "vmoperator.vmware.com/image-trust-anchors-hash"
+
 	// SkipDeletePlatformResourceKey is a privileged annotation that may be used
 	// to skip the deletion of a Kubernetes object's underlying platform
 	// resource. For example, when applied to a VM, deleting the VirtualMachine
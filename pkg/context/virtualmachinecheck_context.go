@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+)
+
+// VirtualMachineCheckContext is the context used for VirtualMachineCheck
+// controllers.
+type VirtualMachineCheckContext struct {
+	context.Context
+	Logger              logr.Logger
+	VirtualMachineCheck *vmopv1.VirtualMachineCheck
+}
+
+func (v *VirtualMachineCheckContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.VirtualMachineCheck.GroupVersionKind(), v.VirtualMachineCheck.Namespace, v.VirtualMachineCheck.Name)
+}
@@ -0,0 +1,27 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+)
+
+// VirtualMachineClassRecommendationContext is the context used for
+// VirtualMachineClassRecommendation controllers.
+type VirtualMachineClassRecommendationContext struct {
+	context.Context
+	Logger         logr.Logger
+	Recommendation *vmopv1.VirtualMachineClassRecommendation
+	VM             *vmopv1.VirtualMachine
+}
+
+func (v *VirtualMachineClassRecommendationContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.Recommendation.GroupVersionKind(), v.Recommendation.Namespace, v.Recommendation.Name)
+}
@@ -0,0 +1,26 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+)
+
+// VirtualMachineIPPoolContext is the context used for VirtualMachineIPPool
+// controllers.
+type VirtualMachineIPPoolContext struct {
+	context.Context
+	Logger               logr.Logger
+	VirtualMachineIPPool *vmopv1.VirtualMachineIPPool
+}
+
+func (v *VirtualMachineIPPoolContext) String() string {
+	return fmt.Sprintf("%s %s/%s", v.VirtualMachineIPPool.GroupVersionKind(), v.VirtualMachineIPPool.Namespace, v.VirtualMachineIPPool.Name)
+}
@@ -29,9 +29,12 @@ var (
 		"contentlibraryproviders.vmoperator.vmware.com",
 		"contentsourcebindings.vmoperator.vmware.com",
 		"contentsources.vmoperator.vmware.com",
+		"virtualmachinechecks.vmoperator.vmware.com",
 		"virtualmachineclassbindings.vmoperator.vmware.com",
 		"virtualmachineclasses.vmoperator.vmware.com",
+		"virtualmachineclassrecommendations.vmoperator.vmware.com",
 		"virtualmachineimages.vmoperator.vmware.com",
+		"virtualmachineippools.vmoperator.vmware.com",
 		"virtualmachinepublishrequests.vmoperator.vmware.com",
 		"virtualmachinereplicasets.vmoperator.vmware.com",
 		"virtualmachines.vmoperator.vmware.com",
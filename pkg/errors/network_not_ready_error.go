@@ -0,0 +1,36 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+)
+
+// NetworkNotReadyError is returned from a method that cannot proceed until a
+// VM's network interface object has been reconciled by the network provider.
+// The VM is requeued by the watch on the network interface objects, so this
+// error should not cause the request to be requeued.
+type NetworkNotReadyError struct {
+	// Message is returned by the Error function. If empty, the Error function
+	// returns "network interface is not ready yet".
+	Message string
+
+	// Name of the network interface object.
+	Name string
+}
+
+func (e NetworkNotReadyError) Error() string {
+	if e.Message == "" {
+		return "network interface is not ready yet"
+	}
+	return e.Message
+}
+
+// IsNetworkNotReadyError returns true if the error or a nested error is a
+// NetworkNotReadyError.
+func IsNetworkNotReadyError(err error) bool {
+	var notReady NetworkNotReadyError
+	return errors.As(err, &notReady)
+}
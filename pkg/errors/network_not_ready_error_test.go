@@ -0,0 +1,51 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+)
+
+var _ = Describe("NetworkNotReadyError", func() {
+
+	DescribeTable("Error",
+		func(e error, expErr string) {
+			Expect(e).To(MatchError(expErr))
+		},
+
+		Entry(
+			"no message",
+			pkgerr.NetworkNotReadyError{},
+			"network interface is not ready yet",
+		),
+
+		Entry(
+			"with message",
+			pkgerr.NetworkNotReadyError{Message: "hi"},
+			"hi",
+		),
+	)
+
+	DescribeTable("IsNetworkNotReadyError",
+		func(e error, expResult bool) {
+			Expect(pkgerr.IsNetworkNotReadyError(e)).To(Equal(expResult))
+		},
+
+		Entry("nil", nil, false),
+		Entry("other error", errors.New("hi"), false),
+		Entry("NetworkNotReadyError", pkgerr.NetworkNotReadyError{}, true),
+		Entry(
+			"wrapped NetworkNotReadyError",
+			fmt.Errorf("failed: %w", pkgerr.NetworkNotReadyError{Name: "my-netif"}),
+			true,
+		),
+	)
+})
@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -24,6 +25,7 @@ import (
 
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
 	imgregv1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha2"
+	nsxv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 
 	netopv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
@@ -84,7 +86,10 @@ func New(ctx context.Context, opts Options) (Manager, error) {
 		_ = imgregv1.AddToScheme(opts.Scheme)
 	}
 
-	if pkgcfg.FromContext(ctx).NetworkProviderType == pkgcfg.NetworkProviderTypeVPC {
+	switch pkgcfg.FromContext(ctx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeNSXT:
+		_ = nsxv1alpha1.AddToScheme(opts.Scheme)
+	case pkgcfg.NetworkProviderTypeVPC:
 		_ = vpcv1alpha1.AddToScheme(opts.Scheme)
 	}
 
@@ -115,6 +120,11 @@ func New(ctx context.Context, opts Options) (Manager, error) {
 					// reason to cache Deployment resources as nothing else in
 					// VM Operator gets them.
 					&appsv1.Deployment{},
+
+					// The VirtualMachineCheck controller gets the Job named
+					// by a check's condition. Jobs are not otherwise used by
+					// VM Operator, so there is no reason to cache them.
+					&batchv1.Job{},
 				},
 			},
 		},
@@ -16,6 +16,14 @@ const (
 	phaseLabel           = "phase"
 	specLabel            = "spec"
 	statusLabel          = "status"
+	diskKeyLabel         = "disk_key"
+	datastoreLabel       = "datastore"
+
+	// VM operation related metrics labels.
+	operationLabel  = "operation"
+	deployModeLabel = "deploy_mode"
+	resultLabel     = "result"
+	faultLabel      = "fault"
 
 	// VMImage related metrics labels (from image registry service).
 	vmiNameLabel      = "vmi_name"
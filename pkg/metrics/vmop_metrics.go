@@ -0,0 +1,173 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/vmware/govmomi/fault"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+)
+
+// The operations for which VM operation metrics are recorded.
+const (
+	VMOperationCreate      = "create"
+	VMOperationUpdate      = "update"
+	VMOperationDelete      = "delete"
+	VMOperationReconfigure = "reconfigure"
+	VMOperationPowerOn     = "power-on"
+	VMOperationPowerOff    = "power-off"
+	VMOperationSuspend     = "suspend"
+	VMOperationRestart     = "restart"
+)
+
+// The modes used to deploy a VM.
+const (
+	DeployModeNone           = "none"
+	DeployModeFastDeploy     = "fast-deploy"
+	DeployModeContentLibrary = "content-library"
+	DeployModeClone          = "clone"
+)
+
+const (
+	operationResultSuccess = "success"
+	operationResultFailure = "failure"
+
+	unknownFault = "Unknown"
+)
+
+var (
+	vmOpMetricsOnce sync.Once
+	vmOpMetrics     *VMOperationMetrics
+)
+
+// VMOperationMetrics records how long operations on VMs take and how often
+// they fail.
+type VMOperationMetrics struct {
+	duration             *prometheus.HistogramVec
+	failures             *prometheus.CounterVec
+	concurrentCreates    prometheus.Gauge
+	maxConcurrentCreates prometheus.Gauge
+}
+
+// NewVMOperationMetrics initializes a singleton and registers all the defined
+// metrics.
+func NewVMOperationMetrics() *VMOperationMetrics {
+	vmOpMetricsOnce.Do(func() {
+		vmOpMetrics = &VMOperationMetrics{
+			duration: prometheus.NewHistogramVec(
+				prometheus.HistogramOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_operation_duration_seconds",
+					Help:      "Duration of an operation on a VM",
+					Buckets: []float64{
+						0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600, 1800,
+					},
+				},
+				[]string{operationLabel, deployModeLabel, resultLabel},
+			),
+			failures: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_operation_failures_total",
+					Help:      "Number of failed operations on VMs"},
+				[]string{operationLabel, deployModeLabel, faultLabel},
+			),
+			concurrentCreates: prometheus.NewGauge(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_concurrent_creates",
+					Help:      "Number of VMs currently being created"},
+			),
+			maxConcurrentCreates: prometheus.NewGauge(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_max_concurrent_creates",
+					Help:      "Maximum number of VMs that may be created concurrently"},
+			),
+		}
+
+		metrics.Registry.MustRegister(
+			vmOpMetrics.duration,
+			vmOpMetrics.failures,
+			vmOpMetrics.concurrentCreates,
+			vmOpMetrics.maxConcurrentCreates,
+		)
+	})
+
+	return vmOpMetrics
+}
+
+// ObserveOperation records the duration of an operation that started at the
+// specified time. If err is a failure, the fault type of the error is also
+// recorded. Errors that only indicate the VM should be requeued are not
+// considered failures.
+func (m *VMOperationMetrics) ObserveOperation(
+	operation, deployMode string,
+	start time.Time,
+	err error) {
+
+	if deployMode == "" {
+		deployMode = DeployModeNone
+	}
+
+	result := operationResultSuccess
+	if isOperationFailure(err) {
+		result = operationResultFailure
+		m.failures.With(prometheus.Labels{
+			operationLabel:  operation,
+			deployModeLabel: deployMode,
+			faultLabel:      FaultType(err),
+		}).Inc()
+	}
+
+	m.duration.With(prometheus.Labels{
+		operationLabel:  operation,
+		deployModeLabel: deployMode,
+		resultLabel:     result,
+	}).Observe(time.Since(start).Seconds())
+}
+
+// SetConcurrentCreates records the number of VMs currently being created and
+// the maximum number of VMs that may be created concurrently.
+func (m *VMOperationMetrics) SetConcurrentCreates(current, maximum int) {
+	m.concurrentCreates.Set(float64(current))
+	m.maxConcurrentCreates.Set(float64(maximum))
+}
+
+// FaultType returns the name of the type of the outermost vSphere fault in the
+// error, ex. "InvalidPowerState". If the error does not contain a vSphere
+// fault, then "Unknown" is returned.
+func FaultType(err error) string {
+	name := unknownFault
+	if err == nil {
+		return name
+	}
+	fault.In(err, func(
+		f vimtypes.BaseMethodFault,
+		_ string,
+		_ []vimtypes.LocalizableMessage) bool {
+
+		t := reflect.TypeOf(f)
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		name = t.Name()
+		return true
+	})
+	return name
+}
+
+func isOperationFailure(err error) bool {
+	return err != nil &&
+		!pkgerr.IsNoRequeueNoError(err) &&
+		!pkgerr.IsRequeueError(err)
+}
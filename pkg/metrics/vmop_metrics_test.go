@@ -0,0 +1,37 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25/soap"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
+)
+
+var _ = DescribeTable("FaultType",
+	func(err error, expected string) {
+		Expect(metrics.FaultType(err)).To(Equal(expected))
+	},
+	Entry("nil error", nil, "Unknown"),
+	Entry("error without a fault", errors.New("hello"), "Unknown"),
+	Entry("vim fault",
+		soap.WrapVimFault(&vimtypes.InvalidPowerState{}),
+		"InvalidPowerState"),
+	Entry("wrapped task error",
+		fmt.Errorf("failed: %w", task.Error{
+			LocalizedMethodFault: &vimtypes.LocalizedMethodFault{
+				Fault: &vimtypes.NotEnoughLicenses{},
+			},
+		}),
+		"NotEnoughLicenses"),
+)
@@ -0,0 +1,274 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/watcher"
+)
+
+const (
+	quickStatsPropPath = "summary.quickStats"
+	layoutExPropPath   = "layoutEx"
+
+	bytesPerMiB = 1024 * 1024
+)
+
+var (
+	vmStatsMetricsOnce sync.Once
+	vmStatsMetrics     *VMStatsMetrics
+)
+
+// vmStatsKey identifies a VM by its vCenter and ManagedObjectReference, since
+// the latter is only unique within a vCenter.
+type vmStatsKey struct {
+	vCenter string
+	ref     vimtypes.ManagedObjectReference
+}
+
+// VMStatsMetrics exports the statistics of VMs received from the vm-watcher
+// service as metrics.
+type VMStatsMetrics struct {
+	mu  sync.Mutex
+	vms map[vmStatsKey]prometheus.Labels
+
+	cpuUsage         *prometheus.GaugeVec
+	memoryUsage      *prometheus.GaugeVec
+	memoryBallooned  *prometheus.GaugeVec
+	memorySwapped    *prometheus.GaugeVec
+	guestHeartbeat   *prometheus.GaugeVec
+	uptime           *prometheus.GaugeVec
+	diskUsage        *prometheus.GaugeVec
+	droppedVMUpdates prometheus.Counter
+}
+
+func NewVMStatsMetrics() *VMStatsMetrics {
+	vmStatsMetricsOnce.Do(func() {
+		vmStatsMetrics = &VMStatsMetrics{
+			vms: map[vmStatsKey]prometheus.Labels{},
+			cpuUsage: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_cpu_usage_mhz",
+					Help:      "CPU usage of a VM in MHz"},
+				[]string{vmNameLabel, vmNamespaceLabel},
+			),
+			memoryUsage: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_memory_usage_bytes",
+					Help:      "Guest memory usage of a VM in bytes"},
+				[]string{vmNameLabel, vmNamespaceLabel},
+			),
+			memoryBallooned: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_memory_ballooned_bytes",
+					Help:      "Memory reclaimed from a VM by the balloon driver in bytes"},
+				[]string{vmNameLabel, vmNamespaceLabel},
+			),
+			memorySwapped: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_memory_swapped_bytes",
+					Help:      "Memory of a VM swapped to disk in bytes"},
+				[]string{vmNameLabel, vmNamespaceLabel},
+			),
+			guestHeartbeat: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_guest_heartbeat",
+					Help:      "Guest heartbeat status of a VM"},
+				[]string{vmNameLabel, vmNamespaceLabel, statusLabel},
+			),
+			uptime: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_uptime_seconds",
+					Help:      "Time a VM has been powered on in seconds"},
+				[]string{vmNameLabel, vmNamespaceLabel},
+			),
+			diskUsage: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_disk_datastore_usage_bytes",
+					Help:      "Datastore usage of a VM's disk, including its snapshots, in bytes"},
+				[]string{vmNameLabel, vmNamespaceLabel, diskKeyLabel, datastoreLabel},
+			),
+			droppedVMUpdates: prometheus.NewCounter(
+				prometheus.CounterOpts{
+					Namespace: metricsNamespace,
+					Name:      "vm_stats_dropped_updates_total",
+					Help:      "Number of VM statistics updates dropped because the maximum number of exported VMs was reached"},
+			),
+		}
+
+		metrics.Registry.MustRegister(
+			vmStatsMetrics.cpuUsage,
+			vmStatsMetrics.memoryUsage,
+			vmStatsMetrics.memoryBallooned,
+			vmStatsMetrics.memorySwapped,
+			vmStatsMetrics.guestHeartbeat,
+			vmStatsMetrics.uptime,
+			vmStatsMetrics.diskUsage,
+			vmStatsMetrics.droppedVMUpdates,
+		)
+	})
+
+	return vmStatsMetrics
+}
+
+// OnStats updates the metrics for a VM with the statistics received from the
+// vm-watcher service. It is intended to be used as a watcher.StatsFn.
+func (vsm *VMStatsMetrics) OnStats(ctx context.Context, r watcher.StatsResult) {
+	vsm.mu.Lock()
+	defer vsm.mu.Unlock()
+
+	key := vmStatsKey{vCenter: r.VCenter, ref: r.Ref}
+	labels, ok := vsm.vms[key]
+
+	if r.Removed {
+		if ok {
+			vsm.deleteMetrics(labels)
+			delete(vsm.vms, key)
+		}
+		return
+	}
+
+	newLabels := prometheus.Labels{
+		vmNameLabel:      r.Name,
+		vmNamespaceLabel: r.Namespace,
+	}
+
+	if !ok {
+		cfg := pkgcfg.FromContext(ctx).VMStatsExporter
+		if cfg.MaxVMs > 0 && len(vsm.vms) >= cfg.MaxVMs {
+			pkglog.FromContextOrDefault(ctx).V(5).Info(
+				"Dropping VM stats due to max VMs",
+				"vCenter", r.VCenter, "ref", r.Ref, "maxVMs", cfg.MaxVMs)
+			vsm.droppedVMUpdates.Inc()
+			return
+		}
+	} else if labels[vmNameLabel] != r.Name ||
+		labels[vmNamespaceLabel] != r.Namespace {
+
+		// The VM is now associated with a different resource.
+		vsm.deleteMetrics(labels)
+	}
+	vsm.vms[key] = newLabels
+
+	for k, v := range r.Props {
+		switch k {
+		case quickStatsPropPath:
+			if qs, ok := v.(vimtypes.VirtualMachineQuickStats); ok {
+				vsm.registerQuickStats(newLabels, qs)
+			}
+		case layoutExPropPath:
+			if l, ok := v.(vimtypes.VirtualMachineFileLayoutEx); ok {
+				vsm.registerDiskUsage(ctx, newLabels, l)
+			}
+		}
+	}
+}
+
+func (vsm *VMStatsMetrics) registerQuickStats(
+	labels prometheus.Labels,
+	qs vimtypes.VirtualMachineQuickStats) {
+
+	vsm.cpuUsage.With(labels).Set(float64(qs.OverallCpuUsage))
+	vsm.memoryUsage.With(labels).Set(float64(qs.GuestMemoryUsage) * bytesPerMiB)
+	vsm.memoryBallooned.With(labels).Set(float64(qs.BalloonedMemory) * bytesPerMiB)
+	vsm.memorySwapped.With(labels).Set(float64(qs.SwappedMemory) * bytesPerMiB)
+	vsm.uptime.With(labels).Set(float64(qs.UptimeSeconds))
+
+	// Delete the previous metric to address any change to the heartbeat.
+	vsm.guestHeartbeat.DeletePartialMatch(labels)
+	if s := qs.GuestHeartbeatStatus; s != "" {
+		vsm.guestHeartbeat.With(prometheus.Labels{
+			vmNameLabel:      labels[vmNameLabel],
+			vmNamespaceLabel: labels[vmNamespaceLabel],
+			statusLabel:      string(s),
+		}).Set(1)
+	}
+}
+
+func (vsm *VMStatsMetrics) registerDiskUsage(
+	ctx context.Context,
+	labels prometheus.Labels,
+	l vimtypes.VirtualMachineFileLayoutEx) {
+
+	// Delete the previous metrics to address any removed disks.
+	vsm.diskUsage.DeletePartialMatch(labels)
+
+	files := make(map[int32]vimtypes.VirtualMachineFileLayoutExFileInfo, len(l.File))
+	for _, f := range l.File {
+		files[f.Key] = f
+	}
+
+	disks := slices.Clone(l.Disk)
+	slices.SortFunc(disks, func(a, b vimtypes.VirtualMachineFileLayoutExDiskLayout) int {
+		return int(a.Key - b.Key)
+	})
+	if maxDisks := pkgcfg.FromContext(ctx).VMStatsExporter.MaxDisksPerVM; maxDisks > 0 &&
+		len(disks) > maxDisks {
+
+		disks = disks[:maxDisks]
+	}
+
+	for _, d := range disks {
+		var (
+			size      int64
+			datastore string
+		)
+		for _, c := range d.Chain {
+			for _, k := range c.FileKey {
+				if f, ok := files[k]; ok {
+					size += f.Size
+					if datastore == "" {
+						datastore = datastoreFromPath(f.Name)
+					}
+				}
+			}
+		}
+		vsm.diskUsage.With(prometheus.Labels{
+			vmNameLabel:      labels[vmNameLabel],
+			vmNamespaceLabel: labels[vmNamespaceLabel],
+			diskKeyLabel:     strconv.Itoa(int(d.Key)),
+			datastoreLabel:   datastore,
+		}).Set(float64(size))
+	}
+}
+
+func (vsm *VMStatsMetrics) deleteMetrics(labels prometheus.Labels) {
+	vsm.cpuUsage.DeletePartialMatch(labels)
+	vsm.memoryUsage.DeletePartialMatch(labels)
+	vsm.memoryBallooned.DeletePartialMatch(labels)
+	vsm.memorySwapped.DeletePartialMatch(labels)
+	vsm.guestHeartbeat.DeletePartialMatch(labels)
+	vsm.uptime.DeletePartialMatch(labels)
+	vsm.diskUsage.DeletePartialMatch(labels)
+}
+
+// datastoreFromPath returns the name of the datastore from a datastore path,
+// ex. "[datastore1] vm/disk.vmdk".
+func datastoreFromPath(p string) string {
+	if strings.HasPrefix(p, "[") {
+		if i := strings.Index(p, "]"); i > 0 {
+			return p[1:i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/watcher"
+)
+
+var _ = Describe("VMStatsMetrics", func() {
+	var (
+		ctx context.Context
+		vsm *metrics.VMStatsMetrics
+		ref vimtypes.ManagedObjectReference
+	)
+
+	getCPUUsage := func(namespace, name string) (float64, bool) {
+		families, err := ctrlmetrics.Registry.Gather()
+		Expect(err).ToNot(HaveOccurred())
+		for _, f := range families {
+			if f.GetName() != "vmservice_vm_cpu_usage_mhz" {
+				continue
+			}
+			for _, m := range f.GetMetric() {
+				labels := map[string]string{}
+				for _, l := range m.GetLabel() {
+					labels[l.GetName()] = l.GetValue()
+				}
+				if labels["vm_namespace"] == namespace && labels["vm_name"] == name {
+					return m.GetGauge().GetValue(), true
+				}
+			}
+		}
+		return 0, false
+	}
+
+	newResult := func(vCenter, name string, cpu int32) watcher.StatsResult {
+		return watcher.StatsResult{
+			Namespace: "my-namespace",
+			Name:      name,
+			Ref:       ref,
+			VCenter:   vCenter,
+			Props: map[string]any{
+				"summary.quickStats": vimtypes.VirtualMachineQuickStats{
+					OverallCpuUsage: cpu,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+		vsm = metrics.NewVMStatsMetrics()
+		ref = vimtypes.ManagedObjectReference{
+			Type:  "VirtualMachine",
+			Value: "vm-42",
+		}
+	})
+
+	When("VMs on different vCenters have the same ManagedObjectReference", func() {
+		BeforeEach(func() {
+			vsm.OnStats(ctx, newResult("", "vm-1", 100))
+			vsm.OnStats(ctx, newResult("vc-2", "vm-2", 200))
+		})
+
+		AfterEach(func() {
+			vsm.OnStats(ctx, watcher.StatsResult{Ref: ref, Removed: true})
+			vsm.OnStats(ctx, watcher.StatsResult{Ref: ref, VCenter: "vc-2", Removed: true})
+		})
+
+		It("should export the metrics for both VMs", func() {
+			v, ok := getCPUUsage("my-namespace", "vm-1")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(float64(100)))
+
+			v, ok = getCPUUsage("my-namespace", "vm-2")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal(float64(200)))
+		})
+
+		It("should only delete the metrics for the removed VM", func() {
+			vsm.OnStats(ctx, watcher.StatsResult{Ref: ref, VCenter: "vc-2", Removed: true})
+
+			_, ok := getCPUUsage("my-namespace", "vm-1")
+			Expect(ok).To(BeTrue())
+
+			_, ok = getCPUUsage("my-namespace", "vm-2")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -6,6 +6,7 @@ package fake
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"sync"
 
@@ -48,8 +49,11 @@ type funcs struct {
 	UpdateContentLibraryItemFn   func(ctx context.Context, itemID, newName string, newDescription *string) error
 	SyncVirtualMachineImageFn    func(ctx context.Context, cli, vmi client.Object) error
 
+	VerifyVirtualMachineImageSignatureFn func(ctx context.Context, cli client.Object, roots *x509.CertPool) error
+
 	UpdateVcPNIDFn           func(ctx context.Context, vcPNID, vcPort string) error
 	UpdateVcCredsFn          func(ctx context.Context, data map[string][]byte) error
+	UpdateVCenterCredsFn     func(ctx context.Context, secretName string, data map[string][]byte) error
 	ComputeCPUMinFrequencyFn func(ctx context.Context) error
 
 	CreateOrUpdateVirtualMachineSetResourcePolicyFn func(ctx context.Context, rp *vmopv1.VirtualMachineSetResourcePolicy) error
@@ -59,6 +63,7 @@ type funcs struct {
 
 	DoesProfileSupportEncryptionFn func(ctx context.Context, profileID string) (bool, error)
 	VSphereClientFn                func(context.Context) (*vsclient.Client, error)
+	VSphereClientForZoneFn         func(ctx context.Context, zoneName string) (*vsclient.Client, error)
 	DeleteSnapshotFn               func(ctx context.Context, vmSnapshot *vmopv1.VirtualMachineSnapshot, vm *vmopv1.VirtualMachine, removeChildren bool, consolidate *bool) (bool, error)
 	GetSnapshotSizeFn              func(ctx context.Context, vmSnapshotName string, vm *vmopv1.VirtualMachine) (int64, error)
 	SyncVMSnapshotTreeStatusFn     func(ctx context.Context, vm *vmopv1.VirtualMachine) error
@@ -254,6 +259,17 @@ func (s *VMProvider) UpdateVcCreds(ctx context.Context, data map[string][]byte)
 	return nil
 }
 
+func (s *VMProvider) UpdateVCenterCreds(ctx context.Context, secretName string, data map[string][]byte) error {
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	if s.UpdateVCenterCredsFn != nil {
+		return s.UpdateVCenterCredsFn(ctx, secretName, data)
+	}
+	return nil
+}
+
 func (s *VMProvider) SyncVirtualMachineImage(ctx context.Context, cli, vmi client.Object) error {
 	_ = pkgcfg.FromContext(ctx)
 
@@ -266,6 +282,22 @@ func (s *VMProvider) SyncVirtualMachineImage(ctx context.Context, cli, vmi clien
 	return nil
 }
 
+func (s *VMProvider) VerifyVirtualMachineImageSignature(
+	ctx context.Context,
+	cli client.Object,
+	roots *x509.CertPool) error {
+
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	if s.VerifyVirtualMachineImageSignatureFn != nil {
+		return s.VerifyVirtualMachineImageSignatureFn(ctx, cli, roots)
+	}
+
+	return nil
+}
+
 func (s *VMProvider) GetItemFromLibraryByName(ctx context.Context,
 	contentLibrary, itemName string) (*library.Item, error) {
 
@@ -402,6 +434,20 @@ func (s *VMProvider) VSphereClient(ctx context.Context) (*vsclient.Client, error
 	return nil, nil
 }
 
+func (s *VMProvider) VSphereClientForZone(ctx context.Context, zoneName string) (*vsclient.Client, error) {
+	_ = pkgcfg.FromContext(ctx)
+
+	s.Lock()
+	defer s.Unlock()
+	if fn := s.VSphereClientForZoneFn; fn != nil {
+		return fn(ctx, zoneName)
+	}
+	if fn := s.VSphereClientFn; fn != nil {
+		return fn(ctx)
+	}
+	return nil, nil
+}
+
 func (s *VMProvider) DeleteSnapshot(
 	ctx context.Context,
 	snapshot *vmopv1.VirtualMachineSnapshot,
@@ -6,6 +6,7 @@ package providers
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 
 	"github.com/vmware/govmomi/object"
@@ -55,6 +56,9 @@ type VirtualMachineProviderInterface interface {
 	// "Infra" related
 	UpdateVcPNID(ctx context.Context, vcPNID, vcPort string) error
 	UpdateVcCreds(ctx context.Context, data map[string][]byte) error
+	// UpdateVCenterCreds updates the credentials for the vCenters, other than
+	// the one from the provider ConfigMap, that use the named Secret.
+	UpdateVCenterCreds(ctx context.Context, secretName string, data map[string][]byte) error
 	ComputeCPUMinFrequency(ctx context.Context) error
 
 	GetItemFromLibraryByName(ctx context.Context, contentLibrary, itemName string) (*library.Item, error)
@@ -64,6 +68,10 @@ type VirtualMachineProviderInterface interface {
 	UpdateContentLibraryItem(ctx context.Context, itemID, newName string, newDescription *string) error
 	SyncVirtualMachineImage(ctx context.Context, cli, vmi ctrlclient.Object) error
 
+	// VerifyVirtualMachineImageSignature verifies the signature of the library
+	// item's OVF package with the provided trust anchors.
+	VerifyVirtualMachineImageSignature(ctx context.Context, cli ctrlclient.Object, roots *x509.CertPool) error
+
 	GetTasksByActID(ctx context.Context, vm *vmopv1.VirtualMachine, actID string) (tasksInfo []vimtypes.TaskInfo, retErr error)
 
 	// DoesProfileSupportEncryption returns true if the specified profile
@@ -74,6 +82,10 @@ type VirtualMachineProviderInterface interface {
 	// VSphereClient returns the provider's vSphere client.
 	VSphereClient(context.Context) (*client.Client, error)
 
+	// VSphereClientForZone returns the vSphere client for the vCenter that
+	// backs the named zone.
+	VSphereClientForZone(ctx context.Context, zoneName string) (*client.Client, error)
+
 	// DeleteSnapshot deletes a snapshot from a virtual machine.
 	DeleteSnapshot(ctx context.Context, vmSnapshot *vmopv1.VirtualMachineSnapshot,
 		vm *vmopv1.VirtualMachine, removeChildren bool, consolidate *bool) (bool, error)
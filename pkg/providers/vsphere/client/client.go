@@ -23,13 +23,16 @@ func NewClient(
 	config *config.VSphereVMProviderConfig) (*Client, error) {
 
 	c, err := client.NewClient(ctx, client.Config{
-		Host:       config.VcPNID,
-		Port:       config.VcPort,
-		Username:   config.VcCreds.Username,
-		Password:   config.VcCreds.Password,
-		CAFilePath: config.CAFilePath,
-		Insecure:   config.InsecureSkipTLSVerify,
-		Datacenter: config.Datacenter,
+		Host:        config.VcPNID,
+		Port:        config.VcPort,
+		Username:    config.VcCreds.Username,
+		Password:    config.VcCreds.Password,
+		Certificate: config.VcCreds.Certificate,
+		PrivateKey:  config.VcCreds.PrivateKey,
+		Token:       config.VcCreds.Token,
+		CAFilePath:  config.CAFilePath,
+		Insecure:    config.InsecureSkipTLSVerify,
+		Datacenter:  config.Datacenter,
 	})
 
 	if err != nil {
@@ -248,3 +248,53 @@ func UpdateVcInConfigMap(ctx context.Context, client ctrlclient.Client, vcPNID,
 
 	return true, nil
 }
+
+// GetProviderConfigForVCenter returns a provider config for a vCenter other
+// than the one in the vSphere Provider ConfigMap. The remaining settings, such
+// as the TLS configuration, are inherited from the ConfigMap. When
+// credsSecretName is empty, the default credentials Secret is used.
+func GetProviderConfigForVCenter(
+	ctx context.Context,
+	client ctrlclient.Client,
+	vcPNID, vcPort, datacenter, credsSecretName string) (*VSphereVMProviderConfig, error) {
+
+	if vcPNID == "" {
+		return nil, errors.New("vCenter PNID is required")
+	}
+	if datacenter == "" {
+		return nil, fmt.Errorf("datacenter is required for vCenter %s", vcPNID)
+	}
+
+	configMap, err := getProviderConfigMap(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if credsSecretName == "" {
+		credsSecretName = pkgcfg.FromContext(ctx).VCCredsSecretName
+	}
+
+	vcCreds, err := credentials.GetProviderCredentials(
+		ctx,
+		client,
+		configMap.Namespace,
+		credsSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	providerConfig, err := ConfigMapToProviderConfig(configMap, vcCreds)
+	if err != nil {
+		return nil, err
+	}
+
+	if vcPort == "" {
+		vcPort = DefaultVCPort
+	}
+
+	providerConfig.VcPNID = vcPNID
+	providerConfig.VcPort = vcPort
+	providerConfig.Datacenter = datacenter
+
+	return providerConfig, nil
+}
@@ -69,6 +69,63 @@ func configTests() {
 		})
 	})
 
+	Describe("GetProviderConfigForVCenter", func() {
+
+		It("returns an error when the PNID is empty", func() {
+			_, err := config.GetProviderConfigForVCenter(ctx, ctx.Client, "", "", "dc-1", "")
+			Expect(err).To(MatchError("vCenter PNID is required"))
+		})
+
+		It("returns an error when the datacenter is empty", func() {
+			_, err := config.GetProviderConfigForVCenter(ctx, ctx.Client, "vc-2", "", "", "")
+			Expect(err).To(MatchError("datacenter is required for vCenter vc-2"))
+		})
+
+		Context("when the credentials Secret is not specified", func() {
+			It("returns a provider config with the default credentials", func() {
+				defaultConfig, err := config.GetProviderConfig(ctx, ctx.Client)
+				Expect(err).ToNot(HaveOccurred())
+
+				providerConfig, err := config.GetProviderConfigForVCenter(ctx, ctx.Client, "vc-2", "", "dc-1", "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(providerConfig.VcPNID).To(Equal("vc-2"))
+				Expect(providerConfig.VcPort).To(Equal(config.DefaultVCPort))
+				Expect(providerConfig.Datacenter).To(Equal("dc-1"))
+				Expect(providerConfig.VcCreds).To(Equal(defaultConfig.VcCreds))
+				Expect(providerConfig.InsecureSkipTLSVerify).To(Equal(defaultConfig.InsecureSkipTLSVerify))
+			})
+		})
+
+		Context("when the credentials Secret is specified", func() {
+			It("returns a provider config with the credentials from the Secret", func() {
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vc-2-creds",
+						Namespace: ctx.PodNamespace,
+					},
+					Data: map[string][]byte{
+						credentials.UsernameKey: []byte("user-2"),
+						credentials.PasswordKey: []byte("pass-2"),
+					},
+				}
+				Expect(ctx.Client.Create(ctx, secret)).To(Succeed())
+
+				providerConfig, err := config.GetProviderConfigForVCenter(ctx, ctx.Client, "vc-2", "8443", "dc-1", secret.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(providerConfig.VcPort).To(Equal("8443"))
+				Expect(providerConfig.VcCreds).To(Equal(credentials.VSphereVMProviderCredentials{
+					Username: "user-2",
+					Password: "pass-2",
+				}))
+			})
+
+			It("returns an error when the Secret does not exist", func() {
+				_, err := config.GetProviderConfigForVCenter(ctx, ctx.Client, "vc-2", "", "dc-1", "does-not-exist")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("UpdateVcInConfigMap", func() {
 
 		Context("UpdateVcInConfigMap", func() {
@@ -74,6 +74,18 @@ const (
 	CloudInitGuestInfoLocalIPv4Key = "guestinfo.local-ipv4"
 	CloudInitGuestInfoLocalIPv6Key = "guestinfo.local-ipv6"
 
+	// GuestInfoNetworkConfig and GuestInfoNetworkConfigEncoding are the keys
+	// used to publish the VM's updated NetPlan network configuration to the
+	// guest after network interfaces were hot-added to or hot-removed from a
+	// powered on VM, regardless of the bootstrap provider.
+	GuestInfoNetworkConfig         = "guestinfo.vmservice.network-config"
+	GuestInfoNetworkConfigEncoding = "guestinfo.vmservice.network-config.encoding"
+
+	// IgnitionGuestInfoConfigData and IgnitionGuestInfoConfigDataEncoding are
+	// the keys read by Ignition's VMware provider.
+	IgnitionGuestInfoConfigData         = "guestinfo.ignition.config.data"
+	IgnitionGuestInfoConfigDataEncoding = "guestinfo.ignition.config.data.encoding"
+
 	// EncryptionClassNameAnnotation specifies the name of an EncryptionClass
 	// resource. This is used by APIs that participate in BYOK but cannot modify
 	// their spec to do so, such as the PersistentVolumeClaim API.
@@ -37,6 +37,7 @@ type Provider interface {
 	UpdateLibraryItem(ctx context.Context, itemID, newName string, newDescription *string) error
 	RetrieveOvfEnvelopeFromLibraryItem(ctx context.Context, item *library.Item) (*ovf.Envelope, error)
 	RetrieveOvfEnvelopeByLibraryItemID(ctx context.Context, itemID string) (*ovf.Envelope, error)
+	DownloadLibraryItemFiles(ctx context.Context, item *library.Item, fn DownloadLibraryItemFilesFunc) error
 	SyncLibraryItem(ctx context.Context, item *library.Item, force bool) error
 	ListLibraryItemStorage(ctx context.Context, itemID string) ([]library.Storage, error)
 	ResolveLibraryItemStorage(ctx context.Context, datacenter *object.Datacenter, storage []library.Storage) error
@@ -229,6 +230,49 @@ func (cs *provider) RetrieveOvfEnvelopeFromLibraryItem(ctx context.Context, item
 	return envelope, nil
 }
 
+// DownloadLibraryItemFilesFunc is called with the files of a library item and
+// a function that opens one of the files for reading.
+type DownloadLibraryItemFilesFunc func(
+	files []library.DownloadFile,
+	open func(fileName string) (io.ReadCloser, error)) error
+
+// DownloadLibraryItemFiles creates a download session for the library item and
+// calls fn with the item's files. The files are only downloaded when they are
+// opened, and the session is deleted after fn returns.
+func (cs *provider) DownloadLibraryItemFiles(
+	ctx context.Context,
+	item *library.Item,
+	fn DownloadLibraryItemFilesFunc) error {
+
+	sessionID, err := cs.libMgr.CreateLibraryItemDownloadSession(ctx, library.Session{LibraryItemID: item.ID})
+	if err != nil {
+		return err
+	}
+
+	logger := log.WithValues("sessionID", sessionID, "itemID", item.ID, "itemName", item.Name)
+	logger.V(4).Info("download session for item created")
+
+	defer func() {
+		if err := cs.libMgr.DeleteLibraryItemDownloadSession(ctx, sessionID); err != nil {
+			logger.Error(err, "Error deleting download session")
+		}
+	}()
+
+	files, err := cs.libMgr.ListLibraryItemDownloadSessionFile(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	return fn(files, func(fileName string) (io.ReadCloser, error) {
+		fileURL, err := cs.prepareLibraryItemDownloadFile(ctx, sessionID, fileName)
+		if err != nil {
+			return nil, err
+		}
+		logger.V(4).Info("downloading file from library item", "fileName", fileName)
+		return readerFromURL(ctx, cs.libMgr.Client, fileURL)
+	})
+}
+
 // UpdateLibraryItem updates the content library item's name and description.
 func (cs *provider) UpdateLibraryItem(ctx context.Context, itemID, newName string, newDescription *string) error {
 	log.Info("Updating Library Item", "itemID", itemID,
@@ -346,12 +390,22 @@ func (cs *provider) generateDownloadURLForLibraryItem(
 		return nil, fmt.Errorf("no files with supported deploy type are available for download for %s", item.ID)
 	}
 
-	_, err = cs.libMgr.PrepareLibraryItemDownloadSessionFile(ctx, sessionID, fileToDownload)
+	return cs.prepareLibraryItemDownloadFile(ctx, sessionID, fileToDownload)
+}
+
+// prepareLibraryItemDownloadFile prepares the file in the download session
+// and returns the URL used to download it.
+func (cs *provider) prepareLibraryItemDownloadFile(
+	ctx context.Context,
+	sessionID string,
+	fileToDownload string) (*url.URL, error) {
+
+	_, err := cs.libMgr.PrepareLibraryItemDownloadSessionFile(ctx, sessionID, fileToDownload)
 	if err != nil {
 		return nil, err
 	}
 
-	logger.V(4).Info("request posted to prepare file", "fileToDownload", fileToDownload)
+	log.V(4).Info("request posted to prepare file", "sessionID", sessionID, "fileToDownload", fileToDownload)
 
 	// Content library api to prepare a file for download guarantees eventual end state of either
 	// ERROR or PREPARED in order to avoid posting too many requests to the api.
@@ -5,7 +5,9 @@
 package contentlibrary_test
 
 import (
+	"io"
 	"os"
+	"slices"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -77,6 +79,31 @@ func clTests() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(ovfEnvelope).ToNot(BeNil())
 			})
+
+			It("Gets items and downloads their files", func() {
+				item, err := clProvider.GetLibraryItem(ctx, ctx.ContentLibraryID, ctx.ContentLibraryImageName, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(item).ToNot(BeNil())
+
+				Expect(clProvider.DownloadLibraryItemFiles(ctx, item,
+					func(files []library.DownloadFile, open func(string) (io.ReadCloser, error)) error {
+						Expect(files).ToNot(BeEmpty())
+						i := slices.IndexFunc(files, func(f library.DownloadFile) bool {
+							return strings.HasSuffix(f.Name, ".ovf")
+						})
+						Expect(i).To(BeNumerically(">=", 0))
+
+						r, err := open(files[i].Name)
+						Expect(err).ToNot(HaveOccurred())
+						defer func() {
+							_ = r.Close()
+						}()
+						data, err := io.ReadAll(r)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(data).ToNot(BeEmpty())
+						return nil
+					})).To(Succeed())
+			})
 		})
 
 		Context("when items are not present in library", func() {
@@ -6,6 +6,7 @@ package credentials
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 
@@ -14,10 +15,39 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// UsernameKey is the key in the provider Secret for the vCenter username.
+	UsernameKey = "username"
+
+	// PasswordKey is the key in the provider Secret for the vCenter password.
+	PasswordKey = "password"
+
+	// CertificateKey is the key in the provider Secret for the PEM-encoded
+	// client certificate of a vCenter solution user.
+	CertificateKey = corev1.TLSCertKey
+
+	// PrivateKeyKey is the key in the provider Secret for the PEM-encoded
+	// private key of a vCenter solution user.
+	PrivateKeyKey = corev1.TLSPrivateKeyKey
+
+	// TokenKey is the key in the provider Secret for a SAML token that is
+	// exchanged for a holder-of-key token bound to the client certificate.
+	TokenKey = "token"
+)
+
 // VSphereVMProviderCredentials wraps the data needed to login to vCenter.
+//
+// Either Username and Password, or Certificate and PrivateKey are set. When
+// Certificate and PrivateKey are set, a holder-of-key token is issued by the
+// vCenter Single Sign-On service and used to login. If Token is also set, it
+// is exchanged for the holder-of-key token.
 type VSphereVMProviderCredentials struct {
 	Username string
 	Password string
+
+	Certificate string
+	PrivateKey  string
+	Token       string
 }
 
 func GetProviderCredentials(
@@ -35,9 +65,29 @@ func GetProviderCredentials(
 }
 
 func ExtractVCCredentials(data map[string][]byte) (VSphereVMProviderCredentials, error) {
+	crt, key := string(data[CertificateKey]), string(data[PrivateKeyKey])
+
+	if crt != "" || key != "" {
+		if crt == "" || key == "" {
+			return VSphereVMProviderCredentials{}, errors.New("vCenter client certificate and private key must both be set")
+		}
+		if _, err := tls.X509KeyPair([]byte(crt), []byte(key)); err != nil {
+			return VSphereVMProviderCredentials{}, fmt.Errorf("invalid vCenter client certificate and private key: %w", err)
+		}
+		return VSphereVMProviderCredentials{
+			Certificate: crt,
+			PrivateKey:  key,
+			Token:       string(data[TokenKey]),
+		}, nil
+	}
+
+	if len(data[TokenKey]) > 0 {
+		return VSphereVMProviderCredentials{}, errors.New("vCenter token requires a client certificate and private key")
+	}
+
 	credentials := VSphereVMProviderCredentials{
-		Username: string(data["username"]),
-		Password: string(data["password"]),
+		Username: string(data[UsernameKey]),
+		Password: string(data[PasswordKey]),
 	}
 
 	if credentials.Username == "" || credentials.Password == "" {
@@ -86,3 +86,95 @@ var _ = Describe("GetProviderCredentials", func() {
 		})
 	})
 })
+
+var _ = Describe("ExtractVCCredentials", func() {
+	var (
+		crt, key []byte
+	)
+
+	BeforeEach(func() {
+		var err error
+		crt, key, err = builder.GenerateSelfSignedCertificate("vmop-solution-user")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("when the data has a client certificate and private key", func() {
+		Specify("returns certificate credentials with no error", func() {
+			credsOut, err := credentials.ExtractVCCredentials(map[string][]byte{
+				"tls.crt": crt,
+				"tls.key": key,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credsOut).To(Equal(credentials.VSphereVMProviderCredentials{
+				Certificate: string(crt),
+				PrivateKey:  string(key),
+			}))
+		})
+
+		Context("and a token", func() {
+			Specify("returns token exchange credentials with no error", func() {
+				credsOut, err := credentials.ExtractVCCredentials(map[string][]byte{
+					"tls.crt": crt,
+					"tls.key": key,
+					"token":   []byte("my-saml-token"),
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credsOut).To(Equal(credentials.VSphereVMProviderCredentials{
+					Certificate: string(crt),
+					PrivateKey:  string(key),
+					Token:       "my-saml-token",
+				}))
+			})
+		})
+
+		Context("and a username and password", func() {
+			Specify("prefers the client certificate", func() {
+				credsOut, err := credentials.ExtractVCCredentials(map[string][]byte{
+					"username": []byte("some-user"),
+					"password": []byte("some-pass"),
+					"tls.crt":  crt,
+					"tls.key":  key,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(credsOut.Username).To(BeEmpty())
+				Expect(credsOut.Certificate).To(Equal(string(crt)))
+			})
+		})
+	})
+
+	Context("when the data has a client certificate but no private key", func() {
+		Specify("returns no credentials with error", func() {
+			credsOut, err := credentials.ExtractVCCredentials(map[string][]byte{
+				"tls.crt": crt,
+			})
+			Expect(err).To(MatchError("vCenter client certificate and private key must both be set"))
+			Expect(credsOut).To(BeZero())
+		})
+	})
+
+	Context("when the private key does not match the client certificate", func() {
+		Specify("returns no credentials with error", func() {
+			_, otherKey, err := builder.GenerateSelfSignedCertificate("other")
+			Expect(err).ToNot(HaveOccurred())
+			credsOut, err := credentials.ExtractVCCredentials(map[string][]byte{
+				"tls.crt": crt,
+				"tls.key": otherKey,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("invalid vCenter client certificate and private key"))
+			Expect(credsOut).To(BeZero())
+		})
+	})
+
+	Context("when the data has a token but no client certificate", func() {
+		Specify("returns no credentials with error", func() {
+			credsOut, err := credentials.ExtractVCCredentials(map[string][]byte{
+				"username": []byte("some-user"),
+				"password": []byte("some-pass"),
+				"token":    []byte("my-saml-token"),
+			})
+			Expect(err).To(MatchError("vCenter token requires a client certificate and private key"))
+			Expect(credsOut).To(BeZero())
+		})
+	})
+})
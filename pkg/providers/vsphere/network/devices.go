@@ -24,6 +24,7 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
 
 // MapEthernetDevicesToSpecIdx maps the VM's ethernet devices to the corresponding
@@ -60,6 +61,32 @@ func MapEthernetDevicesToSpecIdx(
 
 	return devKeyToSpecIdx
 }
+
+// MatchEthCardNetwork returns true if the ethernet device is backed by the
+// network of the interface spec. True is returned if the network provider
+// does not support matching devices to interfaces.
+func MatchEthCardNetwork(
+	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
+	interfaceSpec vmopv1.VirtualMachineNetworkInterfaceSpec,
+	dev vimtypes.BaseVirtualDevice) bool {
+
+	ethCards := object.VirtualDeviceList{dev}
+
+	switch pkgcfg.FromContext(vmCtx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeVDS:
+		return findMatchingEthCardVDS(vmCtx, client, interfaceSpec, ethCards) == 0
+	case pkgcfg.NetworkProviderTypeNSXT:
+		return findMatchingEthCardNSXT(vmCtx, client, interfaceSpec, ethCards) == 0
+	case pkgcfg.NetworkProviderTypeVPC:
+		return findMatchingEthCardVPC(vmCtx, client, interfaceSpec, ethCards) == 0
+	case pkgcfg.NetworkProviderTypeNamed:
+		return findMatchingEthCardNamed(vmCtx, client, interfaceSpec, ethCards) == 0
+	}
+
+	return true
+}
+
 func findMatchingEthCardForInterfaceSpec(
 	vmCtx pkgctx.VirtualMachineContext,
 	client ctrlclient.Client,
@@ -79,6 +106,15 @@ func findMatchingEthCardForInterfaceSpec(
 		matchingIdx = findMatchingEthCardNamed(vmCtx, client, interfaceSpec, ethCards)
 	}
 
+	if matchingIdx >= 0 && !MatchEthCardAdapterType(
+		ethCards[matchingIdx],
+		interfaceSpec.AdapterType,
+		interfaceSpec.PhysicalFunction) {
+
+		// The device will be replaced with one of the interface's adapter type.
+		return -1
+	}
+
 	return matchingIdx
 }
 
@@ -315,3 +351,91 @@ func findMatchingEthCardNamed(
 
 	return -1
 }
+
+// applyAdapterTypeToEthCard configures the ethernet device for the adapter
+// type of the interface result.
+func applyAdapterTypeToEthCard(
+	dev vimtypes.BaseVirtualDevice,
+	result *NetworkInterfaceResult) {
+
+	switch d := dev.(type) {
+	case *vimtypes.VirtualSriovEthernetCard:
+		d.AllowGuestOSMtuChange = ptr.To(true)
+		if result.PhysicalFunction != "" {
+			d.SriovBacking = &vimtypes.VirtualSriovEthernetCardSriovBackingInfo{
+				PhysicalFunctionBacking: &vimtypes.VirtualPCIPassthroughDeviceBackingInfo{
+					Id: result.PhysicalFunction,
+				},
+			}
+		}
+	case *vimtypes.VirtualVmxnet3:
+		if result.AdapterType == vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath {
+			d.UptCompatibilityEnabled = ptr.To(true)
+		}
+	}
+}
+
+// EthCardAdapterType returns the network interface adapter type of the
+// ethernet device, or an empty string if the device is not one of the adapter
+// types that may be specified in the interface spec, ex. E1000.
+func EthCardAdapterType(
+	dev vimtypes.BaseVirtualDevice) vmopv1.VirtualMachineNetworkInterfaceAdapterType {
+
+	switch d := dev.(type) {
+	case *vimtypes.VirtualSriovEthernetCard:
+		return vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV
+	case *vimtypes.VirtualVmxnet3:
+		if ptr.Deref(d.UptCompatibilityEnabled) {
+			return vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath
+		}
+		return vmopv1.VirtualMachineNetworkInterfaceAdapterTypeVmxnet3
+	}
+	return ""
+}
+
+// EthCardPhysicalFunction returns the PCI ID of the SR-IOV physical function
+// that backs the ethernet device, or an empty string if the device is not an
+// SR-IOV device or its physical function is automatically assigned.
+func EthCardPhysicalFunction(dev vimtypes.BaseVirtualDevice) string {
+	if d, ok := dev.(*vimtypes.VirtualSriovEthernetCard); ok {
+		if b := d.SriovBacking; b != nil && b.PhysicalFunctionBacking != nil {
+			return b.PhysicalFunctionBacking.Id
+		}
+	}
+	return ""
+}
+
+// MatchEthCardAdapterType returns true if the ethernet device has the given
+// adapter type, and for SR-IOV, the given physical function. The Vmxnet3, or
+// empty, adapter type matches any device that is not an SR-IOV or DirectPath
+// device so the device types from the VM Class ConfigSpec are preserved.
+func MatchEthCardAdapterType(
+	dev vimtypes.BaseVirtualDevice,
+	adapterType vmopv1.VirtualMachineNetworkInterfaceAdapterType,
+	physicalFunction string) bool {
+
+	devAdapterType := EthCardAdapterType(dev)
+
+	switch adapterType {
+	case vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV:
+		if devAdapterType != adapterType {
+			return false
+		}
+		return physicalFunction == "" ||
+			strings.EqualFold(physicalFunction, EthCardPhysicalFunction(dev))
+	case vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath:
+		return devAdapterType == adapterType
+	default:
+		return devAdapterType != vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV &&
+			devAdapterType != vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath
+	}
+}
+
+// matchEthCardsAdapterType returns true if the current ethernet device has
+// the adapter type of the desired ethernet device.
+func matchEthCardsAdapterType(desired, current vimtypes.BaseVirtualDevice) bool {
+	return MatchEthCardAdapterType(
+		current,
+		EthCardAdapterType(desired),
+		EthCardPhysicalFunction(desired))
+}
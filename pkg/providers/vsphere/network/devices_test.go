@@ -24,6 +24,7 @@ import (
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -371,3 +372,44 @@ var _ = Describe("MapEthernetDevicesToSpecIdx", func() {
 		})
 	})
 })
+
+func uptVmxnet3() *vimtypes.VirtualVmxnet3 {
+	dev := &vimtypes.VirtualVmxnet3{}
+	dev.UptCompatibilityEnabled = ptr.To(true)
+	return dev
+}
+
+var _ = DescribeTable("MatchEthCardAdapterType",
+	func(
+		dev vimtypes.BaseVirtualDevice,
+		adapterType vmopv1.VirtualMachineNetworkInterfaceAdapterType,
+		physicalFunction string,
+		expected bool) {
+
+		Expect(network.MatchEthCardAdapterType(dev, adapterType, physicalFunction)).To(Equal(expected))
+	},
+	Entry("vmxnet3 with empty adapter type",
+		&vimtypes.VirtualVmxnet3{}, vmopv1.VirtualMachineNetworkInterfaceAdapterType(""), "", true),
+	Entry("e1000 with Vmxnet3 adapter type",
+		&vimtypes.VirtualE1000{}, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeVmxnet3, "", true),
+	Entry("vmxnet3 with SRIOV adapter type",
+		&vimtypes.VirtualVmxnet3{}, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV, "", false),
+	Entry("sriov with Vmxnet3 adapter type",
+		&vimtypes.VirtualSriovEthernetCard{}, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeVmxnet3, "", false),
+	Entry("sriov with SRIOV adapter type",
+		&vimtypes.VirtualSriovEthernetCard{}, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV, "", true),
+	Entry("sriov with SRIOV adapter type and matching physical function",
+		&vimtypes.VirtualSriovEthernetCard{
+			SriovBacking: &vimtypes.VirtualSriovEthernetCardSriovBackingInfo{
+				PhysicalFunctionBacking: &vimtypes.VirtualPCIPassthroughDeviceBackingInfo{Id: "0000:3b:00.0"},
+			},
+		}, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV, "0000:3B:00.0", true),
+	Entry("sriov with SRIOV adapter type and different physical function",
+		&vimtypes.VirtualSriovEthernetCard{}, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV, "0000:3b:00.0", false),
+	Entry("vmxnet3 with UPT with DirectPath adapter type",
+		uptVmxnet3(), vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath, "", true),
+	Entry("vmxnet3 with UPT with Vmxnet3 adapter type",
+		uptVmxnet3(), vmopv1.VirtualMachineNetworkInterfaceAdapterTypeVmxnet3, "", false),
+	Entry("vmxnet3 without UPT with DirectPath adapter type",
+		&vimtypes.VirtualVmxnet3{}, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath, "", false),
+)
@@ -0,0 +1,190 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	nsxv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
+	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+const (
+	// securityPolicyDefaultDenyRuleName is the name of the security policy
+	// rule that drops the ingress traffic not allowed by the other rules.
+	securityPolicyDefaultDenyRuleName = "default-deny-ingress"
+)
+
+// SecurityPolicyName returns the name of the NSX SecurityPolicy that enforces
+// the firewall rules of the VM.
+func SecurityPolicyName(vmName string) string {
+	return vmName + "-firewall"
+}
+
+// reconcileFirewallSecurityPolicy creates or updates the NSX SecurityPolicy
+// that enforces the VM's firewall rules with the distributed firewall. The
+// policy is deleted when the VM no longer has firewall rules.
+//
+// The policy is applied to the VM with a selector on the VMNameLabel that is
+// on each of the VM's network interface CRs. The NSX-T network provider uses
+// the legacy nsx.vmware.com SecurityPolicy, while the VPC network provider
+// uses the crd.nsx.vmware.com SecurityPolicy. Both have the same schema.
+func reconcileFirewallSecurityPolicy(
+	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
+	networkSpec *vmopv1.VirtualMachineNetworkSpec) error {
+
+	var policy ctrlclient.Object
+	switch pkgcfg.FromContext(vmCtx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeNSXT:
+		policy = &nsxv1alpha1.SecurityPolicy{}
+	case pkgcfg.NetworkProviderTypeVPC:
+		policy = &vpcv1alpha1.SecurityPolicy{}
+	default:
+		return nil
+	}
+	policy.SetNamespace(vmCtx.VM.Namespace)
+	policy.SetName(SecurityPolicyName(vmCtx.VM.Name))
+
+	if networkSpec.Firewall == nil {
+		return deleteFirewallSecurityPolicy(vmCtx, client, policy)
+	}
+
+	spec := firewallSecurityPolicySpec(vmCtx.VM, networkSpec.Firewall)
+
+	_, err := controllerutil.CreateOrPatch(vmCtx, client, policy, func() error {
+		if err := controllerutil.SetControllerReference(vmCtx.VM, policy, client.Scheme()); err != nil {
+			return err
+		}
+
+		switch p := policy.(type) {
+		case *vpcv1alpha1.SecurityPolicy:
+			p.Spec = spec
+		case *nsxv1alpha1.SecurityPolicy:
+			// The legacy SecurityPolicy has the same schema as the VPC one, so
+			// its spec is converted using their JSON representation.
+			data, err := json.Marshal(spec)
+			if err != nil {
+				return err
+			}
+			p.Spec = nsxv1alpha1.SecurityPolicySpec{}
+			if err := json.Unmarshal(data, &p.Spec); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or patch SecurityPolicy %s: %w", policy.GetName(), err)
+	}
+
+	return nil
+}
+
+// deleteFirewallSecurityPolicy deletes the VM's SecurityPolicy if it exists
+// and is controlled by the VM.
+func deleteFirewallSecurityPolicy(
+	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
+	policy ctrlclient.Object) error {
+
+	if err := client.Get(vmCtx, ctrlclient.ObjectKeyFromObject(policy), policy); err != nil {
+		// The SecurityPolicy CRD may not be installed when no VM has ever
+		// had firewall rules.
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if !metav1.IsControlledBy(policy, vmCtx.VM) {
+		return nil
+	}
+
+	if err := client.Delete(vmCtx, policy); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete SecurityPolicy %s: %w", policy.GetName(), err)
+	}
+
+	return nil
+}
+
+// firewallSecurityPolicySpec returns the SecurityPolicy spec for the firewall
+// rules. Each firewall rule becomes an allow rule, followed by a rule that
+// drops all other ingress traffic.
+func firewallSecurityPolicySpec(
+	vm *vmopv1.VirtualMachine,
+	firewall *vmopv1.VirtualMachineNetworkFirewallSpec) vpcv1alpha1.SecurityPolicySpec {
+
+	spec := vpcv1alpha1.SecurityPolicySpec{
+		AppliedTo: []vpcv1alpha1.SecurityPolicyTarget{
+			{
+				VMSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						VMNameLabel: vm.Name,
+					},
+				},
+			},
+		},
+	}
+
+	for i := range firewall.Ingress {
+		in := &firewall.Ingress[i]
+
+		protocol := corev1.ProtocolTCP
+		if in.Protocol != "" {
+			protocol = corev1.Protocol(in.Protocol)
+		}
+
+		port := vpcv1alpha1.SecurityPolicyPort{
+			Protocol: protocol,
+		}
+		if in.Port != nil {
+			port.Port = intstr.FromInt32(*in.Port)
+			if in.EndPort != nil && *in.EndPort > *in.Port {
+				port.EndPort = int(*in.EndPort)
+			}
+		}
+
+		rule := vpcv1alpha1.SecurityPolicyRule{
+			Name:      fmt.Sprintf("ingress-%d", i),
+			Action:    ptr.To(vpcv1alpha1.RuleActionAllow),
+			Direction: ptr.To(vpcv1alpha1.RuleDirectionIn),
+			Ports:     []vpcv1alpha1.SecurityPolicyPort{port},
+		}
+
+		if len(in.CIDRs) > 0 {
+			peer := vpcv1alpha1.SecurityPolicyPeer{}
+			for _, cidr := range in.CIDRs {
+				peer.IPBlocks = append(peer.IPBlocks, vpcv1alpha1.IPBlock{CIDR: cidr})
+			}
+			rule.Sources = []vpcv1alpha1.SecurityPolicyPeer{peer}
+		}
+
+		spec.Rules = append(spec.Rules, rule)
+	}
+
+	spec.Rules = append(spec.Rules, vpcv1alpha1.SecurityPolicyRule{
+		Name:      securityPolicyDefaultDenyRuleName,
+		Action:    ptr.To(vpcv1alpha1.RuleActionDrop),
+		Direction: ptr.To(vpcv1alpha1.RuleDirectionIn),
+	})
+
+	return spec
+}
@@ -0,0 +1,70 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+)
+
+// applyIPPoolAllocation returns a copy of the interface spec with the
+// addresses allocated by the VirtualMachineIPPool the interface references.
+// The allocated addresses replace the interface's addresses, while the
+// allocated MAC address and gateways are only used when the interface does
+// not specify them.
+//
+// A NetworkNotReadyError is returned if the pool has not yet allocated
+// addresses for the interface. The VM controller watches the pools so the VM
+// is requeued once the addresses are allocated.
+func applyIPPoolAllocation(
+	vmCtx pkgctx.VirtualMachineContext,
+	client ctrlclient.Client,
+	interfaceSpec *vmopv1.VirtualMachineNetworkInterfaceSpec) (*vmopv1.VirtualMachineNetworkInterfaceSpec, error) {
+
+	if interfaceSpec.IPPoolName == "" {
+		return interfaceSpec, nil
+	}
+
+	notReady := pkgerr.NetworkNotReadyError{
+		Message: fmt.Sprintf(
+			"waiting for VirtualMachineIPPool %s to allocate addresses for interface %s",
+			interfaceSpec.IPPoolName, interfaceSpec.Name),
+		Name: interfaceSpec.IPPoolName,
+	}
+
+	pool := &vmopv1.VirtualMachineIPPool{}
+	poolKey := ctrlclient.ObjectKey{Namespace: vmCtx.VM.Namespace, Name: interfaceSpec.IPPoolName}
+	if err := client.Get(vmCtx, poolKey, pool); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, notReady
+		}
+		return nil, fmt.Errorf("failed to get VirtualMachineIPPool %s: %w", poolKey.Name, err)
+	}
+
+	allocation := pool.Allocation(vmCtx.VM.Name, interfaceSpec.Name)
+	if allocation == nil {
+		return nil, notReady
+	}
+
+	spec := interfaceSpec.DeepCopy()
+	spec.Addresses = allocation.Addresses
+	if spec.MACAddr == "" {
+		spec.MACAddr = allocation.MACAddr
+	}
+	if spec.Gateway4 == "" {
+		spec.Gateway4 = allocation.Gateway4
+	}
+	if spec.Gateway6 == "" {
+		spec.Gateway6 = allocation.Gateway6
+	}
+
+	return spec, nil
+}
@@ -18,87 +18,169 @@ func NetPlanCustomization(result NetworkInterfaceResults) (*netplan.Network, err
 		Ethernets: make(map[string]netplan.Ethernet),
 	}
 
+	// The members of a bond are configured by the bond, so they do not get
+	// any IP configuration of their own.
+	bondMembers := map[string]struct{}{}
+	for _, b := range result.Bonds {
+		for _, name := range b.Interfaces {
+			bondMembers[name] = struct{}{}
+		}
+	}
+
 	for _, r := range result.Results {
-		npEth := netplan.Ethernet{
-			Match: &netplan.Match{
-				Macaddress: ptr.To(NormalizeNetplanMac(r.MacAddress)),
-			},
-			SetName: &r.GuestDeviceName,
-			Nameservers: &netplan.Nameserver{
-				Addresses: r.Nameservers,
-				Search:    r.SearchDomains,
+		if _, ok := bondMembers[r.Name]; ok {
+			npEth := netplan.Ethernet{
+				Match: &netplan.Match{
+					Macaddress: ptr.To(NormalizeNetplanMac(r.MacAddress)),
+				},
+				SetName: &r.GuestDeviceName,
+				Dhcp4:   ptr.To(false),
+				Dhcp6:   ptr.To(false),
+			}
+			if r.MTU > 0 {
+				npEth.MTU = &r.MTU
+			}
+			netPlan.Ethernets[r.Name] = npEth
+			continue
+		}
+
+		npEth := netplanEthernetIPConfig(r)
+		npEth.Match = &netplan.Match{
+			Macaddress: ptr.To(NormalizeNetplanMac(r.MacAddress)),
+		}
+		npEth.SetName = &r.GuestDeviceName
+
+		netPlan.Ethernets[r.Name] = npEth
+	}
+
+	if len(result.Bonds) > 0 {
+		netPlan.Bonds = make(map[string]netplan.Bond, len(result.Bonds))
+	}
+	for _, b := range result.Bonds {
+		npEth := netplanEthernetIPConfig(b.InterfaceResult())
+
+		npBond := netplan.Bond{
+			Interfaces:  b.Interfaces,
+			Addresses:   npEth.Addresses,
+			Dhcp4:       npEth.Dhcp4,
+			Dhcp6:       npEth.Dhcp6,
+			AcceptRa:    npEth.AcceptRa,
+			Gateway4:    npEth.Gateway4,
+			Gateway6:    npEth.Gateway6,
+			MTU:         npEth.MTU,
+			Nameservers: npEth.Nameservers,
+			Routes:      npEth.Routes,
+			Parameters: &netplan.BondParameters{
+				Mode: ptr.To(netplan.BondMode(b.Mode)),
 			},
 		}
+		if b.Primary != "" {
+			npBond.Parameters.Primary = ptr.To(b.Primary)
+		}
+
+		netPlan.Bonds[b.Name] = npBond
+	}
 
-		if r.MTU > 0 {
-			npEth.MTU = &r.MTU
+	if len(result.VLANs) > 0 {
+		netPlan.Vlans = make(map[string]netplan.VLAN, len(result.VLANs))
+	}
+	for _, v := range result.VLANs {
+		npEth := netplanEthernetIPConfig(v.InterfaceResult())
+
+		netPlan.Vlans[v.Name] = netplan.VLAN{
+			ID:          ptr.To(int64(v.ID)),
+			Link:        ptr.To(v.Link),
+			Addresses:   npEth.Addresses,
+			Dhcp4:       npEth.Dhcp4,
+			Dhcp6:       npEth.Dhcp6,
+			AcceptRa:    npEth.AcceptRa,
+			Gateway4:    npEth.Gateway4,
+			Gateway6:    npEth.Gateway6,
+			MTU:         npEth.MTU,
+			Nameservers: npEth.Nameservers,
+			Routes:      npEth.Routes,
 		}
+	}
+
+	return netPlan, nil
+}
+
+// netplanEthernetIPConfig returns a netplan ethernet with only the IP, DNS,
+// and route configuration from the result.
+func netplanEthernetIPConfig(r NetworkInterfaceResult) netplan.Ethernet {
+	npEth := netplan.Ethernet{
+		Nameservers: &netplan.Nameserver{
+			Addresses: r.Nameservers,
+			Search:    r.SearchDomains,
+		},
+	}
+
+	if r.MTU > 0 {
+		npEth.MTU = &r.MTU
+	}
 
-		npEth.Dhcp4 = &r.DHCP4
-		npEth.Dhcp6 = &r.DHCP6
-		// Right now we can set the same value as DHCPv6 configuration
-		// and in some future separate/specialize if required.
-		npEth.AcceptRa = &r.DHCP6
-
-		if !*npEth.Dhcp4 {
-			for i := range r.IPConfigs {
-				ipConfig := r.IPConfigs[i]
-				if ipConfig.IsIPv4 {
-					if ipConfig.Gateway != "" {
-						if npEth.Gateway4 == nil || *npEth.Gateway4 == "" {
-							npEth.Gateway4 = &ipConfig.Gateway
-						}
+	npEth.Dhcp4 = &r.DHCP4
+	npEth.Dhcp6 = &r.DHCP6
+	// Right now we can set the same value as DHCPv6 configuration
+	// and in some future separate/specialize if required.
+	npEth.AcceptRa = &r.DHCP6
+
+	if !*npEth.Dhcp4 {
+		for i := range r.IPConfigs {
+			ipConfig := r.IPConfigs[i]
+			if ipConfig.IsIPv4 {
+				if ipConfig.Gateway != "" {
+					if npEth.Gateway4 == nil || *npEth.Gateway4 == "" {
+						npEth.Gateway4 = &ipConfig.Gateway
 					}
-					npEth.Addresses = append(
-						npEth.Addresses,
-						netplan.Address{
-							String: &ipConfig.IPCIDR,
-						},
-					)
 				}
+				npEth.Addresses = append(
+					npEth.Addresses,
+					netplan.Address{
+						String: &ipConfig.IPCIDR,
+					},
+				)
 			}
 		}
-		if !*npEth.Dhcp6 {
-			for i := range r.IPConfigs {
-				ipConfig := r.IPConfigs[i]
-				if !ipConfig.IsIPv4 {
-					if ipConfig.Gateway != "" {
-						if npEth.Gateway6 == nil || *npEth.Gateway6 == "" {
-							npEth.Gateway6 = &ipConfig.Gateway
-						}
+	}
+	if !*npEth.Dhcp6 {
+		for i := range r.IPConfigs {
+			ipConfig := r.IPConfigs[i]
+			if !ipConfig.IsIPv4 {
+				if ipConfig.Gateway != "" {
+					if npEth.Gateway6 == nil || *npEth.Gateway6 == "" {
+						npEth.Gateway6 = &ipConfig.Gateway
 					}
-					npEth.Addresses = append(
-						npEth.Addresses,
-						netplan.Address{
-							String: &ipConfig.IPCIDR,
-						},
-					)
 				}
+				npEth.Addresses = append(
+					npEth.Addresses,
+					netplan.Address{
+						String: &ipConfig.IPCIDR,
+					},
+				)
 			}
 		}
+	}
 
-		for i := range r.Routes {
-			route := r.Routes[i]
-
-			var metric *int64
-			if route.Metric != 0 {
-				metric = ptr.To(int64(route.Metric))
-			}
+	for i := range r.Routes {
+		route := r.Routes[i]
 
-			npEth.Routes = append(
-				npEth.Routes,
-				netplan.Route{
-					To:     &route.To,
-					Metric: metric,
-					Via:    &route.Via,
-				},
-			)
+		var metric *int64
+		if route.Metric != 0 {
+			metric = ptr.To(int64(route.Metric))
 		}
 
-		netPlan.Ethernets[r.Name] = npEth
+		npEth.Routes = append(
+			npEth.Routes,
+			netplan.Route{
+				To:     &route.To,
+				Metric: metric,
+				Via:    &route.Via,
+			},
+		)
 	}
 
-	return netPlan, nil
+	return npEth
 }
 
 // NormalizeNetplanMac normalizes the mac address format to one compatible with netplan.
@@ -245,5 +245,120 @@ var _ = Describe("Netplan", func() {
 				Expect(np.AcceptRa).To(HaveValue(BeFalse()))
 			})
 		})
+
+		Context("VLANs and bonds", func() {
+			const (
+				ifName2       = "my-interface-2"
+				guestDevName2 = "eth43"
+				macAddr2      = "50-8A-80-9D-28-23"
+				bondName      = "bond0"
+				vlanName      = "bond0.100"
+			)
+
+			BeforeEach(func() {
+				results.Results = []network.NetworkInterfaceResult{
+					{
+						MacAddress:      macAddr1,
+						Name:            ifName,
+						GuestDeviceName: guestDevName,
+						IPConfigs: []network.NetworkInterfaceIPConfig{
+							{
+								IPCIDR:  ipv4CIDR,
+								IsIPv4:  true,
+								Gateway: ipv4Gateway,
+							},
+						},
+						MTU: 9000,
+					},
+					{
+						MacAddress:      macAddr2,
+						Name:            ifName2,
+						GuestDeviceName: guestDevName2,
+						DHCP4:           true,
+					},
+				}
+				results.Bonds = []network.NetworkBondResult{
+					{
+						NetworkGuestDeviceResult: network.NetworkGuestDeviceResult{
+							Name:  bondName,
+							DHCP4: true,
+						},
+						Interfaces: []string{ifName, ifName2},
+						Mode:       "active-backup",
+						Primary:    ifName,
+					},
+				}
+				results.VLANs = []network.NetworkVLANResult{
+					{
+						NetworkGuestDeviceResult: network.NetworkGuestDeviceResult{
+							Name: vlanName,
+							IPConfigs: []network.NetworkInterfaceIPConfig{
+								{
+									IPCIDR:  ipv4CIDR,
+									IsIPv4:  true,
+									Gateway: ipv4Gateway,
+								},
+							},
+							Nameservers: []string{dnsServer1},
+							Routes: []network.NetworkInterfaceRoute{
+								{
+									To:  "10.0.0.0/8",
+									Via: ipv4Gateway,
+								},
+							},
+						},
+						ID:   100,
+						Link: bondName,
+					},
+				}
+			})
+
+			It("returns success", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config).ToNot(BeNil())
+
+				By("bond members do not have IP configuration", func() {
+					Expect(config.Ethernets).To(HaveLen(2))
+					for name, mac := range map[string]string{ifName: macAddr1Norm, ifName2: "50:8a:80:9d:28:23"} {
+						Expect(config.Ethernets).To(HaveKey(name))
+						np := config.Ethernets[name]
+						Expect(np.Match.Macaddress).To(HaveValue(Equal(mac)))
+						Expect(np.Addresses).To(BeEmpty())
+						Expect(np.Gateway4).To(BeNil())
+						Expect(np.Dhcp4).To(HaveValue(BeFalse()))
+						Expect(np.Dhcp6).To(HaveValue(BeFalse()))
+					}
+					Expect(config.Ethernets[ifName].SetName).To(HaveValue(Equal(guestDevName)))
+					Expect(config.Ethernets[ifName].MTU).To(HaveValue(BeEquivalentTo(9000)))
+				})
+
+				By("bond", func() {
+					Expect(config.Bonds).To(HaveLen(1))
+					Expect(config.Bonds).To(HaveKey(bondName))
+					np := config.Bonds[bondName]
+					Expect(np.Interfaces).To(Equal([]string{ifName, ifName2}))
+					Expect(np.Dhcp4).To(HaveValue(BeTrue()))
+					Expect(np.Parameters).ToNot(BeNil())
+					Expect(np.Parameters.Mode).To(HaveValue(Equal(netplan.BondMode("active-backup"))))
+					Expect(np.Parameters.Primary).To(HaveValue(Equal(ifName)))
+				})
+
+				By("VLAN", func() {
+					Expect(config.Vlans).To(HaveLen(1))
+					Expect(config.Vlans).To(HaveKey(vlanName))
+					np := config.Vlans[vlanName]
+					Expect(np.ID).To(HaveValue(BeEquivalentTo(100)))
+					Expect(np.Link).To(HaveValue(Equal(bondName)))
+					Expect(np.Dhcp4).To(HaveValue(BeFalse()))
+					Expect(np.Addresses).To(HaveLen(1))
+					Expect(np.Addresses[0].String).To(HaveValue(Equal(ipv4CIDR)))
+					Expect(np.Gateway4).To(HaveValue(Equal(ipv4Gateway)))
+					Expect(np.Nameservers.Addresses).To(Equal([]string{dnsServer1}))
+					Expect(np.Routes).To(HaveLen(1))
+					Expect(np.Routes[0].To).To(HaveValue(Equal("10.0.0.0/8")))
+					Expect(np.Routes[0].Via).To(HaveValue(Equal(ipv4Gateway)))
+				})
+			})
+		})
 	})
 })
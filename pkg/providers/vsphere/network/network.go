@@ -11,7 +11,6 @@ import (
 	"fmt"
 	"net"
 	"strings"
-	"time"
 
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
@@ -23,7 +22,6 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -36,6 +34,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
@@ -43,6 +42,8 @@ import (
 
 type NetworkInterfaceResults struct {
 	Results                   []NetworkInterfaceResult
+	VLANs                     []NetworkVLANResult
+	Bonds                     []NetworkBondResult
 	UpdatedEthCards           bool
 	OrphanedNetworkInterfaces []ctrlclient.Object
 }
@@ -68,6 +69,55 @@ type NetworkInterfaceResult struct {
 	Nameservers     []string
 	SearchDomains   []string
 	Routes          []NetworkInterfaceRoute
+
+	// IPAssignmentMode is how the interface's IP addresses are assigned.
+	IPAssignmentMode vmopv1.VirtualMachineNetworkIPAssignmentMode
+
+	// AdapterType and PhysicalFunction describe the network adapter used
+	// for the interface's ethernet device.
+	AdapterType      vmopv1.VirtualMachineNetworkInterfaceAdapterType
+	PhysicalFunction string
+}
+
+// NetworkGuestDeviceResult is the configuration of a network device that
+// exists only inside the guest, such as a VLAN or bond.
+type NetworkGuestDeviceResult struct {
+	Name          string
+	IPConfigs     []NetworkInterfaceIPConfig
+	DHCP4         bool
+	DHCP6         bool
+	MTU           int64
+	Nameservers   []string
+	SearchDomains []string
+	Routes        []NetworkInterfaceRoute
+}
+
+// InterfaceResult returns the guest device's configuration as a network
+// interface result so it may be rendered the same way as an interface.
+func (r NetworkGuestDeviceResult) InterfaceResult() NetworkInterfaceResult {
+	return NetworkInterfaceResult{
+		Name:          r.Name,
+		IPConfigs:     r.IPConfigs,
+		DHCP4:         r.DHCP4,
+		DHCP6:         r.DHCP6,
+		MTU:           r.MTU,
+		Nameservers:   r.Nameservers,
+		SearchDomains: r.SearchDomains,
+		Routes:        r.Routes,
+	}
+}
+
+type NetworkVLANResult struct {
+	NetworkGuestDeviceResult
+	ID   int32
+	Link string
+}
+
+type NetworkBondResult struct {
+	NetworkGuestDeviceResult
+	Interfaces []string
+	Mode       vmopv1.VirtualMachineNetworkBondMode
+	Primary    string
 }
 
 type NetworkInterfaceIPConfig struct {
@@ -83,8 +133,8 @@ type NetworkInterfaceRoute struct {
 }
 
 const (
-	retryInterval           = 100 * time.Millisecond
 	defaultEthernetCardType = "vmxnet3"
+	sriovEthernetCardType   = "sriov"
 	gatewayIgnored          = "None"
 
 	// VMNameLabel is the label put on a network interface CR that identifies its VM by name.
@@ -92,27 +142,43 @@ const (
 	// VMInterfaceNameLabel is the label put on the network interface CR identifies its name
 	// in the VM network interface spec.
 	VMInterfaceNameLabel = pkg.VMOperatorKey + "/vm-interface-name"
-)
-
-var (
-	// RetryTimeout is var so tests can change it to shorten tests until we get rid of the poll.
-	RetryTimeout = 15 * time.Second
+	// IPAssignmentModeAnnotation is the annotation put on a network interface CR that
+	// tells the network provider how the interface's IP addresses are assigned. The
+	// value is IPAssignmentModeDHCP when the guest obtains its IP addresses via DHCP,
+	// and IPAssignmentModeNone when the addresses are from the interface spec. In
+	// both cases the network provider should not reserve an IP for the interface.
+	IPAssignmentModeAnnotation = pkg.VMOperatorKey + "/ip-assignment-mode"
+	// IPAssignmentModeDHCP is the IPAssignmentModeAnnotation value for an interface
+	// whose IP addresses are obtained via DHCP.
+	IPAssignmentModeDHCP = string(netopv1alpha1.NetworkInterfaceIPAssignmentModeDHCP)
+	// IPAssignmentModeNone is the IPAssignmentModeAnnotation value for an interface
+	// whose IP addresses are from the interface spec.
+	IPAssignmentModeNone = string(netopv1alpha1.NetworkInterfaceIPAssignmentModeNone)
 )
 
 // CreateAndWaitForNetworkInterfaces creates the appropriate CRs for the VM's network
-// interfaces, and then waits for them to be reconciled by NCP (NSX-T) or NetOP (VDS).
+// interfaces, and then checks if they have been reconciled by NCP (NSX-T) or NetOP (VDS).
 //
 // Networking has always been kind of a pain and clunky for us, and unfortunately this
 // code suffers gotchas and other not-so-great limitations.
 //
-//   - Historically, this code used wait.PollImmediate() and we continue to do so here,
-//     but eventually we should Watch() these resources. Note though, that in the very
-//     common case, the CR is reconciled before our poll timeout, so that does save us
-//     from bailing out of the Reconcile.
-//   - NCP, NetOP and VPC CR Status inform us of the backing and IPAM info. However, for
-//     our InterfaceSpec we allow for DHCP but neither NCP nor NetOP has a way for us to
-//     mark the CR to don't do IPAM or to check DHCP is even enabled on the network. So
-//     this burns an IP, and the user must know that DHCP is actually configured.
+//   - This function does not block waiting for the CRs to be reconciled. Instead, a
+//     NetworkNotReadyError is returned for the first CR that is not yet ready, and the
+//     VM controller watches these resources so the VM is requeued when their Status is
+//     updated.
+//   - NCP, NetOP and VPC CR Status inform us of the backing and IPAM info. For our
+//     InterfaceSpec we allow for DHCP and static addresses, in which case the CR is
+//     annotated with the IPAssignmentModeAnnotation so the network provider does not
+//     reserve an IP, and a VPC SubnetPort is not given an IP address binding. Any IP
+//     the provider still reserves for a family that uses DHCP is dropped from the
+//     result. There is still no way for us to check DHCP is even enabled on the
+//     network, so the user must know that DHCP is actually configured.
+//   - An interface may reference a VirtualMachineIPPool, in which case the addresses
+//     allocated by the pool are used as the interface's addresses. Like with the CRs, a
+//     NetworkNotReadyError is returned until the pool has allocated the addresses.
+//   - With NSX-T and VPC, the VM's firewall rules are enforced by the distributed firewall
+//     with a SecurityPolicy CR that is created for the VM. With VDS and named networks,
+//     the rules are instead rendered into the guest by the bootstrap provider.
 //   - CR naming has mostly been working by luck, and sometimes didn't offer very good
 //     discoverability. Here, with v1a2 we now have a "name" field in our InterfaceSpec,
 //     so we use that. A longer term option is to use GenerateName to ensure a unique name,
@@ -147,6 +213,19 @@ func CreateAndWaitForNetworkInterfaces(
 		var result *NetworkInterfaceResult
 		var err error
 
+		if interfaceSpec.IPPoolName != "" {
+			switch networkType {
+			case pkgcfg.NetworkProviderTypeVDS, pkgcfg.NetworkProviderTypeNamed:
+				interfaceSpec, err = applyIPPoolAllocation(vmCtx, client, interfaceSpec)
+			default:
+				err = fmt.Errorf("ipPoolName is not supported by network provider %q", networkType)
+			}
+			if err != nil {
+				return NetworkInterfaceResults{},
+					fmt.Errorf("network interface %q error: %w", networkSpec.Interfaces[i].Name, err)
+			}
+		}
+
 		switch networkType {
 		case pkgcfg.NetworkProviderTypeVDS:
 			result, err = createNetOPNetworkInterface(vmCtx, client, vimClient, interfaceSpec)
@@ -175,11 +254,175 @@ func CreateAndWaitForNetworkInterfaces(
 		results = append(results, *result)
 	}
 
+	if err := reconcileFirewallSecurityPolicy(vmCtx, client, networkSpec); err != nil {
+		return NetworkInterfaceResults{}, err
+	}
+
+	vlans, bonds := guestDeviceResults(
+		networkSpec,
+		defaultToGlobalNameservers,
+		defaultToGlobalSearchDomains)
+
 	return NetworkInterfaceResults{
 		Results: results,
+		VLANs:   vlans,
+		Bonds:   bonds,
 	}, nil
 }
 
+// guestDeviceResults returns the results for the VLANs and bonds from the
+// network spec. Unlike network interfaces, these devices exist only inside
+// the guest, so their configuration comes entirely from the network spec.
+func guestDeviceResults(
+	networkSpec *vmopv1.VirtualMachineNetworkSpec,
+	defaultToGlobalNameservers bool,
+	defaultToGlobalSearchDomains bool) ([]NetworkVLANResult, []NetworkBondResult) {
+
+	var (
+		vlans []NetworkVLANResult
+		bonds []NetworkBondResult
+	)
+
+	for _, vlan := range networkSpec.VLANs {
+		vlans = append(vlans, NetworkVLANResult{
+			NetworkGuestDeviceResult: newGuestDeviceResult(
+				networkSpec,
+				vlan.Name,
+				vlan.VirtualMachineNetworkGuestDeviceIPSpec,
+				defaultToGlobalNameservers,
+				defaultToGlobalSearchDomains),
+			ID:   vlan.ID,
+			Link: vlan.Link,
+		})
+	}
+
+	for _, bond := range networkSpec.Bonds {
+		mode := bond.Mode
+		if mode == "" {
+			mode = vmopv1.VirtualMachineNetworkBondModeActiveBackup
+		}
+		bonds = append(bonds, NetworkBondResult{
+			NetworkGuestDeviceResult: newGuestDeviceResult(
+				networkSpec,
+				bond.Name,
+				bond.VirtualMachineNetworkGuestDeviceIPSpec,
+				defaultToGlobalNameservers,
+				defaultToGlobalSearchDomains),
+			Interfaces: bond.Interfaces,
+			Mode:       mode,
+			Primary:    bond.Primary,
+		})
+	}
+
+	return vlans, bonds
+}
+
+func newGuestDeviceResult(
+	networkSpec *vmopv1.VirtualMachineNetworkSpec,
+	name string,
+	ipSpec vmopv1.VirtualMachineNetworkGuestDeviceIPSpec,
+	defaultToGlobalNameservers bool,
+	defaultToGlobalSearchDomains bool) NetworkGuestDeviceResult {
+
+	result := NetworkGuestDeviceResult{
+		Name:  name,
+		DHCP4: ipSpec.DHCP4,
+		DHCP6: ipSpec.DHCP6,
+	}
+
+	if ipSpec.MTU != nil {
+		result.MTU = *ipSpec.MTU
+	}
+
+	for _, addr := range ipSpec.Addresses {
+		ip, _, err := net.ParseCIDR(addr)
+		if err != nil {
+			continue
+		}
+
+		ipConfig := NetworkInterfaceIPConfig{
+			IPCIDR: addr,
+			IsIPv4: ip.To4() != nil,
+		}
+		if ipConfig.IsIPv4 {
+			ipConfig.Gateway = ipSpec.Gateway4
+		} else {
+			ipConfig.Gateway = ipSpec.Gateway6
+		}
+		if ipConfig.Gateway == gatewayIgnored {
+			ipConfig.Gateway = ""
+		}
+
+		result.IPConfigs = append(result.IPConfigs, ipConfig)
+	}
+
+	for _, route := range ipSpec.Routes {
+		result.Routes = append(result.Routes, NetworkInterfaceRoute{To: route.To, Via: route.Via, Metric: route.Metric})
+	}
+
+	if n := ipSpec.Nameservers; len(n) > 0 {
+		result.Nameservers = n
+	} else if defaultToGlobalNameservers {
+		result.Nameservers = networkSpec.Nameservers
+	}
+
+	if d := ipSpec.SearchDomains; len(d) > 0 {
+		result.SearchDomains = d
+	} else if defaultToGlobalSearchDomains {
+		result.SearchDomains = networkSpec.SearchDomains
+	}
+
+	return result
+}
+
+// getIPAssignmentMode returns the IPAssignmentModeAnnotation value for the
+// interface, or an empty string if the network provider should allocate the
+// interface's IP addresses.
+func getIPAssignmentMode(interfaceSpec *vmopv1.VirtualMachineNetworkInterfaceSpec) string {
+	if len(interfaceSpec.Addresses) == 0 {
+		if interfaceSpec.DHCP4 || interfaceSpec.DHCP6 {
+			return IPAssignmentModeDHCP
+		}
+		return ""
+	}
+
+	// The gateways of the families without a user specified gateway are
+	// backfilled from the IPs reserved by the network provider.
+	for _, addr := range interfaceSpec.Addresses {
+		ip, _, err := net.ParseCIDR(addr)
+		if err != nil {
+			continue
+		}
+		if ip.To4() != nil && interfaceSpec.Gateway4 == "" {
+			return ""
+		}
+		if ip.To4() == nil && interfaceSpec.Gateway6 == "" {
+			return ""
+		}
+	}
+
+	return IPAssignmentModeNone
+}
+
+// setIPAssignmentModeAnnotation sets the IPAssignmentModeAnnotation on the
+// network interface CR if the network provider should not reserve an IP for
+// the interface, otherwise the annotation is removed.
+func setIPAssignmentModeAnnotation(
+	obj metav1.Object,
+	interfaceSpec *vmopv1.VirtualMachineNetworkInterfaceSpec) {
+
+	annotations := obj.GetAnnotations()
+	if mode := getIPAssignmentMode(interfaceSpec); mode != "" {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[IPAssignmentModeAnnotation] = mode
+	} else {
+		delete(annotations, IPAssignmentModeAnnotation)
+	}
+	obj.SetAnnotations(annotations)
+}
+
 // applyInterfaceSpecToResult applies the InterfaceSpec to results. Much of the InterfaceSpec - like DHCP -
 // cannot be specified to the underlying network provider so apply those overrides to the results.
 func applyInterfaceSpecToResult(
@@ -195,6 +438,8 @@ func applyInterfaceSpecToResult(
 		result.GuestDeviceName = result.Name
 	}
 	result.MacAddress = strings.ToLower(result.MacAddress)
+	result.AdapterType = interfaceSpec.AdapterType
+	result.PhysicalFunction = interfaceSpec.PhysicalFunction
 
 	if interfaceSpec.MTU != nil {
 		result.MTU = *interfaceSpec.MTU
@@ -209,6 +454,20 @@ func applyInterfaceSpecToResult(
 		result.DHCP6 = true
 	}
 
+	if getIPAssignmentMode(interfaceSpec) == IPAssignmentModeDHCP && len(result.IPConfigs) > 0 {
+		// The network provider was asked not to reserve an IP for this interface,
+		// but in case it still did, drop those IPs for the families using DHCP so
+		// they are neither configured in the guest nor reported in the status.
+		ipConfigs := make([]NetworkInterfaceIPConfig, 0, len(result.IPConfigs))
+		for _, ipc := range result.IPConfigs {
+			if (ipc.IsIPv4 && interfaceSpec.DHCP4) || (!ipc.IsIPv4 && interfaceSpec.DHCP6) {
+				continue
+			}
+			ipConfigs = append(ipConfigs, ipc)
+		}
+		result.IPConfigs = ipConfigs
+	}
+
 	if len(interfaceSpec.Addresses) > 0 {
 		if interfaceSpec.Gateway4 == "" || interfaceSpec.Gateway6 == "" {
 			// Backfill the gateways from the network provider if not specified in
@@ -294,6 +553,15 @@ func applyInterfaceSpecToResult(
 	} else if defaultToGlobalSearchDomains {
 		result.SearchDomains = networkSpec.SearchDomains
 	}
+
+	// Otherwise, the assignment mode is the one reported by the network
+	// provider.
+	switch {
+	case len(interfaceSpec.Addresses) > 0:
+		result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeStatic
+	case interfaceSpec.DHCP4 || interfaceSpec.DHCP6:
+		result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP
+	}
 }
 
 func createNamedNetworkInterface(
@@ -327,6 +595,8 @@ func createNamedNetworkInterface(
 		NetworkID:  networkRefName,
 		Backing:    backing,
 		MacAddress: interfaceSpec.MACAddr,
+		// Named networks do not have IPAM.
+		IPAssignmentMode: vmopv1.VirtualMachineNetworkIPAssignmentModeNone,
 	}, nil
 }
 
@@ -410,6 +680,7 @@ func createNetOPNetworkInterface(
 		}
 		netIf.Labels[VMNameLabel] = vmCtx.VM.Name
 		netIf.Labels[VMInterfaceNameLabel] = interfaceSpec.Name
+		setIPAssignmentModeAnnotation(netIf, interfaceSpec)
 
 		// NetOp will update the Spec with the default network name so we don't clear that
 		// here if using the default network.
@@ -425,8 +696,7 @@ func createNetOPNetworkInterface(
 		return nil, err
 	}
 
-	netIf, err = waitForReadyNetworkInterface(vmCtx, client, netIf.Name)
-	if err != nil {
+	if err := netOPNetworkInterfaceReady(netIf); err != nil {
 		return nil, err
 	}
 
@@ -461,9 +731,17 @@ func netOpNetIfToResult(
 	switch netIf.Status.IPAssignmentMode {
 	case netopv1alpha1.NetworkInterfaceIPAssignmentModeDHCP:
 		result.DHCP4 = true
+		result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP
 	case netopv1alpha1.NetworkInterfaceIPAssignmentModeNone:
 		result.NoIPAM = true
+		result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeNone
 	default: // netopv1alpha1.NetworkInterfaceIPAssignmentModeStaticPool
+		// When unset, NetOP assumes StaticPool if an IP is assigned, and
+		// otherwise DHCP.
+		result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeIPAM
+		if netIf.Status.IPAssignmentMode == "" && len(netIf.Status.IPConfigs) == 0 {
+			result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP
+		}
 		for _, ip := range netIf.Status.IPConfigs {
 			ipConfig := NetworkInterfaceIPConfig{
 				IPCIDR:  ipCIDRNotation(ip.IP, ip.SubnetMask, ip.IPFamily == corev1.IPv4Protocol),
@@ -489,40 +767,24 @@ func findNetOPCondition(
 	return nil
 }
 
-func waitForReadyNetworkInterface(
-	vmCtx pkgctx.VirtualMachineContext,
-	client ctrlclient.Client,
-	name string) (*netopv1alpha1.NetworkInterface, error) {
-
-	netIf := &netopv1alpha1.NetworkInterface{}
-	netIfKey := types.NamespacedName{Namespace: vmCtx.VM.Namespace, Name: name}
-
-	// TODO: Watch() this type instead.
-	err := wait.PollUntilContextTimeout(vmCtx, retryInterval, RetryTimeout, true, func(_ context.Context) (bool, error) {
-		if err := client.Get(vmCtx, netIfKey, netIf); err != nil {
-			return false, ctrlclient.IgnoreNotFound(err)
-		}
-
-		cond := findNetOPCondition(netIf, netopv1alpha1.NetworkInterfaceReady)
-		return cond != nil && cond.Status == corev1.ConditionTrue, nil
-	})
+// netOPNetworkInterfaceReady returns a NetworkNotReadyError if the
+// NetworkInterface has not yet been reconciled by NetOP. The VM is requeued
+// by the watch on the NetworkInterface resources once the object is updated.
+func netOPNetworkInterfaceReady(netIf *netopv1alpha1.NetworkInterface) error {
+	if cond := findNetOPCondition(netIf, netopv1alpha1.NetworkInterfaceReady); cond != nil && cond.Status == corev1.ConditionTrue {
+		return nil
+	}
 
-	if err != nil {
-		if wait.Interrupted(err) {
-			// Try to return a more meaningful error when timed out.
-			if cond := findNetOPCondition(netIf, netopv1alpha1.NetworkInterfaceFailure); cond != nil && cond.Status == corev1.ConditionTrue {
-				return nil, fmt.Errorf("network interface failure: %s - %s", cond.Reason, cond.Message)
-			}
-			if cond := findNetOPCondition(netIf, netopv1alpha1.NetworkInterfaceReady); cond != nil && cond.Status == corev1.ConditionFalse {
-				return nil, fmt.Errorf("network interface is not ready: %s - %s", cond.Reason, cond.Message)
-			}
-			return nil, fmt.Errorf("network interface is not ready yet")
-		}
+	if cond := findNetOPCondition(netIf, netopv1alpha1.NetworkInterfaceFailure); cond != nil && cond.Status == corev1.ConditionTrue {
+		return fmt.Errorf("network interface failure: %s - %s", cond.Reason, cond.Message)
+	}
 
-		return nil, err
+	notReady := pkgerr.NetworkNotReadyError{Name: netIf.Name}
+	if cond := findNetOPCondition(netIf, netopv1alpha1.NetworkInterfaceReady); cond != nil && cond.Status == corev1.ConditionFalse {
+		notReady.Message = fmt.Sprintf("network interface is not ready: %s - %s", cond.Reason, cond.Message)
 	}
 
-	return netIf, nil
+	return notReady
 }
 
 // NCPCRName returns the name to be used for the NCP VirtualNetworkInterface CR.
@@ -598,6 +860,7 @@ func createNCPNetworkInterface(
 		}
 		vnetIf.Labels[VMNameLabel] = vmCtx.VM.Name
 		vnetIf.Labels[VMInterfaceNameLabel] = interfaceSpec.Name
+		setIPAssignmentModeAnnotation(vnetIf, interfaceSpec)
 
 		vnetIf.Spec.VirtualNetwork = networkRefName
 		return nil
@@ -607,8 +870,7 @@ func createNCPNetworkInterface(
 		return nil, err
 	}
 
-	vnetIf, err = waitForReadyNCPNetworkInterface(vmCtx, client, vnetIf.Name)
-	if err != nil {
+	if err := ncpNetworkInterfaceReady(vnetIf); err != nil {
 		return nil, err
 	}
 
@@ -646,7 +908,9 @@ func ncpNetIfToResult(
 
 	if ipAddress := vnetIf.Status.IPAddresses; len(ipAddress) == 0 || (len(ipAddress) == 1 && ipAddress[0].IP == "") {
 		result.DHCP4 = true
+		result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP
 	} else {
+		result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeIPAM
 		for _, ipAddr := range ipAddress {
 			if ipAddr.IP == "" {
 				continue
@@ -727,6 +991,7 @@ func createVPCNetworkInterface(
 			vpcSubnetPort.Annotations = make(map[string]string)
 		}
 		vpcSubnetPort.Annotations[constants.VPCAttachmentRef] = "virtualmachine/" + vmCtx.VM.Name + "/" + interfaceSpec.Name
+		setIPAssignmentModeAnnotation(vpcSubnetPort, interfaceSpec)
 
 		vpcSubnetPort.Spec.AddressBindings = nil
 
@@ -794,8 +1059,7 @@ func createVPCNetworkInterface(
 		return nil, err
 	}
 
-	vpcSubnetPort, err = waitForReadyVPCSubnetPort(vmCtx, client, vpcSubnetPort.Name)
-	if err != nil {
+	if err := vpcSubnetPortReady(vpcSubnetPort); err != nil {
 		return nil, err
 	}
 
@@ -851,98 +1115,62 @@ func vpcSubnetPortToResult(
 	if len(result.IPConfigs) == 0 {
 		if !subnetPort.Status.NetworkInterfaceConfig.DHCPDeactivatedOnSubnet {
 			result.DHCP4 = true
+			result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP
 		} else {
 			result.NoIPAM = true
+			result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeNone
 		}
+	} else {
+		result.IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeIPAM
 	}
 
 	return result, nil
 }
 
-func waitForReadyVPCSubnetPort(
-	vmCtx pkgctx.VirtualMachineContext,
-	client ctrlclient.Client,
-	name string) (*vpcv1alpha1.SubnetPort, error) {
-
-	subnetPort := &vpcv1alpha1.SubnetPort{}
-	subnetPortKey := types.NamespacedName{Namespace: vmCtx.VM.Namespace, Name: name}
-
-	// TODO: Watch() this type instead.
-	err := wait.PollUntilContextTimeout(vmCtx, retryInterval, RetryTimeout, true, func(_ context.Context) (bool, error) {
-		if err := client.Get(vmCtx, subnetPortKey, subnetPort); err != nil {
-			return false, ctrlclient.IgnoreNotFound(err)
-		}
-
-		for _, condition := range subnetPort.Status.Conditions {
-			if condition.Type == vpcv1alpha1.Ready && condition.Status == corev1.ConditionTrue {
-				return true, nil
+// vpcSubnetPortReady returns a NetworkNotReadyError if the SubnetPort has
+// not yet been reconciled by NSX VPC. The VM is requeued by the watch on the
+// SubnetPort resources once the object is updated.
+func vpcSubnetPortReady(subnetPort *vpcv1alpha1.SubnetPort) error {
+	for _, cond := range subnetPort.Status.Conditions {
+		if cond.Type == vpcv1alpha1.Ready {
+			if cond.Status == corev1.ConditionTrue {
+				return nil
 			}
-		}
-
-		return false, nil
-	})
-
-	if err != nil {
-		if wait.Interrupted(err) {
-			// Try to return a more meaningful error when timed out.
-			for _, cond := range subnetPort.Status.Conditions {
-				if cond.Type == vpcv1alpha1.Ready && cond.Status != corev1.ConditionTrue {
-					return nil, fmt.Errorf("network interface is not ready: %s - %s", cond.Reason, cond.Message)
-				}
+			return pkgerr.NetworkNotReadyError{
+				Name:    subnetPort.Name,
+				Message: fmt.Sprintf("network interface is not ready: %s - %s", cond.Reason, cond.Message),
 			}
-			return nil, fmt.Errorf("network interface is not ready yet")
 		}
-
-		return nil, err
 	}
 
-	return subnetPort, nil
+	return pkgerr.NetworkNotReadyError{Name: subnetPort.Name}
 }
 
-func waitForReadyNCPNetworkInterface(
-	vmCtx pkgctx.VirtualMachineContext,
-	client ctrlclient.Client,
-	name string) (*ncpv1alpha1.VirtualNetworkInterface, error) {
-
-	vnetIf := &ncpv1alpha1.VirtualNetworkInterface{}
-	vnetIfKey := types.NamespacedName{Namespace: vmCtx.VM.Namespace, Name: name}
-
-	// TODO: Watch() this type instead.
-	err := wait.PollUntilContextTimeout(vmCtx, retryInterval, RetryTimeout, true, func(_ context.Context) (bool, error) {
-		if err := client.Get(vmCtx, vnetIfKey, vnetIf); err != nil {
-			return false, ctrlclient.IgnoreNotFound(err)
-		}
-
-		for _, condition := range vnetIf.Status.Conditions {
-			// TODO: Does NCP define condition constants?
-			if strings.Contains(condition.Type, "Ready") && strings.Contains(condition.Status, "True") {
-				return true, nil
-			}
-		}
-
-		return false, nil
-	})
-
-	if err != nil {
-		if wait.Interrupted(err) {
-			// Try to return a more meaningful error when timed out.
-			for _, cond := range vnetIf.Status.Conditions {
-				if strings.Contains(cond.Type, "Ready") && !strings.Contains(cond.Status, "True") {
-					return nil, fmt.Errorf("network interface is not ready: %s - %s", cond.Reason, cond.Message)
+// ncpNetworkInterfaceReady returns a NetworkNotReadyError if the
+// VirtualNetworkInterface has not yet been reconciled by NCP. The VM is
+// requeued by the watch on the VirtualNetworkInterface resources once the
+// object is updated.
+func ncpNetworkInterfaceReady(vnetIf *ncpv1alpha1.VirtualNetworkInterface) error {
+	for _, cond := range vnetIf.Status.Conditions {
+		// TODO: Does NCP define condition constants?
+		if strings.Contains(cond.Type, "Ready") {
+			if !strings.Contains(cond.Status, "True") {
+				return pkgerr.NetworkNotReadyError{
+					Name:    vnetIf.Name,
+					Message: fmt.Sprintf("network interface is not ready: %s - %s", cond.Reason, cond.Message),
 				}
 			}
-			// TODO: NCP also has an annotation but that usually doesn't provide very useful details.
-			return nil, fmt.Errorf("network interface is not ready yet")
-		}
 
-		return nil, err
-	}
+			if vnetIf.Status.ProviderStatus == nil {
+				return fmt.Errorf("network interface is ready but does not have provider status")
+			}
 
-	if vnetIf.Status.ProviderStatus == nil {
-		return nil, fmt.Errorf("network interface is ready but does not have provider status")
+			return nil
+		}
 	}
 
-	return vnetIf, nil
+	// TODO: NCP also has an annotation but that usually doesn't provide very useful details.
+	return pkgerr.NetworkNotReadyError{Name: vnetIf.Name}
 }
 
 // ipCIDRNotation takes the IP and subnet mask and returns the IP in CIDR notation.
@@ -976,11 +1204,18 @@ func CreateDefaultEthCard(
 		return nil, fmt.Errorf("unable to get ethernet card backing info for network %v: %w", result.Backing.Reference(), err)
 	}
 
-	dev, err := object.EthernetCardTypes().CreateEthernetCard(defaultEthernetCardType, backing)
+	cardType := defaultEthernetCardType
+	if result.AdapterType == vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV {
+		cardType = sriovEthernetCardType
+	}
+
+	dev, err := object.EthernetCardTypes().CreateEthernetCard(cardType, backing)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create ethernet card network %v: %w", result.Backing.Reference(), err)
 	}
 
+	applyAdapterTypeToEthCard(dev, result)
+
 	ethCard := dev.(vimtypes.BaseVirtualEthernetCard).GetVirtualEthernetCard()
 	ethCard.ExternalId = result.ExternalID
 	if result.MacAddress != "" {
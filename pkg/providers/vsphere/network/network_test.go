@@ -5,18 +5,21 @@
 package network_test
 
 import (
-	"time"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	netopv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	nsxv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 
 	ncpv1alpha1 "github.com/vmware-tanzu/vm-operator/external/ncp/api/v1alpha1"
@@ -25,6 +28,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
@@ -258,6 +262,114 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 					})
 				})
 			})
+
+			Context("SRIOV adapter type", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].AdapterType = vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV
+					networkSpec.Interfaces[0].PhysicalFunction = "0000:3b:00.0"
+				})
+
+				It("returns success", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(results.Results).To(HaveLen(1))
+
+					result := results.Results[0]
+					Expect(result.AdapterType).To(Equal(vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV))
+					Expect(result.PhysicalFunction).To(Equal("0000:3b:00.0"))
+
+					By("creates an SR-IOV ethernet card", func() {
+						dev, err := network.CreateDefaultEthCard(ctx, &result)
+						Expect(err).ToNot(HaveOccurred())
+						card, ok := dev.(*vimtypes.VirtualSriovEthernetCard)
+						Expect(ok).To(BeTrue())
+						Expect(card.SriovBacking).ToNot(BeNil())
+						Expect(card.SriovBacking.PhysicalFunctionBacking.Id).To(Equal("0000:3b:00.0"))
+						Expect(network.MatchEthCardAdapterType(dev, result.AdapterType, result.PhysicalFunction)).To(BeTrue())
+					})
+				})
+			})
+
+			Context("DirectPath adapter type", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].AdapterType = vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath
+				})
+
+				It("creates a vmxnet3 ethernet card with UPT enabled", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(results.Results).To(HaveLen(1))
+
+					result := results.Results[0]
+					dev, err := network.CreateDefaultEthCard(ctx, &result)
+					Expect(err).ToNot(HaveOccurred())
+					card, ok := dev.(*vimtypes.VirtualVmxnet3)
+					Expect(ok).To(BeTrue())
+					Expect(card.UptCompatibilityEnabled).To(HaveValue(BeTrue()))
+				})
+			})
+			Context("IP pool", func() {
+				const poolName = "my-ip-pool"
+
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].IPPoolName = poolName
+					networkSpec.Interfaces[0].DHCP6 = false
+				})
+
+				When("the pool does not exist", func() {
+					It("returns NetworkNotReadyError", func() {
+						Expect(err).To(HaveOccurred())
+						Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
+						Expect(err.Error()).To(ContainSubstring("waiting for VirtualMachineIPPool my-ip-pool"))
+					})
+				})
+
+				When("the pool has not allocated addresses for the interface", func() {
+					BeforeEach(func() {
+						initObjects = append(initObjects, builder.DummyVirtualMachineIPPool(vm.Namespace, poolName))
+					})
+
+					It("returns NetworkNotReadyError", func() {
+						Expect(err).To(HaveOccurred())
+						Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
+					})
+				})
+
+				When("the pool has allocated addresses for the interface", func() {
+					BeforeEach(func() {
+						pool := builder.DummyVirtualMachineIPPool(vm.Namespace, poolName)
+						pool.Status.Allocations = []vmopv1.VirtualMachineIPPoolAllocation{
+							{
+								VirtualMachineName: vm.Name,
+								InterfaceName:      "eth0",
+								Addresses:          []string{"192.168.1.2/24"},
+								Gateway4:           "192.168.1.1",
+								MACAddr:            "00:50:56:00:00:01",
+							},
+						}
+						initObjects = append(initObjects, pool)
+					})
+
+					It("returns success with the allocated addresses", func() {
+						Expect(err).ToNot(HaveOccurred())
+						Expect(results.Results).To(HaveLen(1))
+
+						result := results.Results[0]
+						Expect(result.MacAddress).To(Equal("00:50:56:00:00:01"))
+						Expect(result.IPConfigs).To(Equal([]network.NetworkInterfaceIPConfig{
+							{
+								IPCIDR:  "192.168.1.2/24",
+								IsIPv4:  true,
+								Gateway: "192.168.1.1",
+							},
+						}))
+						Expect(result.IPAssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeStatic))
+
+						By("does not modify the network spec", func() {
+							Expect(networkSpec.Interfaces[0].Addresses).To(BeEmpty())
+							Expect(networkSpec.Interfaces[0].MACAddr).To(BeEmpty())
+						})
+					})
+				})
+			})
 		})
 
 		Context("network does not exist", func() {
@@ -285,7 +397,6 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 		)
 
 		BeforeEach(func() {
-			network.RetryTimeout = 1 * time.Second
 			testConfig.WithNetworkEnv = builder.NetworkEnvVDS
 		})
 
@@ -307,6 +418,7 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("network interface is not ready yet"))
+				Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
 				Expect(results.Results).To(BeEmpty())
 
 				var externalID string
@@ -381,6 +493,7 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 				Expect(ipConfig.IPCIDR).To(Equal("fd1a:6c85:79fe:7c98::f/56"))
 				Expect(ipConfig.IsIPv4).To(BeFalse())
 				Expect(ipConfig.Gateway).To(Equal("fd1a:6c85:79fe:7c98:0000:0000:0000:0001"))
+				Expect(result.IPAssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeIPAM))
 			})
 
 			When("interfaceSpec provides MAC address", func() {
@@ -465,6 +578,7 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 					Expect(result.DHCP6).To(BeFalse())
 					Expect(result.NoIPAM).To(BeFalse())
 					Expect(result.IPConfigs).To(BeEmpty())
+					Expect(result.IPAssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP))
 				})
 			})
 
@@ -507,6 +621,135 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 					Expect(result.DHCP6).To(BeFalse())
 					Expect(result.NoIPAM).To(BeTrue())
 					Expect(result.IPConfigs).To(BeEmpty())
+					Expect(result.IPAssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeNone))
+				})
+			})
+
+			When("interfaceSpec enables DHCP4", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].DHCP4 = true
+				})
+
+				It("returns success without IPAM", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("network interface is not ready yet"))
+					Expect(results.Results).To(BeEmpty())
+
+					By("simulate successful NetOP reconcile", func() {
+						netInterface := &netopv1alpha1.NetworkInterface{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      network.NetOPCRName(vm.Name, networkName, interfaceName, false),
+								Namespace: vm.Namespace,
+							},
+						}
+						Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(netInterface), netInterface)).To(Succeed())
+						Expect(netInterface.Annotations).To(HaveKeyWithValue(network.IPAssignmentModeAnnotation, network.IPAssignmentModeDHCP))
+
+						// Simulate a provider that still reserved IPs.
+						netInterface.Status.NetworkID = ctx.NetworkRef.Reference().Value
+						netInterface.Status.IPConfigs = []netopv1alpha1.IPConfig{
+							{
+								IP:         "192.168.1.110",
+								IPFamily:   corev1.IPv4Protocol,
+								Gateway:    "192.168.1.1",
+								SubnetMask: "255.255.255.0",
+							},
+							{
+								IP:         "fd1a:6c85:79fe:7c98:0000:0000:0000:000f",
+								IPFamily:   corev1.IPv6Protocol,
+								Gateway:    "fd1a:6c85:79fe:7c98:0000:0000:0000:0001",
+								SubnetMask: "ffff:ffff:ffff:ff00:0000:0000:0000:0000",
+							},
+						}
+						netInterface.Status.Conditions = []netopv1alpha1.NetworkInterfaceCondition{
+							{
+								Type:   netopv1alpha1.NetworkInterfaceReady,
+								Status: corev1.ConditionTrue,
+							},
+						}
+						Expect(ctx.Client.Status().Update(ctx, netInterface)).To(Succeed())
+					})
+
+					results, err = network.CreateAndWaitForNetworkInterfaces(
+						vmCtx,
+						ctx.Client,
+						ctx.VCClient.Client,
+						ctx.Finder,
+						nil,
+						networkSpec)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(results.Results).To(HaveLen(1))
+					result := results.Results[0]
+					Expect(result.DHCP4).To(BeTrue())
+					Expect(result.NoIPAM).To(BeFalse())
+					Expect(result.IPAssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP))
+					Expect(result.IPConfigs).To(HaveLen(1))
+					Expect(result.IPConfigs[0].IsIPv4).To(BeFalse())
+				})
+
+				When("DHCP4 is later disabled", func() {
+					It("removes the annotation", func() {
+						Expect(err).To(HaveOccurred())
+
+						networkSpec.Interfaces[0].DHCP4 = false
+						_, err = network.CreateAndWaitForNetworkInterfaces(
+							vmCtx,
+							ctx.Client,
+							ctx.VCClient.Client,
+							ctx.Finder,
+							nil,
+							networkSpec)
+						Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
+
+						netInterface := &netopv1alpha1.NetworkInterface{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      network.NetOPCRName(vm.Name, networkName, interfaceName, false),
+								Namespace: vm.Namespace,
+							},
+						}
+						Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(netInterface), netInterface)).To(Succeed())
+						Expect(netInterface.Annotations).ToNot(HaveKey(network.IPAssignmentModeAnnotation))
+					})
+				})
+			})
+
+			When("interfaceSpec provides addresses and gateways", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].Addresses = []string{"192.168.1.55/24"}
+					networkSpec.Interfaces[0].Gateway4 = "192.168.1.1"
+				})
+
+				It("creates the NetworkInterface without IPAM", func() {
+					Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
+
+					netInterface := &netopv1alpha1.NetworkInterface{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      network.NetOPCRName(vm.Name, networkName, interfaceName, false),
+							Namespace: vm.Namespace,
+						},
+					}
+					Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(netInterface), netInterface)).To(Succeed())
+					Expect(netInterface.Annotations).To(HaveKeyWithValue(network.IPAssignmentModeAnnotation, network.IPAssignmentModeNone))
+				})
+
+				When("the gateway is not provided", func() {
+					BeforeEach(func() {
+						networkSpec.Interfaces[0].Gateway4 = ""
+					})
+
+					It("creates the NetworkInterface with IPAM so the gateway can be backfilled", func() {
+						Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
+
+						netInterface := &netopv1alpha1.NetworkInterface{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      network.NetOPCRName(vm.Name, networkName, interfaceName, false),
+								Namespace: vm.Namespace,
+							},
+						}
+						Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(netInterface), netInterface)).To(Succeed())
+						Expect(netInterface.Annotations).ToNot(HaveKey(network.IPAssignmentModeAnnotation))
+					})
 				})
 			})
 
@@ -613,7 +856,6 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 		)
 
 		BeforeEach(func() {
-			network.RetryTimeout = 1 * time.Second
 			testConfig.WithNetworkEnv = builder.NetworkEnvNSXT
 		})
 
@@ -635,6 +877,7 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("network interface is not ready yet"))
+				Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
 				Expect(results.Results).To(BeEmpty())
 
 				By("simulate successful NCP reconcile", func() {
@@ -649,6 +892,7 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 					Expect(netInterface.Labels).To(HaveKeyWithValue(network.VMNameLabel, vm.Name))
 					Expect(netInterface.Labels).To(HaveKeyWithValue(network.VMInterfaceNameLabel, interfaceName))
 					Expect(netInterface.Spec.VirtualNetwork).To(Equal(networkName))
+					Expect(netInterface.Annotations).ToNot(HaveKey(network.IPAssignmentModeAnnotation))
 
 					netInterface.Status.InterfaceID = interfaceID
 					netInterface.Status.MacAddress = macAddress
@@ -726,6 +970,63 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 				})
 			})
 
+			When("interfaceSpec enables DHCP4", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].DHCP4 = true
+				})
+
+				It("returns success without IPAM", func() {
+					Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
+
+					By("simulate successful NCP reconcile", func() {
+						netInterface := &ncpv1alpha1.VirtualNetworkInterface{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      network.NCPCRName(vm.Name, networkName, interfaceName, false),
+								Namespace: vm.Namespace,
+							},
+						}
+						Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(netInterface), netInterface)).To(Succeed())
+						Expect(netInterface.Annotations).To(HaveKeyWithValue(network.IPAssignmentModeAnnotation, network.IPAssignmentModeDHCP))
+
+						// Simulate a provider that still reserved an IP.
+						netInterface.Status.InterfaceID = interfaceID
+						netInterface.Status.MacAddress = macAddress
+						netInterface.Status.ProviderStatus = &ncpv1alpha1.VirtualNetworkInterfaceProviderStatus{
+							NsxLogicalSwitchID: builder.GetNsxTLogicalSwitchUUID(0),
+						}
+						netInterface.Status.IPAddresses = []ncpv1alpha1.VirtualNetworkInterfaceIP{
+							{
+								IP:         "192.168.1.110",
+								Gateway:    "192.168.1.1",
+								SubnetMask: "255.255.255.0",
+							},
+						}
+						netInterface.Status.Conditions = []ncpv1alpha1.VirtualNetworkCondition{
+							{
+								Type:   "Ready",
+								Status: "True",
+							},
+						}
+						Expect(ctx.Client.Status().Update(ctx, netInterface)).To(Succeed())
+					})
+
+					results, err = network.CreateAndWaitForNetworkInterfaces(
+						vmCtx,
+						ctx.Client,
+						ctx.VCClient.Client,
+						ctx.Finder,
+						nil,
+						networkSpec)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(results.Results).To(HaveLen(1))
+					result := results.Results[0]
+					Expect(result.DHCP4).To(BeTrue())
+					Expect(result.IPConfigs).To(BeEmpty())
+					Expect(result.IPAssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP))
+				})
+			})
+
 			When("v1a1 NCP network interface exists", func() {
 				BeforeEach(func() {
 					vnetIf := &ncpv1alpha1.VirtualNetworkInterface{
@@ -848,7 +1149,6 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 		)
 
 		BeforeEach(func() {
-			network.RetryTimeout = 1 * time.Second
 			testConfig.WithNetworkEnv = builder.NetworkEnvVPC
 		})
 
@@ -874,6 +1174,7 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("network interface is not ready yet"))
+				Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
 				Expect(results.Results).To(BeEmpty())
 
 				By("simulate successful NSX Operator reconcile", func() {
@@ -1321,6 +1622,86 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 				})
 			})
 
+			When("interfaceSpec enables DHCP4 and provides MAC address", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].DHCP4 = true
+					networkSpec.Interfaces[0].MACAddr = macAddress
+				})
+
+				It("creates SubnetPort without an IP address binding", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
+
+					subnetPort := &vpcv1alpha1.SubnetPort{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      network.VPCCRName(vm.Name, networkName, interfaceName),
+							Namespace: vm.Namespace,
+						},
+					}
+					Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(subnetPort), subnetPort)).To(Succeed())
+					Expect(subnetPort.Annotations).To(HaveKeyWithValue(network.IPAssignmentModeAnnotation, network.IPAssignmentModeDHCP))
+					Expect(subnetPort.Spec.AddressBindings).To(Equal([]vpcv1alpha1.PortAddressBinding{
+						{
+							MACAddress: strings.ToLower(macAddress),
+						},
+					}))
+				})
+			})
+
+			When("interfaceSpec enables DHCP4", func() {
+				BeforeEach(func() {
+					networkSpec.Interfaces[0].DHCP4 = true
+				})
+
+				It("returns success without IPAM", func() {
+					Expect(pkgerr.IsNetworkNotReadyError(err)).To(BeTrue())
+
+					By("simulate successful NSX Operator reconcile", func() {
+						subnetPort := &vpcv1alpha1.SubnetPort{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      network.VPCCRName(vm.Name, networkName, interfaceName),
+								Namespace: vm.Namespace,
+							},
+						}
+						Expect(ctx.Client.Get(ctx, client.ObjectKeyFromObject(subnetPort), subnetPort)).To(Succeed())
+						Expect(subnetPort.Annotations).To(HaveKeyWithValue(network.IPAssignmentModeAnnotation, network.IPAssignmentModeDHCP))
+						Expect(subnetPort.Spec.AddressBindings).To(BeEmpty())
+
+						// Simulate a provider that still allocated an IP.
+						subnetPort.Status.Attachment.ID = interfaceID
+						subnetPort.Status.NetworkInterfaceConfig.LogicalSwitchUUID = builder.GetVPCTLogicalSwitchUUID(0)
+						subnetPort.Status.NetworkInterfaceConfig.IPAddresses = []vpcv1alpha1.NetworkInterfaceIPAddress{
+							{
+								IPAddress: "192.168.1.110/24",
+								Gateway:   "192.168.1.1",
+							},
+						}
+						subnetPort.Status.Conditions = []vpcv1alpha1.Condition{
+							{
+								Type:   vpcv1alpha1.Ready,
+								Status: corev1.ConditionTrue,
+							},
+						}
+						Expect(ctx.Client.Status().Update(ctx, subnetPort)).To(Succeed())
+					})
+
+					results, err = network.CreateAndWaitForNetworkInterfaces(
+						vmCtx,
+						ctx.Client,
+						ctx.VCClient.Client,
+						ctx.Finder,
+						nil,
+						networkSpec)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(results.Results).To(HaveLen(1))
+					result := results.Results[0]
+					Expect(result.DHCP4).To(BeTrue())
+					Expect(result.IPConfigs).To(BeEmpty())
+					Expect(result.IPAssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP))
+				})
+			})
+
 			Context("DHCP is enabled", func() {
 				It("returns success", func() {
 					Expect(err).To(HaveOccurred())
@@ -1422,6 +1803,154 @@ var _ = Describe("CreateAndWaitForNetworkInterfaces", Label(testlabels.VCSim), f
 			})
 		})
 	})
+
+	Context("Firewall", func() {
+		var policyKey client.ObjectKey
+
+		BeforeEach(func() {
+			networkSpec.Firewall = &vmopv1.VirtualMachineNetworkFirewallSpec{
+				Ingress: []vmopv1.VirtualMachineNetworkFirewallRule{
+					{
+						Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolTCP,
+						Port:     ptr.To[int32](22),
+						CIDRs:    []string{"192.168.1.0/24"},
+					},
+					{
+						Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolUDP,
+						Port:     ptr.To[int32](8000),
+						EndPort:  ptr.To[int32](8080),
+					},
+				},
+			}
+			policyKey = client.ObjectKey{
+				Namespace: vm.Namespace,
+				Name:      network.SecurityPolicyName(vm.Name),
+			}
+		})
+
+		When("the network provider is VPC", func() {
+			BeforeEach(func() {
+				testConfig.WithNetworkEnv = builder.NetworkEnvVPC
+			})
+
+			It("creates a SecurityPolicy for the VM and deletes it when the rules are removed", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				policy := &vpcv1alpha1.SecurityPolicy{}
+				Expect(ctx.Client.Get(ctx, policyKey, policy)).To(Succeed())
+				Expect(metav1.IsControlledBy(policy, vm)).To(BeTrue())
+				Expect(policy.Spec.AppliedTo).To(Equal([]vpcv1alpha1.SecurityPolicyTarget{
+					{
+						VMSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{network.VMNameLabel: vm.Name},
+						},
+					},
+				}))
+				Expect(policy.Spec.Rules).To(Equal([]vpcv1alpha1.SecurityPolicyRule{
+					{
+						Name:      "ingress-0",
+						Action:    ptr.To(vpcv1alpha1.RuleActionAllow),
+						Direction: ptr.To(vpcv1alpha1.RuleDirectionIn),
+						Sources: []vpcv1alpha1.SecurityPolicyPeer{
+							{
+								IPBlocks: []vpcv1alpha1.IPBlock{{CIDR: "192.168.1.0/24"}},
+							},
+						},
+						Ports: []vpcv1alpha1.SecurityPolicyPort{
+							{
+								Protocol: corev1.ProtocolTCP,
+								Port:     intstr.FromInt32(22),
+							},
+						},
+					},
+					{
+						Name:      "ingress-1",
+						Action:    ptr.To(vpcv1alpha1.RuleActionAllow),
+						Direction: ptr.To(vpcv1alpha1.RuleDirectionIn),
+						Ports: []vpcv1alpha1.SecurityPolicyPort{
+							{
+								Protocol: corev1.ProtocolUDP,
+								Port:     intstr.FromInt32(8000),
+								EndPort:  8080,
+							},
+						},
+					},
+					{
+						Name:      "default-deny-ingress",
+						Action:    ptr.To(vpcv1alpha1.RuleActionDrop),
+						Direction: ptr.To(vpcv1alpha1.RuleDirectionIn),
+					},
+				}))
+
+				networkSpec.Firewall = nil
+				_, err = network.CreateAndWaitForNetworkInterfaces(
+					vmCtx,
+					ctx.Client,
+					ctx.VCClient.Client,
+					ctx.Finder,
+					nil,
+					networkSpec)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = ctx.Client.Get(ctx, policyKey, &vpcv1alpha1.SecurityPolicy{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			When("the SecurityPolicy is not controlled by the VM", func() {
+				BeforeEach(func() {
+					networkSpec.Firewall = nil
+					initObjects = append(initObjects, &vpcv1alpha1.SecurityPolicy{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: policyKey.Namespace,
+							Name:      policyKey.Name,
+						},
+					})
+				})
+
+				It("does not delete the SecurityPolicy", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(ctx.Client.Get(ctx, policyKey, &vpcv1alpha1.SecurityPolicy{})).To(Succeed())
+				})
+			})
+		})
+
+		When("the network provider is NSX-T", func() {
+			BeforeEach(func() {
+				testConfig.WithNetworkEnv = builder.NetworkEnvNSXT
+			})
+
+			It("creates a legacy SecurityPolicy for the VM", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				policy := &nsxv1alpha1.SecurityPolicy{}
+				Expect(ctx.Client.Get(ctx, policyKey, policy)).To(Succeed())
+				Expect(metav1.IsControlledBy(policy, vm)).To(BeTrue())
+				Expect(policy.Spec.AppliedTo).To(HaveLen(1))
+				Expect(policy.Spec.Rules).To(HaveLen(3))
+				Expect(policy.Spec.Rules[0].Sources[0].IPBlocks).To(Equal([]nsxv1alpha1.IPBlock{{CIDR: "192.168.1.0/24"}}))
+				Expect(policy.Spec.Rules[1].Ports).To(Equal([]nsxv1alpha1.SecurityPolicyPort{
+					{
+						Protocol: corev1.ProtocolUDP,
+						Port:     intstr.FromInt32(8000),
+						EndPort:  8080,
+					},
+				}))
+				Expect(policy.Spec.Rules[2].Action).To(HaveValue(Equal(nsxv1alpha1.RuleActionDrop)))
+			})
+		})
+
+		When("the network provider is VDS", func() {
+			BeforeEach(func() {
+				testConfig.WithNetworkEnv = builder.NetworkEnvVDS
+			})
+
+			It("does not create a SecurityPolicy", func() {
+				Expect(err).ToNot(HaveOccurred())
+				err = ctx.Client.Get(ctx, policyKey, &vpcv1alpha1.SecurityPolicy{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+	})
 })
 
 var _ = Describe("SetNetworkInterfaceOwnerRef", func() {
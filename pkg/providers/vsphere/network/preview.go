@@ -0,0 +1,101 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"net"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+// PreviewNetworkInterfaces returns the network interface results for the VM
+// without creating or waiting on any network interface CRs. The IPAM info that
+// would normally come from the network provider is taken from the VM's
+// status.network.config, which holds the configuration last applied to the
+// guest. This is only meant to be used to preview the bootstrap data.
+func PreviewNetworkInterfaces(vm *vmopv1.VirtualMachine) NetworkInterfaceResults {
+	networkSpec := vm.Spec.Network
+	if networkSpec == nil || networkSpec.Disabled {
+		return NetworkInterfaceResults{}
+	}
+	networkSpec = networkSpec.DeepCopy()
+
+	var defaultToGlobalNameservers, defaultToGlobalSearchDomains bool
+	if bootstrap := vm.Spec.Bootstrap; bootstrap != nil && bootstrap.CloudInit != nil {
+		defaultToGlobalNameservers = ptr.DerefWithDefault(bootstrap.CloudInit.UseGlobalNameserversAsDefault, true)
+		defaultToGlobalSearchDomains = ptr.DerefWithDefault(bootstrap.CloudInit.UseGlobalSearchDomainsAsDefault, true)
+	}
+
+	configStatus := map[string]vmopv1.VirtualMachineNetworkConfigInterfaceStatus{}
+	if vm.Status.Network != nil && vm.Status.Network.Config != nil {
+		for _, s := range vm.Status.Network.Config.Interfaces {
+			configStatus[s.Name] = s
+		}
+	}
+
+	macByName := map[string]string{}
+	if vm.Status.Network != nil {
+		for _, s := range vm.Status.Network.Interfaces {
+			if s.IP != nil && s.IP.MACAddr != "" {
+				macByName[s.Name] = s.IP.MACAddr
+			}
+		}
+	}
+
+	results := make([]NetworkInterfaceResult, 0, len(networkSpec.Interfaces))
+	for i := range networkSpec.Interfaces {
+		interfaceSpec := &networkSpec.Interfaces[i]
+
+		result := NetworkInterfaceResult{
+			ObjectName: interfaceSpec.Name,
+			MacAddress: interfaceSpec.MACAddr,
+		}
+		if result.MacAddress == "" {
+			result.MacAddress = macByName[interfaceSpec.Name]
+		}
+
+		if s, ok := configStatus[interfaceSpec.Name]; ok && s.IP != nil {
+			for _, addr := range s.IP.Addresses {
+				ip, _, err := net.ParseCIDR(addr)
+				if err != nil {
+					continue
+				}
+
+				ipConfig := NetworkInterfaceIPConfig{
+					IPCIDR: addr,
+					IsIPv4: ip.To4() != nil,
+				}
+				if ipConfig.IsIPv4 {
+					ipConfig.Gateway = s.IP.Gateway4
+				} else {
+					ipConfig.Gateway = s.IP.Gateway6
+				}
+
+				result.IPConfigs = append(result.IPConfigs, ipConfig)
+			}
+		}
+
+		applyInterfaceSpecToResult(
+			networkSpec,
+			interfaceSpec,
+			defaultToGlobalNameservers,
+			defaultToGlobalSearchDomains,
+			&result)
+
+		results = append(results, result)
+	}
+
+	vlans, bonds := guestDeviceResults(
+		networkSpec,
+		defaultToGlobalNameservers,
+		defaultToGlobalSearchDomains)
+
+	return NetworkInterfaceResults{
+		Results: results,
+		VLANs:   vlans,
+		Bonds:   bonds,
+	}
+}
@@ -40,6 +40,11 @@ func ReconcileNetworkInterfaces(
 			currentEthCards = slices.Delete(currentEthCards, matchingIdx, matchingIdx+1)
 		} else {
 			existingIdx := findExistingEthCardForOrphanedCR(ctx, r.Name, results.OrphanedNetworkInterfaces, currentEthCards)
+			if existingIdx >= 0 && !matchEthCardsAdapterType(r.Device, currentEthCards[existingIdx]) {
+				// The adapter type of the interface changed so the existing device cannot
+				// be edited. Instead, it is removed below and a new device is added.
+				existingIdx = -1
+			}
 			if existingIdx >= 0 {
 				// As best we can, we determined that one of the VM's current ethernet card corresponds to a now
 				// unreferenced (orphaned) network interface CR with the same interface name. To keep the device
@@ -161,6 +166,10 @@ func FindMatchingEthCard(
 			continue
 		}
 
+		if !matchEthCardsAdapterType(ethCard.(vimtypes.BaseVirtualDevice), currentEthCards[idx]) {
+			continue
+		}
+
 		var backingMatch bool
 		switch a := ethDev.Backing.(type) {
 		case *vimtypes.VirtualEthernetCardNetworkBackingInfo:
@@ -22,10 +22,11 @@ func GroupPlacement(
 	client ctrlclient.Client,
 	vcClient *vim25.Client,
 	finder *find.Finder,
-	namespace, childRPName string,
+	namespace string,
+	constraints Constraints,
 	configSpecs []vimtypes.VirtualMachineConfigSpec) (map[string]Result, error) {
 
-	candidates, resourcePoolToZoneName, err := getPlacementCandidates(ctx, client, vcClient, "", namespace, childRPName)
+	candidates, resourcePoolToZoneName, err := getPlacementCandidates(ctx, client, vcClient, "", namespace, constraints.ChildRPName)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +35,11 @@ func GroupPlacement(
 		return nil, fmt.Errorf("no placement candidates available")
 	}
 
+	candidates, err = applyZoneConstraints(ctx, candidates, constraints)
+	if err != nil {
+		return nil, err
+	}
+
 	recommendations, err := getGroupPlacementRecommendations(ctx, vcClient, finder, candidates, configSpecs)
 	if err != nil {
 		return nil, err
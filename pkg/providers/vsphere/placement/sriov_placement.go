@@ -0,0 +1,214 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package placement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+// SRIOVRequirements returns the number of SR-IOV virtual functions required by
+// the ConfigSpec, keyed by the ID of the physical function the virtual
+// functions must be allocated from. Virtual functions that may be allocated
+// from any physical function are keyed by the empty string.
+func SRIOVRequirements(configSpec vimtypes.VirtualMachineConfigSpec) map[string]int {
+	return sriovVirtualFunctions(pkgutil.DevicesFromConfigSpec(&configSpec))
+}
+
+// HostSRIOVCapacity returns the number of SR-IOV virtual functions that are
+// available on a host, keyed by the ID of the physical function. The used
+// virtual functions are keyed the same way, and those used from an unknown
+// physical function are deducted from the physical function with the most
+// available virtual functions.
+func HostSRIOVCapacity(
+	pciPassthruInfo []vimtypes.BaseHostPciPassthruInfo,
+	used map[string]int) map[string]int {
+
+	available := map[string]int{}
+	for _, info := range pciPassthruInfo {
+		if sriov, ok := info.(*vimtypes.HostSriovInfo); ok && sriov.SriovActive {
+			available[sriov.Id] = int(sriov.NumVirtualFunction)
+		}
+	}
+
+	for pf, n := range used {
+		if pf != "" {
+			if _, ok := available[pf]; ok {
+				available[pf] = max(available[pf]-n, 0)
+			}
+			continue
+		}
+		for ; n > 0; n-- {
+			var maxPF string
+			for id, count := range available {
+				if count > 0 && (maxPF == "" || count > available[maxPF]) {
+					maxPF = id
+				}
+			}
+			if maxPF == "" {
+				break
+			}
+			available[maxPF]--
+		}
+	}
+
+	return available
+}
+
+// HasSRIOVCapacity returns true if the available SR-IOV virtual functions
+// satisfy the required virtual functions.
+func HasSRIOVCapacity(available, required map[string]int) bool {
+	for pf, r := range required {
+		if pf != "" && available[pf] < r {
+			return false
+		}
+	}
+
+	remaining := 0
+	for pf, n := range available {
+		if pf != "" {
+			remaining += n - required[pf]
+		}
+	}
+	return remaining >= required[""]
+}
+
+// filterRecommendationsBySRIOVCapacity returns the recommendations that have
+// a host with the required SR-IOV capacity. When a recommendation does not
+// specify a host, any host in the recommended resource pool's cluster may
+// satisfy the requirements, and the recommendation is updated with the first
+// such host.
+func filterRecommendationsBySRIOVCapacity(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vim25.Client,
+	recommendations []Recommendation,
+	required map[string]int) ([]Recommendation, error) {
+
+	hostCapacity := map[vimtypes.ManagedObjectReference]bool{}
+	hasCapacity := func(hostMoRef vimtypes.ManagedObjectReference) (bool, error) {
+		if ok, cached := hostCapacity[hostMoRef]; cached {
+			return ok, nil
+		}
+		available, err := getHostSRIOVCapacity(vmCtx, vcClient, hostMoRef)
+		if err != nil {
+			return false, err
+		}
+		ok := HasSRIOVCapacity(available, required)
+		hostCapacity[hostMoRef] = ok
+		return ok, nil
+	}
+
+	var filtered []Recommendation
+	for _, rec := range recommendations {
+		var hostMoRefs []vimtypes.ManagedObjectReference
+		if rec.HostMoRef != nil {
+			hostMoRefs = append(hostMoRefs, *rec.HostMoRef)
+		} else {
+			cluster, err := rpMoIDToCluster(vmCtx, vcClient, rec.PoolMoRef)
+			if err != nil {
+				return nil, err
+			}
+			hosts, err := cluster.Hosts(vmCtx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get cluster hosts: %w", err)
+			}
+			for _, h := range hosts {
+				hostMoRefs = append(hostMoRefs, h.Reference())
+			}
+		}
+
+		for _, hostMoRef := range hostMoRefs {
+			ok, err := hasCapacity(hostMoRef)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				rec.HostMoRef = &hostMoRef
+				filtered = append(filtered, rec)
+				break
+			}
+		}
+	}
+
+	return filtered, nil
+}
+
+// getHostSRIOVCapacity returns the number of SR-IOV virtual functions that
+// are not used by the powered on VMs on the host.
+func getHostSRIOVCapacity(
+	ctx context.Context,
+	vcClient *vim25.Client,
+	hostMoRef vimtypes.ManagedObjectReference) (map[string]int, error) {
+
+	pc := property.DefaultCollector(vcClient)
+
+	var moHost mo.HostSystem
+	if err := pc.RetrieveOne(
+		ctx,
+		hostMoRef,
+		[]string{"config.pciPassthruInfo", "vm"},
+		&moHost); err != nil {
+
+		return nil, fmt.Errorf("failed to get host properties: %w", err)
+	}
+
+	if moHost.Config == nil {
+		return nil, nil
+	}
+
+	used := map[string]int{}
+	if len(moHost.Vm) > 0 {
+		var moVMs []mo.VirtualMachine
+		if err := pc.Retrieve(
+			ctx,
+			moHost.Vm,
+			[]string{"config.hardware.device", "runtime.powerState"},
+			&moVMs); err != nil {
+
+			return nil, fmt.Errorf("failed to get host VM properties: %w", err)
+		}
+
+		for _, moVM := range moVMs {
+			if moVM.Config == nil ||
+				moVM.Runtime.PowerState != vimtypes.VirtualMachinePowerStatePoweredOn {
+				continue
+			}
+			for pf, n := range sriovVirtualFunctions(moVM.Config.Hardware.Device) {
+				used[pf] += n
+			}
+		}
+	}
+
+	return HostSRIOVCapacity(moHost.Config.PciPassthruInfo, used), nil
+}
+
+// sriovVirtualFunctions returns the number of SR-IOV virtual functions used by
+// the devices, keyed by the ID of their physical function.
+func sriovVirtualFunctions(devices []vimtypes.BaseVirtualDevice) map[string]int {
+	var vfs map[string]int
+	for _, dev := range devices {
+		card, ok := dev.(*vimtypes.VirtualSriovEthernetCard)
+		if !ok {
+			continue
+		}
+		var pf string
+		if b := card.SriovBacking; b != nil && b.PhysicalFunctionBacking != nil {
+			pf = b.PhysicalFunctionBacking.Id
+		}
+		if vfs == nil {
+			vfs = map[string]int{}
+		}
+		vfs[pf]++
+	}
+	return vfs
+}
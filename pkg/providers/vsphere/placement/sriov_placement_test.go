@@ -0,0 +1,125 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package placement_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/placement"
+)
+
+func sriovInfo(id string, active bool, numVFs int32) *vimtypes.HostSriovInfo {
+	return &vimtypes.HostSriovInfo{
+		HostPciPassthruInfo: vimtypes.HostPciPassthruInfo{Id: id},
+		SriovActive:         active,
+		NumVirtualFunction:  numVFs,
+	}
+}
+
+func sriovCardSpec(pf string) vimtypes.BaseVirtualDeviceConfigSpec {
+	card := &vimtypes.VirtualSriovEthernetCard{}
+	if pf != "" {
+		card.SriovBacking = &vimtypes.VirtualSriovEthernetCardSriovBackingInfo{
+			PhysicalFunctionBacking: &vimtypes.VirtualPCIPassthroughDeviceBackingInfo{Id: pf},
+		}
+	}
+	return &vimtypes.VirtualDeviceConfigSpec{
+		Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+		Device:    card,
+	}
+}
+
+var _ = Describe("SRIOVRequirements", func() {
+	It("returns nothing when there are no SR-IOV devices", func() {
+		configSpec := vimtypes.VirtualMachineConfigSpec{
+			DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+				&vimtypes.VirtualDeviceConfigSpec{
+					Operation: vimtypes.VirtualDeviceConfigSpecOperationAdd,
+					Device:    &vimtypes.VirtualVmxnet3{},
+				},
+			},
+		}
+		Expect(placement.SRIOVRequirements(configSpec)).To(BeEmpty())
+	})
+
+	It("returns the virtual functions by physical function", func() {
+		configSpec := vimtypes.VirtualMachineConfigSpec{
+			DeviceChange: []vimtypes.BaseVirtualDeviceConfigSpec{
+				sriovCardSpec(""),
+				sriovCardSpec("0000:3b:00.0"),
+				sriovCardSpec("0000:3b:00.0"),
+			},
+		}
+		Expect(placement.SRIOVRequirements(configSpec)).To(Equal(map[string]int{
+			"":             1,
+			"0000:3b:00.0": 2,
+		}))
+	})
+})
+
+var _ = Describe("HostSRIOVCapacity", func() {
+	var pciPassthruInfo []vimtypes.BaseHostPciPassthruInfo
+
+	BeforeEach(func() {
+		pciPassthruInfo = []vimtypes.BaseHostPciPassthruInfo{
+			&vimtypes.HostPciPassthruInfo{Id: "0000:18:00.0"},
+			sriovInfo("0000:3b:00.0", true, 8),
+			sriovInfo("0000:3b:00.1", true, 4),
+			sriovInfo("0000:5e:00.0", false, 8),
+		}
+	})
+
+	It("returns the virtual functions of the active physical functions", func() {
+		Expect(placement.HostSRIOVCapacity(pciPassthruInfo, nil)).To(Equal(map[string]int{
+			"0000:3b:00.0": 8,
+			"0000:3b:00.1": 4,
+		}))
+	})
+
+	It("deducts the used virtual functions", func() {
+		used := map[string]int{
+			"0000:3b:00.1": 5,
+			"":             3,
+		}
+		Expect(placement.HostSRIOVCapacity(pciPassthruInfo, used)).To(Equal(map[string]int{
+			"0000:3b:00.0": 5,
+			"0000:3b:00.1": 0,
+		}))
+	})
+})
+
+var _ = DescribeTable("HasSRIOVCapacity",
+	func(available, required map[string]int, expected bool) {
+		Expect(placement.HasSRIOVCapacity(available, required)).To(Equal(expected))
+	},
+	Entry("no requirements", map[string]int{}, map[string]int{}, true),
+	Entry("any physical function",
+		map[string]int{"0000:3b:00.0": 1, "0000:3b:00.1": 1},
+		map[string]int{"": 2},
+		true),
+	Entry("any physical function without enough capacity",
+		map[string]int{"0000:3b:00.0": 1},
+		map[string]int{"": 2},
+		false),
+	Entry("specific physical function",
+		map[string]int{"0000:3b:00.0": 2},
+		map[string]int{"0000:3b:00.0": 2},
+		true),
+	Entry("specific physical function without enough capacity",
+		map[string]int{"0000:3b:00.0": 1, "0000:3b:00.1": 8},
+		map[string]int{"0000:3b:00.0": 2},
+		false),
+	Entry("specific physical function is also used for any physical function",
+		map[string]int{"0000:3b:00.0": 2, "0000:3b:00.1": 1},
+		map[string]int{"0000:3b:00.0": 2, "": 2},
+		false),
+	Entry("missing physical function",
+		map[string]int{"0000:3b:00.0": 2},
+		map[string]int{"0000:5e:00.0": 1},
+		false),
+)
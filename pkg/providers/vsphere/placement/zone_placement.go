@@ -363,6 +363,43 @@ func getZonalPlacementRecommendations(
 	return recommendations, nil
 }
 
+// applyZoneConstraints removes the candidates for the zones that are not
+// allowed by the constraints.
+func applyZoneConstraints(
+	ctx context.Context,
+	candidates map[string][]string,
+	constraints Constraints) (map[string][]string, error) {
+
+	if constraints.Zones.Len() == 0 {
+		return candidates, nil
+	}
+
+	// The VM's candidates may be limited due to external constraints, such as the
+	// requested zones of its PVCs. Apply those constraints here.
+	var disallowedZones []string
+	allowedCandidates := map[string][]string{}
+
+	for zoneName, rpMoIDs := range candidates {
+		if constraints.Zones.Has(zoneName) {
+			allowedCandidates[zoneName] = rpMoIDs
+		} else {
+			disallowedZones = append(disallowedZones, zoneName)
+		}
+	}
+
+	if len(disallowedZones) > 0 {
+		pkglog.FromContextOrDefault(ctx).V(6).Info("Removed candidate zones due to constraints",
+			"candidateZones", maps.Keys(candidates), "disallowedZones", disallowedZones)
+	}
+
+	if len(allowedCandidates) == 0 {
+		return nil, fmt.Errorf("no placement candidates available after applying zone constraints: %s",
+			strings.Join(constraints.Zones.UnsortedList(), ","))
+	}
+
+	return allowedCandidates, nil
+}
+
 // Placement determines if the VM needs placement, and if so, determines where to place the VM
 // and updates the Labels and Annotations with the placement decision.
 func Placement(
@@ -374,6 +411,14 @@ func Placement(
 	constraints Constraints) (*Result, error) {
 
 	curResult := doesVMNeedPlacement(vmCtx)
+
+	sriovRequired := SRIOVRequirements(configSpec)
+	if len(sriovRequired) > 0 && curResult.HostMoRef == nil {
+		// The VM must be placed on a host with the SR-IOV capacity required by
+		// its devices, so select the host here instead of leaving it to DRS.
+		curResult.needHostPlacement = true
+	}
+
 	if !curResult.needZonePlacement &&
 		!curResult.needHostPlacement &&
 		!curResult.needDatastorePlacement {
@@ -397,31 +442,9 @@ func Placement(
 		return nil, fmt.Errorf("no placement candidates available")
 	}
 
-	if constraints.Zones.Len() > 0 {
-		// The VM's candidates may be limited due to external constraints, such as the
-		// requested zones of its PVCs. Apply those constraints here.
-		var disallowedZones []string
-		allowedCandidates := map[string][]string{}
-
-		for zoneName, rpMoIDs := range candidates {
-			if constraints.Zones.Has(zoneName) {
-				allowedCandidates[zoneName] = rpMoIDs
-			} else {
-				disallowedZones = append(disallowedZones, zoneName)
-			}
-		}
-
-		if len(disallowedZones) > 0 {
-			vmCtx.Logger.V(6).Info("Removed candidate zones due to constraints",
-				"candidateZones", maps.Keys(candidates), "disallowedZones", disallowedZones)
-		}
-
-		if len(allowedCandidates) == 0 {
-			return nil, fmt.Errorf("no placement candidates available after applying zone constraints: %s",
-				strings.Join(constraints.Zones.UnsortedList(), ","))
-		}
-
-		candidates = allowedCandidates
+	candidates, err = applyZoneConstraints(vmCtx, candidates, constraints)
+	if err != nil {
+		return nil, err
 	}
 
 	var recommendations []Recommendation
@@ -445,6 +468,16 @@ func Placement(
 		return nil, fmt.Errorf("no placement recommendations available")
 	}
 
+	if len(sriovRequired) > 0 {
+		recommendations, err = filterRecommendationsBySRIOVCapacity(vmCtx, vcClient, recommendations, sriovRequired)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check SR-IOV capacity: %w", err)
+		}
+		if len(recommendations) == 0 {
+			return nil, fmt.Errorf("no placement recommendations with available SR-IOV capacity")
+		}
+	}
+
 	selectedRecommendation := recommendations[rand.Intn(len(recommendations))] // nolint:gosec
 	zoneName := resourcePoolToZoneName[selectedRecommendation.PoolMoRef.Value]
 	vmCtx.Logger.V(4).Info("Placement recommendation", "zone", zoneName, "recommendation", selectedRecommendation)
@@ -458,7 +491,7 @@ func Placement(
 
 	result := Result{
 		ZonePlacement:            curResult.needZonePlacement,
-		InstanceStoragePlacement: curResult.needHostPlacement,
+		InstanceStoragePlacement: curResult.InstanceStoragePlacement && curResult.needHostPlacement,
 		ZoneName:                 zoneName,
 		PoolMoRef:                selectedRecommendation.PoolMoRef,
 		HostMoRef:                selectedRecommendation.HostMoRef,
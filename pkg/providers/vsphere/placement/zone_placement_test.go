@@ -177,6 +177,62 @@ func vcSimPlacement() {
 				Expect(result.PoolMoRef.Value).To(Equal(nsRP.Reference().Value))
 			})
 
+			Context("SR-IOV Placement", func() {
+				const pfID = "0000:01:00.0"
+
+				BeforeEach(func() {
+					testConfig.NumFaultDomains = 1
+					configSpec.DeviceChange = append(configSpec.DeviceChange, sriovCardSpec(pfID))
+				})
+
+				It("returns an error when no host has the SR-IOV capacity", func() {
+					result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+					Expect(err).To(MatchError("no placement recommendations with available SR-IOV capacity"))
+					Expect(result).To(BeNil())
+				})
+
+				When("the hosts have the SR-IOV capacity", func() {
+					JustBeforeEach(func() {
+						sctx := ctx.SimulatorContext()
+						for _, hostEnt := range sctx.Map.All("HostSystem") {
+							sctx.WithLock(
+								hostEnt.Reference(),
+								func() {
+									host := sctx.Map.Get(hostEnt.Reference()).(*simulator.HostSystem)
+									host.Config.PciPassthruInfo = []vimtypes.BaseHostPciPassthruInfo{
+										sriovInfo(pfID, true, 4),
+									}
+								})
+						}
+					})
+
+					It("returns success with a host", func() {
+						result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(result.ZoneName).To(BeElementOf(ctx.ZoneNames))
+						Expect(result.InstanceStoragePlacement).To(BeFalse())
+						Expect(result.HostMoRef).ToNot(BeNil())
+						Expect(result.HostMoRef.Value).ToNot(BeEmpty())
+					})
+
+					When("zone already assigned", func() {
+						JustBeforeEach(func() {
+							vm.Labels[corev1.LabelTopologyZone] = ctx.ZoneNames[0]
+						})
+
+						It("returns success with a host", func() {
+							result, err := placement.Placement(vmCtx, ctx.Client, ctx.VCClient.Client, ctx.Finder, configSpec, constraints)
+							Expect(err).ToNot(HaveOccurred())
+
+							Expect(result.ZoneName).To(Equal(ctx.ZoneNames[0]))
+							Expect(result.HostMoRef).ToNot(BeNil())
+							Expect(result.HostMoRef.Value).ToNot(BeEmpty())
+						})
+					})
+				})
+			})
+
 			Context("Only one zone exists", func() {
 				BeforeEach(func() {
 					testConfig.NumFaultDomains = 1
@@ -11,6 +11,7 @@ import (
 	"maps"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/vmware/govmomi/object"
@@ -28,6 +29,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/clustermodules"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
@@ -298,7 +300,9 @@ func (s *Session) reconcilePoweredOffOrPoweredOnVM(
 		if err := s.poweredOnReconfigure(
 			vmCtx,
 			vcVM,
-			vmCtx.MoVM.Config); err != nil {
+			vmCtx.MoVM.Config,
+			&updateArgs.VMClass,
+			&networkResults); err != nil {
 
 			return err
 		}
@@ -349,7 +353,9 @@ func (s *Session) reconcilePoweredOffOrPoweredOnVM(
 func (s *Session) poweredOnReconfigure(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcVM *object.VirtualMachine,
-	config *vimtypes.VirtualMachineConfigInfo) error {
+	config *vimtypes.VirtualMachineConfigInfo,
+	vmClass *vmopv1.VirtualMachineClass,
+	networkResults *network.NetworkInterfaceResults) error {
 
 	configSpec := &vimtypes.VirtualMachineConfigSpec{}
 
@@ -362,6 +368,10 @@ func (s *Session) poweredOnReconfigure(
 		return err
 	}
 
+	// Any overrides that cannot be hot-added are reported in the VM's
+	// status.pendingResize and applied when the VM is power cycled.
+	vmopv1util.OverwriteCPUMemoryResizeConfigSpec(*vmCtx.VM, vmClass, *config, configSpec, true)
+
 	UpdateConfigSpecExtraConfig(vmCtx, config, configSpec, vmCtx.VM, nil)
 	UpdateConfigSpecChangeBlockTracking(vmCtx, config, configSpec, vmCtx.VM.Spec)
 
@@ -369,6 +379,34 @@ func (s *Session) poweredOnReconfigure(
 		return fmt.Errorf("update CD-ROM device connection error: %w", err)
 	}
 
+	if pkgcfg.FromContext(vmCtx).Features.MutableNetworks {
+		// Hot-add and hot-remove the VM's ethernet devices. The guest is given
+		// the updated network config once the devices have been changed.
+		virtualDevices := object.VirtualDeviceList(config.Hardware.Device)
+		currentEthCards := virtualDevices.SelectByType((*vimtypes.VirtualEthernetCard)(nil))
+
+		ethCardDeviceChanges, err := UpdateEthCardDeviceChanges(vmCtx, networkResults, currentEthCards)
+		if err != nil {
+			return err
+		}
+
+		if slices.ContainsFunc(ethCardDeviceChanges, isPassthroughEthCardDeviceChange) {
+			// SR-IOV and DirectPath devices cannot be hot-plugged, so the
+			// network device changes are applied when the VM is powered off.
+			vmCtx.Logger.Info("Skipping network device changes with SR-IOV or DirectPath devices because VM is powered on")
+			ethCardDeviceChanges = nil
+		}
+
+		if len(ethCardDeviceChanges) > 0 {
+			configSpec.DeviceChange = append(configSpec.DeviceChange, ethCardDeviceChanges...)
+			conditions.MarkFalse(
+				vmCtx.VM,
+				vmopv1.GuestNetworkConfigSyncedCondition,
+				vmopv1.GuestNetworkConfigPendingReason,
+				"Network interfaces changed while the VM is powered on")
+		}
+	}
+
 	if err := doReconfigure(
 		logr.NewContext(
 			vmCtx,
@@ -390,6 +428,15 @@ func (s *Session) poweredOnReconfigure(
 	return nil
 }
 
+func isPassthroughEthCardDeviceChange(dc vimtypes.BaseVirtualDeviceConfigSpec) bool {
+	switch network.EthCardAdapterType(dc.GetVirtualDeviceConfigSpec().Device) {
+	case vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV,
+		vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath:
+		return true
+	}
+	return false
+}
+
 func (s *Session) poweredOffReconfigure(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcVM *object.VirtualMachine,
@@ -603,6 +650,8 @@ func updateConfigSpec(
 		resize.CompareMemoryAllocation(*config, updateArgs.ConfigSpec, configSpec)
 	}
 
+	vmopv1util.OverwriteCPUMemoryResizeConfigSpec(*vmCtx.VM, &updateArgs.VMClass, *config, configSpec, false)
+
 	return configSpec, needsResize, nil
 }
 
@@ -660,9 +709,17 @@ func (s *Session) reconcileNetworkInterfaces(
 		&s.ClusterMoRef,
 		networkSpec)
 	if err != nil {
+		if pkgerr.IsNetworkNotReadyError(err) {
+			conditions.MarkFalse(
+				vmCtx.VM,
+				vmopv1.VirtualMachineConditionNetworkReady,
+				vmopv1.VirtualMachineWaitingForNetworkReason,
+				"%s", err.Error())
+		}
 		return network.NetworkInterfaceResults{},
 			fmt.Errorf("failed to reconcile network interfaces: %w", err)
 	}
+	conditions.MarkTrue(vmCtx.VM, vmopv1.VirtualMachineConditionNetworkReady)
 
 	for idx := range results.Results {
 		result := &results.Results[idx]
@@ -677,14 +734,11 @@ func (s *Session) reconcileNetworkInterfaces(
 	}
 
 	if pkgcfg.FromContext(vmCtx).Features.MutableNetworks {
-		// TODO: Until we are reconfiguring (hot-plug) a powered on VM for network device
-		// changes, don't list orphan interfaces because they need to hang around until
-		// the device is actually removed from the VM.
-		if vmCtx.MoVM.Runtime.PowerState == vimtypes.VirtualMachinePowerStatePoweredOff {
-			if err := network.ListOrphanedNetworkInterfaces(vmCtx, s.K8sClient, &results); err != nil {
-				return network.NetworkInterfaceResults{},
-					fmt.Errorf("failed to list orphaned network interfaces: %w", err)
-			}
+		// The orphaned interfaces are only deleted after the VM was reconfigured
+		// so they hang around until the device is actually removed from the VM.
+		if err := network.ListOrphanedNetworkInterfaces(vmCtx, s.K8sClient, &results); err != nil {
+			return network.NetworkInterfaceResults{},
+				fmt.Errorf("failed to list orphaned network interfaces: %w", err)
 		}
 	}
 
@@ -889,6 +943,8 @@ func (s *Session) resizeVMWhenPoweredStateOff(
 		return err
 	}
 
+	vmopv1util.OverwriteCPUMemoryResizeConfigSpec(*vmCtx.VM, resizeArgs.VMClass, *moVM.Config, &configSpec, false)
+
 	reconfigErr := doReconfigure(
 		logr.NewContext(
 			vmCtx,
@@ -940,6 +996,8 @@ func (s *Session) getResizeConfigSpecForPoweredOffVM(
 		return nil, false, err
 	}
 
+	vmopv1util.OverwriteCPUMemoryResizeConfigSpec(*vmCtx.VM, &updateArgs.VMClass, *config, &configSpec, false)
+
 	return &configSpec, needsResize, nil
 }
 
@@ -1057,6 +1115,19 @@ func (s *Session) reconcileNetworkAndGuestCustomizationState(
 	// network configuration.
 	vmlifecycle.UpdateNetworkStatusConfig(vmCtx.VM, bootstrapArgs)
 
+	if pkgcfg.FromContext(vmCtx).Features.MutableNetworks &&
+		vmCtx.MoVM.Runtime.PowerState == vimtypes.VirtualMachinePowerStatePoweredOn {
+
+		if err := vmlifecycle.ReconcileGuestNetworkConfig(
+			vmCtx,
+			vcVM,
+			vmCtx.MoVM.Config,
+			bootstrapArgs); err != nil {
+
+			return err
+		}
+	}
+
 	return vmlifecycle.DoBootstrap(
 		vmCtx,
 		vcVM,
@@ -1362,8 +1433,14 @@ func doReconfigure(
 		return nil
 	}
 
+	start := time.Now()
 	resVM := res.NewVMFromObject(vcVM)
 	taskInfo, err := resVM.Reconfigure(ctx, &configSpec)
+	metrics.NewVMOperationMetrics().ObserveOperation(
+		metrics.VMOperationReconfigure,
+		metrics.DeployModeNone,
+		start,
+		err)
 
 	UpdateVMGuestIDReconfiguredCondition(vm, configSpec, taskInfo)
 
@@ -51,9 +51,10 @@ type BootstrapData struct {
 	VAppData   map[string]string
 	VAppExData map[string]map[string]string
 
-	CloudConfig *cloudinit.CloudConfigSecretData
-	Sysprep     *sysprep.SecretData
-	LinuxPrep   *linuxprep.SecretData
+	CloudConfig   *cloudinit.CloudConfigSecretData
+	UserDataParts []cloudinit.UserDataPart
+	Sysprep       *sysprep.SecretData
+	LinuxPrep     *linuxprep.SecretData
 }
 
 type TemplateRenderFunc func(string, string) string
@@ -67,6 +68,11 @@ type BootstrapArgs struct {
 	HostName         string
 	DNSServers       []string
 	SearchSuffixes   []string
+
+	// CustomizeSysprepDomainJoin is true when the Sysprep domain join
+	// credentials were rotated before the guest joined the domain, so the VM
+	// is customized again at its next power on with the new credentials.
+	CustomizeSysprepDomainJoin bool
 }
 
 var (
@@ -110,16 +116,20 @@ func DoBootstrap(
 		linuxPrep  = bootstrap.LinuxPrep
 		sysPrep    = bootstrap.Sysprep
 		vAppConfig = bootstrap.VAppConfig
+		ignition   = bootstrap.Ignition
 	)
 
 	if sysPrep != nil || vAppConfig != nil {
 		bootstrapArgs.TemplateRenderFn = GetTemplateRenderFunc(vmCtx, &bootstrapArgs)
 	}
 
+	domainJoinHash := getSysprepDomainJoinState(vmCtx, config, sysPrep, &bootstrapArgs)
+
 	var (
 		configSpec     *vimtypes.VirtualMachineConfigSpec
 		customSpec     *vimtypes.CustomizationSpec
 		customizeLatch *bool
+		customized     bool
 		err            error
 	)
 
@@ -134,6 +144,8 @@ func DoBootstrap(
 			vmCtx, config, sysPrep, vAppConfig, &bootstrapArgs)
 	case vAppConfig != nil:
 		configSpec, customSpec, err = BootstrapVAppConfig(vmCtx, config, vAppConfig, &bootstrapArgs)
+	case ignition != nil:
+		configSpec, customSpec, err = BootStrapIgnition(vmCtx, config, ignition, &bootstrapArgs)
 	}
 
 	if err != nil {
@@ -183,7 +195,12 @@ func DoBootstrap(
 	if customSpec != nil {
 		const hashKey = pkgconst.BootstrapHashCustomSpecAnnotationKey
 
-		newHash, err := getVimTypeHash(customSpec)
+		hashSpec := customSpec
+		if usesSysprepDomainJoin(sysPrep) {
+			hashSpec = withoutDomainJoinCredentials(customSpec)
+		}
+
+		newHash, err := getVimTypeHash(hashSpec)
 		if err != nil {
 			return err
 		}
@@ -214,10 +231,20 @@ func DoBootstrap(
 				vmCtx.VM.Annotations[hashKey] = newHash
 			}
 
+			customized = true
 			retErr = errors.Join(retErr, ErrBootstrapCustomize)
 		}
 	}
 
+	if domainJoinHash != "" && (customized || !bootstrapArgs.CustomizeSysprepDomainJoin) {
+		// Only record the hash of the rotated credentials once the VM has
+		// been customized with them so a failed customization is retried.
+		if vmCtx.VM.Annotations == nil {
+			vmCtx.VM.Annotations = map[string]string{}
+		}
+		vmCtx.VM.Annotations[pkgconst.SysprepDomainJoinCredentialsHashAnnotationKey] = domainJoinHash
+	}
+
 	if customizeLatch != nil && *customizeLatch {
 		// Set latch to false so that a later customization on has to be
 		// explicitly requested.
@@ -242,7 +269,8 @@ func GetBootstrapArgs(
 		bootstrap = *bs
 	}
 
-	isCloudInit := bootstrap.CloudInit != nil
+	// Ignition is given the same per-interface network config as Cloud-Init.
+	isCloudInit := bootstrap.CloudInit != nil || bootstrap.Ignition != nil
 	isGOSC := bootstrap.LinuxPrep != nil || bootstrap.Sysprep != nil
 
 	bsa := BootstrapArgs{
@@ -451,11 +479,11 @@ func SanitizeConfigSpec(cs vimtypes.VirtualMachineConfigSpec) vimtypes.VirtualMa
 
 		// This is what is likely to contain any sensitive. We can expand this to vendor
 		// and metadata later if needed.
-		if optVal.Key == constants.CloudInitGuestInfoUserdata {
+		switch optVal.Key {
+		case constants.CloudInitGuestInfoUserdata, constants.IgnitionGuestInfoConfigData:
 			optValCopy := *optVal
 			optValCopy.Value = redacted
 			cs.ExtraConfig[i] = &optValCopy
-			break
 		}
 	}
 
@@ -113,9 +113,10 @@ func BootStrapCloudInit(
 	logger.V(4).Info("Reconciling Cloud-Init bootstrap state")
 
 	if bsArgs.NetworkResults.UpdatedEthCards {
-		// We're not yet doing hot-plug of ethernet devices for a powered on VM. Therefore, if this
-		// VM is on and there were network device related changes, don't apply a new cloud-config
-		// since the VM does not have the expected ethernet devices.
+		// The ethernet devices of a powered on VM are hot-plugged before the bootstrap is
+		// reconciled. If this VM is on and there were network device related changes, don't
+		// apply a new cloud-config until the VM has the expected ethernet devices. The updated
+		// network config is published to the guest by ReconcileGuestNetworkConfig.
 		if vmCtx.MoVM.Runtime.PowerState == vimtypes.VirtualMachinePowerStatePoweredOn {
 			vmCtx.Logger.V(4).Info("Skipping Cloud-Init bootstrap with pending network changes because VM is powered on")
 			return nil, nil, nil
@@ -141,16 +142,59 @@ func BootStrapCloudInit(
 		return nil, nil, err
 	}
 
+	userdata, err := getCloudInitUserData(vmCtx, cloudInitSpec, bsArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var configSpec *vimtypes.VirtualMachineConfigSpec
+	var customSpec *vimtypes.CustomizationSpec
+
+	switch vmCtx.VM.Annotations[constants.CloudInitTypeAnnotation] {
+	case constants.CloudInitTypeValueCloudInitPrep:
+		configSpec, customSpec, err = GetCloudInitPrepCustSpec(vmCtx, config, metadata, userdata)
+	case constants.CloudInitTypeValueGuestInfo, "":
+		fallthrough
+	default:
+		configSpec, err = GetCloudInitGuestInfoCustSpec(vmCtx, config, metadata, userdata)
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return configSpec, customSpec, nil
+}
+
+func getCloudInitUserData(
+	vmCtx pkgctx.VirtualMachineContext,
+	cloudInitSpec *vmopv1.VirtualMachineBootstrapCloudInitSpec,
+	bsArgs *BootstrapArgs) (string, error) {
+
+	var firewallParts []cloudinit.UserDataPart
+	if firewall := guestFirewallSpec(vmCtx); firewall != nil {
+		firewallParts = append(firewallParts, firewallUserDataPart(firewall))
+	}
+
 	var userdata string
 	if cooked := cloudInitSpec.CloudConfig; cooked != nil {
 		if bsArgs.CloudConfig == nil {
-			return nil, nil, fmt.Errorf("cloudConfigSecretData is nil")
+			return "", fmt.Errorf("cloudConfigSecretData is nil")
 		}
 		data, err := cloudinit.MarshalYAML(*cooked, *bsArgs.CloudConfig)
 		if err != nil {
-			return nil, nil, err
+			return "", err
 		}
 		userdata = data
+		if len(firewallParts) > 0 {
+			return cloudinit.MarshalMultiPart(append([]cloudinit.UserDataPart{
+				{
+					Name:        "cloud-config",
+					ContentType: string(vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig),
+					Data:        userdata,
+				},
+			}, firewallParts...))
+		}
 	} else if raw := cloudInitSpec.RawCloudConfig; raw != nil {
 		keys := []string{raw.Key}
 		for _, key := range append(keys, CloudInitUserDataSecretKeys...) {
@@ -161,25 +205,56 @@ func BootStrapCloudInit(
 		}
 
 		// NOTE: The old code didn't error out if userdata wasn't found, so keep going.
+
+		if len(firewallParts) > 0 {
+			return rawCloudConfigWithParts(userdata, firewallParts)
+		}
+	} else if len(cloudInitSpec.UserDataParts) > 0 {
+		data, err := cloudinit.MarshalMultiPart(append(
+			slices.Clone(bsArgs.UserDataParts), firewallParts...))
+		if err != nil {
+			return "", err
+		}
+		userdata = data
+	} else if len(firewallParts) > 0 {
+		return cloudinit.MarshalMultiPart(firewallParts)
 	}
 
-	var configSpec *vimtypes.VirtualMachineConfigSpec
-	var customSpec *vimtypes.CustomizationSpec
+	return userdata, nil
+}
 
-	switch vmCtx.VM.Annotations[constants.CloudInitTypeAnnotation] {
-	case constants.CloudInitTypeValueCloudInitPrep:
-		configSpec, customSpec, err = GetCloudInitPrepCustSpec(vmCtx, config, metadata, userdata)
-	case constants.CloudInitTypeValueGuestInfo, "":
-		fallthrough
-	default:
-		configSpec, err = GetCloudInitGuestInfoCustSpec(vmCtx, config, metadata, userdata)
+// rawCloudConfigWithParts returns a multi-part user data document with the
+// raw user data as its first part, followed by the provided parts.
+//
+// The raw user data's part uses the text/plain content type so cloud-init
+// determines its type from its contents, ex. #cloud-config or #!/bin/sh.
+func rawCloudConfigWithParts(
+	userdata string,
+	parts []cloudinit.UserDataPart) (string, error) {
+
+	if userdata == "" {
+		return cloudinit.MarshalMultiPart(parts)
 	}
 
+	plainText, err := pkgutil.TryToDecodeBase64Gzip([]byte(userdata))
 	if err != nil {
-		return nil, nil, err
+		return "", fmt.Errorf("decoding cloud-init userdata failed: %w", err)
 	}
 
-	return configSpec, customSpec, nil
+	// The VM's validation webhook rejects a firewall with a raw cloud-config
+	// that is a MIME document, but the Secret may have been changed since.
+	if cloudinit.IsMIMEMultiPart(plainText) {
+		return "", fmt.Errorf(
+			"cannot add user data parts to a raw cloud-config that is a MIME document")
+	}
+
+	return cloudinit.MarshalMultiPart(append([]cloudinit.UserDataPart{
+		{
+			Name:        "user-data",
+			ContentType: "text/plain",
+			Data:        plainText,
+		},
+	}, parts...))
 }
 
 func GetCloudInitMetadata(
@@ -18,6 +18,7 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	vmopv1cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha5/cloudinit"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/internal"
@@ -296,6 +297,183 @@ var _ = Describe("CloudInit Bootstrap", func() {
 				})
 			})
 		})
+
+		Context("UserDataParts", func() {
+			BeforeEach(func() {
+				cloudInitSpec.UserDataParts = []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+					{
+						Name:  "base",
+						Value: ptr.To("#cloud-config\nruncmd:\n- echo base\n"),
+					},
+					{
+						Name:        "app.sh",
+						ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript,
+						ConfigMap: &common.ConfigMapKeySelector{
+							Name: "my-configmap",
+							Key:  "app.sh",
+						},
+					},
+				}
+				bsArgs.UserDataParts = []cloudinit.UserDataPart{
+					{
+						Name:        "base",
+						ContentType: "text/cloud-config",
+						Data:        "#cloud-config\nruncmd:\n- echo base\n",
+					},
+					{
+						Name:        "app.sh",
+						ContentType: "text/x-shellscript",
+						Data:        "#!/bin/sh\necho app\n",
+					},
+				}
+				vmCtx.VM.Annotations[constants.CloudInitTypeAnnotation] = constants.CloudInitTypeValueGuestInfo
+			})
+
+			It("Returns success with a multi-part userdata", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configSpec).ToNot(BeNil())
+
+				exp, err := cloudinit.MarshalMultiPart(bsArgs.UserDataParts)
+				Expect(err).ToNot(HaveOccurred())
+
+				extraConfig := pkgutil.OptionValues(configSpec.ExtraConfig).StringMap()
+				Expect(extraConfig).To(HaveKeyWithValue(constants.CloudInitGuestInfoUserdataEncoding, "gzip+base64"))
+				data, err := pkgutil.TryToDecodeBase64Gzip([]byte(extraConfig[constants.CloudInitGuestInfoUserdata]))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(data).To(Equal(exp))
+				Expect(data).To(HavePrefix("Content-Type: multipart/mixed; boundary="))
+				Expect(data).To(ContainSubstring("#!/bin/sh\necho app\n"))
+			})
+
+			When("a cloud-config part is invalid", func() {
+				BeforeEach(func() {
+					bsArgs.UserDataParts[0].Data = "#cloud-config\nruncmd: hello\n"
+				})
+				It("Returns an error", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(HavePrefix(`invalid cloud-config in user data part "base": `))
+				})
+			})
+		})
+
+		Context("Firewall", func() {
+			var userdata string
+
+			BeforeEach(func() {
+				vmCtx.Context = pkgcfg.NewContext()
+				pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+					config.NetworkProviderType = pkgcfg.NetworkProviderTypeVDS
+				})
+				vmCtx.VM.Annotations[constants.CloudInitTypeAnnotation] = constants.CloudInitTypeValueGuestInfo
+				vmCtx.VM.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+					Firewall: &vmopv1.VirtualMachineNetworkFirewallSpec{
+						Ingress: []vmopv1.VirtualMachineNetworkFirewallRule{
+							{
+								Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolTCP,
+								Port:     ptr.To[int32](22),
+								CIDRs:    []string{"192.168.1.0/24", "fd00::/64"},
+							},
+							{
+								Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolUDP,
+								Port:     ptr.To[int32](8000),
+								EndPort:  ptr.To[int32](8080),
+							},
+							{
+								Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolTCP,
+							},
+						},
+					},
+				}
+			})
+
+			JustBeforeEach(func() {
+				userdata = ""
+				if configSpec != nil {
+					extraConfig := pkgutil.OptionValues(configSpec.ExtraConfig).StringMap()
+					data, err := pkgutil.TryToDecodeBase64Gzip([]byte(extraConfig[constants.CloudInitGuestInfoUserdata]))
+					Expect(err).ToNot(HaveOccurred())
+					userdata = data
+				}
+			})
+
+			It("Returns a multi-part userdata with the nftables ruleset", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(userdata).To(HavePrefix("Content-Type: multipart/mixed; boundary="))
+				Expect(userdata).To(ContainSubstring("filename=" + vmlifecycle.FirewallUserDataPartName))
+				Expect(userdata).To(ContainSubstring("- path: /etc/vmoperator/firewall.nft\n"))
+				Expect(userdata).To(ContainSubstring(
+					"    table inet vmoperator {\n" +
+						"      chain input {\n" +
+						"        type filter hook input priority filter; policy drop;\n"))
+				Expect(userdata).To(ContainSubstring(
+					"        ip saddr { 192.168.1.0/24 } tcp dport 22 accept\n" +
+						"        ip6 saddr { fd00::/64 } tcp dport 22 accept\n" +
+						"        udp dport 8000-8080 accept\n" +
+						"        meta l4proto tcp accept\n"))
+				Expect(userdata).To(ContainSubstring(`runcmd:` + "\n" + `- "[ ! -f /etc/vmoperator/firewall.nft ] || nft -f /etc/vmoperator/firewall.nft"`))
+			})
+
+			When("the network provider is NSX-T", func() {
+				BeforeEach(func() {
+					pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+						config.NetworkProviderType = pkgcfg.NetworkProviderTypeNSXT
+					})
+					cloudInitSpec.RawCloudConfig = &common.SecretKeySelector{Key: "my-key"}
+					bsArgs.Data["my-key"] = cloudInitUserdata
+				})
+				It("Does not render the rules into the userdata", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(userdata).To(Equal(cloudInitUserdata))
+				})
+			})
+
+			When("there is a RawCloudConfig", func() {
+				BeforeEach(func() {
+					cloudInitSpec.RawCloudConfig = &common.SecretKeySelector{Key: "my-key"}
+					bsArgs.Data["my-key"] = base64.StdEncoding.EncodeToString([]byte("#cloud-config\nruncmd:\n- echo raw\n"))
+				})
+				It("Returns a multi-part userdata with the raw cloud-config as a text/plain part", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(userdata).To(HavePrefix("Content-Type: multipart/mixed; boundary="))
+					Expect(userdata).To(ContainSubstring("Content-Type: text/plain; charset=utf-8"))
+					Expect(userdata).To(ContainSubstring("#cloud-config\nruncmd:\n- echo raw\n"))
+					Expect(userdata).To(ContainSubstring(vmlifecycle.FirewallUserDataPartName))
+				})
+
+				When("the RawCloudConfig is a MIME multi-part document", func() {
+					BeforeEach(func() {
+						bsArgs.Data["my-key"] = "Content-Type: multipart/mixed; boundary=foo\n\n--foo--\n"
+					})
+					It("Returns an error", func() {
+						Expect(err).To(MatchError("cannot add user data parts to a raw cloud-config that is a MIME document"))
+					})
+				})
+			})
+
+			When("there are UserDataParts", func() {
+				BeforeEach(func() {
+					cloudInitSpec.UserDataParts = []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+						{
+							Name:  "base",
+							Value: ptr.To("#cloud-config\nruncmd:\n- echo base\n"),
+						},
+					}
+					bsArgs.UserDataParts = []cloudinit.UserDataPart{
+						{
+							Name:        "base",
+							ContentType: "text/cloud-config",
+							Data:        "#cloud-config\nruncmd:\n- echo base\n",
+						},
+					}
+				})
+				It("Appends the firewall part to the parts", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(bsArgs.UserDataParts).To(HaveLen(1))
+					Expect(userdata).To(ContainSubstring("filename=base"))
+					Expect(userdata).To(ContainSubstring("filename=" + vmlifecycle.FirewallUserDataPartName))
+				})
+			})
+		})
 	})
 
 	Context("GetCloudInitMetadata", func() {
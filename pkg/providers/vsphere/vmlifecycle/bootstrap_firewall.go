@@ -0,0 +1,220 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit"
+)
+
+const (
+	// FirewallUserDataPartName is the name of the user data part that
+	// contains the guest firewall rules.
+	FirewallUserDataPartName = "vmoperator-firewall.cfg"
+
+	// firewallNFTablesPath is the path of the nftables ruleset in the guest.
+	firewallNFTablesPath = "/etc/vmoperator/firewall.nft"
+
+	// firewallNFTablesTable is the name of the nftables table that contains
+	// the guest firewall rules.
+	firewallNFTablesTable = "vmoperator"
+
+	// firewallSysprepRuleName is the name prefix of the Windows Firewall rules
+	// that are added for the guest firewall rules.
+	firewallSysprepRuleName = "vmoperator-ingress"
+)
+
+// guestFirewallSpec returns the VM's firewall spec if its rules are applied
+// inside of the guest. The rules are applied by the distributed firewall with
+// the NSX-T and VPC network providers, so they are not rendered into the
+// guest.
+func guestFirewallSpec(
+	vmCtx pkgctx.VirtualMachineContext) *vmopv1.VirtualMachineNetworkFirewallSpec {
+
+	networkSpec := vmCtx.VM.Spec.Network
+	if networkSpec == nil || networkSpec.Disabled || networkSpec.Firewall == nil {
+		return nil
+	}
+
+	switch pkgcfg.FromContext(vmCtx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeVDS, pkgcfg.NetworkProviderTypeNamed:
+		return networkSpec.Firewall
+	default:
+		return nil
+	}
+}
+
+// firewallUserDataPart returns a cloud-config user data part that applies
+// the firewall rules with nftables.
+//
+// The ruleset is written to the guest with write_files, applied once with
+// runcmd, and then re-applied with bootcmd each time the guest boots. The
+// part's merge_how appends its lists to those from the other user data parts
+// so it does not replace the user's own write_files, bootcmd, or runcmd.
+func firewallUserDataPart(
+	firewall *vmopv1.VirtualMachineNetworkFirewallSpec) cloudinit.UserDataPart {
+
+	applyCmd := fmt.Sprintf(
+		"[ ! -f %[1]s ] || nft -f %[1]s", firewallNFTablesPath)
+
+	var sb strings.Builder
+	sb.WriteString("#cloud-config\n")
+	sb.WriteString("merge_how:\n")
+	sb.WriteString("- name: list\n  settings: [append]\n")
+	sb.WriteString("- name: dict\n  settings: [no_replace, recurse_list]\n")
+	sb.WriteString("write_files:\n")
+	fmt.Fprintf(&sb, "- path: %s\n", firewallNFTablesPath)
+	sb.WriteString("  permissions: '0644'\n")
+	sb.WriteString("  content: |\n")
+	for _, line := range strings.Split(firewallNFTablesRuleset(firewall), "\n") {
+		if line != "" {
+			fmt.Fprintf(&sb, "    %s\n", line)
+		}
+	}
+	fmt.Fprintf(&sb, "bootcmd:\n- %q\n", applyCmd)
+	fmt.Fprintf(&sb, "runcmd:\n- %q\n", applyCmd)
+
+	return cloudinit.UserDataPart{
+		Name:        FirewallUserDataPartName,
+		ContentType: string(vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig),
+		Data:        sb.String(),
+	}
+}
+
+// firewallNFTablesRuleset returns the nftables ruleset for the firewall rules.
+//
+// Ingress traffic that does not match a rule is dropped, except for traffic
+// that belongs to an established connection, loopback traffic, ICMPv6 so IPv6
+// neighbor discovery keeps working, and DHCP replies.
+func firewallNFTablesRuleset(
+	firewall *vmopv1.VirtualMachineNetworkFirewallSpec) string {
+
+	var sb strings.Builder
+
+	// Declaring and then deleting the table makes the ruleset idempotent
+	// since the file may be applied more than once.
+	fmt.Fprintf(&sb, "table inet %s\n", firewallNFTablesTable)
+	fmt.Fprintf(&sb, "delete table inet %s\n", firewallNFTablesTable)
+	fmt.Fprintf(&sb, "table inet %s {\n", firewallNFTablesTable)
+	sb.WriteString("  chain input {\n")
+	sb.WriteString("    type filter hook input priority filter; policy drop;\n")
+	sb.WriteString("    ct state established,related accept\n")
+	sb.WriteString("    ct state invalid drop\n")
+	sb.WriteString("    iifname \"lo\" accept\n")
+	sb.WriteString("    meta l4proto ipv6-icmp accept\n")
+	sb.WriteString("    udp dport { 68, 546 } accept\n")
+
+	for i := range firewall.Ingress {
+		rule := &firewall.Ingress[i]
+
+		proto := strings.ToLower(string(firewallRuleProtocol(rule)))
+		match := "meta l4proto " + proto
+		if ports := firewallRulePorts(rule); ports != "" {
+			match = fmt.Sprintf("%s dport %s", proto, ports)
+		}
+
+		v4, v6 := firewallRuleCIDRs(rule)
+		if len(v4) == 0 && len(v6) == 0 {
+			fmt.Fprintf(&sb, "    %s accept\n", match)
+			continue
+		}
+		if len(v4) > 0 {
+			fmt.Fprintf(&sb, "    ip saddr { %s } %s accept\n",
+				strings.Join(v4, ", "), match)
+		}
+		if len(v6) > 0 {
+			fmt.Fprintf(&sb, "    ip6 saddr { %s } %s accept\n",
+				strings.Join(v6, ", "), match)
+		}
+	}
+
+	sb.WriteString("  }\n")
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// firewallSysprepCommands returns the commands that apply the firewall rules
+// with the Windows Firewall. The commands are run by GuiRunOnce, so they only
+// run when the Administrator logs on, which is why the validation webhook
+// requires AutoLogon.
+//
+// The default inbound action is set to block so ingress traffic that does not
+// match an allow rule is dropped. Please note the Windows Firewall may already
+// have other allow rules that are enabled.
+func firewallSysprepCommands(
+	firewall *vmopv1.VirtualMachineNetworkFirewallSpec) []string {
+
+	cmds := []string{
+		"netsh advfirewall set allprofiles state on",
+		"netsh advfirewall set allprofiles firewallpolicy blockinbound,allowoutbound",
+	}
+
+	for i := range firewall.Ingress {
+		rule := &firewall.Ingress[i]
+
+		cmd := fmt.Sprintf(
+			"netsh advfirewall firewall add rule name=%s-%d dir=in action=allow protocol=%s",
+			firewallSysprepRuleName, i, firewallRuleProtocol(rule))
+		if ports := firewallRulePorts(rule); ports != "" {
+			cmd += " localport=" + ports
+		}
+		if v4, v6 := firewallRuleCIDRs(rule); len(v4)+len(v6) > 0 {
+			cmd += " remoteip=" + strings.Join(append(v4, v6...), ",")
+		}
+
+		cmds = append(cmds, cmd)
+	}
+
+	return cmds
+}
+
+func firewallRuleProtocol(
+	rule *vmopv1.VirtualMachineNetworkFirewallRule) vmopv1.VirtualMachineNetworkFirewallProtocol {
+
+	if rule.Protocol == "" {
+		return vmopv1.VirtualMachineNetworkFirewallProtocolTCP
+	}
+	return rule.Protocol
+}
+
+// firewallRulePorts returns the rule's port or port range, ex. 8000-8080, or
+// an empty string if the rule matches all ports.
+func firewallRulePorts(rule *vmopv1.VirtualMachineNetworkFirewallRule) string {
+	if rule.Port == nil {
+		return ""
+	}
+	if rule.EndPort != nil && *rule.EndPort > *rule.Port {
+		return fmt.Sprintf("%d-%d", *rule.Port, *rule.EndPort)
+	}
+	return fmt.Sprintf("%d", *rule.Port)
+}
+
+// firewallRuleCIDRs returns the rule's IPv4 and IPv6 source networks. The
+// CIDRs are validated by the webhook, so any that cannot be parsed are
+// skipped.
+func firewallRuleCIDRs(
+	rule *vmopv1.VirtualMachineNetworkFirewallRule) (v4, v6 []string) {
+
+	for _, c := range rule.CIDRs {
+		prefix, err := netip.ParsePrefix(c)
+		if err != nil {
+			continue
+		}
+		prefix = prefix.Masked()
+		if prefix.Addr().Is4() {
+			v4 = append(v4, prefix.String())
+		} else {
+			v6 = append(v6, prefix.String())
+		}
+	}
+	return v4, v6
+}
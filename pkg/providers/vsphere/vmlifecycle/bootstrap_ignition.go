@@ -0,0 +1,160 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ignition"
+	ignitionvalidate "github.com/vmware-tanzu/vm-operator/pkg/util/ignition/validate"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+// IgnitionConfigSecretKey is the Secret key used for the Ignition config when
+// the key is omitted from spec.bootstrap.ignition.rawConfig.
+const IgnitionConfigSecretKey = "config.ign"
+
+func BootStrapIgnition(
+	vmCtx pkgctx.VirtualMachineContext,
+	config *vimtypes.VirtualMachineConfigInfo,
+	ignitionSpec *vmopv1.VirtualMachineBootstrapIgnitionSpec,
+	bsArgs *BootstrapArgs) (*vimtypes.VirtualMachineConfigSpec, *vimtypes.CustomizationSpec, error) {
+
+	logger := pkglog.FromContextOrDefault(vmCtx)
+	logger.V(4).Info("Reconciling Ignition bootstrap state")
+
+	if bsArgs.NetworkResults.UpdatedEthCards {
+		// Like Cloud-Init, do not apply a new config to a powered on VM with
+		// pending network device changes until the VM has the expected
+		// ethernet devices.
+		if vmCtx.MoVM.Runtime.PowerState == vimtypes.VirtualMachinePowerStatePoweredOn {
+			vmCtx.Logger.V(4).Info("Skipping Ignition bootstrap with pending network changes because VM is powered on")
+			return nil, nil, nil
+		}
+	}
+
+	data, err := GetIgnitionConfig(ignitionSpec, bsArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configSpec, err := GetIgnitionGuestInfoConfigSpec(vmCtx, config, data, ignitionSpec.Encoding)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return configSpec, nil, nil
+}
+
+// GetIgnitionConfig returns the validated Ignition config for the VM, with the
+// VM's network configuration merged in unless disabled.
+func GetIgnitionConfig(
+	ignitionSpec *vmopv1.VirtualMachineBootstrapIgnitionSpec,
+	bsArgs *BootstrapArgs) (string, error) {
+
+	var data string
+	if ignitionSpec.Config != "" {
+		data = ignitionSpec.Config
+	} else if raw := ignitionSpec.RawConfig; raw != nil {
+		key := raw.Key
+		if key == "" {
+			key = IgnitionConfigSecretKey
+		}
+
+		data = bsArgs.BootstrapData.Data[key]
+		if data == "" {
+			return "", fmt.Errorf("no Ignition config data with key %q", key)
+		}
+	} else {
+		return "", fmt.Errorf("no Ignition config data")
+	}
+
+	// Ensure the data is normalized first to plain-text.
+	data, err := pkgutil.TryToDecodeBase64Gzip([]byte(data))
+	if err != nil {
+		return "", fmt.Errorf("decoding Ignition config failed: %w", err)
+	}
+
+	if errs := ignitionvalidate.Config(nil, []byte(data)); len(errs) > 0 {
+		return "", fmt.Errorf("invalid Ignition config: %w", errs.ToAggregate())
+	}
+
+	if !ptr.DerefWithDefault(ignitionSpec.MergeNetworkConfig, true) {
+		return data, nil
+	}
+
+	netPlan, err := network.NetPlanCustomization(bsArgs.NetworkResults)
+	if err != nil {
+		return "", fmt.Errorf("failed to create NetPlan customization: %w", err)
+	}
+
+	fqdn := bsArgs.HostName
+	if bsArgs.DomainName != "" {
+		fqdn = bsArgs.HostName + "." + bsArgs.DomainName
+	}
+
+	merged, err := ignition.MergeFiles(
+		[]byte(data),
+		ignition.NetworkFiles(fqdn, netPlan)...)
+	if err != nil {
+		return "", fmt.Errorf("failed to merge network config into Ignition config: %w", err)
+	}
+
+	return string(merged), nil
+}
+
+func GetIgnitionGuestInfoConfigSpec(
+	ctx context.Context,
+	config *vimtypes.VirtualMachineConfigInfo,
+	data string,
+	encoding vmopv1.VirtualMachineBootstrapIgnitionEncoding) (*vimtypes.VirtualMachineConfigSpec, error) {
+
+	logger := pkglog.FromContextOrDefault(ctx)
+	logger.V(4).Info("Reconciling Ignition GuestInfo bootstrap state")
+
+	var (
+		encodedData  string
+		encodingName string
+	)
+
+	switch encoding {
+	case vmopv1.VirtualMachineBootstrapIgnitionEncodingBase64:
+		encodedData = base64.StdEncoding.EncodeToString([]byte(data))
+		encodingName = "base64"
+	case vmopv1.VirtualMachineBootstrapIgnitionEncodingGzipBase64, "":
+		var err error
+		if encodedData, err = pkgutil.EncodeGzipBase64(data); err != nil {
+			return nil, fmt.Errorf("encoding Ignition config failed: %w", err)
+		}
+		encodingName = "gzip+base64"
+	default:
+		return nil, fmt.Errorf("unsupported Ignition config encoding %q", encoding)
+	}
+
+	extraConfig := pkgutil.OptionValues{
+		&vimtypes.OptionValue{
+			Key:   constants.IgnitionGuestInfoConfigData,
+			Value: encodedData,
+		},
+		&vimtypes.OptionValue{
+			Key:   constants.IgnitionGuestInfoConfigDataEncoding,
+			Value: encodingName,
+		},
+	}
+
+	return &vimtypes.VirtualMachineConfigSpec{
+		ExtraConfig: pkgutil.OptionValues(config.ExtraConfig).Diff(extraConfig...),
+	}, nil
+}
@@ -0,0 +1,245 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+var _ = Describe("Ignition Bootstrap", func() {
+	const (
+		ignitionConfig = `{"ignition":{"version":"3.4.0"},"passwd":{"users":[{"name":"core"}]}}`
+		macAddr        = "00:50:56:aa:bb:cc"
+	)
+
+	var (
+		bsArgs       vmlifecycle.BootstrapArgs
+		configInfo   *vimtypes.VirtualMachineConfigInfo
+		ignitionSpec *vmopv1.VirtualMachineBootstrapIgnitionSpec
+
+		configSpec *vimtypes.VirtualMachineConfigSpec
+		custSpec   *vimtypes.CustomizationSpec
+		err        error
+
+		vmCtx pkgctx.VirtualMachineContext
+	)
+
+	getIgnitionConfig := func() map[string]any {
+		ExpectWithOffset(1, configSpec).ToNot(BeNil())
+		extraConfig := pkgutil.OptionValues(configSpec.ExtraConfig).StringMap()
+		data, err := pkgutil.TryToDecodeBase64Gzip([]byte(extraConfig[constants.IgnitionGuestInfoConfigData]))
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		var obj map[string]any
+		ExpectWithOffset(1, json.Unmarshal([]byte(data), &obj)).To(Succeed())
+		return obj
+	}
+
+	getFilePaths := func(obj map[string]any) []string {
+		var paths []string
+		if storage, ok := obj["storage"].(map[string]any); ok {
+			if files, ok := storage["files"].([]any); ok {
+				for _, f := range files {
+					paths = append(paths, f.(map[string]any)["path"].(string))
+				}
+			}
+		}
+		return paths
+	}
+
+	BeforeEach(func() {
+		configInfo = &vimtypes.VirtualMachineConfigInfo{}
+		ignitionSpec = &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+			Config: ignitionConfig,
+		}
+
+		bsArgs = vmlifecycle.BootstrapArgs{
+			HostName:   "my-vm",
+			DomainName: "local",
+			NetworkResults: network.NetworkInterfaceResults{
+				Results: []network.NetworkInterfaceResult{
+					{
+						MacAddress:      macAddr,
+						GuestDeviceName: "eth0",
+						IPConfigs: []network.NetworkInterfaceIPConfig{
+							{
+								IPCIDR:  "192.168.1.10/24",
+								IsIPv4:  true,
+								Gateway: "192.168.1.1",
+							},
+						},
+					},
+				},
+			},
+		}
+		bsArgs.Data = map[string]string{}
+
+		vmCtx = pkgctx.VirtualMachineContext{
+			Context: context.Background(),
+			Logger:  suite.GetLogger(),
+			VM: &vmopv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ignition-bootstrap-test",
+					Namespace: "test-ns",
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		configSpec, custSpec, err = vmlifecycle.BootStrapIgnition(
+			vmCtx,
+			configInfo,
+			ignitionSpec,
+			&bsArgs,
+		)
+	})
+
+	Context("Pending network changes because VM is powered on", func() {
+		BeforeEach(func() {
+			bsArgs.NetworkResults.UpdatedEthCards = true
+			vmCtx.MoVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOn
+		})
+
+		It("returns no error", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configSpec).To(BeNil())
+			Expect(custSpec).To(BeNil())
+		})
+	})
+
+	Context("Inline config", func() {
+		It("Should return the config with the network config merged in", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(custSpec).To(BeNil())
+
+			extraConfig := pkgutil.OptionValues(configSpec.ExtraConfig).StringMap()
+			Expect(extraConfig).To(HaveLen(2))
+			Expect(extraConfig).To(HaveKeyWithValue(constants.IgnitionGuestInfoConfigDataEncoding, "gzip+base64"))
+
+			obj := getIgnitionConfig()
+			Expect(obj).To(HaveKey("passwd"))
+			Expect(getFilePaths(obj)).To(ConsistOf(
+				"/etc/hostname",
+				"/etc/systemd/network/00-vmoperator-eth0.link",
+				"/etc/systemd/network/00-vmoperator-eth0.network",
+			))
+		})
+
+		When("the config already has a file at a merged path", func() {
+			BeforeEach(func() {
+				ignitionSpec.Config = `{"ignition":{"version":"3.4.0"},"storage":{"files":[{"path":"/etc/hostname","contents":{"source":"data:,mine"}}]}}`
+			})
+			It("Should not replace the file", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getIgnitionConfig()
+				Expect(getFilePaths(obj)).To(HaveLen(3))
+				files := obj["storage"].(map[string]any)["files"].([]any)
+				Expect(files[0].(map[string]any)["contents"]).To(HaveKeyWithValue("source", "data:,mine"))
+			})
+		})
+
+		When("merging the network config is disabled", func() {
+			BeforeEach(func() {
+				ignitionSpec.MergeNetworkConfig = ptr.To(false)
+			})
+			It("Should return the config as-is", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getIgnitionConfig()
+				Expect(obj).ToNot(HaveKey("storage"))
+			})
+		})
+
+		When("the encoding is base64", func() {
+			BeforeEach(func() {
+				ignitionSpec.Encoding = vmopv1.VirtualMachineBootstrapIgnitionEncodingBase64
+				ignitionSpec.MergeNetworkConfig = ptr.To(false)
+			})
+			It("Should return base64-encoded config", func() {
+				Expect(err).ToNot(HaveOccurred())
+				extraConfig := pkgutil.OptionValues(configSpec.ExtraConfig).StringMap()
+				Expect(extraConfig).To(HaveKeyWithValue(constants.IgnitionGuestInfoConfigDataEncoding, "base64"))
+				Expect(extraConfig).To(HaveKeyWithValue(constants.IgnitionGuestInfoConfigData,
+					base64.StdEncoding.EncodeToString([]byte(ignitionConfig))))
+			})
+		})
+
+		When("the config is invalid", func() {
+			BeforeEach(func() {
+				ignitionSpec.Config = `{"ignition":{"version":"2.3.0"}}`
+			})
+			It("Should return an error", func() {
+				Expect(err).To(MatchError(ContainSubstring("invalid Ignition config")))
+				Expect(configSpec).To(BeNil())
+			})
+		})
+
+		When("the ExtraConfig is already up-to-date", func() {
+			BeforeEach(func() {
+				ignitionSpec.Encoding = vmopv1.VirtualMachineBootstrapIgnitionEncodingBase64
+				ignitionSpec.MergeNetworkConfig = ptr.To(false)
+				configInfo.ExtraConfig = []vimtypes.BaseOptionValue{
+					&vimtypes.OptionValue{
+						Key:   constants.IgnitionGuestInfoConfigData,
+						Value: base64.StdEncoding.EncodeToString([]byte(ignitionConfig)),
+					},
+					&vimtypes.OptionValue{
+						Key:   constants.IgnitionGuestInfoConfigDataEncoding,
+						Value: "base64",
+					},
+				}
+			})
+			It("Should return an empty ExtraConfig", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(configSpec).ToNot(BeNil())
+				Expect(configSpec.ExtraConfig).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("Raw config", func() {
+		BeforeEach(func() {
+			ignitionSpec.Config = ""
+			ignitionSpec.RawConfig = &common.SecretKeySelector{
+				Name: "my-secret",
+			}
+		})
+
+		When("the Secret has the default key", func() {
+			BeforeEach(func() {
+				data, err := pkgutil.EncodeGzipBase64(ignitionConfig)
+				Expect(err).ToNot(HaveOccurred())
+				bsArgs.Data[vmlifecycle.IgnitionConfigSecretKey] = data
+			})
+			It("Should return the decoded config", func() {
+				Expect(err).ToNot(HaveOccurred())
+				obj := getIgnitionConfig()
+				Expect(obj).To(HaveKey("passwd"))
+			})
+		})
+
+		When("the Secret does not have the key", func() {
+			It("Should return an error", func() {
+				Expect(err).To(MatchError(`no Ignition config data with key "config.ign"`))
+			})
+		})
+	})
+})
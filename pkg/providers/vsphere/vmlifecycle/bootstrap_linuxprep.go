@@ -45,6 +45,36 @@ func BootStrapLinuxPrep(
 		return nil, nil, nil, fmt.Errorf("failed to create GOSC NIC mappings: %w", err)
 	}
 
+	identity := linuxPrepIdentity(vmCtx, linuxPrepSpec, bsArgs)
+
+	customSpec := &vimtypes.CustomizationSpec{
+		Identity: identity,
+		GlobalIPSettings: vimtypes.CustomizationGlobalIPSettings{
+			DnsSuffixList: bsArgs.SearchSuffixes,
+			DnsServerList: bsArgs.DNSServers,
+		},
+		NicSettingMap: nicSettingMap,
+	}
+
+	var configSpec *vimtypes.VirtualMachineConfigSpec
+	if vAppConfigSpec != nil {
+		configSpec = &vimtypes.VirtualMachineConfigSpec{}
+		configSpec.VAppConfig, err = GetOVFVAppConfigForConfigSpec(
+			config,
+			vAppConfigSpec,
+			bsArgs.BootstrapData.VAppData,
+			bsArgs.BootstrapData.VAppExData,
+			bsArgs.TemplateRenderFn)
+	}
+
+	return configSpec, customSpec, customizeAtNextPowerOn, err
+}
+
+func linuxPrepIdentity(
+	vmCtx pkgctx.VirtualMachineContext,
+	linuxPrepSpec *vmopv1.VirtualMachineBootstrapLinuxPrepSpec,
+	bsArgs *BootstrapArgs) *vimtypes.CustomizationLinuxPrep {
+
 	identity := &vimtypes.CustomizationLinuxPrep{
 		HostName: &vimtypes.CustomizationFixedName{
 			Name: bsArgs.HostName,
@@ -80,25 +110,5 @@ func BootStrapLinuxPrep(
 		}
 	}
 
-	customSpec := &vimtypes.CustomizationSpec{
-		Identity: identity,
-		GlobalIPSettings: vimtypes.CustomizationGlobalIPSettings{
-			DnsSuffixList: bsArgs.SearchSuffixes,
-			DnsServerList: bsArgs.DNSServers,
-		},
-		NicSettingMap: nicSettingMap,
-	}
-
-	var configSpec *vimtypes.VirtualMachineConfigSpec
-	if vAppConfigSpec != nil {
-		configSpec = &vimtypes.VirtualMachineConfigSpec{}
-		configSpec.VAppConfig, err = GetOVFVAppConfigForConfigSpec(
-			config,
-			vAppConfigSpec,
-			bsArgs.BootstrapData.VAppData,
-			bsArgs.BootstrapData.VAppExData,
-			bsArgs.TemplateRenderFn)
-	}
-
-	return configSpec, customSpec, customizeAtNextPowerOn, err
+	return identity
 }
@@ -0,0 +1,97 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"sigs.k8s.io/yaml"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+)
+
+// ReconcileGuestNetworkConfig publishes the VM's NetPlan network configuration
+// to the guest via GuestInfo after network interfaces were hot-added to or
+// hot-removed from the powered on VM. The configuration is only published
+// while the VM has the GuestNetworkConfigSynced condition, which is added when
+// the VM's network devices are changed while it is powered on.
+//
+// The same GuestInfo key is used regardless of the bootstrap provider since
+// neither Cloud-Init nor GOSC reapply the network configuration to an already
+// customized guest.
+func ReconcileGuestNetworkConfig(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcVM *object.VirtualMachine,
+	config *vimtypes.VirtualMachineConfigInfo,
+	bsArgs BootstrapArgs) error {
+
+	if !conditions.Has(vmCtx.VM, vmopv1.GuestNetworkConfigSyncedCondition) {
+		return nil
+	}
+
+	configSpec, err := GetGuestNetworkConfigSpec(config, bsArgs)
+	if err != nil {
+		return err
+	}
+
+	if len(configSpec.ExtraConfig) == 0 {
+		return nil
+	}
+
+	vmCtx.Logger.Info("Publishing updated guest network config")
+	if err := doReconfigure(vmCtx, vcVM, configSpec); err != nil {
+		return fmt.Errorf("guest network config reconfigure failed: %w", err)
+	}
+
+	conditions.MarkFalse(
+		vmCtx.VM,
+		vmopv1.GuestNetworkConfigSyncedCondition,
+		vmopv1.GuestNetworkConfigPendingReason,
+		"Waiting for the guest to apply the updated network config")
+
+	return nil
+}
+
+// GetGuestNetworkConfigSpec returns a ConfigSpec with the ExtraConfig changes
+// required to publish the VM's NetPlan network configuration to the guest.
+func GetGuestNetworkConfigSpec(
+	config *vimtypes.VirtualMachineConfigInfo,
+	bsArgs BootstrapArgs) (*vimtypes.VirtualMachineConfigSpec, error) {
+
+	netPlan, err := network.NetPlanCustomization(bsArgs.NetworkResults)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NetPlan customization: %w", err)
+	}
+
+	data, err := yaml.Marshal(netPlan)
+	if err != nil {
+		return nil, fmt.Errorf("yaml marshalling of guest network config failed: %w", err)
+	}
+
+	encodedData, err := pkgutil.EncodeGzipBase64(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("encoding guest network config failed: %w", err)
+	}
+
+	return &vimtypes.VirtualMachineConfigSpec{
+		ExtraConfig: pkgutil.OptionValues(config.ExtraConfig).Diff(
+			&vimtypes.OptionValue{
+				Key:   constants.GuestInfoNetworkConfig,
+				Value: encodedData,
+			},
+			&vimtypes.OptionValue{
+				Key:   constants.GuestInfoNetworkConfigEncoding,
+				Value: "gzip+base64",
+			},
+		),
+	}, nil
+}
@@ -0,0 +1,96 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/netplan"
+)
+
+var _ = Describe("GetGuestNetworkConfigSpec", func() {
+	const macAddr = "00:50:56:aa:bb:cc"
+
+	var (
+		bsArgs     vmlifecycle.BootstrapArgs
+		configInfo *vimtypes.VirtualMachineConfigInfo
+
+		configSpec *vimtypes.VirtualMachineConfigSpec
+		err        error
+	)
+
+	BeforeEach(func() {
+		configInfo = &vimtypes.VirtualMachineConfigInfo{}
+		bsArgs = vmlifecycle.BootstrapArgs{
+			NetworkResults: network.NetworkInterfaceResults{
+				Results: []network.NetworkInterfaceResult{
+					{
+						Name:            "eth0",
+						MacAddress:      macAddr,
+						GuestDeviceName: "eth0",
+						IPConfigs: []network.NetworkInterfaceIPConfig{
+							{
+								IPCIDR:  "192.168.1.10/24",
+								IsIPv4:  true,
+								Gateway: "192.168.1.1",
+							},
+						},
+					},
+					{
+						Name:            "eth1",
+						MacAddress:      "00:50:56:aa:bb:cd",
+						GuestDeviceName: "eth1",
+						DHCP4:           true,
+					},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		configSpec, err = vmlifecycle.GetGuestNetworkConfigSpec(configInfo, bsArgs)
+	})
+
+	It("returns the NetPlan config for every interface", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(configSpec).ToNot(BeNil())
+
+		extraConfig := pkgutil.OptionValues(configSpec.ExtraConfig).StringMap()
+		Expect(extraConfig).To(HaveLen(2))
+		Expect(extraConfig).To(HaveKeyWithValue(constants.GuestInfoNetworkConfigEncoding, "gzip+base64"))
+
+		data, err := pkgutil.TryToDecodeBase64Gzip([]byte(extraConfig[constants.GuestInfoNetworkConfig]))
+		Expect(err).ToNot(HaveOccurred())
+
+		var netPlan netplan.Network
+		Expect(yaml.Unmarshal([]byte(data), &netPlan)).To(Succeed())
+		Expect(netPlan.Ethernets).To(HaveLen(2))
+		Expect(netPlan.Ethernets).To(HaveKey("eth0"))
+		Expect(netPlan.Ethernets).To(HaveKey("eth1"))
+		Expect(netPlan.Ethernets["eth0"].Addresses).To(HaveLen(1))
+		Expect(*netPlan.Ethernets["eth0"].Addresses[0].String).To(Equal("192.168.1.10/24"))
+	})
+
+	When("the VM already has the network config", func() {
+		BeforeEach(func() {
+			cs, err := vmlifecycle.GetGuestNetworkConfigSpec(configInfo, bsArgs)
+			Expect(err).ToNot(HaveOccurred())
+			configInfo.ExtraConfig = cs.ExtraConfig
+		})
+
+		It("returns no ExtraConfig changes", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configSpec.ExtraConfig).To(BeEmpty())
+		})
+	})
+})
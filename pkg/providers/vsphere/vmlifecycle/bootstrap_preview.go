@@ -0,0 +1,423 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/linuxprep"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/sysprep"
+)
+
+// BootstrapPreview is the bootstrap payload that would be sent to the guest
+// for a VM. Any values that originate from Secrets are redacted unless the
+// LogSensitiveData option is enabled.
+type BootstrapPreview struct {
+	// CloudConfig is the Cloud-Init user data.
+	CloudConfig string `json:"cloudConfig,omitempty"`
+
+	// Metadata is the Cloud-Init metadata, including the network config.
+	Metadata string `json:"metadata,omitempty"`
+
+	// NetPlan is the network configuration in the netplan format.
+	NetPlan string `json:"netplan,omitempty"`
+
+	// Sysprep is either the rendered, raw Sysprep unattend XML or the
+	// CustomizationSysprep generated from the inline Sysprep fields.
+	Sysprep string `json:"sysprep,omitempty"`
+
+	// LinuxPrep is the generated CustomizationLinuxPrep.
+	LinuxPrep string `json:"linuxPrep,omitempty"`
+
+	// GuestOSCustomization is the per-adapter GOSC network configuration.
+	GuestOSCustomization string `json:"guestOSCustomization,omitempty"`
+
+	// Ignition is the Ignition config, including any merged network config.
+	Ignition string `json:"ignition,omitempty"`
+
+	// VAppProperties are the rendered vApp property values by key.
+	VAppProperties map[string]string `json:"vAppProperties,omitempty"`
+
+	// TemplateErrors are the errors encountered when rendering any templates.
+	TemplateErrors []string `json:"templateErrors,omitempty"`
+}
+
+// PreviewBootstrap returns the bootstrap payload for the VM without
+// reconfiguring or customizing it. The same functions used to bootstrap the
+// VM are used to render the payload, so the preview matches what the guest
+// receives, except that template errors are returned instead of only logged.
+func PreviewBootstrap(
+	vmCtx pkgctx.VirtualMachineContext,
+	bsArgs BootstrapArgs) (BootstrapPreview, error) {
+
+	var preview BootstrapPreview
+
+	bootstrap := vmCtx.VM.Spec.Bootstrap
+	if bootstrap == nil {
+		return preview, nil
+	}
+
+	sensitive := pkgcfg.FromContext(vmCtx).LogSensitiveData
+	redactRaw := !sensitive && rawBootstrapDataFromSecret(vmCtx.VM)
+	if !sensitive {
+		bsArgs.BootstrapData = redactBootstrapData(bootstrap, bsArgs.BootstrapData, redactRaw)
+	}
+
+	if bootstrap.Sysprep != nil || bootstrap.VAppConfig != nil {
+		bsArgs.TemplateRenderFn = getTemplateRenderFunc(
+			vmCtx,
+			&bsArgs,
+			func(name, _ string, err error) {
+				preview.TemplateErrors = append(
+					preview.TemplateErrors,
+					fmt.Sprintf("%s: %s", name, err))
+			})
+	}
+
+	switch {
+	case bootstrap.CloudInit != nil:
+		if err := previewCloudInit(vmCtx, bootstrap.CloudInit, &bsArgs, sensitive, redactRaw, &preview); err != nil {
+			return preview, err
+		}
+	case bootstrap.LinuxPrep != nil:
+		if err := previewGOSCNetwork(&bsArgs, &preview); err != nil {
+			return preview, err
+		}
+		var err error
+		preview.LinuxPrep, err = marshalPreview(
+			linuxPrepIdentity(vmCtx, bootstrap.LinuxPrep, &bsArgs))
+		if err != nil {
+			return preview, err
+		}
+	case bootstrap.Sysprep != nil:
+		if err := previewGOSCNetwork(&bsArgs, &preview); err != nil {
+			return preview, err
+		}
+		if err := previewSysprep(vmCtx, bootstrap.Sysprep, &bsArgs, sensitive, redactRaw, &preview); err != nil {
+			return preview, err
+		}
+	case bootstrap.Ignition != nil:
+		data, err := GetIgnitionConfig(bootstrap.Ignition, &bsArgs)
+		if err != nil {
+			return preview, err
+		}
+		switch {
+		case redactRaw && bootstrap.Ignition.Config == "":
+			data = redacted
+		case !sensitive:
+			data = redactIgnitionConfig(data)
+		}
+		preview.Ignition = data
+	}
+
+	if vApp := bootstrap.VAppConfig; vApp != nil {
+		preview.VAppProperties = previewVAppProperties(vApp, &bsArgs)
+	}
+
+	return preview, nil
+}
+
+func previewCloudInit(
+	vmCtx pkgctx.VirtualMachineContext,
+	cloudInitSpec *vmopv1.VirtualMachineBootstrapCloudInitSpec,
+	bsArgs *BootstrapArgs,
+	sensitive, redactRaw bool,
+	preview *BootstrapPreview) error {
+
+	// Do not let the preview mutate the VM's instance ID.
+	cloudInitSpec = cloudInitSpec.DeepCopy()
+	vm := vmCtx.VM.DeepCopy()
+
+	netPlan, err := network.NetPlanCustomization(bsArgs.NetworkResults)
+	if err != nil {
+		return fmt.Errorf("failed to create NetPlan customization: %w", err)
+	}
+	if preview.NetPlan, err = marshalPreview(netPlan); err != nil {
+		return err
+	}
+
+	sshPublicKeys := bsArgs.BootstrapData.Data["ssh-public-keys"]
+	if len(cloudInitSpec.SSHAuthorizedKeys) > 0 {
+		sshPublicKeys = strings.Join(cloudInitSpec.SSHAuthorizedKeys, "\n")
+	} else if redactRaw && sshPublicKeys != "" {
+		sshPublicKeys = redacted
+	}
+
+	metadata, err := GetCloudInitMetadata(
+		BootStrapCloudInitInstanceID(vm, cloudInitSpec),
+		bsArgs.HostName, bsArgs.DomainName, netPlan, sshPublicKeys,
+		cloudInitSpec.WaitOnNetwork4, cloudInitSpec.WaitOnNetwork6)
+	if err != nil {
+		return err
+	}
+	preview.Metadata = metadata
+
+	userdata, err := getCloudInitUserData(vmCtx, cloudInitSpec, bsArgs)
+	if err != nil {
+		return err
+	}
+	if userdata != "" {
+		if userdata, err = pkgutil.TryToDecodeBase64Gzip([]byte(userdata)); err != nil {
+			return fmt.Errorf("decoding cloud-init userdata failed: %w", err)
+		}
+	}
+	switch {
+	case redactRaw && cloudInitSpec.RawCloudConfig != nil:
+		userdata = redacted
+	case !sensitive:
+		userdata = redactCloudConfig(userdata)
+	}
+	preview.CloudConfig = userdata
+
+	return nil
+}
+
+func previewGOSCNetwork(
+	bsArgs *BootstrapArgs,
+	preview *BootstrapPreview) error {
+
+	nicSettingMap, err := network.GuestOSCustomization(bsArgs.NetworkResults)
+	if err != nil {
+		return fmt.Errorf("failed to create GOSC adapter mappings: %w", err)
+	}
+
+	preview.GuestOSCustomization, err = marshalPreview(nicSettingMap)
+	return err
+}
+
+func previewSysprep(
+	vmCtx pkgctx.VirtualMachineContext,
+	sysPrepSpec *vmopv1.VirtualMachineBootstrapSysprepSpec,
+	bsArgs *BootstrapArgs,
+	sensitive, redactRaw bool,
+	preview *BootstrapPreview) error {
+
+	if raw := sysPrepSpec.RawSysprep; raw != nil {
+		key := raw.Key
+		if key == "" {
+			key = "unattend"
+		}
+
+		data := bsArgs.BootstrapData.Data[key]
+		if data == "" {
+			return fmt.Errorf("no Sysprep XML data with key %q", key)
+		}
+
+		data, err := pkgutil.TryToDecodeBase64Gzip([]byte(data))
+		if err != nil {
+			return fmt.Errorf("decoding Sysprep unattend XML failed: %w", err)
+		}
+
+		if bsArgs.TemplateRenderFn != nil {
+			data = bsArgs.TemplateRenderFn(key, data)
+		}
+
+		switch {
+		case redactRaw:
+			data = redacted
+		case !sensitive:
+			data = redactSysprepXML(data)
+		}
+
+		preview.Sysprep = data
+		return nil
+	}
+
+	if sysPrep := sysPrepSpec.Sysprep; sysPrep != nil {
+		var err error
+		preview.Sysprep, err = marshalPreview(convertTo(vmCtx, sysPrep, bsArgs))
+		return err
+	}
+
+	return fmt.Errorf("no Sysprep data")
+}
+
+func previewVAppProperties(
+	vAppConfigSpec *vmopv1.VirtualMachineBootstrapVAppConfigSpec,
+	bsArgs *BootstrapArgs) map[string]string {
+
+	vAppData := maps.Clone(bsArgs.BootstrapData.VAppData)
+	if len(vAppConfigSpec.Properties) > 0 {
+		vAppData = map[string]string{}
+
+		for _, p := range vAppConfigSpec.Properties {
+			if p.Value.Value != nil {
+				vAppData[p.Key] = *p.Value.Value
+			} else if p.Value.From != nil {
+				from := p.Value.From
+				vAppData[p.Key] = bsArgs.BootstrapData.VAppExData[from.Name][from.Key]
+			}
+		}
+	}
+
+	if bsArgs.TemplateRenderFn != nil {
+		for k, v := range vAppData {
+			vAppData[k] = bsArgs.TemplateRenderFn(k, v)
+		}
+	}
+
+	return vAppData
+}
+
+// rawBootstrapDataFromSecret returns true if the VM's raw bootstrap data, ex.
+// the raw Cloud-Init user data or vApp properties, is read from a Secret. It
+// is only read from a ConfigMap for VMs that use the v1alpha1 ConfigMap
+// transport.
+func rawBootstrapDataFromSecret(vm *vmopv1.VirtualMachine) bool {
+	_, ok := vm.Annotations[vmopv1.V1alpha1ConfigMapTransportAnnotation]
+	return !ok
+}
+
+// redactBootstrapData returns a copy of the bootstrap data with the values
+// that are referenced from Secrets replaced. The raw Cloud-Init user data,
+// Sysprep XML and Ignition config are left as-is so any template errors are
+// still reported, and are instead redacted in their entirety once rendered.
+func redactBootstrapData(
+	bootstrap *vmopv1.VirtualMachineBootstrapSpec,
+	in BootstrapData,
+	redactRaw bool) BootstrapData {
+
+	out := in
+
+	if redactRaw && in.VAppData != nil {
+		out.VAppData = redactValues(in.VAppData)
+	}
+
+	if in.VAppExData != nil {
+		out.VAppExData = make(map[string]map[string]string, len(in.VAppExData))
+		for name, data := range in.VAppExData {
+			out.VAppExData[name] = redactValues(data)
+		}
+	}
+
+	if cc := in.CloudConfig; cc != nil {
+		ccCopy := cloudinit.CloudConfigSecretData{}
+		if cc.Users != nil {
+			ccCopy.Users = make(map[string]cloudinit.CloudConfigUserSecretData, len(cc.Users))
+			for k, v := range cc.Users {
+				if v.HashPasswd != "" {
+					v.HashPasswd = redacted
+				}
+				if v.Passwd != "" {
+					v.Passwd = redacted
+				}
+				ccCopy.Users[k] = v
+			}
+		}
+		if cc.WriteFiles != nil {
+			ccCopy.WriteFiles = redactValues(cc.WriteFiles)
+		}
+		out.CloudConfig = &ccCopy
+	}
+
+	if sp := in.Sysprep; sp != nil {
+		spCopy := sysprep.SecretData{
+			ScriptText: sp.ScriptText,
+		}
+		if sp.ProductID != "" {
+			spCopy.ProductID = redacted
+		}
+		if sp.Password != "" {
+			spCopy.Password = redacted
+		}
+		if sp.DomainUsername != "" {
+			spCopy.DomainUsername = redacted
+		}
+		if sp.DomainPassword != "" {
+			spCopy.DomainPassword = redacted
+		}
+		if sp.ScriptText != "" && bootstrap.Sysprep != nil && bootstrap.Sysprep.Sysprep != nil &&
+			scriptTextFromSecret(bootstrap.Sysprep.Sysprep.ScriptText) {
+			spCopy.ScriptText = redacted
+		}
+		out.Sysprep = &spCopy
+	}
+
+	if parts := in.UserDataParts; len(parts) > 0 &&
+		bootstrap.CloudInit != nil && len(bootstrap.CloudInit.UserDataParts) == len(parts) {
+
+		out.UserDataParts = make([]cloudinit.UserDataPart, len(parts))
+		for i := range parts {
+			out.UserDataParts[i] = parts[i]
+			if bootstrap.CloudInit.UserDataParts[i].Secret != nil {
+				out.UserDataParts[i].Data = redacted
+				out.UserDataParts[i].Redacted = true
+			}
+		}
+	}
+
+	if lp := in.LinuxPrep; lp != nil {
+		lpCopy := linuxprep.SecretData{
+			ScriptText: lp.ScriptText,
+		}
+		if lp.Password != "" {
+			lpCopy.Password = redacted
+		}
+		if lp.ScriptText != "" && bootstrap.LinuxPrep != nil &&
+			scriptTextFromSecret(bootstrap.LinuxPrep.ScriptText) {
+			lpCopy.ScriptText = redacted
+		}
+		out.LinuxPrep = &lpCopy
+	}
+
+	return out
+}
+
+func scriptTextFromSecret(scriptText *common.ValueOrSecretKeySelector) bool {
+	return scriptText != nil && scriptText.From != nil
+}
+
+func redactValues(in map[string]string) map[string]string {
+	out := make(map[string]string, len(in))
+	for k := range in {
+		out[k] = redacted
+	}
+	return out
+}
+
+var (
+	cloudConfigPasswordRx = regexp.MustCompile(`(?m)^(\s*(?:-\s+)?(?:passwd|hashed_passwd|plain_text_passwd|password)\s*:\s*)\S.*$`)
+	sysprepPasswordRx     = regexp.MustCompile(`(?is)(<(?:AdministratorPassword|Password)>.*?<Value>).*?(</Value>)`)
+	sysprepProductKeyRx   = regexp.MustCompile(`(?is)(<ProductKey>(?:\s*<Key>)?).*?(</(?:Key|ProductKey)>)`)
+	ignitionPasswordRx    = regexp.MustCompile(`("passwordHash"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+)
+
+// redactCloudConfig redacts the user passwords in a cloud-config document.
+func redactCloudConfig(data string) string {
+	return cloudConfigPasswordRx.ReplaceAllString(data, "${1}"+redacted)
+}
+
+// redactIgnitionConfig redacts the user password hashes in an Ignition config.
+func redactIgnitionConfig(data string) string {
+	return ignitionPasswordRx.ReplaceAllString(data, `${1}"`+redacted+`"`)
+}
+
+// redactSysprepXML redacts the passwords and product key in an unattend XML
+// document.
+func redactSysprepXML(data string) string {
+	data = sysprepPasswordRx.ReplaceAllString(data, "${1}"+redacted+"${2}")
+	data = sysprepProductKeyRx.ReplaceAllString(data, "${1}"+redacted+"${2}")
+	return data
+}
+
+func marshalPreview(obj any) (string, error) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal bootstrap preview: %w", err)
+	}
+	return string(data), nil
+}
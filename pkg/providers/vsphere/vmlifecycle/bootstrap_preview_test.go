@@ -0,0 +1,372 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
+	vmopv1sysprep "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/sysprep"
+)
+
+var _ = Describe("PreviewBootstrap", func() {
+
+	const (
+		macAddr = "00:50:56:aa:bb:cc"
+		ipCIDR  = "192.168.1.10/24"
+		gateway = "192.168.1.1"
+	)
+
+	var (
+		ctx     context.Context
+		vm      *vmopv1.VirtualMachine
+		bsArgs  vmlifecycle.BootstrapArgs
+		preview vmlifecycle.BootstrapPreview
+		err     error
+	)
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+
+		vm = &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-vm",
+				Namespace: "my-ns",
+				UID:       "my-uid",
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				Bootstrap: &vmopv1.VirtualMachineBootstrapSpec{},
+			},
+		}
+
+		bsArgs = vmlifecycle.BootstrapArgs{
+			HostName: "my-vm",
+			NetworkResults: network.NetworkInterfaceResults{
+				Results: []network.NetworkInterfaceResult{
+					{
+						MacAddress:      macAddr,
+						GuestDeviceName: "eth0",
+						IPConfigs: []network.NetworkInterfaceIPConfig{
+							{
+								IPCIDR:  ipCIDR,
+								IsIPv4:  true,
+								Gateway: gateway,
+							},
+						},
+					},
+				},
+			},
+		}
+		bsArgs.Data = map[string]string{}
+	})
+
+	JustBeforeEach(func() {
+		vmCtx := pkgctx.VirtualMachineContext{
+			Context: ctx,
+			Logger:  suite.GetLogger().WithName("bootstrap-preview-tests"),
+			VM:      vm,
+		}
+		preview, err = vmlifecycle.PreviewBootstrap(vmCtx, bsArgs)
+	})
+
+	When("there is no bootstrap spec", func() {
+		BeforeEach(func() {
+			vm.Spec.Bootstrap = nil
+		})
+		It("returns an empty preview", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(preview).To(Equal(vmlifecycle.BootstrapPreview{}))
+		})
+	})
+
+	When("using Cloud-Init", func() {
+		BeforeEach(func() {
+			vm.Spec.Bootstrap.CloudInit = &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+				RawCloudConfig: &vmopv1common.SecretKeySelector{
+					Name: "my-secret",
+					Key:  "user-data",
+				},
+			}
+			bsArgs.Data["user-data"] = "#cloud-config\nusers:\n- name: bob\n  passwd: hunter2\n"
+		})
+
+		It("returns the metadata and netplan and redacts the user data", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(preview.CloudConfig).To(Equal("***"))
+			Expect(preview.Metadata).To(ContainSubstring("instance-id: my-uid"))
+			Expect(preview.NetPlan).To(ContainSubstring(ipCIDR))
+			Expect(preview.NetPlan).To(ContainSubstring(macAddr))
+		})
+
+		When("the user data is from a ConfigMap", func() {
+			BeforeEach(func() {
+				vm.Annotations = map[string]string{
+					vmopv1.V1alpha1ConfigMapTransportAnnotation: "true",
+				}
+			})
+			It("redacts the password", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(preview.CloudConfig).To(ContainSubstring("name: bob"))
+				Expect(preview.CloudConfig).To(ContainSubstring("passwd: ***"))
+				Expect(preview.CloudConfig).ToNot(ContainSubstring("hunter2"))
+			})
+		})
+
+		It("does not mutate the VM", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Spec.Bootstrap.CloudInit.InstanceID).To(BeEmpty())
+		})
+
+		When("sensitive data is allowed", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.LogSensitiveData = true
+				})
+			})
+			It("does not redact the user data", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(preview.CloudConfig).To(ContainSubstring("name: bob"))
+				Expect(preview.CloudConfig).To(ContainSubstring("passwd: hunter2"))
+			})
+		})
+	})
+
+	When("using Cloud-Init user data parts", func() {
+		BeforeEach(func() {
+			vm.Spec.Bootstrap.CloudInit = &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+				UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+					{
+						Name:  "base",
+						Value: ptr.To("#cloud-config\nruncmd:\n- echo base\n"),
+					},
+					{
+						Name: "agent",
+						Secret: &vmopv1common.SecretKeySelector{
+							Name: "my-secret",
+							Key:  "agent",
+						},
+					},
+				},
+			}
+			bsArgs.UserDataParts = []cloudinit.UserDataPart{
+				{
+					Name:        "base",
+					ContentType: "text/cloud-config",
+					Data:        "#cloud-config\nruncmd:\n- echo base\n",
+				},
+				{
+					Name:        "agent",
+					ContentType: "text/cloud-config",
+					Data:        "#cloud-config\nruncmd:\n- echo token=hunter2\n",
+				},
+			}
+		})
+
+		It("redacts the parts from Secrets", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(preview.CloudConfig).To(ContainSubstring("echo base"))
+			Expect(preview.CloudConfig).ToNot(ContainSubstring("hunter2"))
+		})
+
+		It("does not mutate the bootstrap args", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bsArgs.UserDataParts[1].Data).To(ContainSubstring("hunter2"))
+		})
+
+		When("sensitive data is allowed", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.LogSensitiveData = true
+				})
+			})
+			It("does not redact the parts", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(preview.CloudConfig).To(ContainSubstring("hunter2"))
+			})
+		})
+	})
+
+	When("using raw Sysprep", func() {
+		BeforeEach(func() {
+			vm.Spec.Bootstrap.Sysprep = &vmopv1.VirtualMachineBootstrapSysprepSpec{
+				RawSysprep: &vmopv1common.SecretKeySelector{
+					Name: "my-secret",
+					Key:  "unattend",
+				},
+			}
+		})
+
+		When("the template is valid", func() {
+			BeforeEach(func() {
+				bsArgs.Data["unattend"] = `<IP>{{ V1alpha5_FirstIP }}</IP>` +
+					`<AdministratorPassword><Value>hunter2</Value></AdministratorPassword>`
+			})
+			It("redacts the rendered XML", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(preview.TemplateErrors).To(BeEmpty())
+				Expect(preview.Sysprep).To(Equal("***"))
+				Expect(preview.GuestOSCustomization).To(ContainSubstring("192.168.1.10"))
+			})
+
+			When("sensitive data is allowed", func() {
+				BeforeEach(func() {
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.LogSensitiveData = true
+					})
+				})
+				It("returns the rendered XML", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(preview.Sysprep).To(ContainSubstring("<IP>" + ipCIDR + "</IP>"))
+					Expect(preview.Sysprep).To(ContainSubstring("<Value>hunter2</Value>"))
+				})
+			})
+
+			When("the XML is from a ConfigMap", func() {
+				BeforeEach(func() {
+					vm.Annotations = map[string]string{
+						vmopv1.V1alpha1ConfigMapTransportAnnotation: "true",
+					}
+				})
+				It("returns the rendered XML and redacts the password", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(preview.Sysprep).To(ContainSubstring("<IP>" + ipCIDR + "</IP>"))
+					Expect(preview.Sysprep).To(ContainSubstring("<Value>***</Value>"))
+				})
+			})
+		})
+
+		When("the template is invalid", func() {
+			BeforeEach(func() {
+				bsArgs.Data["unattend"] = `<IP>{{ .V1alpha5.Nope }}</IP>`
+			})
+			It("returns the template error", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(preview.TemplateErrors).To(HaveLen(1))
+				Expect(preview.TemplateErrors[0]).To(HavePrefix("unattend: failed to execute template"))
+				Expect(preview.Sysprep).To(Equal("***"))
+			})
+		})
+	})
+
+	When("using inline Sysprep", func() {
+		BeforeEach(func() {
+			vm.Spec.Bootstrap.Sysprep = &vmopv1.VirtualMachineBootstrapSysprepSpec{
+				Sysprep: &vmopv1sysprep.Sysprep{
+					GUIUnattended: &vmopv1sysprep.GUIUnattended{
+						AutoLogon: true,
+						Password: &vmopv1sysprep.PasswordSecretKeySelector{
+							Name: "my-secret",
+							Key:  "password",
+						},
+					},
+					ScriptText: &vmopv1common.ValueOrSecretKeySelector{
+						From: &vmopv1common.SecretKeySelector{
+							Name: "my-secret",
+							Key:  "script",
+						},
+					},
+				},
+			}
+			bsArgs.Sysprep = &sysprep.SecretData{
+				Password:   "hunter2",
+				ScriptText: "echo hunter3",
+			}
+		})
+		It("redacts the password and script", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(preview.Sysprep).To(ContainSubstring("***"))
+			Expect(preview.Sysprep).ToNot(ContainSubstring("hunter2"))
+			Expect(preview.Sysprep).ToNot(ContainSubstring("hunter3"))
+		})
+	})
+
+	When("using Ignition", func() {
+		BeforeEach(func() {
+			vm.Spec.Bootstrap.Ignition = &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+				Config: `{"ignition":{"version":"3.4.0"},"passwd":{"users":[{"name":"core","passwordHash":"hunter2"}]}}`,
+			}
+		})
+		It("returns the config with the network config and redacts the password", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(preview.Ignition).To(ContainSubstring(`"passwordHash":"***"`))
+			Expect(preview.Ignition).ToNot(ContainSubstring("hunter2"))
+			Expect(preview.Ignition).To(ContainSubstring("/etc/systemd/network/00-vmoperator-eth0.network"))
+		})
+
+		When("the config is from a Secret", func() {
+			BeforeEach(func() {
+				vm.Spec.Bootstrap.Ignition = &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+					RawConfig: &vmopv1common.SecretKeySelector{
+						Name: "my-secret",
+					},
+				}
+				bsArgs.Data[vmlifecycle.IgnitionConfigSecretKey] = `{"ignition":{"version":"3.4.0"}}`
+			})
+			It("redacts the config", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(preview.Ignition).To(Equal("***"))
+			})
+		})
+	})
+
+	When("using vApp properties", func() {
+		BeforeEach(func() {
+			vm.Spec.Bootstrap.VAppConfig = &vmopv1.VirtualMachineBootstrapVAppConfigSpec{
+				Properties: []vmopv1common.KeyValueOrSecretKeySelectorPair{
+					{
+						Key: "ip",
+						Value: vmopv1common.ValueOrSecretKeySelector{
+							Value: ptr.To("{{ V1alpha5_FirstIP }}"),
+						},
+					},
+					{
+						Key: "password",
+						Value: vmopv1common.ValueOrSecretKeySelector{
+							From: &vmopv1common.SecretKeySelector{
+								Name: "my-secret",
+								Key:  "password",
+							},
+						},
+					},
+				},
+			}
+			bsArgs.VAppExData = map[string]map[string]string{
+				"my-secret": {"password": "hunter2"},
+			}
+		})
+		It("renders the values and redacts the ones from Secrets", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(preview.VAppProperties).To(HaveKeyWithValue("ip", ipCIDR))
+			Expect(preview.VAppProperties).To(HaveKeyWithValue("password", "***"))
+		})
+
+		When("the properties are from a Secret", func() {
+			BeforeEach(func() {
+				vm.Spec.Bootstrap.VAppConfig = &vmopv1.VirtualMachineBootstrapVAppConfigSpec{
+					RawProperties: "my-secret",
+				}
+				bsArgs.VAppData = map[string]string{
+					"password": "hunter2",
+				}
+			})
+			It("redacts the values", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(preview.VAppProperties).To(Equal(map[string]string{"password": "***"}))
+			})
+		})
+	})
+})
@@ -5,8 +5,10 @@
 package vmlifecycle
 
 import (
+	"crypto/sha256"
 	"fmt"
 
+	"github.com/vmware/govmomi/object"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
@@ -36,6 +38,11 @@ func BootstrapSysPrep(
 	}
 
 	customizeAtNextPowerOn := sysPrepSpec.CustomizeAtNextPowerOn
+	if bsArgs.CustomizeSysprepDomainJoin &&
+		(customizeAtNextPowerOn == nil || !*customizeAtNextPowerOn) {
+		// Use a latch that is not in the spec so the spec is unchanged.
+		customizeAtNextPowerOn = ptr.To(true)
+	}
 	if customizeAtNextPowerOn != nil && !*customizeAtNextPowerOn {
 		vmCtx.Logger.V(4).Info("Skipping Sysprep since customization at next power on is false")
 		return nil, nil, customizeAtNextPowerOn, nil
@@ -142,9 +149,17 @@ func convertTo(
 		sysprepCustomization.UserData.ProductId = bootstrapData.Sysprep.ProductID
 	}
 
+	var guiRunOnceCommands []string
+	if firewall := guestFirewallSpec(vmCtx); firewall != nil {
+		// Apply the firewall rules before the user's commands.
+		guiRunOnceCommands = append(guiRunOnceCommands, firewallSysprepCommands(firewall)...)
+	}
 	if from.GUIRunOnce != nil {
+		guiRunOnceCommands = append(guiRunOnceCommands, from.GUIRunOnce.Commands...)
+	}
+	if from.GUIRunOnce != nil || len(guiRunOnceCommands) > 0 {
 		sysprepCustomization.GuiRunOnce = &vimtypes.CustomizationGuiRunOnce{
-			CommandList: from.GUIRunOnce.Commands,
+			CommandList: guiRunOnceCommands,
 		}
 	}
 
@@ -155,6 +170,9 @@ func convertTo(
 			DomainAdmin:   from.Identification.DomainAdmin,
 			DomainOU:      from.Identification.DomainOU,
 		}
+		if from.Identification.DomainJoin != nil && bootstrapData.Sysprep != nil {
+			sysprepCustomization.Identification.DomainAdmin = bootstrapData.Sysprep.DomainUsername
+		}
 		if bootstrapData.Sysprep != nil && bootstrapData.Sysprep.DomainPassword != "" {
 			sysprepCustomization.Identification.DomainAdminPassword = &vimtypes.CustomizationPassword{
 				Value:     bootstrapData.Sysprep.DomainPassword,
@@ -209,3 +227,90 @@ func parseLicenseDataMode(mode vmopv1sysprep.CustomizationLicenseDataMode) vimty
 		return ""
 	}
 }
+
+// usesSysprepDomainJoin returns true if the Sysprep spec joins a domain with
+// the credentials from spec.bootstrap.sysprep.sysprep.identification.domainJoin.
+func usesSysprepDomainJoin(sysPrepSpec *vmopv1.VirtualMachineBootstrapSysprepSpec) bool {
+	if sysPrepSpec == nil || sysPrepSpec.Sysprep == nil {
+		return false
+	}
+	id := sysPrepSpec.Sysprep.Identification
+	return id != nil && id.DomainJoin != nil
+}
+
+// getSysprepDomainJoinState returns the hash of the domain join credentials
+// that is recorded on the VM once it has been customized with them. When the
+// credentials have been rotated and the guest has not reported that it joined
+// the domain, bsArgs.CustomizeSysprepDomainJoin is set so the VM is customized
+// again at its next power on.
+func getSysprepDomainJoinState(
+	vmCtx pkgctx.VirtualMachineContext,
+	config *vimtypes.VirtualMachineConfigInfo,
+	sysPrepSpec *vmopv1.VirtualMachineBootstrapSysprepSpec,
+	bsArgs *BootstrapArgs) string {
+
+	const hashKey = pkgconst.SysprepDomainJoinCredentialsHashAnnotationKey
+
+	if !usesSysprepDomainJoin(sysPrepSpec) || bsArgs.Sysprep == nil {
+		delete(vmCtx.VM.Annotations, hashKey)
+		return ""
+	}
+
+	newHash := getDomainJoinCredentialsHash(
+		bsArgs.Sysprep.DomainUsername,
+		bsArgs.Sysprep.DomainPassword)
+
+	oldHash := vmCtx.VM.Annotations[hashKey]
+	if oldHash == "" || oldHash == newHash {
+		return newHash
+	}
+
+	var extraConfig map[string]string
+	if config != nil {
+		extraConfig = object.OptionValueList(config.ExtraConfig).StringMap()
+	}
+
+	if joined, _, _, _ := pkgutil.GetDomainJoinConditionValues(extraConfig); joined {
+		vmCtx.Logger.V(4).Info(
+			"Skipping Sysprep customization for rotated domain join credentials " +
+				"since VM has joined the domain")
+		return newHash
+	}
+
+	vmCtx.Logger.Info(
+		"Domain join credentials rotated, customizing VM at next power on")
+	bsArgs.CustomizeSysprepDomainJoin = true
+
+	return newHash
+}
+
+// getDomainJoinCredentialsHash returns a one-way hash of the domain join
+// credentials that is safe to store on the VM.
+func getDomainJoinCredentialsHash(username, password string) string {
+	h := sha256.New()
+	_, _ = h.Write([]byte(username))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(password))
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// withoutDomainJoinCredentials returns a copy of the customization spec
+// without the domain join credentials. This is used to hash the spec so that
+// rotating the credentials does not, by itself, cause a VM that has joined
+// the domain to be customized again.
+func withoutDomainJoinCredentials(
+	customSpec *vimtypes.CustomizationSpec) *vimtypes.CustomizationSpec {
+
+	sp, ok := customSpec.Identity.(*vimtypes.CustomizationSysprep)
+	if !ok {
+		return customSpec
+	}
+
+	spCopy := *sp
+	spCopy.Identification.DomainAdmin = ""
+	spCopy.Identification.DomainAdminPassword = nil
+
+	customSpecCopy := *customSpec
+	customSpecCopy.Identity = &spCopy
+	return &customSpecCopy
+}
@@ -203,6 +203,61 @@ var _ = Describe("SysPrep Bootstrap", func() {
 				Expect(sysPrep.ExtraConfig).To(BeEmpty())
 			})
 
+			When("the VM has firewall rules", func() {
+				BeforeEach(func() {
+					vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+						Firewall: &vmopv1.VirtualMachineNetworkFirewallSpec{
+							Ingress: []vmopv1.VirtualMachineNetworkFirewallRule{
+								{
+									Port:  ptr.To[int32](3389),
+									CIDRs: []string{"192.168.1.0/24", "fd00::/64"},
+								},
+								{
+									Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolUDP,
+									Port:     ptr.To[int32](8000),
+									EndPort:  ptr.To[int32](8080),
+								},
+							},
+						},
+					}
+				})
+
+				When("the network provider is VDS", func() {
+					BeforeEach(func() {
+						pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+							config.NetworkProviderType = pkgcfg.NetworkProviderTypeVDS
+						})
+					})
+
+					It("should apply the rules before the user's commands", func() {
+						Expect(err).ToNot(HaveOccurred())
+						sysPrep := custSpec.Identity.(*vimtypes.CustomizationSysprep)
+						Expect(sysPrep.GuiRunOnce.CommandList).To(Equal([]string{
+							"netsh advfirewall set allprofiles state on",
+							"netsh advfirewall set allprofiles firewallpolicy blockinbound,allowoutbound",
+							"netsh advfirewall firewall add rule name=vmoperator-ingress-0 dir=in action=allow protocol=TCP localport=3389 remoteip=192.168.1.0/24,fd00::/64",
+							"netsh advfirewall firewall add rule name=vmoperator-ingress-1 dir=in action=allow protocol=UDP localport=8000-8080",
+							"blah",
+							"boom",
+						}))
+					})
+				})
+
+				When("the network provider is VPC", func() {
+					BeforeEach(func() {
+						pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+							config.NetworkProviderType = pkgcfg.NetworkProviderTypeVPC
+						})
+					})
+
+					It("should not apply the rules in the guest", func() {
+						Expect(err).ToNot(HaveOccurred())
+						sysPrep := custSpec.Identity.(*vimtypes.CustomizationSysprep)
+						Expect(sysPrep.GuiRunOnce.CommandList).To(Equal([]string{"blah", "boom"}))
+					})
+				})
+			})
+
 			When("GuestCustomizationVCDParity is enabled", func() {
 				BeforeEach(func() {
 					pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
@@ -28,6 +28,23 @@ func GetTemplateRenderFunc(
 	bsArgs *BootstrapArgs,
 ) TemplateRenderFunc {
 
+	// TODO: Don't log, return errors instead.
+	return getTemplateRenderFunc(vmCtx, bsArgs, func(_, templateStr string, err error) {
+		vmCtx.Logger.Error(err, "failed to render template", "templateStr", templateStr)
+	})
+}
+
+// TemplateRenderErrorFunc is called when a template cannot be parsed or
+// executed. The original, normalized template string is used as the rendered
+// value.
+type TemplateRenderErrorFunc func(name, templateStr string, err error)
+
+func getTemplateRenderFunc(
+	vmCtx pkgctx.VirtualMachineContext,
+	bsArgs *BootstrapArgs,
+	errFn TemplateRenderErrorFunc,
+) TemplateRenderFunc {
+
 	// There is a lot of duplication here, especially since the "template" types are the same in v1a1
 	// and v1a2. We've conflated a lot of things here making this all a little nuts.
 
@@ -138,17 +155,16 @@ func GetTemplateRenderFunc(
 		return str
 	}
 
-	// TODO: Don't log, return errors instead.
 	renderTemplate := func(name, templateStr string) string {
 		templ, err := template.New(name).Funcs(funcMap).Parse(templateStr)
 		if err != nil {
-			vmCtx.Logger.Error(err, "failed to parse template", "templateStr", templateStr)
+			errFn(name, templateStr, fmt.Errorf("failed to parse template: %w", err))
 			return normalizeStr(templateStr)
 		}
 		var doc bytes.Buffer
 		err = templ.Execute(&doc, &templateData)
 		if err != nil {
-			vmCtx.Logger.Error(err, "failed to execute template", "templateStr", templateStr)
+			errFn(name, templateStr, fmt.Errorf("failed to execute template: %w", err))
 			return normalizeStr(templateStr)
 		}
 		return normalizeStr(doc.String())
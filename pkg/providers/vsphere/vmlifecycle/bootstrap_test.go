@@ -14,11 +14,14 @@ import (
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	vmopv1sysprep "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep"
+	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/internal"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/vmlifecycle"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/sysprep"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -377,5 +380,139 @@ var _ = Describe("DoBootstrap", func() {
 				Expect(vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn).To(HaveValue(BeFalse()))
 			})
 		})
+
+		Context("DomainJoin", func() {
+			const hashKey = pkgconst.SysprepDomainJoinCredentialsHashAnnotationKey
+
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Bootstrap.Sysprep.Sysprep.Identification = &vmopv1sysprep.Identification{
+					DomainJoin: &vmopv1sysprep.DomainJoin{
+						Credentials: vmopv1sysprep.DomainJoinCredentialsSecretKeySelector{
+							Name: "my-domain-join-secret",
+						},
+					},
+				}
+				bsArgs.DomainName = "example.com"
+				bsArgs.Sysprep = &sysprep.SecretData{
+					DomainUsername: "joiner",
+					DomainPassword: "password1",
+				}
+			})
+
+			It("Customizes and records the credentials hash", func() {
+				Expect(bsErr).To(MatchError(vmlifecycle.ErrBootstrapCustomize))
+				Expect(vmCtx.VM.Annotations).To(HaveKeyWithValue(hashKey, Not(BeEmpty())))
+				Expect(vmCtx.VM.Annotations[hashKey]).ToNot(ContainSubstring("password1"))
+				Expect(vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn).To(BeNil())
+			})
+
+			When("the VM was customized with other credentials", func() {
+				BeforeEach(func() {
+					vmCtx.VM.Annotations[hashKey] = "previous-hash"
+				})
+
+				When("the guest has not joined the domain", func() {
+					It("Customizes and records the credentials hash", func() {
+						Expect(bsErr).To(MatchError(vmlifecycle.ErrBootstrapCustomize))
+						Expect(vmCtx.VM.Annotations[hashKey]).ToNot(Equal("previous-hash"))
+						Expect(vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn).To(BeNil())
+					})
+
+					When("the VM is not powering on", func() {
+						BeforeEach(func() {
+							vmCtx.VM.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+						})
+
+						It("Does not record the credentials hash", func() {
+							Expect(bsErr).ToNot(HaveOccurred())
+							Expect(vmCtx.VM.Annotations[hashKey]).To(Equal("previous-hash"))
+							Expect(vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn).To(BeNil())
+						})
+					})
+
+					When("CustomizedAtNextPowerOn is false", func() {
+						BeforeEach(func() {
+							vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn = ptr.To(false)
+						})
+
+						It("Customizes without changing the spec", func() {
+							Expect(bsErr).To(MatchError(vmlifecycle.ErrBootstrapCustomize))
+							Expect(vmCtx.VM.Annotations[hashKey]).ToNot(Equal("previous-hash"))
+							Expect(vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn).To(HaveValue(BeFalse()))
+						})
+					})
+
+					When("the customization fails", func() {
+						BeforeEach(func() {
+							task, err := vcVM.PowerOn(ctx)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(task.Wait(ctx)).To(Succeed())
+						})
+
+						It("Does not record the credentials hash", func() {
+							Expect(bsErr).To(HaveOccurred())
+							Expect(bsErr).ToNot(MatchError(vmlifecycle.ErrBootstrapCustomize))
+							Expect(vmCtx.VM.Annotations[hashKey]).To(Equal("previous-hash"))
+						})
+					})
+				})
+
+				When("the guest has joined the domain", func() {
+					BeforeEach(func() {
+						vmCtx.VM.Spec.PowerState = vmopv1.VirtualMachinePowerStateOff
+						configInfo.ExtraConfig = append(configInfo.ExtraConfig, &vimtypes.OptionValue{
+							Key:   pkgutil.GuestInfoDomainJoinCondition,
+							Value: "true",
+						})
+					})
+
+					It("Records the credentials hash without customizing", func() {
+						Expect(bsErr).ToNot(HaveOccurred())
+						Expect(vmCtx.VM.Annotations[hashKey]).ToNot(Equal("previous-hash"))
+						Expect(vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn).To(BeNil())
+					})
+				})
+			})
+
+			When("only the credentials changed since the last customization", func() {
+				var customSpecHash string
+
+				BeforeEach(func() {
+					Expect(vmlifecycle.DoBootstrap(vmCtx, vcVM, configInfo, bsArgs)).
+						To(MatchError(vmlifecycle.ErrBootstrapCustomize))
+					customSpecHash = vmCtx.VM.Annotations[pkgconst.BootstrapHashCustomSpecAnnotationKey]
+					Expect(customSpecHash).ToNot(BeEmpty())
+
+					// The guest has joined the domain, so rotating the
+					// credentials must not cause another customization.
+					configInfo.ExtraConfig = append(configInfo.ExtraConfig, &vimtypes.OptionValue{
+						Key:   pkgutil.GuestInfoDomainJoinCondition,
+						Value: "true",
+					})
+					bsArgs.Sysprep = &sysprep.SecretData{
+						DomainUsername: "joiner",
+						DomainPassword: "password2",
+					}
+				})
+
+				It("Does not customize", func() {
+					Expect(bsErr).ToNot(HaveOccurred())
+					Expect(vmCtx.VM.Annotations[pkgconst.BootstrapHashCustomSpecAnnotationKey]).To(Equal(customSpecHash))
+					Expect(vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn).To(BeNil())
+				})
+
+				When("the guest has not joined the domain", func() {
+					BeforeEach(func() {
+						configInfo.ExtraConfig = configInfo.ExtraConfig[:len(configInfo.ExtraConfig)-1]
+					})
+
+					It("Customizes again", func() {
+						Expect(bsErr).To(MatchError(vmlifecycle.ErrBootstrapCustomize))
+						Expect(vmCtx.VM.Annotations[pkgconst.BootstrapHashCustomSpecAnnotationKey]).To(Equal(customSpecHash))
+						Expect(vmCtx.VM.Spec.Bootstrap.Sysprep.CustomizeAtNextPowerOn).To(BeNil())
+					})
+				})
+			})
+		})
 	})
 })
@@ -6,6 +6,7 @@ package vmlifecycle
 
 import (
 	"strings"
+	"time"
 
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/vapi/rest"
@@ -14,6 +15,7 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 )
 
 // CreateArgs contains the arguments needed to create a VM.
@@ -59,11 +61,36 @@ func CreateVirtualMachine(
 	if strings.HasPrefix(createArgs.ProviderItemID, "vm-") {
 		// This is a VM-backed image, and it can only be provisioned via fast
 		// deploy.
-		return fastDeploy(vmCtx, vimClient, createArgs)
+		return observeCreate(
+			metrics.DeployModeFastDeploy,
+			func() (*vimtypes.ManagedObjectReference, error) {
+				return fastDeploy(vmCtx, vimClient, createArgs)
+			})
 	}
 
 	if createArgs.UseContentLibrary {
 		return deployFromContentLibrary(vmCtx, restClient, vimClient, createArgs)
 	}
-	return cloneVMFromInventory(vmCtx, finder, createArgs)
+	return observeCreate(
+		metrics.DeployModeClone,
+		func() (*vimtypes.ManagedObjectReference, error) {
+			return cloneVMFromInventory(vmCtx, finder, createArgs)
+		})
+}
+
+// observeCreate records the duration and result of creating a VM with the
+// specified deploy mode.
+func observeCreate(
+	deployMode string,
+	createFn func() (*vimtypes.ManagedObjectReference, error)) (
+	*vimtypes.ManagedObjectReference, error) {
+
+	start := time.Now()
+	ref, err := createFn()
+	metrics.NewVMOperationMetrics().ObserveOperation(
+		metrics.VMOperationCreate,
+		deployMode,
+		start,
+		err)
+	return ref, err
 }
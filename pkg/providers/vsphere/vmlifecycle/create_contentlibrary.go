@@ -17,6 +17,7 @@ import (
 
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/contentlibrary"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
@@ -131,13 +132,29 @@ func deployFromContentLibrary(
 	switch item.Type {
 	case library.ItemTypeOVF:
 		if pkgcfg.FromContext(vmCtx).Features.FastDeploy {
-			return fastDeploy(vmCtx, vimClient, createArgs)
+			return observeCreate(
+				metrics.DeployModeFastDeploy,
+				func() (*vimtypes.ManagedObjectReference, error) {
+					return fastDeploy(vmCtx, vimClient, createArgs)
+				})
 		}
-		return deployOVF(vmCtx, restClient, item, createArgs)
+		return observeCreate(
+			metrics.DeployModeContentLibrary,
+			func() (*vimtypes.ManagedObjectReference, error) {
+				return deployOVF(vmCtx, restClient, item, createArgs)
+			})
 	case library.ItemTypeVMTX:
-		return deployVMTX(vmCtx, restClient, item, createArgs)
+		return observeCreate(
+			metrics.DeployModeContentLibrary,
+			func() (*vimtypes.ManagedObjectReference, error) {
+				return deployVMTX(vmCtx, restClient, item, createArgs)
+			})
 	case library.ItemTypeISO:
-		return createVM(vmCtx, vimClient, createArgs)
+		return observeCreate(
+			metrics.DeployModeContentLibrary,
+			func() (*vimtypes.ManagedObjectReference, error) {
+				return createVM(vmCtx, vimClient, createArgs)
+			})
 	default:
 		return nil, fmt.Errorf("item %s not a supported type: %s", item.Name, item.Type)
 	}
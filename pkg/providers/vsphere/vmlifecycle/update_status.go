@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/mo"
@@ -65,6 +66,7 @@ func ReconcileStatus(
 	errs = append(errs, reconcileStatusAnno2Conditions(vmCtx, k8sClient, vcVM, data)...)
 	errs = append(errs, reconcileStatusClass(vmCtx, k8sClient, vcVM, data)...)
 	errs = append(errs, reconcileStatusPowerState(vmCtx, k8sClient, vcVM, data)...)
+	errs = append(errs, reconcileStatusPendingResize(vmCtx, k8sClient, vcVM, data)...)
 	errs = append(errs, reconcileStatusIdentifiers(vmCtx, k8sClient, vcVM, data)...)
 	errs = append(errs, reconcileStatusHardware(vmCtx, k8sClient, vcVM, data)...)
 	errs = append(errs, reconcileStatusHardwareVersion(vmCtx, k8sClient, vcVM, data)...)
@@ -78,6 +80,8 @@ func ReconcileStatus(
 		errs = append(errs, reconcileHardwareCondition(vmCtx, k8sClient, vcVM, data)...)
 	}
 
+	errs = append(errs, reconcileStatusConfigDrift(vmCtx, k8sClient, vcVM, data)...)
+
 	if pkgcfg.FromContext(vmCtx).AsyncSignalEnabled {
 		errs = append(errs, reconcileStatusProbe(vmCtx, k8sClient, vcVM, data)...)
 	}
@@ -171,6 +175,63 @@ func reconcileStatusClass(
 	return nil
 }
 
+// reconcileStatusPendingResize reports the resize of a powered on VM that is
+// deferred until the VM is power cycled, and when that power cycle is
+// scheduled to occur based on the VM's maintenance window.
+func reconcileStatusPendingResize(
+	vmCtx pkgctx.VirtualMachineContext,
+	k8sClient ctrlclient.Client,
+	_ *object.VirtualMachine,
+	_ ReconcileStatusData) []error {
+
+	var (
+		vm = vmCtx.VM
+		f  = pkgcfg.FromContext(vmCtx).Features
+	)
+
+	if (!f.VMResize && !f.VMResizeCPUMemory) ||
+		vm.Status.PowerState != vmopv1.VirtualMachinePowerStateOn {
+
+		vm.Status.PendingResize = nil
+		return nil
+	}
+
+	var cpuMemoryPending bool
+	if config := vmCtx.MoVM.Config; config != nil {
+		cpuMemoryPending = vmopv1util.IsCPUMemoryResizePending(*vm, *config)
+	}
+
+	if !cpuMemoryPending && !vmopv1util.IsResizePending(*vm) {
+		vm.Status.PendingResize = nil
+		return nil
+	}
+
+	vm.Status.PendingResize = &vmopv1.VirtualMachinePendingResizeStatus{
+		ClassName: vm.Spec.ClassName,
+	}
+	if hw := vm.Spec.Hardware; cpuMemoryPending {
+		vm.Status.PendingResize.CPUs = hw.CPUs
+		vm.Status.PendingResize.Memory = hw.Memory
+	}
+
+	window, err := vmopv1util.GetMaintenanceWindow(vmCtx, k8sClient, *vm)
+	if err != nil {
+		return []error{err}
+	}
+	if window == nil {
+		return nil
+	}
+
+	// The start of the window is returned regardless of whether or not the
+	// window is currently open.
+	if _, start := window.Open(time.Now()); !start.IsZero() {
+		scheduledTime := metav1.NewTime(start)
+		vm.Status.PendingResize.ScheduledTime = &scheduledTime
+	}
+
+	return nil
+}
+
 func reconcileStatusGroup(
 	vmCtx pkgctx.VirtualMachineContext,
 	k8sClient ctrlclient.Client,
@@ -413,6 +474,8 @@ func reconcileStatusGuest(
 	MarkVMToolsRunningStatusCondition(vmCtx.VM, vmCtx.MoVM.Guest)
 	MarkCustomizationInfoCondition(vmCtx.VM, vmCtx.MoVM.Guest)
 	MarkBootstrapCondition(vmCtx.VM, extraConfig)
+	MarkDomainJoinCondition(vmCtx.VM, extraConfig)
+	MarkGuestNetworkConfigSyncedCondition(vmCtx.VM, vmCtx.MoVM.Config)
 
 	if config := vmCtx.MoVM.Config; config != nil {
 		guestID := vmCtx.MoVM.Config.GuestId
@@ -787,6 +850,147 @@ func MarkBootstrapCondition(
 	}
 }
 
+// MarkDomainJoinCondition sets the GuestDomainJoin condition for a VM that
+// joins a domain with spec.bootstrap.sysprep.sysprep.identification.domainJoin
+// from the value reported by the guest.
+func MarkDomainJoinCondition(
+	vm *vmopv1.VirtualMachine,
+	extraConfig map[string]string) {
+
+	var sysPrepSpec *vmopv1.VirtualMachineBootstrapSysprepSpec
+	if bs := vm.Spec.Bootstrap; bs != nil {
+		sysPrepSpec = bs.Sysprep
+	}
+	if !usesSysprepDomainJoin(sysPrepSpec) {
+		conditions.Delete(vm, vmopv1.GuestDomainJoinCondition)
+		return
+	}
+
+	status, reason, msg, ok := pkgutil.GetDomainJoinConditionValues(extraConfig)
+	if !ok {
+		conditions.MarkUnknown(vm, vmopv1.GuestDomainJoinCondition,
+			vmopv1.GuestDomainJoinPendingReason,
+			"The guest has not reported whether it joined the domain")
+		return
+	}
+
+	if status {
+		c := conditions.TrueCondition(vmopv1.GuestDomainJoinCondition)
+		if reason != "" {
+			c.Reason = reason
+		}
+		c.Message = msg
+		conditions.Set(vm, c)
+	} else {
+		if reason == "" {
+			reason = vmopv1.GuestDomainJoinFailedReason
+		}
+		conditions.MarkFalse(vm, vmopv1.GuestDomainJoinCondition, reason, "%s", msg)
+	}
+}
+
+// MarkGuestNetworkConfigSyncedCondition marks the GuestNetworkConfigSynced
+// condition true once the guest reports the network configuration published
+// after a network interface was hot-added to or hot-removed from the VM.
+//
+// The guest has applied the configuration when it reports exactly the VM's
+// network devices, with the devices' MAC addresses, so a hot-removed device
+// is pending until the guest no longer reports it. Additionally, every
+// configured interface must be reported by the guest with all of its static
+// addresses, or with any address that is not link-local when the interface
+// uses DHCP.
+func MarkGuestNetworkConfigSyncedCondition(
+	vm *vmopv1.VirtualMachine,
+	config *vimtypes.VirtualMachineConfigInfo) {
+
+	c := conditions.Get(vm, vmopv1.GuestNetworkConfigSyncedCondition)
+	if c == nil || c.Status == metav1.ConditionTrue {
+		return
+	}
+
+	if config == nil || vm.Status.Network == nil || vm.Status.Network.Config == nil {
+		return
+	}
+
+	deviceMACs := map[int32]string{}
+	for _, dev := range object.VirtualDeviceList(config.Hardware.Device).
+		SelectByType((*vimtypes.VirtualEthernetCard)(nil)) {
+
+		ethCard := dev.(vimtypes.BaseVirtualEthernetCard).GetVirtualEthernetCard()
+		deviceMACs[ethCard.Key] = ethCard.MacAddress
+	}
+
+	reportedDevices := sets.New[int32]()
+	for _, s := range vm.Status.Network.Interfaces {
+		if s.DeviceKey == 0 {
+			// The interface is not backed by one of the VM's network devices.
+			continue
+		}
+
+		mac, ok := deviceMACs[s.DeviceKey]
+		if !ok || s.IP == nil || !strings.EqualFold(s.IP.MACAddr, mac) {
+			return
+		}
+		reportedDevices.Insert(s.DeviceKey)
+	}
+
+	if reportedDevices.Len() != len(deviceMACs) {
+		return
+	}
+
+	for _, configIface := range vm.Status.Network.Config.Interfaces {
+		idx := slices.IndexFunc(vm.Status.Network.Interfaces,
+			func(s vmopv1.VirtualMachineNetworkInterfaceStatus) bool {
+				return s.Name == configIface.Name
+			})
+		if idx < 0 {
+			return
+		}
+
+		if !guestHasConfiguredIPs(configIface.IP, vm.Status.Network.Interfaces[idx].IP) {
+			return
+		}
+	}
+
+	conditions.MarkTrue(vm, vmopv1.GuestNetworkConfigSyncedCondition)
+}
+
+func guestHasConfiguredIPs(
+	configIP *vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus,
+	guestIP *vmopv1.VirtualMachineNetworkInterfaceIPStatus) bool {
+
+	if configIP == nil ||
+		configIP.AssignmentMode == vmopv1.VirtualMachineNetworkIPAssignmentModeNone {
+		return true
+	}
+
+	guestAddrs := sets.New[string]()
+	if guestIP != nil {
+		for _, a := range guestIP.Addresses {
+			if ip := net.ParseIP(a.Address); ip != nil && !ip.IsLinkLocalUnicast() {
+				guestAddrs.Insert(ip.String())
+			}
+		}
+	}
+
+	if configIP.AssignmentMode == vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP ||
+		(configIP.DHCP != nil && len(configIP.Addresses) == 0) {
+		return guestAddrs.Len() > 0
+	}
+
+	for _, a := range configIP.Addresses {
+		ip, _, err := net.ParseCIDR(a)
+		if err != nil {
+			ip = net.ParseIP(a)
+		}
+		if ip == nil || !guestAddrs.Has(ip.String()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func MarkVMClassConfigurationSynced(
 	ctx context.Context,
 	vm *vmopv1.VirtualMachine,
@@ -888,87 +1092,27 @@ func UpdateNetworkStatusConfig(vm *vmopv1.VirtualMachine, args BootstrapArgs) {
 		}
 	}
 
+	// The members of a bond do not have any IP configuration of their own
+	// inside the guest.
+	bondMembers := map[string]struct{}{}
+	for _, b := range args.NetworkResults.Bonds {
+		for _, name := range b.Interfaces {
+			bondMembers[name] = struct{}{}
+		}
+	}
+
 	// Iterate over each network result.
 	for i := range args.NetworkResults.Results {
 
-		// Declare the interface's config status.
-		var ifc vmopv1.VirtualMachineNetworkConfigInterfaceStatus
-
 		// Define a short alias for the indexed result.
 		r := args.NetworkResults.Results[i]
 
-		// Grab a temp copy of the result's IP configuration list to make it
-		// more obvious that the purpose of the next three lines of code is not
-		// to update the r.IPConfigs directly.
-		ipConfigs := args.NetworkResults.Results[i].IPConfigs
-
-		// The intended DHCP configuration is presented per interface, so if
-		// there are no resulting IP configs, but DHCP4 or DHCP6 is configured,
-		// go ahead and create a single, fake IP config so the DHCP info can be
-		// collected the same way below.
-		if len(ipConfigs) == 0 && (r.DHCP4 || r.DHCP6) {
-			ipConfigs = []network.NetworkInterfaceIPConfig{{}}
-		}
-
-		// If there *are* resulting IP configs, then ensure the field ifc.IP
-		// is not nil so avoid an NPE later. We do not initialize this field
-		// unless there *are* resulting IP configs to avoid an empty object
-		// when printing the VM's status.
-		if len(ipConfigs) > 0 {
-			ifc.IP = &vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus{}
-		}
-
-		// Iterate over each of the result's IP configurations.
-		for j := range ipConfigs {
-			ipc := ipConfigs[j]
-
-			// Assign the gateways.
-			if gw := ipc.Gateway; gw != "" {
-				if ipc.IsIPv4 && ifc.IP.Gateway4 == "" {
-					ifc.IP.Gateway4 = gw
-				} else if !ipc.IsIPv4 && ifc.IP.Gateway6 == "" {
-					ifc.IP.Gateway6 = gw
-				}
-			}
-
-			// Append the IP address.
-			if ip := ipc.IPCIDR; ip != "" {
-				ifc.IP.Addresses = append(ifc.IP.Addresses, ip)
-			}
-
-			// Update DHCP information.
-			if v4, v6 := r.DHCP4, r.DHCP6; v4 || v6 {
-				ifc.IP.DHCP = &vmopv1.VirtualMachineNetworkConfigDHCPStatus{}
-				if v4 {
-					ifc.IP.DHCP.IP4 = &vmopv1.VirtualMachineNetworkConfigDHCPOptionsStatus{
-						Enabled: v4,
-					}
-				}
-				if v6 {
-					ifc.IP.DHCP.IP6 = &vmopv1.VirtualMachineNetworkConfigDHCPOptionsStatus{
-						Enabled: v6,
-					}
-				}
-			}
-
-			// Update DNS information.
-			{
-				ns, sd := r.Nameservers, r.SearchDomains
-				if ln, ls := len(ns), len(sd); ln > 0 || ls > 0 {
-					ifc.DNS = &vmopv1.VirtualMachineNetworkConfigDNSStatus{}
-					if ln > 0 {
-						ifc.DNS.Nameservers = ns
-					}
-					if ls > 0 {
-						ifc.DNS.SearchDomains = sd
-					}
-				}
-			}
+		if _, ok := bondMembers[r.Name]; ok {
+			continue
 		}
 
-		if ip := ifc.IP; ip != nil && len(ip.Addresses) > 0 {
-			slices.Sort(ifc.IP.Addresses)
-		}
+		// Get the interface's config status.
+		ifc := networkConfigInterfaceStatus(r)
 
 		// Only append the interface config if it is not empty.
 		if !reflect.DeepEqual(ifc, emptyIfaceConfig) {
@@ -980,6 +1124,9 @@ func UpdateNetworkStatusConfig(vm *vmopv1.VirtualMachine, args BootstrapArgs) {
 		}
 	}
 
+	// Update the VLANs and bonds.
+	nc.GuestDevices = networkConfigGuestDeviceStatus(args.NetworkResults)
+
 	// If the network config ended up empty, then ensure the VM's field
 	// status.network.config is nil IFF status.network is non-nil.
 	// Otherwise, assign the network config to the VM's status.network.config
@@ -996,6 +1143,148 @@ func UpdateNetworkStatusConfig(vm *vmopv1.VirtualMachine, args BootstrapArgs) {
 	}
 }
 
+// networkConfigInterfaceStatus returns the configured state of the network
+// interface from the provided result. The name is not assigned.
+func networkConfigInterfaceStatus(
+	r network.NetworkInterfaceResult) vmopv1.VirtualMachineNetworkConfigInterfaceStatus {
+
+	// Declare the interface's config status.
+	var ifc vmopv1.VirtualMachineNetworkConfigInterfaceStatus
+
+	// Grab a temp copy of the result's IP configuration list to make it
+	// more obvious that the purpose of the next three lines of code is not
+	// to update the r.IPConfigs directly.
+	ipConfigs := r.IPConfigs
+
+	// The intended DHCP configuration is presented per interface, so if
+	// there are no resulting IP configs, but DHCP4 or DHCP6 is configured,
+	// go ahead and create a single, fake IP config so the DHCP info can be
+	// collected the same way below.
+	if len(ipConfigs) == 0 && (r.DHCP4 || r.DHCP6) {
+		ipConfigs = []network.NetworkInterfaceIPConfig{{}}
+	}
+
+	// If there *are* resulting IP configs, then ensure the field ifc.IP
+	// is not nil so avoid an NPE later. We do not initialize this field
+	// unless there *are* resulting IP configs or an assignment mode to
+	// avoid an empty object when printing the VM's status.
+	if len(ipConfigs) > 0 || r.IPAssignmentMode != "" {
+		ifc.IP = &vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus{
+			AssignmentMode: r.IPAssignmentMode,
+		}
+	}
+
+	// Iterate over each of the result's IP configurations.
+	for j := range ipConfigs {
+		ipc := ipConfigs[j]
+
+		// Assign the gateways.
+		if gw := ipc.Gateway; gw != "" {
+			if ipc.IsIPv4 && ifc.IP.Gateway4 == "" {
+				ifc.IP.Gateway4 = gw
+			} else if !ipc.IsIPv4 && ifc.IP.Gateway6 == "" {
+				ifc.IP.Gateway6 = gw
+			}
+		}
+
+		// Append the IP address.
+		if ip := ipc.IPCIDR; ip != "" {
+			ifc.IP.Addresses = append(ifc.IP.Addresses, ip)
+		}
+
+		// Update DHCP information.
+		if v4, v6 := r.DHCP4, r.DHCP6; v4 || v6 {
+			ifc.IP.DHCP = &vmopv1.VirtualMachineNetworkConfigDHCPStatus{}
+			if v4 {
+				ifc.IP.DHCP.IP4 = &vmopv1.VirtualMachineNetworkConfigDHCPOptionsStatus{
+					Enabled: v4,
+				}
+			}
+			if v6 {
+				ifc.IP.DHCP.IP6 = &vmopv1.VirtualMachineNetworkConfigDHCPOptionsStatus{
+					Enabled: v6,
+				}
+			}
+		}
+
+		// Update DNS information.
+		{
+			ns, sd := r.Nameservers, r.SearchDomains
+			if ln, ls := len(ns), len(sd); ln > 0 || ls > 0 {
+				ifc.DNS = &vmopv1.VirtualMachineNetworkConfigDNSStatus{}
+				if ln > 0 {
+					ifc.DNS.Nameservers = ns
+				}
+				if ls > 0 {
+					ifc.DNS.SearchDomains = sd
+				}
+			}
+		}
+	}
+
+	if ip := ifc.IP; ip != nil && len(ip.Addresses) > 0 {
+		slices.Sort(ifc.IP.Addresses)
+	}
+
+	return ifc
+}
+
+// networkConfigGuestDeviceStatus returns the configured state of the VLANs and
+// bonds from the provided results.
+func networkConfigGuestDeviceStatus(
+	results network.NetworkInterfaceResults) []vmopv1.VirtualMachineNetworkConfigGuestDeviceStatus {
+
+	if len(results.VLANs) == 0 && len(results.Bonds) == 0 {
+		return nil
+	}
+
+	// The VLANs and bonds reference network interfaces by the names from the
+	// VM's spec, but the status describes the devices inside the guest.
+	guestDeviceNames := map[string]string{}
+	for _, r := range results.Results {
+		if r.GuestDeviceName != "" {
+			guestDeviceNames[r.Name] = r.GuestDeviceName
+		}
+	}
+	guestDeviceName := func(name string) string {
+		if n, ok := guestDeviceNames[name]; ok {
+			return n
+		}
+		return name
+	}
+
+	var devices []vmopv1.VirtualMachineNetworkConfigGuestDeviceStatus
+
+	for _, b := range results.Bonds {
+		ifc := networkConfigInterfaceStatus(b.InterfaceResult())
+		d := vmopv1.VirtualMachineNetworkConfigGuestDeviceStatus{
+			Name:     b.Name,
+			Type:     vmopv1.VirtualMachineNetworkGuestDeviceTypeBond,
+			BondMode: b.Mode,
+			IP:       ifc.IP,
+			DNS:      ifc.DNS,
+		}
+		for _, name := range b.Interfaces {
+			d.Links = append(d.Links, guestDeviceName(name))
+		}
+		devices = append(devices, d)
+	}
+
+	for _, v := range results.VLANs {
+		ifc := networkConfigInterfaceStatus(v.InterfaceResult())
+		devices = append(devices, vmopv1.VirtualMachineNetworkConfigGuestDeviceStatus{
+			Name:   v.Name,
+			Type:   vmopv1.VirtualMachineNetworkGuestDeviceTypeVLAN,
+			Links:  []string{guestDeviceName(v.Link)},
+			VLANID: v.ID,
+			IP:     ifc.IP,
+			DNS:    ifc.DNS,
+		})
+	}
+
+	return devices
+}
+
 // updateGuestNetworkStatus updates the provided VM's status.network
 // field with information from the guestInfo.
 //
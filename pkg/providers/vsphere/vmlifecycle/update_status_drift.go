@@ -0,0 +1,329 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmlifecycle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vmware/govmomi/object"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
+	vmoprecord "github.com/vmware-tanzu/vm-operator/pkg/record"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+// ConfigDrift describes a field of the VM's configuration in vSphere that has
+// drifted from what is implied by the VM's spec and class.
+type ConfigDrift struct {
+	// Reason is the reason used for the event emitted for the drift, ex.
+	// HardwareCPUMismatch.
+	Reason string
+
+	// Message describes the drift.
+	Message string
+}
+
+// deviceDriftConditionTypes are the hardware validation conditions whose
+// mismatches are reported as drift.
+var deviceDriftConditionTypes = []string{
+	vmopv1.VirtualMachineHardwareControllersVerified,
+	vmopv1.VirtualMachineHardwareVolumesVerified,
+	vmopv1.VirtualMachineHardwareCDROMVerified,
+}
+
+// reconcileStatusConfigDrift compares the VM's configuration in vSphere with
+// what is implied by the VM's spec and class, and sets the
+// VirtualMachineConfigDriftVerified condition. An event is emitted for each
+// drifted field when the drift changes. When the VM's drift policy is Adopt,
+// the drifted number of CPUs and memory are adopted into the VM's spec.
+//
+// Drift of the VM's controllers, volumes, and CD-ROM devices is reported
+// from the hardware validation conditions, so this must be called after
+// reconcileHardwareCondition.
+func reconcileStatusConfigDrift(
+	vmCtx pkgctx.VirtualMachineContext,
+	k8sClient ctrlclient.Client,
+	_ *object.VirtualMachine,
+	data ReconcileStatusData) []error {
+
+	vm := vmCtx.VM
+
+	if vmCtx.MoVM.Config == nil {
+		return nil
+	}
+
+	var vmClass *vmopv1.VirtualMachineClass
+	if !vmopv1util.IsClasslessVM(*vm) {
+		var obj vmopv1.VirtualMachineClass
+		if err := k8sClient.Get(
+			vmCtx,
+			ctrlclient.ObjectKey{Namespace: vm.Namespace, Name: vm.Spec.ClassName},
+			&obj); err != nil {
+
+			if !apierrors.IsNotFound(err) {
+				return []error{fmt.Errorf("failed to get VirtualMachineClass for drift detection: %w", err)}
+			}
+		} else {
+			vmClass = &obj
+		}
+	}
+
+	cpuMemoryDrifts := checkCPUMemoryDrift(vmCtx, vmClass)
+	if len(cpuMemoryDrifts) > 0 &&
+		vm.Spec.DriftPolicy == vmopv1.VirtualMachineDriftPolicyAdopt {
+
+		if f := pkgcfg.FromContext(vmCtx).Features; f.VMResize || f.VMResizeCPUMemory {
+			adoptCPUMemoryDrift(vmCtx, cpuMemoryDrifts)
+			cpuMemoryDrifts = nil
+		}
+	}
+
+	drifts := cpuMemoryDrifts
+	drifts = append(drifts, checkNetworkInterfaceDrift(vmCtx, k8sClient, data)...)
+
+	for _, t := range deviceDriftConditionTypes {
+		if c := conditions.Get(vm, t); c != nil && c.Status == metav1.ConditionFalse {
+			drifts = append(drifts, ConfigDrift{
+				Reason:  c.Reason,
+				Message: c.Message,
+			})
+		}
+	}
+
+	if len(drifts) == 0 {
+		conditions.MarkTrue(vm, vmopv1.VirtualMachineConfigDriftVerified)
+		return nil
+	}
+
+	messages := make([]string, 0, len(drifts))
+	for _, d := range drifts {
+		messages = append(messages, d.Message)
+	}
+	message := formatIssues(messages...)
+
+	// Only emit events when the drift changes so the same drift is not
+	// reported on every reconcile.
+	if c := conditions.Get(vm, vmopv1.VirtualMachineConfigDriftVerified); c == nil ||
+		c.Status != metav1.ConditionFalse || c.Message != message {
+
+		recorder := vmoprecord.FromContext(vmCtx)
+		for _, d := range drifts {
+			recorder.Warnf(vm, d.Reason, "%s", d.Message)
+		}
+
+		vmCtx.Logger.Info("VM configuration drift detected",
+			"driftPolicy", vm.Spec.DriftPolicy,
+			"drift", message)
+	}
+
+	conditions.MarkFalse(
+		vm,
+		vmopv1.VirtualMachineConfigDriftVerified,
+		vmopv1.VirtualMachineConfigDriftedReason,
+		"%s",
+		message)
+
+	return nil
+}
+
+// checkCPUMemoryDrift compares the VM's number of CPUs and memory with the
+// values from the VM's spec or class.
+func checkCPUMemoryDrift(
+	vmCtx pkgctx.VirtualMachineContext,
+	vmClass *vmopv1.VirtualMachineClass) []ConfigDrift {
+
+	// A resize that is deferred until the VM is power cycled is already
+	// reported in the VM's status.pendingResize field.
+	if vmCtx.VM.Status.PendingResize != nil {
+		return nil
+	}
+
+	var (
+		drifts       []ConfigDrift
+		hw           = vmCtx.MoVM.Config.Hardware
+		cpus, memory = vmopv1util.ExpectedCPUMemory(*vmCtx.VM, vmClass)
+	)
+
+	if cpus != nil && *cpus != int64(hw.NumCPU) {
+		drifts = append(drifts, ConfigDrift{
+			Reason:  vmopv1.VirtualMachineHardwareCPUMismatchReason,
+			Message: fmt.Sprintf("CPUs: expected %d, observed %d", *cpus, hw.NumCPU),
+		})
+	}
+
+	if memory != nil {
+		expectedMB := vmopv1util.MemoryQuantityToMB(*memory)
+		if expectedMB != int64(hw.MemoryMB) {
+			drifts = append(drifts, ConfigDrift{
+				Reason: vmopv1.VirtualMachineHardwareMemoryMismatchReason,
+				Message: fmt.Sprintf("memory: expected %dMi, observed %dMi",
+					expectedMB, hw.MemoryMB),
+			})
+		}
+	}
+
+	return drifts
+}
+
+// adoptCPUMemoryDrift updates the VM's spec.hardware.cpus and
+// spec.hardware.memory fields to match the VM's configuration in vSphere.
+func adoptCPUMemoryDrift(
+	vmCtx pkgctx.VirtualMachineContext,
+	drifts []ConfigDrift) {
+
+	var (
+		vm = vmCtx.VM
+		hw = vmCtx.MoVM.Config.Hardware
+	)
+
+	if vm.Spec.Hardware == nil {
+		vm.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{}
+	}
+
+	recorder := vmoprecord.FromContext(vmCtx)
+
+	for _, d := range drifts {
+		switch d.Reason {
+		case vmopv1.VirtualMachineHardwareCPUMismatchReason:
+			vm.Spec.Hardware.CPUs = ptr.To(int64(hw.NumCPU))
+		case vmopv1.VirtualMachineHardwareMemoryMismatchReason:
+			vm.Spec.Hardware.Memory = resource.NewQuantity(
+				int64(hw.MemoryMB)*1024*1024, resource.BinarySI)
+		}
+
+		recorder.Eventf(vm, vmopv1.VirtualMachineConfigDriftAdoptedReason,
+			"Adopted drift into spec: %s", d.Message)
+	}
+}
+
+// checkNetworkInterfaceDrift compares the VM's network interfaces with the
+// interfaces in the VM's spec. The number of interfaces is compared, and then
+// each interface's adapter type, MAC address, and network are compared with
+// those of its corresponding ethernet device.
+func checkNetworkInterfaceDrift(
+	vmCtx pkgctx.VirtualMachineContext,
+	k8sClient ctrlclient.Client,
+	data ReconcileStatusData) []ConfigDrift {
+
+	netSpec := vmCtx.VM.Spec.Network
+	if netSpec == nil {
+		return nil
+	}
+
+	var interfaces []vmopv1.VirtualMachineNetworkInterfaceSpec
+	if !netSpec.Disabled {
+		interfaces = netSpec.Interfaces
+	}
+
+	ethCards := object.VirtualDeviceList(vmCtx.MoVM.Config.Hardware.Device).
+		SelectByType((*vimtypes.VirtualEthernetCard)(nil))
+
+	if len(interfaces) != len(ethCards) {
+		return []ConfigDrift{
+			{
+				Reason: vmopv1.VirtualMachineHardwareNetworkInterfacesMismatchReason,
+				Message: fmt.Sprintf("network interfaces: expected %d, observed %d",
+					len(interfaces), len(ethCards)),
+			},
+		}
+	}
+
+	specIdxToEthCard := make(map[int]vimtypes.BaseVirtualDevice, len(ethCards))
+	for _, dev := range ethCards {
+		if idx, ok := data.NetworkDeviceKeysToSpecIdx[dev.GetVirtualDevice().Key]; ok {
+			specIdxToEthCard[idx] = dev
+		}
+	}
+
+	var drifts []ConfigDrift
+	for i, interfaceSpec := range interfaces {
+		var msg string
+
+		if dev, ok := specIdxToEthCard[i]; !ok {
+			msg = fmt.Sprintf("network interface %s: no matching device",
+				interfaceSpec.Name)
+		} else {
+			ethCard := dev.(vimtypes.BaseVirtualEthernetCard).GetVirtualEthernetCard()
+
+			switch {
+			case !network.MatchEthCardAdapterType(dev, interfaceSpec.AdapterType, interfaceSpec.PhysicalFunction):
+				msg = fmt.Sprintf("network interface %s: adapter type: expected %s, observed %s",
+					interfaceSpec.Name, expectedAdapterType(interfaceSpec), observedAdapterType(dev))
+			case interfaceSpec.MACAddr != "" && !strings.EqualFold(interfaceSpec.MACAddr, ethCard.MacAddress):
+				msg = fmt.Sprintf("network interface %s: MAC address: expected %s, observed %s",
+					interfaceSpec.Name, interfaceSpec.MACAddr, ethCard.MacAddress)
+			case !network.MatchEthCardNetwork(vmCtx, k8sClient, interfaceSpec, dev):
+				msg = fmt.Sprintf("network interface %s: network: expected %s, observed %s",
+					interfaceSpec.Name, expectedNetworkName(interfaceSpec), observedNetworkName(dev))
+			default:
+				continue
+			}
+		}
+
+		drifts = append(drifts, ConfigDrift{
+			Reason:  vmopv1.VirtualMachineHardwareNetworkInterfacesMismatchReason,
+			Message: msg,
+		})
+	}
+
+	return drifts
+}
+
+func expectedAdapterType(
+	interfaceSpec vmopv1.VirtualMachineNetworkInterfaceSpec) string {
+
+	if interfaceSpec.AdapterType == "" {
+		return string(vmopv1.VirtualMachineNetworkInterfaceAdapterTypeVmxnet3)
+	}
+	if interfaceSpec.PhysicalFunction != "" {
+		return fmt.Sprintf("%s (%s)", interfaceSpec.AdapterType, interfaceSpec.PhysicalFunction)
+	}
+	return string(interfaceSpec.AdapterType)
+}
+
+func observedAdapterType(dev vimtypes.BaseVirtualDevice) string {
+	adapterType := string(network.EthCardAdapterType(dev))
+	if adapterType == "" {
+		// The device is not one of the adapter types that may be specified
+		// in the interface spec, ex. E1000.
+		return strings.TrimPrefix(fmt.Sprintf("%T", dev), "*types.Virtual")
+	}
+	if pf := network.EthCardPhysicalFunction(dev); pf != "" {
+		return fmt.Sprintf("%s (%s)", adapterType, pf)
+	}
+	return adapterType
+}
+
+func expectedNetworkName(
+	interfaceSpec vmopv1.VirtualMachineNetworkInterfaceSpec) string {
+
+	if n := interfaceSpec.Network; n != nil && n.Name != "" {
+		return n.Name
+	}
+	return "the namespace default network"
+}
+
+func observedNetworkName(dev vimtypes.BaseVirtualDevice) string {
+	switch b := dev.GetVirtualDevice().Backing.(type) {
+	case *vimtypes.VirtualEthernetCardNetworkBackingInfo:
+		return b.DeviceName
+	case *vimtypes.VirtualEthernetCardDistributedVirtualPortBackingInfo:
+		return b.Port.PortgroupKey
+	case *vimtypes.VirtualEthernetCardOpaqueNetworkBackingInfo:
+		return b.OpaqueNetworkId
+	}
+	return "unknown"
+}
@@ -10,6 +10,7 @@ import (
 	"math"
 	"slices"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -28,6 +29,7 @@ import (
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
+	vmopv1sysprep "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
@@ -39,6 +41,7 @@ import (
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -3085,6 +3088,396 @@ var _ = Describe("UpdateStatus", func() {
 			})
 		})
 	})
+
+	Context("PendingResize", func() {
+		const nsName = "pending-resize"
+
+		BeforeEach(func() {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.Features.VMResize = true
+			})
+
+			vmCtx.VM.Namespace = nsName
+			vmCtx.VM.Status.PendingResize = &vmopv1.VirtualMachinePendingResizeStatus{
+				ClassName: "stale",
+			}
+			Expect(vmopv1util.SetLastResizedAnnotationClassName(vmCtx.VM, "old-class")).To(Succeed())
+			Expect(vmCtx.MoVM.Runtime.PowerState).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+		})
+
+		When("the VM does not have a maintenance window", func() {
+			It("reports the pending resize without a scheduled time", func() {
+				Expect(vmCtx.VM.Status.PendingResize).To(Equal(&vmopv1.VirtualMachinePendingResizeStatus{
+					ClassName: builder.DummyClassName,
+				}))
+			})
+		})
+
+		When("the VM has a maintenance window", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+					Schedule: "0 2 * * *",
+					Duration: metav1.Duration{Duration: time.Hour},
+				}
+			})
+
+			It("reports when the resize is scheduled", func() {
+				pendingResize := vmCtx.VM.Status.PendingResize
+				Expect(pendingResize).ToNot(BeNil())
+				Expect(pendingResize.ClassName).To(Equal(builder.DummyClassName))
+				Expect(pendingResize.ScheduledTime).ToNot(BeNil())
+				scheduledTime := pendingResize.ScheduledTime.UTC()
+				Expect(scheduledTime.Hour()).To(Equal(2))
+				Expect(scheduledTime.Minute()).To(Equal(0))
+				Expect(scheduledTime).To(BeTemporally("<=", time.Now().Add(24*time.Hour)))
+			})
+		})
+
+		When("the namespace has a maintenance window", func() {
+			BeforeEach(func() {
+				Expect(ctx.Client.Create(ctx, &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: nsName,
+						Annotations: map[string]string{
+							vmopv1.MaintenanceWindowScheduleAnnotation: "30 3 * * *",
+							vmopv1.MaintenanceWindowDurationAnnotation: "1h",
+						},
+					},
+				})).To(Succeed())
+			})
+
+			It("reports when the resize is scheduled", func() {
+				pendingResize := vmCtx.VM.Status.PendingResize
+				Expect(pendingResize).ToNot(BeNil())
+				Expect(pendingResize.ScheduledTime).ToNot(BeNil())
+				scheduledTime := pendingResize.ScheduledTime.UTC()
+				Expect(scheduledTime.Hour()).To(Equal(3))
+				Expect(scheduledTime.Minute()).To(Equal(30))
+			})
+		})
+
+		When("the VM is synced with its class", func() {
+			BeforeEach(func() {
+				Expect(vmopv1util.SetLastResizedAnnotationClassName(vmCtx.VM, builder.DummyClassName)).To(Succeed())
+			})
+
+			It("clears the pending resize", func() {
+				Expect(vmCtx.VM.Status.PendingResize).To(BeNil())
+			})
+
+			When("the VM has a CPU override", func() {
+				BeforeEach(func() {
+					vmCtx.VM.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{
+						CPUs: ptr.To(int64(vmCtx.MoVM.Config.Hardware.NumCPU) + 1),
+					}
+				})
+
+				It("reports the pending override", func() {
+					pendingResize := vmCtx.VM.Status.PendingResize
+					Expect(pendingResize).ToNot(BeNil())
+					Expect(pendingResize.ClassName).To(Equal(builder.DummyClassName))
+					Expect(pendingResize.CPUs).To(Equal(vmCtx.VM.Spec.Hardware.CPUs))
+					Expect(pendingResize.Memory).To(BeNil())
+				})
+
+				When("the VM has CPU hot-add enabled", func() {
+					BeforeEach(func() {
+						vmCtx.MoVM.Config.CpuHotAddEnabled = ptr.To(true)
+					})
+
+					It("clears the pending resize", func() {
+						Expect(vmCtx.VM.Status.PendingResize).To(BeNil())
+					})
+				})
+			})
+		})
+
+		When("the VM is powered off", func() {
+			BeforeEach(func() {
+				vmCtx.MoVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOff
+			})
+
+			It("clears the pending resize", func() {
+				Expect(vmCtx.VM.Status.PendingResize).To(BeNil())
+			})
+		})
+
+		When("resize is not enabled", func() {
+			BeforeEach(func() {
+				pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+					config.Features.VMResize = false
+					config.Features.VMResizeCPUMemory = false
+				})
+			})
+
+			It("clears the pending resize", func() {
+				Expect(vmCtx.VM.Status.PendingResize).To(BeNil())
+			})
+		})
+	})
+
+	Context("ConfigDrift", func() {
+
+		var (
+			chanRecord chan string
+		)
+
+		BeforeEach(func() {
+			chanRecord = make(chan string, 10)
+
+			vmCtx.Context = record.WithContext(
+				vmCtx.Context,
+				record.New(&apirecord.FakeRecorder{Events: chanRecord}))
+
+			pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+				config.Features.VMResizeCPUMemory = true
+				config.NetworkProviderType = pkgcfg.NetworkProviderTypeNamed
+			})
+
+			vmCtx.VM.Spec.ClassName = ""
+			vmCtx.VM.Spec.Hardware = nil
+			vmCtx.VM.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{}
+
+			ethCards := object.VirtualDeviceList(vmCtx.MoVM.Config.Hardware.Device).
+				SelectByType((*vimtypes.VirtualEthernetCard)(nil))
+			for i, dev := range ethCards {
+				// Use a standard network backing since the Named network
+				// provider matches devices by the backing's device name.
+				dev.GetVirtualDevice().Backing = &vimtypes.VirtualEthernetCardNetworkBackingInfo{
+					VirtualDeviceDeviceBackingInfo: vimtypes.VirtualDeviceDeviceBackingInfo{
+						DeviceName: "VM Network",
+					},
+				}
+				vmCtx.VM.Spec.Network.Interfaces = append(vmCtx.VM.Spec.Network.Interfaces,
+					vmopv1.VirtualMachineNetworkInterfaceSpec{
+						Name: fmt.Sprintf("eth%d", i),
+						Network: &vmopv1common.PartialObjectRef{
+							Name: "VM Network",
+						},
+					})
+				data.NetworkDeviceKeysToSpecIdx[dev.GetVirtualDevice().Key] = i
+			}
+		})
+
+		assertEvent := func(msg string) {
+			var e string
+			EventuallyWithOffset(1, chanRecord).Should(Receive(&e, Equal(msg)))
+		}
+
+		When("the VM has not drifted", func() {
+			It("marks the condition true", func() {
+				Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)).To(BeTrue())
+				Expect(chanRecord).To(BeEmpty())
+			})
+		})
+
+		When("the VM's CPUs and memory have drifted from the overrides", func() {
+			var (
+				numCPU   int32
+				memoryMB int32
+			)
+
+			BeforeEach(func() {
+				// Otherwise the overrides are reported as a pending resize
+				// since the VM does not have hot-add enabled.
+				vmCtx.MoVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOff
+
+				numCPU = vmCtx.MoVM.Config.Hardware.NumCPU
+				memoryMB = vmCtx.MoVM.Config.Hardware.MemoryMB
+
+				vmCtx.VM.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{
+					CPUs:   ptr.To(int64(numCPU) + 1),
+					Memory: ptr.To(resource.MustParse(fmt.Sprintf("%dMi", memoryMB*2))),
+				}
+			})
+
+			It("reports the drift", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.VirtualMachineConfigDriftedReason))
+				Expect(c.Message).To(Equal(fmt.Sprintf(
+					"CPUs: expected %d, observed %d\nmemory: expected %dMi, observed %dMi",
+					numCPU+1, numCPU, memoryMB*2, memoryMB)))
+
+				assertEvent(fmt.Sprintf("Warning %s CPUs: expected %d, observed %d",
+					vmopv1.VirtualMachineHardwareCPUMismatchReason, numCPU+1, numCPU))
+				assertEvent(fmt.Sprintf("Warning %s memory: expected %dMi, observed %dMi",
+					vmopv1.VirtualMachineHardwareMemoryMismatchReason, memoryMB*2, memoryMB))
+			})
+
+			When("the drift was already reported", func() {
+				JustBeforeEach(func() {
+					Expect(chanRecord).To(HaveLen(2))
+					for range 2 {
+						<-chanRecord
+					}
+					Expect(vmlifecycle.ReconcileStatus(vmCtx, ctx.Client, vcVM, data)).To(Succeed())
+				})
+
+				It("does not emit the events again", func() {
+					Expect(conditions.IsFalse(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)).To(BeTrue())
+					Expect(chanRecord).To(BeEmpty())
+				})
+			})
+
+			When("the drift policy is Adopt", func() {
+				BeforeEach(func() {
+					vmCtx.VM.Spec.DriftPolicy = vmopv1.VirtualMachineDriftPolicyAdopt
+				})
+
+				It("adopts the drift into the spec", func() {
+					Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)).To(BeTrue())
+					Expect(vmCtx.VM.Spec.Hardware.CPUs).To(HaveValue(BeEquivalentTo(numCPU)))
+					Expect(vmCtx.VM.Spec.Hardware.Memory).ToNot(BeNil())
+					Expect(vmCtx.VM.Spec.Hardware.Memory.Value()).To(BeEquivalentTo(int64(memoryMB) * 1024 * 1024))
+					Expect(chanRecord).To(HaveLen(2))
+				})
+
+				When("resize is not enabled", func() {
+					BeforeEach(func() {
+						pkgcfg.SetContext(vmCtx, func(config *pkgcfg.Config) {
+							config.Features.VMResizeCPUMemory = false
+						})
+					})
+
+					It("only reports the drift", func() {
+						Expect(conditions.IsFalse(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)).To(BeTrue())
+						Expect(vmCtx.VM.Spec.Hardware.CPUs).To(HaveValue(BeEquivalentTo(numCPU + 1)))
+					})
+				})
+			})
+		})
+
+		When("the VM's memory has drifted from its class", func() {
+			var memoryMB int32
+
+			BeforeEach(func() {
+				memoryMB = vmCtx.MoVM.Config.Hardware.MemoryMB
+
+				vmClass := builder.DummyVirtualMachineClass("drift-class")
+				vmClass.Namespace = vmCtx.VM.Namespace
+				vmClass.Spec.Hardware.Cpus = int64(vmCtx.MoVM.Config.Hardware.NumCPU)
+				vmClass.Spec.Hardware.Memory = resource.MustParse(fmt.Sprintf("%dMi", memoryMB*2))
+				Expect(ctx.Client.Create(ctx, vmClass)).To(Succeed())
+
+				vmCtx.VM.Spec.ClassName = vmClass.Name
+				vmopv1util.MustSetLastResizedAnnotation(vmCtx.VM, *vmClass)
+			})
+
+			It("reports the drift", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Message).To(Equal(fmt.Sprintf(
+					"memory: expected %dMi, observed %dMi", memoryMB*2, memoryMB)))
+			})
+
+			When("the VM has not been resized to the class", func() {
+				BeforeEach(func() {
+					Expect(vmopv1util.SetLastResizedAnnotationClassName(vmCtx.VM, "old-class")).To(Succeed())
+					vmCtx.VM.Status.PendingResize = nil
+					vmCtx.MoVM.Runtime.PowerState = vimtypes.VirtualMachinePowerStatePoweredOff
+				})
+
+				It("does not report drift", func() {
+					Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)).To(BeTrue())
+				})
+			})
+		})
+
+		When("a network interface was added to the VM", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Network.Interfaces = vmCtx.VM.Spec.Network.Interfaces[1:]
+			})
+
+			It("reports the drift", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Message).To(HavePrefix("network interfaces: expected"))
+				var e string
+				Eventually(chanRecord).Should(Receive(&e,
+					HavePrefix("Warning "+vmopv1.VirtualMachineHardwareNetworkInterfacesMismatchReason)))
+			})
+		})
+
+		When("a network interface's MAC address has drifted", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Network.Interfaces[0].MACAddr = "00:50:56:00:00:01"
+			})
+
+			It("reports the drift", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Message).To(HavePrefix(
+					"network interface eth0: MAC address: expected 00:50:56:00:00:01, observed"))
+			})
+		})
+
+		When("a network interface's network has drifted", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Network.Interfaces[0].Network.Name = "other-network"
+			})
+
+			It("reports the drift", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Message).To(Equal(
+					"network interface eth0: network: expected other-network, observed VM Network"))
+				assertEvent("Warning " + vmopv1.VirtualMachineHardwareNetworkInterfacesMismatchReason +
+					" network interface eth0: network: expected other-network, observed VM Network")
+			})
+		})
+
+		When("a network interface's adapter type has drifted", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Network.Interfaces[0].AdapterType =
+					vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV
+			})
+
+			It("reports the drift", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Message).To(Equal(
+					"network interface eth0: adapter type: expected SRIOV, observed E1000"))
+			})
+		})
+
+		When("a network interface does not have a matching device", func() {
+			BeforeEach(func() {
+				clear(data.NetworkDeviceKeysToSpecIdx)
+			})
+
+			It("reports the drift", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Message).To(Equal("network interface eth0: no matching device"))
+			})
+		})
+
+		When("a hardware device mismatch is reported", func() {
+			BeforeEach(func() {
+				conditions.MarkFalse(
+					vmCtx.VM,
+					vmopv1.VirtualMachineHardwareCDROMVerified,
+					vmopv1.VirtualMachineHardwareCDROMMismatchReason,
+					"unexpected CD-ROM devices: my-iso")
+			})
+
+			It("reports the drift with the mismatch reason", func() {
+				c := conditions.Get(vmCtx.VM, vmopv1.VirtualMachineConfigDriftVerified)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Message).To(Equal("unexpected CD-ROM devices: my-iso"))
+				assertEvent("Warning " + vmopv1.VirtualMachineHardwareCDROMMismatchReason +
+					" unexpected CD-ROM devices: my-iso")
+			})
+		})
+	})
 })
 
 var _ = Describe("VirtualMachineTools Status to VM Status Condition", func() {
@@ -3437,6 +3830,272 @@ var _ = Describe("VSphere Bootstrap Status to VM Status Condition", func() {
 	})
 })
 
+var _ = Describe("VSphere Domain Join Status to VM Status Condition", func() {
+	Context("MarkDomainJoinCondition", func() {
+		var (
+			vm          *vmopv1.VirtualMachine
+			extraConfig map[string]string
+		)
+
+		BeforeEach(func() {
+			vm = &vmopv1.VirtualMachine{
+				Spec: vmopv1.VirtualMachineSpec{
+					Bootstrap: &vmopv1.VirtualMachineBootstrapSpec{
+						Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+							Sysprep: &vmopv1sysprep.Sysprep{
+								Identification: &vmopv1sysprep.Identification{
+									DomainJoin: &vmopv1sysprep.DomainJoin{
+										Credentials: vmopv1sysprep.DomainJoinCredentialsSecretKeySelector{
+											Name: "my-domain-join-secret",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			vmlifecycle.MarkDomainJoinCondition(vm, extraConfig)
+		})
+
+		AfterEach(func() {
+			extraConfig = nil
+		})
+
+		When("the VM does not use domain join", func() {
+			BeforeEach(func() {
+				vm.Spec.Bootstrap.Sysprep.Sysprep.Identification.DomainJoin = nil
+				conditions.MarkTrue(vm, vmopv1.GuestDomainJoinCondition)
+			})
+			It("removes condition", func() {
+				Expect(conditions.Get(vm, vmopv1.GuestDomainJoinCondition)).To(BeNil())
+			})
+		})
+
+		When("the guest has not reported the domain join status", func() {
+			BeforeEach(func() {
+				extraConfig = map[string]string{
+					pkgutil.GuestInfoBootstrapCondition: "true",
+				}
+			})
+			It("sets condition unknown", func() {
+				c := conditions.Get(vm, vmopv1.GuestDomainJoinCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionUnknown))
+				Expect(c.Reason).To(Equal(vmopv1.GuestDomainJoinPendingReason))
+			})
+		})
+
+		When("the guest reports it joined the domain", func() {
+			BeforeEach(func() {
+				extraConfig = map[string]string{
+					pkgutil.GuestInfoDomainJoinCondition: "true",
+				}
+			})
+			It("sets condition true", func() {
+				Expect(conditions.IsTrue(vm, vmopv1.GuestDomainJoinCondition)).To(BeTrue())
+			})
+		})
+
+		When("the guest reports it failed to join the domain", func() {
+			BeforeEach(func() {
+				extraConfig = map[string]string{
+					pkgutil.GuestInfoDomainJoinCondition: "false,AccessDenied,access is denied",
+				}
+			})
+			It("sets condition false", func() {
+				expectedConditions := []metav1.Condition{
+					*conditions.FalseCondition(
+						vmopv1.GuestDomainJoinCondition,
+						"AccessDenied",
+						"access is denied"),
+				}
+				Expect(vm.Status.Conditions).To(conditions.MatchConditions(expectedConditions))
+			})
+		})
+
+		When("the guest reports it failed to join the domain without a reason", func() {
+			BeforeEach(func() {
+				extraConfig = map[string]string{
+					pkgutil.GuestInfoDomainJoinCondition: "false",
+				}
+			})
+			It("sets condition false with the default reason", func() {
+				c := conditions.Get(vm, vmopv1.GuestDomainJoinCondition)
+				Expect(c).ToNot(BeNil())
+				Expect(c.Status).To(Equal(metav1.ConditionFalse))
+				Expect(c.Reason).To(Equal(vmopv1.GuestDomainJoinFailedReason))
+			})
+		})
+	})
+})
+
+var _ = Describe("Guest Network Config Status to VM Status Condition", func() {
+	Context("MarkGuestNetworkConfigSyncedCondition", func() {
+		var (
+			vm     *vmopv1.VirtualMachine
+			config *vimtypes.VirtualMachineConfigInfo
+		)
+
+		BeforeEach(func() {
+			config = &vimtypes.VirtualMachineConfigInfo{
+				Hardware: vimtypes.VirtualHardware{
+					Device: []vimtypes.BaseVirtualDevice{
+						&vimtypes.VirtualVmxnet3{
+							VirtualVmxnet: vimtypes.VirtualVmxnet{
+								VirtualEthernetCard: vimtypes.VirtualEthernetCard{
+									VirtualDevice: vimtypes.VirtualDevice{Key: 4000},
+									MacAddress:    "00:50:56:00:00:01",
+								},
+							},
+						},
+						&vimtypes.VirtualVmxnet3{
+							VirtualVmxnet: vimtypes.VirtualVmxnet{
+								VirtualEthernetCard: vimtypes.VirtualEthernetCard{
+									VirtualDevice: vimtypes.VirtualDevice{Key: 4001},
+									MacAddress:    "00:50:56:00:00:02",
+								},
+							},
+						},
+					},
+				},
+			}
+			vm = &vmopv1.VirtualMachine{
+				Status: vmopv1.VirtualMachineStatus{
+					Network: &vmopv1.VirtualMachineNetworkStatus{
+						Config: &vmopv1.VirtualMachineNetworkConfigStatus{
+							Interfaces: []vmopv1.VirtualMachineNetworkConfigInterfaceStatus{
+								{
+									Name: "eth0",
+									IP: &vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus{
+										AssignmentMode: vmopv1.VirtualMachineNetworkIPAssignmentModeStatic,
+										Addresses:      []string{"192.168.1.10/24"},
+									},
+								},
+								{
+									Name: "eth1",
+									IP: &vmopv1.VirtualMachineNetworkConfigInterfaceIPStatus{
+										AssignmentMode: vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP,
+									},
+								},
+							},
+						},
+						Interfaces: []vmopv1.VirtualMachineNetworkInterfaceStatus{
+							{
+								Name:      "eth0",
+								DeviceKey: 4000,
+								IP: &vmopv1.VirtualMachineNetworkInterfaceIPStatus{
+									MACAddr: "00:50:56:00:00:01",
+									Addresses: []vmopv1.VirtualMachineNetworkInterfaceIPAddrStatus{
+										{Address: "192.168.1.10"},
+									},
+								},
+							},
+							{
+								Name:      "eth1",
+								DeviceKey: 4001,
+								IP: &vmopv1.VirtualMachineNetworkInterfaceIPStatus{
+									MACAddr: "00:50:56:00:00:02",
+									Addresses: []vmopv1.VirtualMachineNetworkInterfaceIPAddrStatus{
+										{Address: "fe80::1"},
+										{Address: "10.0.0.5"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			conditions.MarkFalse(vm,
+				vmopv1.GuestNetworkConfigSyncedCondition,
+				vmopv1.GuestNetworkConfigPendingReason,
+				"")
+		})
+
+		JustBeforeEach(func() {
+			vmlifecycle.MarkGuestNetworkConfigSyncedCondition(vm, config)
+		})
+
+		When("the VM does not have the condition", func() {
+			BeforeEach(func() {
+				conditions.Delete(vm, vmopv1.GuestNetworkConfigSyncedCondition)
+			})
+			It("does not add the condition", func() {
+				Expect(conditions.Get(vm, vmopv1.GuestNetworkConfigSyncedCondition)).To(BeNil())
+			})
+		})
+
+		When("the guest reports the configured interfaces and addresses", func() {
+			It("sets condition true", func() {
+				Expect(conditions.IsTrue(vm, vmopv1.GuestNetworkConfigSyncedCondition)).To(BeTrue())
+			})
+		})
+
+		When("the guest does not report a configured interface", func() {
+			BeforeEach(func() {
+				vm.Status.Network.Interfaces = vm.Status.Network.Interfaces[:1]
+			})
+			It("leaves condition pending", func() {
+				Expect(conditions.GetReason(vm, vmopv1.GuestNetworkConfigSyncedCondition)).
+					To(Equal(vmopv1.GuestNetworkConfigPendingReason))
+			})
+		})
+
+		When("the guest does not report a static address", func() {
+			BeforeEach(func() {
+				vm.Status.Network.Interfaces[0].IP.Addresses[0].Address = "192.168.1.11"
+			})
+			It("leaves condition pending", func() {
+				Expect(conditions.GetReason(vm, vmopv1.GuestNetworkConfigSyncedCondition)).
+					To(Equal(vmopv1.GuestNetworkConfigPendingReason))
+			})
+		})
+
+		When("the guest still reports a hot-removed interface", func() {
+			BeforeEach(func() {
+				config.Hardware.Device = config.Hardware.Device[:1]
+				vm.Status.Network.Config.Interfaces = vm.Status.Network.Config.Interfaces[:1]
+			})
+			It("leaves condition pending", func() {
+				Expect(conditions.GetReason(vm, vmopv1.GuestNetworkConfigSyncedCondition)).
+					To(Equal(vmopv1.GuestNetworkConfigPendingReason))
+			})
+
+			When("the guest no longer reports the interface", func() {
+				BeforeEach(func() {
+					vm.Status.Network.Interfaces = vm.Status.Network.Interfaces[:1]
+				})
+				It("sets condition true", func() {
+					Expect(conditions.IsTrue(vm, vmopv1.GuestNetworkConfigSyncedCondition)).To(BeTrue())
+				})
+			})
+		})
+
+		When("the guest reports a different MAC address for an interface", func() {
+			BeforeEach(func() {
+				vm.Status.Network.Interfaces[1].IP.MACAddr = "00:50:56:00:00:03"
+			})
+			It("leaves condition pending", func() {
+				Expect(conditions.GetReason(vm, vmopv1.GuestNetworkConfigSyncedCondition)).
+					To(Equal(vmopv1.GuestNetworkConfigPendingReason))
+			})
+		})
+
+		When("the guest only reports a link-local address for a DHCP interface", func() {
+			BeforeEach(func() {
+				vm.Status.Network.Interfaces[1].IP.Addresses = vm.Status.Network.Interfaces[1].IP.Addresses[:1]
+			})
+			It("leaves condition pending", func() {
+				Expect(conditions.GetReason(vm, vmopv1.GuestNetworkConfigSyncedCondition)).
+					To(Equal(vmopv1.GuestNetworkConfigPendingReason))
+			})
+		})
+	})
+})
+
 var _ = Describe("VirtualMachineReconcileReady Status to VM Status Condition", func() {
 	Context("MarkReconciliationCondition", func() {
 		var (
@@ -3690,6 +4349,40 @@ var _ = Describe("UpdateNetworkStatusConfig", func() {
 						Expect(ic.DNS.SearchDomains).To(Equal([]string{"per.vm"}))
 					})
 				})
+
+				When("the interface uses DHCP instead of IPAM", func() {
+					BeforeEach(func() {
+						args.NetworkResults.Results[0].IPConfigs = nil
+						args.NetworkResults.Results[0].DHCP4 = true
+						args.NetworkResults.Results[0].IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP
+					})
+					Specify("status.network.config.interfaces should report the DHCP assignment mode", func() {
+						Expect(config.Interfaces).To(HaveLen(1))
+						ic := config.Interfaces[0]
+						Expect(ic.IP).ToNot(BeNil())
+						Expect(ic.IP.AssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeDHCP))
+						Expect(ic.IP.Addresses).To(BeEmpty())
+						Expect(ic.IP.DHCP).ToNot(BeNil())
+						Expect(ic.IP.DHCP.IP4).ToNot(BeNil())
+						Expect(ic.IP.DHCP.IP4.Enabled).To(BeTrue())
+					})
+				})
+
+				When("the interface has no IP assignment", func() {
+					BeforeEach(func() {
+						args.NetworkResults.Results[0].IPConfigs = nil
+						args.NetworkResults.Results[0].NoIPAM = true
+						args.NetworkResults.Results[0].IPAssignmentMode = vmopv1.VirtualMachineNetworkIPAssignmentModeNone
+					})
+					Specify("status.network.config.interfaces should report the None assignment mode", func() {
+						Expect(config.Interfaces).To(HaveLen(1))
+						ic := config.Interfaces[0]
+						Expect(ic.IP).ToNot(BeNil())
+						Expect(ic.IP.AssignmentMode).To(Equal(vmopv1.VirtualMachineNetworkIPAssignmentModeNone))
+						Expect(ic.IP.Addresses).To(BeEmpty())
+						Expect(ic.IP.DHCP).To(BeNil())
+					})
+				})
 			})
 
 			When("there are two network interface results", func() {
@@ -3729,6 +4422,68 @@ var _ = Describe("UpdateNetworkStatusConfig", func() {
 					ExpectWithOffset(1, ic.IP.Gateway4).To(Equal("192.168.0.1"))
 					ExpectWithOffset(1, ic.IP.Gateway6).To(Equal("FD00:F500::::"))
 				})
+
+				When("the interfaces are members of a bond with a VLAN", func() {
+					BeforeEach(func() {
+						args.NetworkResults.Results[0].GuestDeviceName = "ens192"
+						args.NetworkResults.Results[1].GuestDeviceName = "ens224"
+						args.NetworkResults.Bonds = []network.NetworkBondResult{
+							{
+								NetworkGuestDeviceResult: network.NetworkGuestDeviceResult{
+									Name:  "bond0",
+									DHCP4: true,
+								},
+								Interfaces: []string{"eth0", "eth1"},
+								Mode:       vmopv1.VirtualMachineNetworkBondModeActiveBackup,
+							},
+						}
+						args.NetworkResults.VLANs = []network.NetworkVLANResult{
+							{
+								NetworkGuestDeviceResult: network.NetworkGuestDeviceResult{
+									Name: "bond0.100",
+									IPConfigs: []network.NetworkInterfaceIPConfig{
+										{
+											IPCIDR:  "192.168.100.10/24",
+											IsIPv4:  true,
+											Gateway: "192.168.100.1",
+										},
+									},
+									Nameservers: []string{"1.1.1.1"},
+								},
+								ID:   100,
+								Link: "bond0",
+							},
+						}
+					})
+
+					Specify("status.network.config.interfaces should be nil", func() {
+						Expect(config.Interfaces).To(BeNil())
+					})
+					Specify("status.network.config.guestDevices should have the bond and VLAN", func() {
+						Expect(config.GuestDevices).To(HaveLen(2))
+
+						bond := config.GuestDevices[0]
+						Expect(bond.Name).To(Equal("bond0"))
+						Expect(bond.Type).To(Equal(vmopv1.VirtualMachineNetworkGuestDeviceTypeBond))
+						Expect(bond.Links).To(Equal([]string{"ens192", "ens224"}))
+						Expect(bond.BondMode).To(Equal(vmopv1.VirtualMachineNetworkBondModeActiveBackup))
+						Expect(bond.IP).ToNot(BeNil())
+						Expect(bond.IP.DHCP).ToNot(BeNil())
+						Expect(bond.IP.DHCP.IP4).ToNot(BeNil())
+						Expect(bond.IP.DHCP.IP4.Enabled).To(BeTrue())
+
+						vlan := config.GuestDevices[1]
+						Expect(vlan.Name).To(Equal("bond0.100"))
+						Expect(vlan.Type).To(Equal(vmopv1.VirtualMachineNetworkGuestDeviceTypeVLAN))
+						Expect(vlan.Links).To(Equal([]string{"bond0"}))
+						Expect(vlan.VLANID).To(BeEquivalentTo(100))
+						Expect(vlan.IP).ToNot(BeNil())
+						Expect(vlan.IP.Addresses).To(Equal([]string{"192.168.100.10/24"}))
+						Expect(vlan.IP.Gateway4).To(Equal("192.168.100.1"))
+						Expect(vlan.DNS).ToNot(BeNil())
+						Expect(vlan.DNS.Nameservers).To(Equal([]string{"1.1.1.1"}))
+					})
+				})
 			})
 		})
 	})
@@ -6,8 +6,10 @@ package vsphere
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math/rand"
 	"strings"
 	"sync"
@@ -47,6 +49,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/record"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	imgutil "github.com/vmware-tanzu/vm-operator/pkg/util/image"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ovfcache"
 	vsclient "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
 )
@@ -64,6 +67,14 @@ type vSphereVMProvider struct {
 
 	vcClientLock sync.Mutex
 	vcClient     *vcclient.Client
+
+	// vcClients are the clients for the vCenters that back availability
+	// zones other than the vCenter from the provider ConfigMap.
+	vcClients map[topology.VCenter]*vcclient.Client
+
+	// resourceVCenters are the vCenters where resources that are not
+	// associated with a zone, ex. content library items, were found.
+	resourceVCenters map[string]topology.VCenter
 }
 
 func NewVSphereVMProviderFromClient(
@@ -185,31 +196,9 @@ func (vs *vSphereVMProvider) SyncVirtualMachineImage(
 	ctx context.Context,
 	cli, vmi ctrlclient.Object) error {
 
-	var (
-		itemID      string
-		itemVersion string
-		itemType    imgregv1a1.ContentLibraryItemType
-	)
-
-	switch cli := cli.(type) {
-	case *imgregv1a1.ContentLibraryItem:
-		itemID = string(cli.Spec.UUID)
-		itemVersion = cli.Status.ContentVersion
-		itemType = cli.Status.Type
-	case *imgregv1a1.ClusterContentLibraryItem:
-		itemID = string(cli.Spec.UUID)
-		itemVersion = cli.Status.ContentVersion
-		itemType = cli.Status.Type
-	case *imgregv1.ContentLibraryItem:
-		itemID = cli.Spec.ID
-		itemVersion = cli.Status.ContentVersion
-		itemType = imgregv1a1.ContentLibraryItemType(cli.Status.Type)
-	case *imgregv1.ClusterContentLibraryItem:
-		itemID = cli.Spec.ID
-		itemVersion = cli.Status.ContentVersion
-		itemType = imgregv1a1.ContentLibraryItemType(cli.Status.Type)
-	default:
-		return fmt.Errorf("unexpected content library item K8s object type %T", cli)
+	itemID, itemVersion, itemType, err := getLibraryItemInfo(cli)
+	if err != nil {
+		return err
 	}
 
 	logger := pkglog.FromContextOrDefault(ctx).V(4).WithValues(
@@ -239,6 +228,75 @@ func (vs *vSphereVMProvider) SyncVirtualMachineImage(
 	return vs.syncVirtualMachineImage(ctx, vmi, itemID, itemVersion)
 }
 
+// VerifyVirtualMachineImageSignature verifies the signature of the library
+// item's OVF package with the provided trust anchors. The errors from
+// imgutil.VerifySignature are returned when the package is not signed or the
+// signature cannot be verified.
+func (vs *vSphereVMProvider) VerifyVirtualMachineImageSignature(
+	ctx context.Context,
+	cli ctrlclient.Object,
+	roots *x509.CertPool) error {
+
+	itemID, _, itemType, err := getLibraryItemInfo(cli)
+	if err != nil {
+		return err
+	}
+
+	if itemType != imgregv1a1.ContentLibraryItemTypeOvf {
+		return fmt.Errorf("%w: library item type %q does not support signatures",
+			imgutil.ErrSignatureMissing, itemType)
+	}
+
+	client, err := vs.getVcClientForLibraryItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	clProv := contentlibrary.NewProvider(ctx, client.RestClient())
+
+	item, err := clProv.GetLibraryItemID(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	return clProv.DownloadLibraryItemFiles(
+		ctx,
+		item,
+		func(files []library.DownloadFile, open func(string) (io.ReadCloser, error)) error {
+			pkgFiles := make([]imgutil.PackageFile, len(files))
+			for i := range files {
+				pkgFiles[i].Name = files[i].Name
+				if c := files[i].Checksum; c != nil {
+					pkgFiles[i].ChecksumAlgorithm = c.Algorithm
+					pkgFiles[i].Checksum = c.Checksum
+				}
+			}
+			return imgutil.VerifySignature(pkgFiles, open, roots)
+		})
+}
+
+// getLibraryItemInfo returns the ID, content version, and type of the library
+// item.
+func getLibraryItemInfo(
+	cli ctrlclient.Object) (string, string, imgregv1a1.ContentLibraryItemType, error) {
+
+	switch cli := cli.(type) {
+	case *imgregv1a1.ContentLibraryItem:
+		return string(cli.Spec.UUID), cli.Status.ContentVersion, cli.Status.Type, nil
+	case *imgregv1a1.ClusterContentLibraryItem:
+		return string(cli.Spec.UUID), cli.Status.ContentVersion, cli.Status.Type, nil
+	case *imgregv1.ContentLibraryItem:
+		return cli.Spec.ID, cli.Status.ContentVersion,
+			imgregv1a1.ContentLibraryItemType(cli.Status.Type), nil
+	case *imgregv1.ClusterContentLibraryItem:
+		return cli.Spec.ID, cli.Status.ContentVersion,
+			imgregv1a1.ContentLibraryItemType(cli.Status.Type), nil
+	default:
+		return "", "", "", fmt.Errorf(
+			"unexpected content library item K8s object type %T", cli)
+	}
+}
+
 func (vs *vSphereVMProvider) syncVirtualMachineImageFastDeploy(
 	ctx context.Context,
 	vmi ctrlclient.Object,
@@ -359,7 +417,7 @@ func (vs *vSphereVMProvider) syncVirtualMachineImageFastDeployVM(
 	vmi ctrlclient.Object,
 	vmMoID string) error {
 
-	vcClient, err := vs.getVcClient(ctx)
+	vcClient, err := vs.getVcClientForLibraryItem(ctx, vmMoID)
 	if err != nil {
 		return fmt.Errorf("failed to get a vc client for image vm: %w", err)
 	}
@@ -407,7 +465,7 @@ func (vs *vSphereVMProvider) syncVirtualMachineImage(
 func (vs *vSphereVMProvider) getOvfEnvelope(
 	ctx context.Context, itemID string) (*ovf.Envelope, error) {
 
-	client, err := vs.getVcClient(ctx)
+	client, err := vs.getVcClientForLibraryItem(ctx, itemID)
 	if err != nil {
 		return nil, err
 	}
@@ -424,7 +482,7 @@ func (vs *vSphereVMProvider) GetItemFromLibraryByName(ctx context.Context,
 	pkglog.FromContextOrDefault(ctx).V(4).Info("Get item from ContentLibrary",
 		"UUID", contentLibrary, "item name", itemName)
 
-	client, err := vs.getVcClient(ctx)
+	client, err := vs.getVcClientForLibrary(ctx, contentLibrary)
 	if err != nil {
 		return nil, err
 	}
@@ -437,7 +495,12 @@ func (vs *vSphereVMProvider) GetItemFromInventoryByName(
 	ctx context.Context,
 	contentLibrary, itemName string) (object.Reference, error) {
 
-	client, err := vs.getVcClient(ctx)
+	folderRef := vimtypes.ManagedObjectReference{
+		Type:  string(vimtypes.ManagedObjectTypeFolder),
+		Value: contentLibrary,
+	}
+
+	client, err := vs.getVcClientForManagedObject(ctx, folderRef)
 	if err != nil {
 		return nil, err
 	}
@@ -446,11 +509,6 @@ func (vs *vSphereVMProvider) GetItemFromInventoryByName(
 
 	searchIndex := object.NewSearchIndex(c)
 
-	folderRef := vimtypes.ManagedObjectReference{
-		Type:  string(vimtypes.ManagedObjectTypeFolder),
-		Value: contentLibrary,
-	}
-
 	vm, err := searchIndex.FindChild(ctx, folderRef, itemName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find child vm %s: %w", itemName, err)
@@ -486,7 +544,7 @@ func (vs *vSphereVMProvider) ContainsExtraConfigEntry(
 func (vs *vSphereVMProvider) UpdateContentLibraryItem(ctx context.Context, itemID, newName string, newDescription *string) error {
 	pkglog.FromContextOrDefault(ctx).V(4).Info("Update Content Library Item", "itemID", itemID)
 
-	client, err := vs.getVcClient(ctx)
+	client, err := vs.getVcClientForLibraryItem(ctx, itemID)
 	if err != nil {
 		return err
 	}
@@ -605,7 +663,7 @@ func (vs *vSphereVMProvider) computeCPUMinFrequency(ctx context.Context) (uint64
 func (vs *vSphereVMProvider) GetTasksByActID(ctx context.Context, vm *vmopv1.VirtualMachine, actID string) (_ []vimtypes.TaskInfo, retErr error) {
 	logger := pkglog.FromContextOrDefault(ctx)
 
-	vcClient, err := vs.getVcClient(ctx)
+	vcClient, err := vs.getVcClientForZone(ctx, vm.Labels[corev1.LabelTopologyZone])
 	if err != nil {
 		return nil, err
 	}
@@ -676,7 +734,7 @@ func (vs *vSphereVMProvider) DoesProfileSupportEncryption(
 	ctx context.Context,
 	profileID string) (bool, error) {
 
-	c, err := vs.getVcClient(ctx)
+	c, err := vs.getVcClientForProfile(ctx, profileID)
 	if err != nil {
 		return false, err
 	}
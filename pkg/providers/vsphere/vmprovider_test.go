@@ -5,6 +5,7 @@
 package vsphere_test
 
 import (
+	"crypto/x509"
 	"errors"
 	"fmt"
 
@@ -20,11 +21,14 @@ import (
 	imgregv1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha2"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
+	imgutil "github.com/vmware-tanzu/vm-operator/pkg/util/image"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -105,6 +109,32 @@ var _ = Describe("UpdateVcCreds", func() {
 		})
 	})
 
+	When("New Credentials use a client certificate", func() {
+
+		It("VC Client is logged out", func() {
+			vcClient, err := vmProvider.VSphereClient(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vcClient).NotTo(BeNil())
+			session, err := vcClient.RestClient().Session(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(session).ToNot(BeNil())
+
+			crt, key, err := builder.GenerateSelfSignedCertificate("vmop-solution-user")
+			Expect(err).NotTo(HaveOccurred())
+
+			data := map[string][]byte{
+				"tls.crt": crt,
+				"tls.key": key,
+			}
+			Expect(vmProvider.UpdateVcCreds(ctx, data)).To(Succeed())
+			By("Client is logged out", func() {
+				session, err := vcClient.RestClient().Session(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(session).To(BeNil())
+			})
+		})
+	})
+
 	When("Same Credentials", func() {
 
 		It("VC Client is not logged out", func() {
@@ -133,6 +163,134 @@ var _ = Describe("UpdateVcCreds", func() {
 	})
 })
 
+var _ = Describe("VSphereClientForZone", func() {
+	var (
+		ctx        *builder.TestContextForVCSim
+		testConfig builder.VCSimTestConfig
+		vmProvider providers.VirtualMachineProviderInterface
+		zoneName   string
+	)
+
+	BeforeEach(func() {
+		ctx = suite.NewTestContextForVCSim(testConfig)
+		vmProvider = vsphere.NewVSphereVMProviderFromClient(ctx, ctx.Client, ctx.Recorder)
+		zoneName = ctx.GetFirstZoneName()
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+	})
+
+	When("the zone is backed by the default vCenter", func() {
+		It("returns the default client", func() {
+			vcClient, err := vmProvider.VSphereClient(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			zoneClient, err := vmProvider.VSphereClientForZone(ctx, zoneName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zoneClient).To(BeIdenticalTo(vcClient))
+		})
+	})
+
+	When("the zone does not exist", func() {
+		It("returns the default client", func() {
+			vcClient, err := vmProvider.VSphereClient(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			zoneClient, err := vmProvider.VSphereClientForZone(ctx, "does-not-exist")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zoneClient).To(BeIdenticalTo(vcClient))
+		})
+	})
+
+	When("the zone is backed by another vCenter", func() {
+		const credsSecretName = "zone-vc-creds"
+
+		BeforeEach(func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      credsSecretName,
+					Namespace: ctx.PodNamespace,
+				},
+				Data: map[string][]byte{
+					"username": []byte(ctx.VCClientConfig.Username),
+					"password": []byte(ctx.VCClientConfig.Password),
+				},
+			}
+			Expect(ctx.Client.Create(ctx, secret)).To(Succeed())
+
+			az := &topologyv1.AvailabilityZone{}
+			Expect(ctx.Client.Get(ctx, ctrlclient.ObjectKey{Name: zoneName}, az)).To(Succeed())
+			az.Annotations = map[string]string{
+				topology.VCenterPNIDAnnotationKey:            ctx.VCClientConfig.Host,
+				topology.VCenterPortAnnotationKey:            ctx.VCClientConfig.Port,
+				topology.VCenterDatacenterAnnotationKey:      ctx.VCClientConfig.Datacenter,
+				topology.VCenterCredsSecretNameAnnotationKey: credsSecretName,
+			}
+			Expect(ctx.Client.Update(ctx, az)).To(Succeed())
+		})
+
+		It("returns a client for the zone's vCenter", func() {
+			vcClient, err := vmProvider.VSphereClient(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			zoneClient, err := vmProvider.VSphereClientForZone(ctx, zoneName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zoneClient).NotTo(BeNil())
+			Expect(zoneClient).NotTo(BeIdenticalTo(vcClient))
+			Expect(zoneClient.Valid()).To(BeTrue())
+
+			By("returning the same client on subsequent calls", func() {
+				zoneClient2, err := vmProvider.VSphereClientForZone(ctx, zoneName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zoneClient2).To(BeIdenticalTo(zoneClient))
+			})
+
+			By("returning the same client when the credentials are unchanged", func() {
+				Expect(vmProvider.UpdateVCenterCreds(ctx, credsSecretName, map[string][]byte{
+					"username": []byte(ctx.VCClientConfig.Username),
+					"password": []byte(ctx.VCClientConfig.Password),
+				})).To(Succeed())
+				zoneClient2, err := vmProvider.VSphereClientForZone(ctx, zoneName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zoneClient2).To(BeIdenticalTo(zoneClient))
+			})
+
+			By("returning a new client when the credentials change", func() {
+				Expect(vmProvider.UpdateVCenterCreds(ctx, credsSecretName, map[string][]byte{
+					"username": []byte(ctx.VCClientConfig.Username),
+					"password": []byte("new-password"),
+				})).To(Succeed())
+				zoneClient2, err := vmProvider.VSphereClientForZone(ctx, zoneName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zoneClient2).NotTo(BeIdenticalTo(zoneClient))
+			})
+
+			By("finding a storage profile on the vCenters", func() {
+				ok, err := vmProvider.DoesProfileSupportEncryption(ctx, ctx.EncryptedStorageProfileID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		When("the credentials Secret does not exist", func() {
+			BeforeEach(func() {
+				Expect(ctx.Client.Delete(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      credsSecretName,
+						Namespace: ctx.PodNamespace,
+					},
+				})).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, err := vmProvider.VSphereClientForZone(ctx, zoneName)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
+
 var _ = Describe("SyncVirtualMachineImage", func() {
 	var (
 		ctx        *builder.TestContextForVCSim
@@ -434,6 +592,61 @@ var _ = Describe("SyncVirtualMachineImage", func() {
 	})
 })
 
+var _ = Describe("VerifyVirtualMachineImageSignature", func() {
+	var (
+		ctx        *builder.TestContextForVCSim
+		testConfig builder.VCSimTestConfig
+		vmProvider providers.VirtualMachineProviderInterface
+	)
+
+	BeforeEach(func() {
+		testConfig.WithContentLibrary = true
+		ctx = suite.NewTestContextForVCSim(testConfig)
+		vmProvider = vsphere.NewVSphereVMProviderFromClient(ctx, ctx.Client, ctx.Recorder)
+	})
+
+	AfterEach(func() {
+		ctx.AfterEach()
+	})
+
+	When("content library item is an unexpected K8s object type", func() {
+		It("should return an error", func() {
+			err := vmProvider.VerifyVirtualMachineImageSignature(ctx, &imgregv1a1.ContentLibrary{}, x509.NewCertPool())
+			Expect(err).To(MatchError(fmt.Sprintf("unexpected content library item K8s object type %T", &imgregv1a1.ContentLibrary{})))
+		})
+	})
+
+	When("content library item is not an OVF type", func() {
+		It("should return ErrSignatureMissing", func() {
+			isoItem := &imgregv1a1.ContentLibraryItem{
+				Spec: imgregv1a1.ContentLibraryItemSpec{
+					UUID: types.UID(ctx.ContentLibraryIsoItemID),
+				},
+				Status: imgregv1a1.ContentLibraryItemStatus{
+					Type: imgregv1a1.ContentLibraryItemTypeIso,
+				},
+			}
+			err := vmProvider.VerifyVirtualMachineImageSignature(ctx, isoItem, x509.NewCertPool())
+			Expect(err).To(MatchError(imgutil.ErrSignatureMissing))
+		})
+	})
+
+	When("content library item is an unsigned OVF", func() {
+		It("should return ErrSignatureMissing", func() {
+			cli := &imgregv1a1.ContentLibraryItem{
+				Spec: imgregv1a1.ContentLibraryItemSpec{
+					UUID: types.UID(ctx.ContentLibraryItemID),
+				},
+				Status: imgregv1a1.ContentLibraryItemStatus{
+					Type: imgregv1a1.ContentLibraryItemTypeOvf,
+				},
+			}
+			err := vmProvider.VerifyVirtualMachineImageSignature(ctx, cli, x509.NewCertPool())
+			Expect(err).To(MatchError(imgutil.ErrSignatureMissing))
+		})
+	})
+})
+
 const ovfEnvelopeYAML = `
 diskSection:
   disk:
@@ -0,0 +1,405 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/vmware/govmomi/fault"
+	pbmtypes "github.com/vmware/govmomi/pbm/types"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vim25/mo"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	vcclient "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/client"
+	vcconfig "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/config"
+	vccreds "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/credentials"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
+	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	vsclient "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
+)
+
+// getVcClientForVM returns the client for the vCenter that backs the VM's
+// zone. The default client is returned if the VM has not been assigned a zone.
+func (vs *vSphereVMProvider) getVcClientForVM(
+	vmCtx pkgctx.VirtualMachineContext) (*vcclient.Client, error) {
+
+	return vs.getVcClientForZone(vmCtx, vmCtx.VM.Labels[corev1.LabelTopologyZone])
+}
+
+// getVcClientForZone returns the client for the vCenter that backs the named
+// availability zone. The default client is returned if the zone name is empty
+// or the zone is backed by the vCenter from the provider ConfigMap.
+func (vs *vSphereVMProvider) getVcClientForZone(
+	ctx context.Context,
+	zoneName string) (*vcclient.Client, error) {
+
+	if zoneName == "" {
+		return vs.getVcClient(ctx)
+	}
+
+	vc, ok, err := topology.GetAvailabilityZoneVCenter(ctx, vs.k8sClient, zoneName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		// There is no AvailabilityZone when the fault domains feature is
+		// disabled, in which case the default vCenter backs the zone.
+		return vs.getVcClient(ctx)
+	}
+	if !ok {
+		return vs.getVcClient(ctx)
+	}
+
+	return vs.getVcClientForVCenter(ctx, vc)
+}
+
+// getVcClientForVCenter returns the client for the provided vCenter. The
+// client is cached so the vCenter's config and credentials are only read when
+// a client is created, and a new client is created after the client is cleared
+// by UpdateVCenterCreds.
+//
+// The login happens without holding vcClientLock so an unreachable vCenter
+// does not block the clients for the other vCenters.
+func (vs *vSphereVMProvider) getVcClientForVCenter(
+	ctx context.Context,
+	vc topology.VCenter) (*vcclient.Client, error) {
+
+	vs.vcClientLock.Lock()
+	vcClient := vs.vcClients[vc]
+	vs.vcClientLock.Unlock()
+
+	if vcClient != nil {
+		return vcClient, nil
+	}
+
+	config, err := vcconfig.GetProviderConfigForVCenter(
+		ctx,
+		vs.k8sClient,
+		vc.PNID,
+		vc.Port,
+		vc.Datacenter,
+		vc.CredsSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	newVcClient, err := vcclient.NewClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	vcClient = func() *vcclient.Client {
+		vs.vcClientLock.Lock()
+		defer vs.vcClientLock.Unlock()
+
+		// Another caller may have created a client while this one logged in.
+		if c := vs.vcClients[vc]; c != nil {
+			return c
+		}
+
+		if vs.vcClients == nil {
+			vs.vcClients = map[topology.VCenter]*vcclient.Client{}
+		}
+		vs.vcClients[vc] = newVcClient
+
+		return newVcClient
+	}()
+
+	if vcClient != newVcClient {
+		newVcClient.Logout(ctx)
+	}
+
+	return vcClient, nil
+}
+
+// getVcClientForVCenterOrDefault returns the client for the provided vCenter,
+// or the default client if the vCenter is the zero value.
+func (vs *vSphereVMProvider) getVcClientForVCenterOrDefault(
+	ctx context.Context,
+	vc topology.VCenter) (*vcclient.Client, error) {
+
+	if vc == (topology.VCenter{}) {
+		return vs.getVcClient(ctx)
+	}
+	return vs.getVcClientForVCenter(ctx, vc)
+}
+
+// UpdateVCenterCreds clears the clients for the vCenters, other than the one
+// from the provider ConfigMap, that use the named credentials Secret when the
+// credentials have changed. The default credentials Secret is used by the
+// vCenters that do not specify a Secret. Secrets that are not used by any of
+// the clients are ignored.
+func (vs *vSphereVMProvider) UpdateVCenterCreds(
+	ctx context.Context,
+	secretName string,
+	data map[string][]byte) error {
+
+	defaultSecretName := pkgcfg.FromContext(ctx).VCCredsSecretName
+	usesSecret := func(vc topology.VCenter) bool {
+		if vc.CredsSecretName == "" {
+			return secretName == defaultSecretName
+		}
+		return secretName == vc.CredsSecretName
+	}
+
+	vs.vcClientLock.Lock()
+	inUse := false
+	for vc := range vs.vcClients {
+		if usesSecret(vc) {
+			inUse = true
+			break
+		}
+	}
+	vs.vcClientLock.Unlock()
+
+	if !inUse {
+		return nil
+	}
+
+	newVcCreds, err := vccreds.ExtractVCCredentials(data)
+	if err != nil {
+		return err
+	}
+
+	oldVcClients := func() []*vcclient.Client {
+		vs.vcClientLock.Lock()
+		defer vs.vcClientLock.Unlock()
+
+		var oldVcClients []*vcclient.Client
+		for vc, vcClient := range vs.vcClients {
+			if usesSecret(vc) && vcClient.Config().VcCreds != newVcCreds {
+				// Clear and logout the existing client so a new client is
+				// created by the next call to getVcClientForVCenter().
+				delete(vs.vcClients, vc)
+				oldVcClients = append(oldVcClients, vcClient)
+			}
+		}
+		return oldVcClients
+	}()
+
+	for _, c := range oldVcClients {
+		c.Logout(ctx)
+	}
+
+	return nil
+}
+
+// getVcClientForResource returns the client for the vCenter with the resource
+// identified by key, ex. a content library item. When the availability zones
+// are backed by more than one vCenter, the vCenters are searched with existsFn,
+// starting with the default vCenter, and the vCenter where the resource was
+// found is cached. The default client is returned when the resource is not
+// found so callers report the same errors as with a single vCenter.
+func (vs *vSphereVMProvider) getVcClientForResource(
+	ctx context.Context,
+	key string,
+	existsFn func(context.Context, *vcclient.Client) (bool, error)) (*vcclient.Client, error) {
+
+	zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, vs.k8sClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if !topology.HasMultipleVCenters(zonesByVCenter) {
+		return vs.getVcClient(ctx)
+	}
+
+	vs.vcClientLock.Lock()
+	vc, ok := vs.resourceVCenters[key]
+	vs.vcClientLock.Unlock()
+
+	if ok {
+		if _, exists := zonesByVCenter[vc]; exists {
+			return vs.getVcClientForVCenterOrDefault(ctx, vc)
+		}
+	}
+
+	vCenters := []topology.VCenter{{}}
+	for vc := range zonesByVCenter {
+		if vc != (topology.VCenter{}) {
+			vCenters = append(vCenters, vc)
+		}
+	}
+	slices.SortFunc(vCenters[1:], func(a, b topology.VCenter) int {
+		return cmp.Or(
+			cmp.Compare(a.PNID, b.PNID),
+			cmp.Compare(a.Port, b.Port),
+			cmp.Compare(a.Datacenter, b.Datacenter),
+			cmp.Compare(a.CredsSecretName, b.CredsSecretName))
+	})
+
+	var errs []error
+	for _, vc := range vCenters {
+		vcClient, err := vs.getVcClientForVCenterOrDefault(ctx, vc)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		exists, err := existsFn(ctx, vcClient)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if exists {
+			vs.vcClientLock.Lock()
+			if vs.resourceVCenters == nil {
+				vs.resourceVCenters = map[string]topology.VCenter{}
+			}
+			vs.resourceVCenters[key] = vc
+			vs.vcClientLock.Unlock()
+
+			return vcClient, nil
+		}
+	}
+
+	// The resource may be on a vCenter that could not be searched.
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return vs.getVcClient(ctx)
+}
+
+// getVcClientForLibraryItem returns the client for the vCenter with the
+// content library item.
+func (vs *vSphereVMProvider) getVcClientForLibraryItem(
+	ctx context.Context,
+	itemID string) (*vcclient.Client, error) {
+
+	if strings.HasPrefix(itemID, "vm-") {
+		// The ID of an item in an inventory content library is the moref of
+		// its VM.
+		return vs.getVcClientForManagedObject(
+			ctx,
+			vimtypes.ManagedObjectReference{
+				Type:  string(vimtypes.ManagedObjectTypeVirtualMachine),
+				Value: itemID,
+			})
+	}
+
+	return vs.getVcClientForResource(
+		ctx,
+		"LibraryItem:"+itemID,
+		func(ctx context.Context, c *vcclient.Client) (bool, error) {
+			if _, err := library.NewManager(c.RestClient()).GetLibraryItem(ctx, itemID); err != nil {
+				if pkgutil.IsNotFoundError(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		})
+}
+
+// getVcClientForManagedObject returns the client for the vCenter with the
+// managed object.
+func (vs *vSphereVMProvider) getVcClientForManagedObject(
+	ctx context.Context,
+	ref vimtypes.ManagedObjectReference) (*vcclient.Client, error) {
+
+	return vs.getVcClientForResource(
+		ctx,
+		ref.String(),
+		func(ctx context.Context, c *vcclient.Client) (bool, error) {
+			var obj mo.ManagedEntity
+			err := property.DefaultCollector(c.VimClient()).RetrieveOne(
+				ctx, ref, []string{"name"}, &obj)
+			if err != nil {
+				if fault.Is(err, &vimtypes.ManagedObjectNotFound{}) {
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		})
+}
+
+// getVcClientForLibrary returns the client for the vCenter with the content
+// library.
+func (vs *vSphereVMProvider) getVcClientForLibrary(
+	ctx context.Context,
+	libraryID string) (*vcclient.Client, error) {
+
+	return vs.getVcClientForResource(
+		ctx,
+		"Library:"+libraryID,
+		func(ctx context.Context, c *vcclient.Client) (bool, error) {
+			if _, err := library.NewManager(c.RestClient()).GetLibraryByID(ctx, libraryID); err != nil {
+				if pkgutil.IsNotFoundError(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		})
+}
+
+// getVcClientForProfile returns the client for the vCenter with the storage
+// profile.
+func (vs *vSphereVMProvider) getVcClientForProfile(
+	ctx context.Context,
+	profileID string) (*vcclient.Client, error) {
+
+	return vs.getVcClientForResource(
+		ctx,
+		"StorageProfile:"+profileID,
+		func(ctx context.Context, c *vcclient.Client) (bool, error) {
+			profiles, err := c.PbmClient().RetrieveContent(
+				ctx,
+				[]pbmtypes.PbmProfileId{{UniqueId: profileID}})
+			if err != nil {
+				if fault.Is(err, &vimtypes.InvalidArgument{}) {
+					return false, nil
+				}
+				return false, err
+			}
+			return len(profiles) > 0, nil
+		})
+}
+
+// getZonesForDefaultVCenter returns the names of the availability zones backed
+// by the vCenter from the provider ConfigMap. A nil set is returned when all of
+// the zones are backed by that vCenter.
+//
+// VMs that have not been assigned a zone are placed on the default vCenter, so
+// this set constrains the candidate zones for their placement.
+func (vs *vSphereVMProvider) getZonesForDefaultVCenter(
+	ctx context.Context) (sets.Set[string], error) {
+
+	zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, vs.k8sClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if !topology.HasMultipleVCenters(zonesByVCenter) {
+		return nil, nil
+	}
+
+	return sets.New(zonesByVCenter[topology.VCenter{}]...), nil
+}
+
+func (vs *vSphereVMProvider) VSphereClientForZone(
+	ctx context.Context,
+	zoneName string) (*vsclient.Client, error) {
+
+	c, err := vs.getVcClientForZone(ctx, zoneName)
+	if err != nil {
+		return nil, err
+	}
+	return c.Client, nil
+}
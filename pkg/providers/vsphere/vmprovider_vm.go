@@ -48,6 +48,7 @@ import (
 	ctxop "github.com/vmware-tanzu/vm-operator/pkg/context/operation"
 	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	vcclient "github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/client"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/clustermodules"
@@ -191,7 +192,7 @@ func (vs *vSphereVMProvider) createOrUpdateVirtualMachine(
 		VM:     vm,
 	}
 
-	client, err := vs.getVcClient(vmCtx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -328,7 +329,7 @@ func (vs *vSphereVMProvider) DeleteVirtualMachine(
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(vmCtx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return err
 	}
@@ -341,7 +342,15 @@ func (vs *vSphereVMProvider) DeleteVirtualMachine(
 		return nil
 	}
 
-	return virtualmachine.DeleteVirtualMachine(vmCtx, vcVM)
+	start := time.Now()
+	err = virtualmachine.DeleteVirtualMachine(vmCtx, vcVM)
+	metrics.NewVMOperationMetrics().ObserveOperation(
+		metrics.VMOperationDelete,
+		metrics.DeployModeNone,
+		start,
+		err)
+
+	return err
 }
 
 func (vs *vSphereVMProvider) PublishVirtualMachine(
@@ -361,7 +370,7 @@ func (vs *vSphereVMProvider) PublishVirtualMachine(
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(ctx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get vCenter client: %w", err)
 	}
@@ -418,7 +427,7 @@ func (vs *vSphereVMProvider) GetVirtualMachineGuestHeartbeat(
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(vmCtx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return "", err
 	}
@@ -450,7 +459,7 @@ func (vs *vSphereVMProvider) GetVirtualMachineProperties(
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(vmCtx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -513,7 +522,7 @@ func (vs *vSphereVMProvider) GetVirtualMachineWebMKSTicket(
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(vmCtx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return "", err
 	}
@@ -544,7 +553,7 @@ func (vs *vSphereVMProvider) GetVirtualMachineHardwareVersion(
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(vmCtx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return 0, err
 	}
@@ -839,10 +848,18 @@ func errOrReconcileErr(reconcileErr, err error) error {
 func (vs *vSphereVMProvider) updateVirtualMachine(
 	vmCtx pkgctx.VirtualMachineContext,
 	vcVM *object.VirtualMachine,
-	vcClient *vcclient.Client) error {
+	vcClient *vcclient.Client) (retErr error) {
 
 	vmCtx.Logger.V(4).Info("Updating VirtualMachine")
 
+	defer func(start time.Time) {
+		metrics.NewVMOperationMetrics().ObserveOperation(
+			metrics.VMOperationUpdate,
+			metrics.DeployModeNone,
+			start,
+			retErr)
+	}(time.Now())
+
 	var reconcileErr error
 
 	//
@@ -1274,7 +1291,7 @@ func (vs *vSphereVMProvider) reconcilePowerState(
 			// Check to see if a possible restart is required.
 			// Please note a VM may only be restarted if it is powered on.
 			if vmCtx.VM.Spec.NextRestartTime == "" {
-				return nil
+				return vs.reconcilePendingResizePowerCycle(vmCtx, vcVM)
 			}
 
 			// If non-empty, the value of spec.nextRestartTime is guaranteed
@@ -1289,6 +1306,7 @@ func (vs *vSphereVMProvider) reconcilePowerState(
 					vmCtx.VM.Spec.NextRestartTime, time.RFC3339Nano, err)
 			}
 
+			start := time.Now()
 			result, err := vmutil.RestartAndWait(
 				logr.NewContext(vmCtx, vmCtx.Logger),
 				vcVM.Client(),
@@ -1296,6 +1314,13 @@ func (vs *vSphereVMProvider) reconcilePowerState(
 				false,
 				nextRestartTime,
 				vmutil.ParsePowerOpMode(string(vmCtx.VM.Spec.RestartMode)))
+			if err != nil || result.AnyChange() {
+				metrics.NewVMOperationMetrics().ObserveOperation(
+					metrics.VMOperationRestart,
+					metrics.DeployModeNone,
+					start,
+					err)
+			}
 			if err != nil {
 				return err
 			}
@@ -1306,7 +1331,7 @@ func (vs *vSphereVMProvider) reconcilePowerState(
 				return ErrRestart
 			}
 
-			return nil
+			return vs.reconcilePendingResizePowerCycle(vmCtx, vcVM)
 		}
 
 		powerOpMode = hard
@@ -1339,11 +1364,17 @@ func (vs *vSphereVMProvider) reconcilePowerState(
 	}
 
 	if setPowerState {
+		start := time.Now()
 		err := res.NewVMFromObject(vcVM).SetPowerState(
 			vmCtx,
 			currentPowerState,
 			vmCtx.VM.Spec.PowerState,
 			powerOpMode)
+		metrics.NewVMOperationMetrics().ObserveOperation(
+			powerStateOperation(desiredPowerState),
+			metrics.DeployModeNone,
+			start,
+			err)
 
 		if errors.Is(err, ErrSetPowerState) &&
 			desiredPowerState == vmopv1.VirtualMachinePowerStateOn {
@@ -1360,6 +1391,81 @@ func (vs *vSphereVMProvider) reconcilePowerState(
 	return nil
 }
 
+// reconcilePendingResizePowerCycle powers off a VM that has a pending resize
+// once the VM's maintenance window is open. The subsequent reconcile treats the
+// VM as going from powered off to on, resizing the VM before powering it back
+// on.
+func (vs *vSphereVMProvider) reconcilePendingResizePowerCycle(
+	vmCtx pkgctx.VirtualMachineContext,
+	vcVM *object.VirtualMachine) error {
+
+	pendingResize := vmCtx.VM.Status.PendingResize
+	if pendingResize == nil || pendingResize.ScheduledTime == nil {
+		return nil
+	}
+
+	// The scheduled time may be from an earlier reconcile, so check that the
+	// maintenance window is still open before powering off the VM.
+	window, err := vmopv1util.GetMaintenanceWindow(vmCtx, vs.k8sClient, *vmCtx.VM)
+	if err != nil {
+		return err
+	}
+	if window == nil {
+		return nil
+	}
+
+	open, windowStart := window.Open(time.Now())
+	if windowStart.IsZero() {
+		return nil
+	}
+	if !open {
+		// The maintenance window is not open. Requeue the request with a
+		// delay of the time until the window next opens.
+		scheduledTime := metav1.NewTime(windowStart)
+		pendingResize.ScheduledTime = &scheduledTime
+
+		vmCtx.Logger.V(4).Info(
+			"Skipping resize power cycle as the maintenance window is not open",
+			"className", pendingResize.ClassName,
+			"scheduledTime", pendingResize.ScheduledTime)
+		return pkgerr.RequeueError{
+			After: time.Until(windowStart),
+		}
+	}
+
+	vmCtx.Logger.Info(
+		"Powering off VM to apply pending resize",
+		"className", pendingResize.ClassName,
+		"scheduledTime", pendingResize.ScheduledTime)
+
+	start := time.Now()
+	err = res.NewVMFromObject(vcVM).SetPowerState(
+		vmCtx,
+		vmopv1.VirtualMachinePowerStateOn,
+		vmopv1.VirtualMachinePowerStateOff,
+		vmCtx.VM.Spec.PowerOffMode)
+	metrics.NewVMOperationMetrics().ObserveOperation(
+		metrics.VMOperationPowerOff,
+		metrics.DeployModeNone,
+		start,
+		err)
+
+	return err
+}
+
+// powerStateOperation returns the name of the operation used to record the
+// metrics for changing a VM's power state to the desired power state.
+func powerStateOperation(desired vmopv1.VirtualMachinePowerState) string {
+	switch desired {
+	case vmopv1.VirtualMachinePowerStateOff:
+		return metrics.VMOperationPowerOff
+	case vmopv1.VirtualMachinePowerStateSuspended:
+		return metrics.VMOperationSuspend
+	default:
+		return metrics.VMOperationPowerOn
+	}
+}
+
 func verifyConfigInfo(vmCtx pkgctx.VirtualMachineContext) error {
 	if vmCtx.MoVM.Config == nil {
 		return pkgerr.NoRequeueError{
@@ -1487,6 +1593,26 @@ func (vs *vSphereVMProvider) vmCreateDoPlacement(
 		Zones:       pvcZones,
 	}
 
+	if vmCtx.VM.Labels[corev1.LabelTopologyZone] == "" {
+		// A VM without a zone is placed using the default vCenter's client,
+		// so only the zones backed by that vCenter are candidates.
+		vcZones, err := vs.getZonesForDefaultVCenter(vmCtx)
+		if err != nil {
+			return err
+		}
+		if vcZones != nil {
+			if constraints.Zones.Len() > 0 {
+				constraints.Zones = constraints.Zones.Intersection(vcZones)
+			} else {
+				constraints.Zones = vcZones
+			}
+			if constraints.Zones.Len() == 0 {
+				return errors.New(
+					"no placement candidates available on the default vCenter")
+			}
+		}
+	}
+
 	result, err := placement.Placement(
 		vmCtx,
 		vs.k8sClient,
@@ -1743,7 +1869,7 @@ func (vs *vSphereVMProvider) vmCreateGetSourceFilePaths(
 	}
 
 	var (
-		datacenterID = vs.vcClient.Datacenter().Reference().Value
+		datacenterID = vcClient.Datacenter().Reference().Value
 		datastoreID  = createArgs.Datastores[0].MoRef.Value
 		itemID       = createArgs.ImageStatus.ProviderItemID
 		itemVersion  = createArgs.ImageStatus.ProviderContentVersion
@@ -1896,11 +2022,15 @@ func (vs *vSphereVMProvider) vmCreateConcurrentAllowed(vmCtx pkgctx.VirtualMachi
 	}
 
 	concurrentCreateCount++
+	metrics.NewVMOperationMetrics().SetConcurrentCreates(
+		concurrentCreateCount, maxDeployThreads)
 	createCountLock.Unlock()
 
 	decrementFn := func() {
 		createCountLock.Lock()
 		concurrentCreateCount--
+		metrics.NewVMOperationMetrics().SetConcurrentCreates(
+			concurrentCreateCount, maxDeployThreads)
 		createCountLock.Unlock()
 	}
 
@@ -1921,7 +2051,7 @@ func (vs *vSphereVMProvider) vmCreateGetArgs(
 		return nil, err
 	}
 
-	err = vs.vmCreateGenConfigSpec(vmCtx, createArgs)
+	err = vs.vmCreateGenConfigSpec(vmCtx, vcClient, createArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -2164,7 +2294,15 @@ func (vs *vSphereVMProvider) vmCreateDoNetworking(
 		nil, // Don't know the CCR yet (needed to resolve backings for NSX-T)
 		networkSpec)
 	if err != nil {
-		pkgcnd.MarkError(vmCtx.VM, vmopv1.VirtualMachineConditionNetworkReady, "NotReady", err)
+		if pkgerr.IsNetworkNotReadyError(err) {
+			pkgcnd.MarkFalse(
+				vmCtx.VM,
+				vmopv1.VirtualMachineConditionNetworkReady,
+				vmopv1.VirtualMachineWaitingForNetworkReason,
+				"%s", err.Error())
+		} else {
+			pkgcnd.MarkError(vmCtx.VM, vmopv1.VirtualMachineConditionNetworkReady, "NotReady", err)
+		}
 		return err
 	}
 
@@ -2176,6 +2314,7 @@ func (vs *vSphereVMProvider) vmCreateDoNetworking(
 
 func (vs *vSphereVMProvider) vmCreateGenConfigSpec(
 	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vcclient.Client,
 	createArgs *VMCreateArgs) error {
 
 	// TODO: This is a partial dupe of what's done in the update path in the remaining Session code. I got
@@ -2231,7 +2370,7 @@ func (vs *vSphereVMProvider) vmCreateGenConfigSpec(
 			if err := vmconfcrypto.Reconcile(
 				vmCtx,
 				vs.k8sClient,
-				vs.vcClient.VimClient(),
+				vcClient.VimClient(),
 				vmCtx.VM,
 				vmCtx.MoVM,
 				&createArgs.ConfigSpec); err != nil {
@@ -2244,7 +2383,7 @@ func (vs *vSphereVMProvider) vmCreateGenConfigSpec(
 	if err := vmconfbootoptions.Reconcile(
 		vmCtx,
 		vs.k8sClient,
-		vs.vcClient.VimClient(),
+		vcClient.VimClient(),
 		vmCtx.VM,
 		vmCtx.MoVM,
 		&createArgs.ConfigSpec); err != nil {
@@ -2608,7 +2747,7 @@ func (vs *vSphereVMProvider) getConfigSpecFromVM(
 	vmiCache vmopv1.VirtualMachineImageCache,
 	vmClass vmopv1.VirtualMachineClass) (vimtypes.VirtualMachineConfigSpec, error) {
 
-	vcClient, err := vs.getVcClient(vmCtx)
+	vcClient, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return vimtypes.VirtualMachineConfigSpec{},
 			fmt.Errorf("failed to get vc client: %w", err)
@@ -2746,9 +2885,24 @@ func (vs *vSphereVMProvider) vmCreateGenConfigSpecZipNetworkInterfaces(
 		ethCard := device.(vimtypes.BaseVirtualEthernetCard).GetVirtualEthernetCard()
 
 		if resultsIdx < len(createArgs.NetworkResults.Results) {
-			err := network.ApplyInterfaceResultToVirtualEthCard(vmCtx, ethCard, &createArgs.NetworkResults.Results[resultsIdx])
-			if err != nil {
-				return err
+			result := &createArgs.NetworkResults.Results[resultsIdx]
+
+			switch result.AdapterType {
+			case vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV,
+				vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath:
+				// The interface's explicit adapter type takes precedence over
+				// the device type from the class ConfigSpec.
+				ethCardDev, err := network.CreateDefaultEthCard(vmCtx, result)
+				if err != nil {
+					return err
+				}
+				ethCardDev.GetVirtualDevice().Key = spec.Device.GetVirtualDevice().Key
+				spec.Device = ethCardDev
+			default:
+				err := network.ApplyInterfaceResultToVirtualEthCard(vmCtx, ethCard, result)
+				if err != nil {
+					return err
+				}
 			}
 			resultsIdx++
 
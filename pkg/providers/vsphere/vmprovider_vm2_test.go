@@ -9,11 +9,12 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
-	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +31,7 @@ import (
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
+	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/network"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
@@ -307,9 +309,6 @@ func vmE2ETests() {
 	)
 
 	BeforeEach(func() {
-		// Speed up tests until we Watch the network interface types. Sigh.
-		network.RetryTimeout = 1 * time.Millisecond
-
 		testConfig = builder.VCSimTestConfig{
 			NumNetworks:        3,
 			WithContentLibrary: true,
@@ -567,6 +566,51 @@ func vmE2ETests() {
 							np.assertNetworkInterfacesDNE(ctx, vm, networkName1, interfaceName1)
 						})
 					})
+
+					By("hot-add network interface to powered on VM", func() {
+						vm.Spec.Network.Interfaces = append(vm.Spec.Network.Interfaces, vm.Spec.Network.Interfaces[0])
+						vm.Spec.Network.Interfaces[1].Name = interfaceName1
+						vm.Spec.Network.Interfaces[1].Network = ptr.To(*vm.Spec.Network.Interfaces[1].Network)
+						vm.Spec.Network.Interfaces[1].Network.Name = networkName1
+
+						err = createOrUpdateVM(ctx, vmProvider, vm)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring("network interface is not ready yet"))
+
+						np.simulateInterfaceReconcile(ctx, vm, vm.Spec.Network.Interfaces[1], 1)
+						Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+						Expect(vcVM.PowerState(ctx)).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+					})
+
+					By("hot-added interface has expected NIC backing and guest network config", func() {
+						devList, err := vcVM.Device(ctx)
+						Expect(err).ToNot(HaveOccurred())
+						l := devList.SelectByType(&vimtypes.VirtualEthernetCard{})
+						Expect(l).To(HaveLen(2))
+						np.assertEthernetCard(ctx, l[1], vm.Spec.Network.Interfaces[1], 1)
+
+						Expect(conditions.GetReason(vm, vmopv1.GuestNetworkConfigSyncedCondition)).
+							To(Equal(vmopv1.GuestNetworkConfigPendingReason))
+
+						var o mo.VirtualMachine
+						Expect(vcVM.Properties(ctx, vcVM.Reference(), []string{"config.extraConfig"}, &o)).To(Succeed())
+						ec := object.OptionValueList(o.Config.ExtraConfig)
+						v, ok := ec.GetString(constants.GuestInfoNetworkConfig)
+						Expect(ok).To(BeTrue())
+						Expect(v).ToNot(BeEmpty())
+					})
+
+					By("hot-remove network interface from powered on VM", func() {
+						vm.Spec.Network.Interfaces = vm.Spec.Network.Interfaces[:1]
+						Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+						Expect(vcVM.PowerState(ctx)).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+
+						devList, err := vcVM.Device(ctx)
+						Expect(err).ToNot(HaveOccurred())
+						l := devList.SelectByType(&vimtypes.VirtualEthernetCard{})
+						Expect(l).To(HaveLen(1))
+						np.assertNetworkInterfacesDNE(ctx, vm, networkName1, interfaceName1)
+					})
 				})
 			},
 			Entry("VDS with CloudInit", builder.NetworkEnvVDS, bsCloudInit),
@@ -6,6 +6,7 @@ package vsphere
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	vimtypes "github.com/vmware/govmomi/vim25/types"
@@ -92,7 +93,7 @@ func (vs *vSphereVMProvider) vmGroupGetVMPlacementArgs(
 				return nil, fmt.Errorf("all VMs being placed as group must belong to same child ResourcePool")
 			}
 
-			configSpec, err := vs.vmGroupGetVMPlacementConfigSpec(vmCtx, createArgs)
+			configSpec, err := vs.vmGroupGetVMPlacementConfigSpec(vmCtx, vcClient, createArgs)
 			if err != nil {
 				return nil, err
 			}
@@ -141,9 +142,10 @@ func (vs *vSphereVMProvider) vmGroupGetVMCreatePrereqs(
 
 func (vs *vSphereVMProvider) vmGroupGetVMPlacementConfigSpec(
 	vmCtx pkgctx.VirtualMachineContext,
+	vcClient *vcclient.Client,
 	createArgs *VMCreateArgs) (*vimtypes.VirtualMachineConfigSpec, error) {
 
-	if err := vs.vmCreateGenConfigSpec(vmCtx, createArgs); err != nil {
+	if err := vs.vmCreateGenConfigSpec(vmCtx, vcClient, createArgs); err != nil {
 		return nil, err
 	}
 
@@ -168,13 +170,27 @@ func (vs *vSphereVMProvider) vmGroupDoPlacement(
 	namespace string,
 	placementArgs *vmGroupPlacementArgs) (map[string]placement.Result, error) {
 
+	// The group is placed using the default vCenter's client, so only the
+	// zones backed by that vCenter are candidates.
+	vcZones, err := vs.getZonesForDefaultVCenter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if vcZones != nil && vcZones.Len() == 0 {
+		return nil, errors.New(
+			"no placement candidates available on the default vCenter")
+	}
+
 	return placement.GroupPlacement(
 		ctx,
 		vs.k8sClient,
 		vcClient.VimClient(),
 		vcClient.Finder(),
 		namespace,
-		placementArgs.childResourcePoolName,
+		placement.Constraints{
+			ChildRPName: placementArgs.childResourcePoolName,
+			Zones:       vcZones,
+		},
 		placementArgs.configSpecs,
 	)
 }
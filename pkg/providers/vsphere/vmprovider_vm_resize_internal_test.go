@@ -0,0 +1,78 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vsphere
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
+)
+
+var _ = Describe("reconcilePendingResizePowerCycle", func() {
+
+	var (
+		vs    *vSphereVMProvider
+		vmCtx pkgctx.VirtualMachineContext
+	)
+
+	BeforeEach(func() {
+		vm := &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-namespace",
+				Name:      "my-vm",
+			},
+			Spec: vmopv1.VirtualMachineSpec{
+				PowerState: vmopv1.VirtualMachinePowerStateOn,
+			},
+		}
+
+		vs = &vSphereVMProvider{
+			k8sClient: fake.NewClientBuilder().Build(),
+		}
+		vmCtx = pkgctx.VirtualMachineContext{
+			Context: context.Background(),
+			Logger:  logr.Discard(),
+			VM:      vm,
+		}
+	})
+
+	When("the scheduled time has passed but the maintenance window is closed", func() {
+		BeforeEach(func() {
+			vmCtx.VM.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+				Schedule: fmt.Sprintf("0 %d * * *", (time.Now().UTC().Hour()+2)%24),
+				Duration: metav1.Duration{Duration: time.Hour},
+			}
+			scheduledTime := metav1.NewTime(time.Now().Add(-22 * time.Hour))
+			vmCtx.VM.Status.PendingResize = &vmopv1.VirtualMachinePendingResizeStatus{
+				ClassName:     "my-new-class",
+				ScheduledTime: &scheduledTime,
+			}
+		})
+
+		It("should requeue until the window next opens without powering off the VM", func() {
+			// The VM is nil, so an attempt to power it off would panic.
+			err := vs.reconcilePendingResizePowerCycle(vmCtx, nil)
+
+			var requeueErr pkgerr.RequeueError
+			Expect(errors.As(err, &requeueErr)).To(BeTrue())
+			Expect(requeueErr.After).To(BeNumerically(">", time.Hour))
+			Expect(requeueErr.After).To(BeNumerically("<=", 2*time.Hour))
+
+			Expect(vmCtx.VM.Status.PendingResize.ScheduledTime.Time).To(BeTemporally(">", time.Now()))
+		})
+	})
+})
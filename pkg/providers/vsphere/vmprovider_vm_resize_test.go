@@ -7,6 +7,7 @@ package vsphere_test
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -20,6 +21,7 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	pkgerr "github.com/vmware-tanzu/vm-operator/pkg/errors"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
@@ -534,6 +536,71 @@ func vmResizeTests() {
 					Expect(c.Reason).To(Equal("ClassNameChanged"))
 				})
 
+				It("Resizes in maintenance window", func() {
+					vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+					vm.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+						Schedule: "* * * * *",
+						Duration: metav1.Duration{Duration: time.Hour},
+					}
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+
+					newCS := configSpec
+					newCS.NumCPUs = 42
+					newCS.MemoryMB = 8192
+					newVMClass := createVMClass(newCS)
+					vm.Spec.ClassName = newVMClass.Name
+
+					vcVM, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+					Expect(err).ToNot(HaveOccurred())
+
+					By("Power cycles and resizes", func() {
+						var o mo.VirtualMachine
+						Expect(vcVM.Properties(ctx, vcVM.Reference(), nil, &o)).To(Succeed())
+						Expect(o.Summary.Runtime.PowerState).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+						Expect(o.Config.Hardware.NumCPU).To(BeEquivalentTo(newCS.NumCPUs))
+						Expect(o.Config.Hardware.MemoryMB).To(BeEquivalentTo(newCS.MemoryMB))
+					})
+
+					assertExpectedResizedClassFields(vm, newVMClass)
+					Expect(vm.Status.PendingResize).To(BeNil())
+				})
+
+				It("Defers resize until maintenance window", func() {
+					vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
+					vm.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+						Schedule: fmt.Sprintf("0 %d * * *", (time.Now().UTC().Hour()+2)%24),
+						Duration: metav1.Duration{Duration: time.Hour},
+					}
+					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
+					Expect(vm.Status.PowerState).To(Equal(vmopv1.VirtualMachinePowerStateOn))
+
+					newCS := configSpec
+					newCS.NumCPUs = 42
+					newCS.MemoryMB = 8192
+					newVMClass := createVMClass(newCS)
+					vm.Spec.ClassName = newVMClass.Name
+
+					err := createOrUpdateVM(ctx, vmProvider, vm)
+					Expect(pkgerr.IsRequeueError(err)).To(BeTrue())
+
+					Expect(vm.Status.PendingResize).ToNot(BeNil())
+					Expect(vm.Status.PendingResize.ClassName).To(Equal(newVMClass.Name))
+					Expect(vm.Status.PendingResize.ScheduledTime).ToNot(BeNil())
+					Expect(vm.Status.PendingResize.ScheduledTime.Time).To(BeTemporally(">", time.Now()))
+
+					By("Does not resize", func() {
+						vcVM := ctx.GetVMFromMoID(vm.Status.UniqueID)
+						Expect(vcVM).ToNot(BeNil())
+
+						var o mo.VirtualMachine
+						Expect(vcVM.Properties(ctx, vcVM.Reference(), nil, &o)).To(Succeed())
+						Expect(o.Summary.Runtime.PowerState).To(Equal(vimtypes.VirtualMachinePowerStatePoweredOn))
+						Expect(o.Config.Hardware.NumCPU).To(BeEquivalentTo(configSpec.NumCPUs))
+						Expect(o.Config.Hardware.MemoryMB).To(BeEquivalentTo(configSpec.MemoryMB))
+					})
+				})
+
 				It("Has Same Class Resize Annotation", func() {
 					vm.Spec.PowerState = vmopv1.VirtualMachinePowerStateOn
 					Expect(createOrUpdateVM(ctx, vmProvider, vm)).To(Succeed())
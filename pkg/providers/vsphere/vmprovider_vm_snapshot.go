@@ -55,7 +55,7 @@ func (vs *vSphereVMProvider) DeleteSnapshot(
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(ctx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return false, err
 	}
@@ -99,7 +99,7 @@ func (vs *vSphereVMProvider) GetSnapshotSize(
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(ctx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return 0, err
 	}
@@ -137,7 +137,7 @@ func (vs *vSphereVMProvider) SyncVMSnapshotTreeStatus(ctx context.Context, vm *v
 		VM:      vm,
 	}
 
-	client, err := vs.getVcClient(ctx)
+	client, err := vs.getVcClientForVM(vmCtx)
 	if err != nil {
 		return err
 	}
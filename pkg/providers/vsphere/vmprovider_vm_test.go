@@ -40,6 +40,7 @@ import (
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/cloudinit"
 	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	vspherepolv1 "github.com/vmware-tanzu/vm-operator/external/vsphere-policy/api/v1alpha1"
 	backupapi "github.com/vmware-tanzu/vm-operator/pkg/backup/api"
 	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
@@ -52,6 +53,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/virtualmachine"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
@@ -2802,6 +2804,32 @@ func vmTests() {
 				})
 			})
 
+			When("zones are backed by different vCenters", func() {
+				JustBeforeEach(func() {
+					delete(vm.Labels, corev1.LabelTopologyZone)
+
+					firstZoneName := ctx.GetFirstZoneName()
+					for _, zoneName := range ctx.ZoneNames {
+						if zoneName == firstZoneName {
+							continue
+						}
+						az := &topologyv1.AvailabilityZone{}
+						Expect(ctx.Client.Get(ctx, client.ObjectKey{Name: zoneName}, az)).To(Succeed())
+						az.Annotations = map[string]string{
+							topology.VCenterPNIDAnnotationKey:       "vc-2.example.com",
+							topology.VCenterDatacenterAnnotationKey: "datacenter-2",
+						}
+						Expect(ctx.Client.Update(ctx, az)).To(Succeed())
+					}
+				})
+
+				It("creates VM without a zone in a zone backed by the default vCenter", func() {
+					_, err := createOrUpdateAndGetVcVM(ctx, vmProvider, vm)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vm.Labels).To(HaveKeyWithValue(corev1.LabelTopologyZone, ctx.GetFirstZoneName()))
+				})
+			})
+
 			It("creates VM in assigned zone", func() {
 				Expect(len(ctx.ZoneNames)).To(BeNumerically(">", 1))
 				azName := ctx.ZoneNames[rand.Intn(len(ctx.ZoneNames))]
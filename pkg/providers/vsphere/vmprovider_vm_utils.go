@@ -378,6 +378,7 @@ func GetVirtualMachineBootstrap(
 	var data, vAppData map[string]string
 	var vAppExData map[string]map[string]string
 	var cloudConfigSecretData *cloudinit.CloudConfigSecretData
+	var userDataParts []cloudinit.UserDataPart
 	var sysprepSecretData *sysprep.SecretData
 	var linuxPrepSecretData *linuxprep.SecretData
 
@@ -402,6 +403,18 @@ func GetVirtualMachineBootstrap(
 				conditions.MarkFalse(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady, reason, "%s", msg)
 				return vmlifecycle.BootstrapData{}, err
 			}
+		} else if parts := v.UserDataParts; len(parts) > 0 {
+			out, err := cloudinit.GetUserDataParts(
+				vmCtx,
+				k8sClient,
+				vmCtx.VM.Namespace,
+				parts)
+			if err != nil {
+				reason, msg := errToConditionReasonAndMessage(err)
+				conditions.MarkFalse(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady, reason, "%s", msg)
+				return vmlifecycle.BootstrapData{}, err
+			}
+			userDataParts = out
 		}
 	} else if v := bootstrapSpec.Sysprep; v != nil {
 		if cooked := v.Sysprep; cooked != nil {
@@ -425,6 +438,20 @@ func GetVirtualMachineBootstrap(
 				return vmlifecycle.BootstrapData{}, err
 			}
 		}
+	} else if v := bootstrapSpec.Ignition; v != nil {
+		if raw := v.RawConfig; raw != nil {
+			key := raw.Key
+			if key == "" {
+				key = vmlifecycle.IgnitionConfigSecretKey
+			}
+			var err error
+			data, err = getSecretData(vmCtx, k8sClient, raw.Name, key, false)
+			if err != nil {
+				reason, msg := errToConditionReasonAndMessage(err)
+				conditions.MarkFalse(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady, reason, "%s", msg)
+				return vmlifecycle.BootstrapData{}, err
+			}
+		}
 	} else if v := bootstrapSpec.LinuxPrep; v != nil {
 		out, err := linuxprep.GetLinuxPrepSecretData(
 			vmCtx,
@@ -486,12 +513,13 @@ func GetVirtualMachineBootstrap(
 	conditions.MarkTrue(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady)
 
 	return vmlifecycle.BootstrapData{
-		Data:        data,
-		VAppData:    vAppData,
-		VAppExData:  vAppExData,
-		CloudConfig: cloudConfigSecretData,
-		Sysprep:     sysprepSecretData,
-		LinuxPrep:   linuxPrepSecretData,
+		Data:          data,
+		VAppData:      vAppData,
+		VAppExData:    vAppExData,
+		CloudConfig:   cloudConfigSecretData,
+		UserDataParts: userDataParts,
+		Sysprep:       sysprepSecretData,
+		LinuxPrep:     linuxPrepSecretData,
 	}, nil
 }
 
@@ -679,6 +707,21 @@ func GetAdditionalResourcesForBackup(
 					return nil, err
 				}
 				objects = append(objects, obj)
+			} else if parts := v.UserDataParts; len(parts) > 0 {
+				out, err := cloudinit.GetUserDataPartResources(vmCtx, k8sClient, vmCtx.VM.Namespace, parts)
+				if err != nil {
+					return nil, err
+				}
+				// GVK is dropped when getting a core K8s resource from client.
+				// Add it in backup so that the resource can be applied successfully during restore.
+				for i := range out {
+					if _, ok := out[i].(*corev1.ConfigMap); ok {
+						out[i].GetObjectKind().SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+					} else {
+						out[i].GetObjectKind().SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+					}
+				}
+				objects = append(objects, out...)
 			}
 		} else if v := bootstrapSpec.Sysprep; v != nil {
 			if cooked := v.Sysprep; cooked != nil {
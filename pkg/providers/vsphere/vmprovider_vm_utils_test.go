@@ -24,6 +24,7 @@ import (
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere"
 	"github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
@@ -617,6 +618,51 @@ func vmUtilTests() {
 			})
 		})
 
+		When("Bootstrap via CloudInit UserDataParts", func() {
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+					CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+						UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+							{
+								Name:  "base",
+								Value: ptr.To("#cloud-config\n"),
+							},
+							{
+								Name:        "app.sh",
+								ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript,
+								ConfigMap: &common.ConfigMapKeySelector{
+									Name: dataName,
+									Key:  "foo",
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("return an error when resources does not exist", func() {
+				_, err := vsphere.GetVirtualMachineBootstrap(vmCtx, k8sClient)
+				Expect(err).To(HaveOccurred())
+				Expect(conditions.IsFalse(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady)).To(BeTrue())
+			})
+
+			When("ConfigMap exists", func() {
+				BeforeEach(func() {
+					initObjects = append(initObjects, bootstrapCM)
+				})
+
+				It("returns success", func() {
+					bsData, err := vsphere.GetVirtualMachineBootstrap(vmCtx, k8sClient)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(bsData.UserDataParts).To(HaveLen(2))
+					Expect(bsData.UserDataParts[0].Data).To(Equal("#cloud-config\n"))
+					Expect(bsData.UserDataParts[1].ContentType).To(Equal("text/x-shellscript"))
+					Expect(bsData.UserDataParts[1].Data).To(Equal("bar"))
+					Expect(conditions.IsTrue(vmCtx.VM, vmopv1.VirtualMachineConditionBootstrapReady)).To(BeTrue())
+				})
+			})
+		})
+
 		When("Bootstrap via RawSysprep", func() {
 			BeforeEach(func() {
 				vmCtx.VM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
@@ -1250,6 +1296,55 @@ func vmUtilTests() {
 			})
 		})
 
+		When("VM spec has bootstrap in UserDataParts referencing ConfigMap and Secret objects", func() {
+
+			BeforeEach(func() {
+				vmCtx.VM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+					CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+						UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+							{
+								Name: "base",
+								ConfigMap: &common.ConfigMapKeySelector{
+									Name: "dummy-user-data-part-config-map",
+									Key:  "user-data",
+								},
+							},
+							{
+								Name: "app",
+								Secret: &common.SecretKeySelector{
+									Name: "dummy-user-data-part-secret",
+									Key:  "user-data",
+								},
+							},
+						},
+					},
+				}
+				initObjects = append(initObjects,
+					&corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: vmCtx.VM.Namespace,
+							Name:      "dummy-user-data-part-config-map",
+						},
+					},
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: vmCtx.VM.Namespace,
+							Name:      "dummy-user-data-part-secret",
+						},
+					})
+			})
+
+			It("Should return the ConfigMap and Secret objects as additional resources for backup", func() {
+				objects, err := vsphere.GetAdditionalResourcesForBackup(vmCtx, k8sClient)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(objects).To(HaveLen(2))
+				Expect(objects[0].GetName()).To(Equal("dummy-user-data-part-config-map"))
+				Expect(objects[0].GetObjectKind().GroupVersionKind()).To(Equal(corev1.SchemeGroupVersion.WithKind("ConfigMap")))
+				Expect(objects[1].GetName()).To(Equal("dummy-user-data-part-secret"))
+				Expect(objects[1].GetObjectKind().GroupVersionKind()).To(Equal(corev1.SchemeGroupVersion.WithKind("Secret")))
+			})
+		})
+
 		When("VM spec has bootstrap in Sysprep referencing a Secret object", func() {
 
 			BeforeEach(func() {
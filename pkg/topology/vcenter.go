@@ -0,0 +1,114 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"context"
+	"errors"
+
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
+)
+
+const (
+	// VCenterPNIDAnnotationKey is the annotation on an AvailabilityZone that
+	// specifies the PNID of the vCenter that backs the zone. When this
+	// annotation is absent, the zone is backed by the vCenter from the
+	// provider ConfigMap.
+	VCenterPNIDAnnotationKey = "vmoperator.vmware.com/vcenter-pnid"
+
+	// VCenterPortAnnotationKey is the annotation on an AvailabilityZone that
+	// specifies the port of the vCenter that backs the zone.
+	VCenterPortAnnotationKey = "vmoperator.vmware.com/vcenter-port"
+
+	// VCenterDatacenterAnnotationKey is the annotation on an AvailabilityZone
+	// that specifies the managed object ID of the Datacenter on the vCenter
+	// that backs the zone.
+	VCenterDatacenterAnnotationKey = "vmoperator.vmware.com/vcenter-datacenter"
+
+	// VCenterCredsSecretNameAnnotationKey is the annotation on an
+	// AvailabilityZone that specifies the name of the Secret, in the VM
+	// Operator namespace, with the credentials for the vCenter that backs the
+	// zone.
+	VCenterCredsSecretNameAnnotationKey = "vmoperator.vmware.com/vcenter-creds-secret-name"
+)
+
+// VCenter describes the vCenter that backs an availability zone. The zero
+// value refers to the vCenter from the provider ConfigMap.
+type VCenter struct {
+	PNID            string
+	Port            string
+	Datacenter      string
+	CredsSecretName string
+}
+
+// GetVCenter returns the vCenter that backs the provided availability zone.
+// False is returned if the zone is backed by the vCenter from the provider
+// ConfigMap.
+func GetVCenter(az topologyv1.AvailabilityZone) (VCenter, bool) {
+	pnid := az.Annotations[VCenterPNIDAnnotationKey]
+	if pnid == "" {
+		return VCenter{}, false
+	}
+	return VCenter{
+		PNID:            pnid,
+		Port:            az.Annotations[VCenterPortAnnotationKey],
+		Datacenter:      az.Annotations[VCenterDatacenterAnnotationKey],
+		CredsSecretName: az.Annotations[VCenterCredsSecretNameAnnotationKey],
+	}, true
+}
+
+// GetAvailabilityZoneVCenter returns the vCenter that backs the named
+// availability zone. False is returned if the zone is backed by the vCenter
+// from the provider ConfigMap.
+func GetAvailabilityZoneVCenter(
+	ctx context.Context,
+	client ctrlclient.Client,
+	availabilityZoneName string) (VCenter, bool, error) {
+
+	az, err := GetAvailabilityZone(ctx, client, availabilityZoneName)
+	if err != nil {
+		return VCenter{}, false, err
+	}
+
+	vc, ok := GetVCenter(az)
+	return vc, ok, nil
+}
+
+// GetAvailabilityZonesByVCenter returns the names of the availability zones
+// grouped by the vCenter that backs them. The zones backed by the vCenter from
+// the provider ConfigMap are keyed by the zero value of VCenter.
+func GetAvailabilityZonesByVCenter(
+	ctx context.Context,
+	client ctrlclient.Client) (map[VCenter][]string, error) {
+
+	availabilityZones, err := GetAvailabilityZones(ctx, client)
+	if err != nil {
+		if errors.Is(err, ErrNoAvailabilityZones) {
+			return map[VCenter][]string{}, nil
+		}
+		return nil, err
+	}
+
+	zonesByVCenter := map[VCenter][]string{}
+	for _, az := range availabilityZones {
+		vc, _ := GetVCenter(az)
+		zonesByVCenter[vc] = append(zonesByVCenter[vc], az.Name)
+	}
+
+	return zonesByVCenter, nil
+}
+
+// HasMultipleVCenters returns true if any of the availability zones is backed
+// by a vCenter other than the one from the provider ConfigMap.
+func HasMultipleVCenters(zonesByVCenter map[VCenter][]string) bool {
+	for vc := range zonesByVCenter {
+		if vc != (VCenter{}) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package topology_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
+	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("vCenters", func() {
+	var (
+		ctx    context.Context
+		client ctrlclient.Client
+		vc2    topology.VCenter
+	)
+
+	BeforeEach(func() {
+		ctx = pkgcfg.NewContextWithDefaultConfig()
+		client = builder.NewFakeClient()
+		vc2 = topology.VCenter{
+			PNID:            "vc-2.example.com",
+			Port:            "8443",
+			Datacenter:      "datacenter-2",
+			CredsSecretName: "vc-2-creds",
+		}
+	})
+
+	newAZ := func(name string, vc *topology.VCenter) *topologyv1.AvailabilityZone {
+		obj := &topologyv1.AvailabilityZone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+		if vc != nil {
+			obj.Annotations = map[string]string{
+				topology.VCenterPNIDAnnotationKey:            vc.PNID,
+				topology.VCenterPortAnnotationKey:            vc.Port,
+				topology.VCenterDatacenterAnnotationKey:      vc.Datacenter,
+				topology.VCenterCredsSecretNameAnnotationKey: vc.CredsSecretName,
+			}
+		}
+		return obj
+	}
+
+	Context("GetVCenter", func() {
+		It("should return false when the zone does not have a vCenter", func() {
+			vc, ok := topology.GetVCenter(*newAZ("az-1", nil))
+			Expect(ok).To(BeFalse())
+			Expect(vc).To(BeZero())
+		})
+		It("should return the zone's vCenter", func() {
+			vc, ok := topology.GetVCenter(*newAZ("az-1", &vc2))
+			Expect(ok).To(BeTrue())
+			Expect(vc).To(Equal(vc2))
+		})
+	})
+
+	Context("GetAvailabilityZoneVCenter", func() {
+		BeforeEach(func() {
+			Expect(client.Create(ctx, newAZ("az-1", nil))).To(Succeed())
+			Expect(client.Create(ctx, newAZ("az-2", &vc2))).To(Succeed())
+		})
+		It("should return false for a zone backed by the default vCenter", func() {
+			_, ok, err := topology.GetAvailabilityZoneVCenter(ctx, client, "az-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+		It("should return the vCenter for a zone backed by another vCenter", func() {
+			vc, ok, err := topology.GetAvailabilityZoneVCenter(ctx, client, "az-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(vc).To(Equal(vc2))
+		})
+		It("should return an error when the zone does not exist", func() {
+			_, _, err := topology.GetAvailabilityZoneVCenter(ctx, client, "az-3")
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("GetAvailabilityZonesByVCenter", func() {
+		When("there are no availability zones", func() {
+			It("should return an empty map", func() {
+				zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, client)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(zonesByVCenter).To(BeEmpty())
+				Expect(topology.HasMultipleVCenters(zonesByVCenter)).To(BeFalse())
+			})
+		})
+		When("all zones are backed by the default vCenter", func() {
+			BeforeEach(func() {
+				Expect(client.Create(ctx, newAZ("az-1", nil))).To(Succeed())
+				Expect(client.Create(ctx, newAZ("az-2", nil))).To(Succeed())
+			})
+			It("should return the zones for the default vCenter", func() {
+				zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, client)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(zonesByVCenter).To(HaveLen(1))
+				Expect(zonesByVCenter[topology.VCenter{}]).To(ConsistOf("az-1", "az-2"))
+				Expect(topology.HasMultipleVCenters(zonesByVCenter)).To(BeFalse())
+			})
+		})
+		When("zones are backed by different vCenters", func() {
+			BeforeEach(func() {
+				Expect(client.Create(ctx, newAZ("az-1", nil))).To(Succeed())
+				Expect(client.Create(ctx, newAZ("az-2", &vc2))).To(Succeed())
+				Expect(client.Create(ctx, newAZ("az-3", &vc2))).To(Succeed())
+			})
+			It("should group the zones by vCenter", func() {
+				zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, client)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(zonesByVCenter).To(HaveLen(2))
+				Expect(zonesByVCenter[topology.VCenter{}]).To(ConsistOf("az-1"))
+				Expect(zonesByVCenter[vc2]).To(ConsistOf("az-2", "az-3"))
+				Expect(topology.HasMultipleVCenters(zonesByVCenter)).To(BeTrue())
+			})
+		})
+	})
+})
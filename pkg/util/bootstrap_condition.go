@@ -13,12 +13,31 @@ import (
 // about the bootstrap status may be stored.
 const GuestInfoBootstrapCondition = "guestinfo.vmservice.bootstrap.condition"
 
+// GuestInfoDomainJoinCondition is the ExtraConfig key at which possible info
+// about whether or not a Sysprep VM joined its domain may be stored.
+const GuestInfoDomainJoinCondition = "guestinfo.vmservice.sysprep.domainjoin.condition"
+
 // GetBootstrapConditionValues returns the bootstrap condition values from a
 // VM if the data is present.
 func GetBootstrapConditionValues(
 	extraConfig map[string]string) (bool, string, string, bool) {
 
-	val, ok := extraConfig[GuestInfoBootstrapCondition]
+	return getGuestInfoConditionValues(extraConfig, GuestInfoBootstrapCondition)
+}
+
+// GetDomainJoinConditionValues returns the domain join condition values from
+// a VM if the data is present.
+func GetDomainJoinConditionValues(
+	extraConfig map[string]string) (bool, string, string, bool) {
+
+	return getGuestInfoConditionValues(extraConfig, GuestInfoDomainJoinCondition)
+}
+
+func getGuestInfoConditionValues(
+	extraConfig map[string]string,
+	key string) (bool, string, string, bool) {
+
+	val, ok := extraConfig[key]
 	if !ok {
 		return false, "", "", false
 	}
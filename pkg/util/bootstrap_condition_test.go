@@ -140,3 +140,38 @@ var _ = DescribeTable("GetBootstrapConditionValuesTest",
 		false, "my-reason", "my,comma,delimited,message", true,
 	),
 )
+
+var _ = DescribeTable("GetDomainJoinConditionValuesTest",
+	func(extraConfig map[string]string,
+		expectedStatus bool, expectedReason string, expectedMsg string, expectedOK bool) {
+
+		status, reason, msg, ok := util.GetDomainJoinConditionValues(extraConfig)
+		Expect(status).To(Equal(expectedStatus))
+		Expect(reason).To(Equal(expectedReason))
+		Expect(msg).To(Equal(expectedMsg))
+		Expect(ok).To(Equal(expectedOK))
+	},
+	func(extraConfig map[string]string,
+		expectedStatus bool, expectedReason string, expectedMsg string, expectedOK bool) string {
+		return fmt.Sprintf("ExtraConfig '%v' should return status=%v, reason=%q, msg=%q, ok=%v",
+			extraConfig, expectedStatus, expectedReason, expectedMsg, expectedOK)
+	},
+	Entry(nil,
+		map[string]string{
+			util.GuestInfoBootstrapCondition: "true",
+		},
+		false, "", "", false,
+	),
+	Entry(nil,
+		map[string]string{
+			util.GuestInfoDomainJoinCondition: "true",
+		},
+		true, "", "", true,
+	),
+	Entry(nil,
+		map[string]string{
+			util.GuestInfoDomainJoinCondition: "false,AccessDenied,the account may not add computers to the domain",
+		},
+		false, "AccessDenied", "the account may not add computers to the domain", true,
+	),
+)
@@ -0,0 +1,263 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudinit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit/validate"
+)
+
+const (
+	jinjaTemplateHeader = "## template: jinja"
+	multiPartBoundary   = "==VMOP-BOUNDARY-"
+	maxMIMELineLength   = 998
+	base64LineLength    = 76
+)
+
+// UserDataPart is a part of a MIME multi-part user data document whose data
+// has been resolved from an inline value, ConfigMap, or Secret.
+type UserDataPart struct {
+	Name        string
+	ContentType string
+	Data        string
+
+	// Redacted is true when Data has been redacted, ex. for a preview, and
+	// therefore is not validated.
+	Redacted bool
+}
+
+// GetUserDataParts returns the resolved data for each of the provided parts,
+// in the order in which they were specified.
+func GetUserDataParts(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	namespace string,
+	in []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart) ([]UserDataPart, error) {
+
+	out := make([]UserDataPart, len(in))
+
+	for i := range in {
+		p := in[i]
+
+		out[i].Name = p.Name
+		out[i].ContentType = string(p.ContentType)
+		if out[i].ContentType == "" {
+			out[i].ContentType = string(vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig)
+		}
+
+		switch {
+		case p.Value != nil:
+			out[i].Data = *p.Value
+
+		case p.ConfigMap != nil:
+			var obj corev1.ConfigMap
+			key := ctrlclient.ObjectKey{Namespace: namespace, Name: p.ConfigMap.Name}
+			if err := k8sClient.Get(ctx, key, &obj); err != nil {
+				return nil, err
+			}
+			data, ok := obj.Data[p.ConfigMap.Key]
+			if !ok || data == "" {
+				return nil, fmt.Errorf(
+					"no data found for key %q for configmap %s/%s",
+					p.ConfigMap.Key, namespace, p.ConfigMap.Name)
+			}
+			out[i].Data = data
+
+		case p.Secret != nil:
+			var data string
+			if err := util.GetSecretData(
+				ctx, k8sClient,
+				namespace, p.Secret.Name, p.Secret.Key,
+				&data); err != nil {
+
+				return nil, err
+			}
+			plainText, err := util.TryToDecodeBase64Gzip([]byte(data))
+			if err != nil {
+				return nil, err
+			}
+			out[i].Data = plainText
+
+		default:
+			return nil, fmt.Errorf(
+				"user data part %q does not specify value, configMap, or secret",
+				p.Name)
+		}
+	}
+
+	return out, nil
+}
+
+// IsMIMEMultiPart returns true if the provided plain-text user data is a MIME
+// multi-part document, to which additional parts cannot be added without
+// re-parsing the document.
+func IsMIMEMultiPart(data string) bool {
+	return strings.HasPrefix(strings.ToLower(data), "content-type:")
+}
+
+// MarshalMultiPart returns the provided parts as a MIME multi-part user data
+// document. Parts with the text/cloud-config content type are validated
+// using the CloudConfig schema.
+//
+// The document's boundary is derived from the parts, so the same parts
+// always produce the same document.
+func MarshalMultiPart(parts []UserDataPart) (string, error) {
+	if len(parts) == 0 {
+		return "", nil
+	}
+
+	h := sha256.New()
+	for i := range parts {
+		p := parts[i]
+		if p.ContentType == string(vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig) &&
+			!p.Redacted && !strings.HasPrefix(p.Data, jinjaTemplateHeader) {
+
+			if err := validate.CloudConfigYAML(p.Data); err != nil {
+				return "", fmt.Errorf(
+					"invalid cloud-config in user data part %q: %w", p.Name, err)
+			}
+		}
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00", p.Name, p.ContentType, p.Data)
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if err := w.SetBoundary(
+		multiPartBoundary + hex.EncodeToString(h.Sum(nil))[:32]); err != nil {
+		return "", err
+	}
+
+	for i := range parts {
+		p := parts[i]
+		hdr := textproto.MIMEHeader{}
+		hdr.Set("Content-Type", mime.FormatMediaType(
+			p.ContentType, map[string]string{"charset": "utf-8"}))
+		hdr.Set("MIME-Version", "1.0")
+		data := p.Data
+		if is7Bit(data) {
+			hdr.Set("Content-Transfer-Encoding", "7bit")
+		} else {
+			hdr.Set("Content-Transfer-Encoding", "base64")
+			data = encodeBase64Lines(data)
+		}
+		hdr.Set("Content-Disposition", mime.FormatMediaType(
+			"attachment", map[string]string{"filename": p.Name}))
+		pw, err := w.CreatePart(hdr)
+		if err != nil {
+			return "", err
+		}
+		if _, err := pw.Write([]byte(data)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	var doc bytes.Buffer
+	fmt.Fprintf(&doc, "Content-Type: %s\r\n", mime.FormatMediaType(
+		"multipart/mixed", map[string]string{"boundary": w.Boundary()}))
+	fmt.Fprint(&doc, "MIME-Version: 1.0\r\n\r\n")
+	if _, err := body.WriteTo(&doc); err != nil {
+		return "", err
+	}
+
+	return doc.String(), nil
+}
+
+// is7Bit returns true if the provided data may be sent with a 7bit
+// Content-Transfer-Encoding per RFC 2045, i.e. it is ASCII without NUL or bare
+// CR characters, and no line exceeds 998 octets.
+func is7Bit(data string) bool {
+	lineLen := 0
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; {
+		case c == 0 || c >= 0x80:
+			return false
+		case c == '\r':
+			if i+1 >= len(data) || data[i+1] != '\n' {
+				return false
+			}
+		case c == '\n':
+			lineLen = 0
+			continue
+		}
+		lineLen++
+		if lineLen > maxMIMELineLength {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeBase64Lines returns the base64 encoding of the provided data, wrapped
+// at 76 characters per line as required by RFC 2045.
+func encodeBase64Lines(data string) string {
+	enc := base64.StdEncoding.EncodeToString([]byte(data))
+	var b strings.Builder
+	for len(enc) > base64LineLength {
+		b.WriteString(enc[:base64LineLength])
+		b.WriteString("\r\n")
+		enc = enc[base64LineLength:]
+	}
+	b.WriteString(enc)
+	return b.String()
+}
+
+// GetUserDataPartResources returns the ConfigMap and Secret resources
+// referenced by the provided parts.
+func GetUserDataPartResources(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	namespace string,
+	in []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart) ([]ctrlclient.Object, error) {
+
+	uniqueConfigMaps := map[string]struct{}{}
+	uniqueSecrets := map[string]struct{}{}
+	var result []ctrlclient.Object
+
+	for i := range in {
+		if v := in[i].ConfigMap; v != nil {
+			if _, ok := uniqueConfigMaps[v.Name]; ok {
+				continue
+			}
+			obj := &corev1.ConfigMap{}
+			key := ctrlclient.ObjectKey{Namespace: namespace, Name: v.Name}
+			if err := k8sClient.Get(ctx, key, obj); err != nil {
+				return nil, err
+			}
+			result = append(result, obj)
+			uniqueConfigMaps[v.Name] = struct{}{}
+		} else if v := in[i].Secret; v != nil {
+			if _, ok := uniqueSecrets[v.Name]; ok {
+				continue
+			}
+			obj, err := util.GetSecretResource(ctx, k8sClient, namespace, v.Name)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, obj)
+			uniqueSecrets[v.Name] = struct{}{}
+		}
+	}
+
+	return result, nil
+}
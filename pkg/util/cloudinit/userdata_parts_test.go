@@ -0,0 +1,368 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudinit_test
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
+	"github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit"
+)
+
+var _ = Describe("GetUserDataParts", func() {
+	var (
+		err            error
+		ctx            context.Context
+		k8sClient      ctrlclient.Client
+		initialObjects []ctrlclient.Object
+		namespace      string
+		parts          []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart
+		out            []cloudinit.UserDataPart
+	)
+
+	BeforeEach(func() {
+		err = nil
+		ctx = context.Background()
+		namespace = "default"
+
+		gzipped, err := util.EncodeGzipBase64("#cloud-config\nruncmd:\n- echo app\n")
+		Expect(err).ToNot(HaveOccurred())
+
+		initialObjects = []ctrlclient.Object{
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-configmap",
+				},
+				Data: map[string]string{
+					"agent.sh": "#!/bin/sh\necho agent\n",
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "my-secret",
+				},
+				Data: map[string][]byte{
+					"user-data": []byte(gzipped),
+				},
+			},
+		}
+
+		parts = []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+			{
+				Name:  "base",
+				Value: addrOf("#cloud-config\nruncmd:\n- echo base\n"),
+			},
+			{
+				Name:        "agent.sh",
+				ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript,
+				ConfigMap: &common.ConfigMapKeySelector{
+					Name: "my-configmap",
+					Key:  "agent.sh",
+				},
+			},
+			{
+				Name:        "app",
+				ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig,
+				Secret: &common.SecretKeySelector{
+					Name: "my-secret",
+					Key:  "user-data",
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().WithObjects(initialObjects...).Build()
+		out, err = cloudinit.GetUserDataParts(ctx, k8sClient, namespace, parts)
+	})
+
+	When("all of the parts' data exists", func() {
+		It("should return the resolved parts in order", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal([]cloudinit.UserDataPart{
+				{
+					Name:        "base",
+					ContentType: "text/cloud-config",
+					Data:        "#cloud-config\nruncmd:\n- echo base\n",
+				},
+				{
+					Name:        "agent.sh",
+					ContentType: "text/x-shellscript",
+					Data:        "#!/bin/sh\necho agent\n",
+				},
+				{
+					Name:        "app",
+					ContentType: "text/cloud-config",
+					Data:        "#cloud-config\nruncmd:\n- echo app\n",
+				},
+			}))
+		})
+	})
+
+	When("the ConfigMap does not exist", func() {
+		BeforeEach(func() {
+			parts[1].ConfigMap.Name = "does-not-exist"
+		})
+		It("should return an error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+
+	When("the ConfigMap key does not exist", func() {
+		BeforeEach(func() {
+			parts[1].ConfigMap.Key = "does-not-exist"
+		})
+		It("should return an error", func() {
+			Expect(err).To(MatchError(
+				`no data found for key "does-not-exist" for configmap default/my-configmap`))
+		})
+	})
+
+	When("the Secret key does not exist", func() {
+		BeforeEach(func() {
+			parts[2].Secret.Key = "does-not-exist"
+		})
+		It("should return an error", func() {
+			Expect(err).To(MatchError(
+				`no data found for key "does-not-exist" for secret default/my-secret`))
+		})
+	})
+
+	When("a part does not specify a source", func() {
+		BeforeEach(func() {
+			parts[0].Value = nil
+		})
+		It("should return an error", func() {
+			Expect(err).To(MatchError(
+				`user data part "base" does not specify value, configMap, or secret`))
+		})
+	})
+})
+
+var _ = Describe("MarshalMultiPart", func() {
+	var (
+		err   error
+		parts []cloudinit.UserDataPart
+		out   string
+	)
+
+	BeforeEach(func() {
+		parts = []cloudinit.UserDataPart{
+			{
+				Name:        "base",
+				ContentType: "text/cloud-config",
+				Data:        "#cloud-config\nruncmd:\n- echo base\n",
+			},
+			{
+				Name:        "agent.sh",
+				ContentType: "text/x-shellscript",
+				Data:        "#!/bin/sh\necho agent\n",
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		out, err = cloudinit.MarshalMultiPart(parts)
+	})
+
+	When("there are no parts", func() {
+		BeforeEach(func() {
+			parts = nil
+		})
+		It("should return an empty string", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(BeEmpty())
+		})
+	})
+
+	When("the parts are valid", func() {
+		It("should return a MIME multi-part document with the parts in order", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			msg, err := mail.ReadMessage(strings.NewReader(out))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(msg.Header.Get("MIME-Version")).To(Equal("1.0"))
+
+			mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mediaType).To(Equal("multipart/mixed"))
+			Expect(params).To(HaveKey("boundary"))
+
+			r := multipart.NewReader(msg.Body, params["boundary"])
+			for i := range parts {
+				p, err := r.NextPart()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(p.FileName()).To(Equal(parts[i].Name))
+				Expect(p.Header.Get("Content-Type")).To(Equal(parts[i].ContentType + "; charset=utf-8"))
+				data, err := io.ReadAll(p)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).To(Equal(parts[i].Data))
+			}
+			_, err = r.NextPart()
+			Expect(err).To(MatchError(io.EOF))
+		})
+
+		It("should return the same document for the same parts", func() {
+			out2, err := cloudinit.MarshalMultiPart(parts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out2).To(Equal(out))
+		})
+
+		It("should return a different document when a part changes", func() {
+			parts[1].Data = "#!/bin/sh\necho agent v2\n"
+			out2, err := cloudinit.MarshalMultiPart(parts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out2).ToNot(Equal(out))
+		})
+	})
+
+	When("a part contains non-ASCII data", func() {
+		BeforeEach(func() {
+			parts[1].Data = "#!/bin/sh\necho h\u00e9llo\n"
+		})
+		It("should base64 encode only that part", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			msg, err := mail.ReadMessage(strings.NewReader(out))
+			Expect(err).ToNot(HaveOccurred())
+			_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			Expect(err).ToNot(HaveOccurred())
+
+			r := multipart.NewReader(msg.Body, params["boundary"])
+
+			p, err := r.NextPart()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.Header.Get("Content-Transfer-Encoding")).To(Equal("7bit"))
+			data, err := io.ReadAll(p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(parts[0].Data))
+
+			p, err = r.NextPart()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.Header.Get("Content-Transfer-Encoding")).To(Equal("base64"))
+			data, err = io.ReadAll(base64.NewDecoder(base64.StdEncoding, p))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(parts[1].Data))
+		})
+	})
+
+	When("a cloud-config part is invalid", func() {
+		BeforeEach(func() {
+			parts[0].Data = "#cloud-config\nruncmd: hello\n"
+		})
+		It("should return an error", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`invalid cloud-config in user data part "base": `))
+		})
+	})
+
+	When("a cloud-config part is a Jinja template", func() {
+		BeforeEach(func() {
+			parts[0].Data = "## template: jinja\n#cloud-config\nhostname: {{ v1.local_hostname }}\n"
+		})
+		It("should not validate the part", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
+
+var _ = DescribeTable("IsMIMEMultiPart",
+	func(data string, expected bool) {
+		Expect(cloudinit.IsMIMEMultiPart(data)).To(Equal(expected))
+	},
+	Entry("a cloud-config", "#cloud-config\nhostname: vm\n", false),
+	Entry("a shell script", "#!/bin/sh\necho hello\n", false),
+	Entry("a MIME document", "Content-Type: multipart/mixed; boundary=\"b\"\n", true),
+	Entry("a MIME document with a lower-case header", "content-type: multipart/mixed; boundary=\"b\"\n", true),
+)
+
+var _ = Describe("GetUserDataPartResources", func() {
+	var (
+		err            error
+		ctx            context.Context
+		k8sClient      ctrlclient.Client
+		initialObjects []ctrlclient.Object
+		parts          []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart
+		out            []ctrlclient.Object
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		initialObjects = []ctrlclient.Object{
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "my-configmap",
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "my-secret",
+				},
+			},
+		}
+		parts = []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+			{
+				Name:  "base",
+				Value: addrOf("#cloud-config\n"),
+			},
+			{
+				Name:      "agent.sh",
+				ConfigMap: &common.ConfigMapKeySelector{Name: "my-configmap", Key: "agent.sh"},
+			},
+			{
+				Name:      "proxy.sh",
+				ConfigMap: &common.ConfigMapKeySelector{Name: "my-configmap", Key: "proxy.sh"},
+			},
+			{
+				Name:   "app",
+				Secret: &common.SecretKeySelector{Name: "my-secret", Key: "user-data"},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().WithObjects(initialObjects...).Build()
+		out, err = cloudinit.GetUserDataPartResources(ctx, k8sClient, "default", parts)
+	})
+
+	It("should return each referenced resource once", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(HaveLen(2))
+		Expect(out[0]).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+		Expect(out[0].GetName()).To(Equal("my-configmap"))
+		Expect(out[1]).To(BeAssignableToTypeOf(&corev1.Secret{}))
+		Expect(out[1].GetName()).To(Equal("my-secret"))
+	})
+
+	When("a referenced resource does not exist", func() {
+		BeforeEach(func() {
+			initialObjects = initialObjects[:1]
+		})
+		It("should return an error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
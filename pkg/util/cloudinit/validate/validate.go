@@ -6,10 +6,12 @@ package validate
 
 import (
 	"encoding/json"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/cloudinit"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
 	cloudinitschema "github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit/schema"
@@ -19,6 +21,8 @@ const (
 	invalidRunCmd           = "value must be a list"
 	invalidRunCmdElement    = "value must be a string or list of strings"
 	invalidWriteFileContent = "value must be a string, multi-line string, or SecretKeySelector"
+	invalidUserDataPartSrc  = "exactly one of value, configMap, or secret must be specified"
+	jinjaTemplateHeader     = "## template: jinja"
 )
 
 // CloudConfigJSONRawMessage returns any errors encountered when validating the
@@ -157,3 +161,81 @@ func CloudConfigYAML(in string) error {
 
 	return nil
 }
+
+// UserDataParts returns any errors encountered when validating the parts of a
+// multi-part user data document.
+func UserDataParts(
+	fieldPath *field.Path,
+	in []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if fieldPath == nil {
+		fieldPath = field.NewPath("userDataParts")
+	} else {
+		fieldPath = fieldPath.Child("userDataParts")
+	}
+
+	for i := range in {
+		var (
+			p         = in[i]
+			fieldPath = fieldPath.Key(p.Name)
+			numSrcs   int
+		)
+
+		if p.Value != nil {
+			numSrcs++
+		}
+		if v := p.ConfigMap; v != nil {
+			numSrcs++
+			if v.Name == "" {
+				allErrs = append(allErrs, field.Required(
+					fieldPath.Child("configMap", "name"), ""))
+			}
+			if v.Key == "" {
+				allErrs = append(allErrs, field.Required(
+					fieldPath.Child("configMap", "key"), ""))
+			}
+		}
+		if v := p.Secret; v != nil {
+			numSrcs++
+			if v.Name == "" {
+				allErrs = append(allErrs, field.Required(
+					fieldPath.Child("secret", "name"), ""))
+			}
+			if v.Key == "" {
+				allErrs = append(allErrs, field.Required(
+					fieldPath.Child("secret", "key"), ""))
+			}
+		}
+
+		if numSrcs != 1 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					fieldPath,
+					p.Name,
+					invalidUserDataPartSrc))
+			continue
+		}
+
+		// Inline CloudConfig parts may be validated now. Parts from ConfigMap
+		// and Secret resources are validated when the document is assembled.
+		isCloudConfig := p.ContentType == "" ||
+			p.ContentType == vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig
+		if p.Value != nil && isCloudConfig &&
+			!strings.HasPrefix(*p.Value, jinjaTemplateHeader) {
+
+			if err := CloudConfigYAML(*p.Value); err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(
+						fieldPath.Child("value"),
+						*p.Value,
+						err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -12,9 +12,11 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	vmopv1cloudinit "github.com/vmware-tanzu/vm-operator/api/v1alpha5/cloudinit"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
 	cloudinitvalidate "github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit/validate"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
 
 var _ = Describe("Validate CloudConfigJSONRawMessage", func() {
@@ -152,3 +154,110 @@ var _ = Describe("Validate CloudConfigYAML", func() {
 		})
 	})
 })
+
+var _ = Describe("Validate UserDataParts", func() {
+	var (
+		parts []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart
+		errs  field.ErrorList
+	)
+
+	BeforeEach(func() {
+		errs = nil
+		parts = []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+			{
+				Name:  "base",
+				Value: ptr.To("#cloud-config\nruncmd:\n- echo hello\n"),
+			},
+			{
+				Name:        "app",
+				ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript,
+				ConfigMap: &common.ConfigMapKeySelector{
+					Name: "my-configmap",
+					Key:  "app.sh",
+				},
+			},
+			{
+				Name:        "secrets",
+				ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeCloudConfig,
+				Secret: &common.SecretKeySelector{
+					Name: "my-secret",
+					Key:  "user-data",
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		errs = cloudinitvalidate.UserDataParts(
+			field.NewPath("spec").Child("bootstrap").Child("cloudInit"),
+			parts)
+	})
+
+	When("The parts are valid", func() {
+		It("Should not return any errors", func() {
+			Expect(errs).To(HaveLen(0))
+		})
+	})
+
+	When("A part does not specify a source", func() {
+		BeforeEach(func() {
+			parts[0].Value = nil
+		})
+		It("Should return a single error", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.userDataParts[base]: Invalid value: "base": exactly one of value, configMap, or secret must be specified`))
+		})
+	})
+
+	When("A part specifies more than one source", func() {
+		BeforeEach(func() {
+			parts[1].Value = ptr.To("#!/bin/sh\n")
+		})
+		It("Should return a single error", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.userDataParts[app]: Invalid value: "app": exactly one of value, configMap, or secret must be specified`))
+		})
+	})
+
+	When("A part's Secret key is empty", func() {
+		BeforeEach(func() {
+			parts[2].Secret.Key = ""
+		})
+		It("Should return a single error", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs.ToAggregate().Error()).To(Equal(
+				`spec.bootstrap.cloudInit.userDataParts[secrets].secret.key: Required value`))
+		})
+	})
+
+	When("A part's inline CloudConfig is invalid", func() {
+		BeforeEach(func() {
+			parts[0].Value = ptr.To("#cloud-config\nruncmd: hello\n")
+		})
+		It("Should return a single error", func() {
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.bootstrap.cloudInit.userDataParts[base].value"))
+		})
+	})
+
+	When("A part's inline CloudConfig is a Jinja template", func() {
+		BeforeEach(func() {
+			parts[0].Value = ptr.To("## template: jinja\n#cloud-config\nhostname: {{ v1.local_hostname }}\n")
+		})
+		It("Should not return any errors", func() {
+			Expect(errs).To(HaveLen(0))
+		})
+	})
+
+	When("A part's inline value is not a CloudConfig", func() {
+		BeforeEach(func() {
+			parts[0].ContentType = vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript
+			parts[0].Value = ptr.To("#!/bin/sh\necho hello: [\n")
+		})
+		It("Should not return any errors", func() {
+			Expect(errs).To(HaveLen(0))
+		})
+	})
+})
@@ -0,0 +1,239 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxYears is how far into the future Next searches for a time that matches
+// a schedule before giving up, ex. for "0 0 30 2 *".
+const maxYears = 5
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = [5]field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day-of-month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day-of-week", min: 0, max: 7},
+}
+
+// Schedule is a parsed, standard five field cron expression. Schedules are
+// always evaluated in UTC.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar are true when the day-of-month and day-of-week
+	// fields are "*". When both fields are restricted, a day matches if
+	// either field matches, which is the behavior of the traditional cron.
+	domStar, dowStar bool
+}
+
+// Parse parses a standard five field cron expression, i.e.
+// "minute hour day-of-month month day-of-week".
+//
+// Each field may be "*", a value, a range "a-b", or a list of these separated
+// by commas. A step may be appended to "*", a range, or a value, ex. "*/15",
+// "1-5/2", or "5/10". Both 0 and 7 are Sunday in the day-of-week field.
+func Parse(spec string) (Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return Schedule{}, fmt.Errorf(
+			"expected %d fields but found %d in %q",
+			len(fields), len(parts), spec)
+	}
+
+	var bits [5]uint64
+	for i := range parts {
+		b, err := parseField(parts[i], fields[i])
+		if err != nil {
+			return Schedule{}, err
+		}
+		bits[i] = b
+	}
+
+	// Sunday may be specified as 0 or 7.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = (bits[4] | 1) &^ (1 << 7)
+	}
+
+	return Schedule{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4],
+		domStar: parts[2] == "*",
+		dowStar: parts[4] == "*",
+	}, nil
+}
+
+func parseField(s string, f field) (uint64, error) {
+	var bits uint64
+	for _, expr := range strings.Split(s, ",") {
+		b, err := parseExpr(expr, f)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func parseExpr(expr string, f field) (uint64, error) {
+	var (
+		rangeExpr = expr
+		step      = 1
+		hasStep   bool
+	)
+
+	if i := strings.IndexByte(expr, '/'); i >= 0 {
+		rangeExpr = expr[:i]
+		n, err := strconv.Atoi(expr[i+1:])
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid step in %s field: %q", f.name, expr)
+		}
+		step, hasStep = n, true
+	}
+
+	var start, end int
+	switch {
+	case rangeExpr == "*":
+		start, end = f.min, f.max
+	case strings.Contains(rangeExpr, "-"):
+		a, b, _ := strings.Cut(rangeExpr, "-")
+		var err error
+		if start, err = parseValue(a, f); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(b, f); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range in %s field: %q", f.name, expr)
+		}
+	default:
+		var err error
+		if start, err = parseValue(rangeExpr, f); err != nil {
+			return 0, err
+		}
+		end = start
+		if hasStep {
+			end = f.max
+		}
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value in %s field: %q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf(
+			"value %d in %s field is not between %d and %d",
+			v, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the first time after t that matches the schedule. The zero
+// time is returned if the schedule does not match any time in the next
+// several years.
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + maxYears
+
+wrap:
+	for t.Year() <= yearLimit {
+		for s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			if t.Month() == time.January {
+				continue wrap
+			}
+		}
+
+		for !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			if t.Day() == 1 {
+				continue wrap
+			}
+		}
+
+		for s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			if t.Hour() == 0 {
+				continue wrap
+			}
+		}
+
+		for s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			if t.Minute() == 0 {
+				continue wrap
+			}
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// Window is a recurring window of time that starts according to a schedule
+// and remains open for a duration.
+type Window struct {
+	Schedule Schedule
+	Duration time.Duration
+}
+
+// ParseWindow returns a Window for the specified cron expression and
+// duration.
+func ParseWindow(spec string, duration time.Duration) (Window, error) {
+	s, err := Parse(spec)
+	if err != nil {
+		return Window{}, err
+	}
+	if duration <= 0 {
+		return Window{}, fmt.Errorf("duration must be positive: %s", duration)
+	}
+	return Window{Schedule: s, Duration: duration}, nil
+}
+
+// Open returns true if the window is open at t. When the window is open, the
+// time at which it opened is returned, otherwise the time at which it next
+// opens is returned.
+func (w Window) Open(t time.Time) (bool, time.Time) {
+	// The first start after t-Duration is either a start of the window that
+	// is still open at t, or the next start of the window.
+	start := w.Schedule.Next(t.Add(-w.Duration))
+	if start.IsZero() {
+		return false, start
+	}
+	return !start.After(t), start
+}
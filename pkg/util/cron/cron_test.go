@@ -0,0 +1,141 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package cron_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cron"
+)
+
+func date(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+var _ = Describe("Parse", func() {
+	DescribeTable("invalid expressions",
+		func(spec string) {
+			_, err := cron.Parse(spec)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("too few fields", "* * * *"),
+		Entry("too many fields", "* * * * * *"),
+		Entry("minute out of range", "60 * * * *"),
+		Entry("hour out of range", "* 24 * * *"),
+		Entry("day-of-month out of range", "* * 0 * *"),
+		Entry("month out of range", "* * * 13 *"),
+		Entry("day-of-week out of range", "* * * * 8"),
+		Entry("not a number", "a * * * *"),
+		Entry("inverted range", "* 5-1 * * *"),
+		Entry("zero step", "*/0 * * * *"),
+		Entry("invalid step", "*/a * * * *"),
+	)
+})
+
+var _ = Describe("Next", func() {
+	DescribeTable("returns the next matching time",
+		func(spec string, from, expected time.Time) {
+			s, err := cron.Parse(spec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Next(from)).To(Equal(expected))
+		},
+		Entry("every minute",
+			"* * * * *",
+			date(2026, 10, 19, 10, 30).Add(10*time.Second),
+			date(2026, 10, 19, 10, 31)),
+		Entry("strictly after the specified time",
+			"30 10 * * *",
+			date(2026, 10, 19, 10, 30),
+			date(2026, 10, 20, 10, 30)),
+		Entry("step",
+			"*/15 * * * *",
+			date(2026, 10, 19, 10, 31),
+			date(2026, 10, 19, 10, 45)),
+		Entry("value with step",
+			"5/20 * * * *",
+			date(2026, 10, 19, 10, 26),
+			date(2026, 10, 19, 10, 45)),
+		Entry("list and range",
+			"0 1,3-4 * * *",
+			date(2026, 10, 19, 1, 0),
+			date(2026, 10, 19, 3, 0)),
+		Entry("day-of-week",
+			"0 2 * * 6",
+			date(2026, 10, 19, 0, 0),
+			date(2026, 10, 24, 2, 0)),
+		Entry("Sunday as 7",
+			"0 2 * * 7",
+			date(2026, 10, 19, 0, 0),
+			date(2026, 10, 25, 2, 0)),
+		Entry("day-of-month and day-of-week",
+			"0 0 1 * 6",
+			date(2026, 10, 19, 0, 0),
+			date(2026, 10, 24, 0, 0)),
+		Entry("next year",
+			"0 0 1 1 *",
+			date(2026, 10, 19, 0, 0),
+			date(2027, 1, 1, 0, 0)),
+		Entry("leap day",
+			"0 0 29 2 *",
+			date(2026, 10, 19, 0, 0),
+			date(2028, 2, 29, 0, 0)),
+		Entry("never",
+			"0 0 30 2 *",
+			date(2026, 10, 19, 0, 0),
+			time.Time{}),
+	)
+
+	It("should evaluate the schedule in UTC", func() {
+		s, err := cron.Parse("0 2 * * *")
+		Expect(err).ToNot(HaveOccurred())
+		loc := time.FixedZone("UTC+5", 5*60*60)
+		from := time.Date(2026, 10, 19, 6, 0, 0, 0, loc)
+		Expect(s.Next(from)).To(Equal(date(2026, 10, 19, 2, 0)))
+	})
+})
+
+var _ = Describe("Window", func() {
+	var w cron.Window
+
+	BeforeEach(func() {
+		var err error
+		w, err = cron.ParseWindow("0 2 * * 6", 4*time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should return an error for a non-positive duration", func() {
+		_, err := cron.ParseWindow("0 2 * * 6", 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error for an invalid schedule", func() {
+		_, err := cron.ParseWindow("0 2 * *", time.Hour)
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("Open",
+		func(t time.Time, expectedOpen bool, expectedStart time.Time) {
+			open, start := w.Open(t)
+			Expect(open).To(Equal(expectedOpen))
+			Expect(start).To(Equal(expectedStart))
+		},
+		Entry("before the window",
+			date(2026, 10, 24, 1, 59),
+			false, date(2026, 10, 24, 2, 0)),
+		Entry("at the start of the window",
+			date(2026, 10, 24, 2, 0),
+			true, date(2026, 10, 24, 2, 0)),
+		Entry("inside the window",
+			date(2026, 10, 24, 5, 59),
+			true, date(2026, 10, 24, 2, 0)),
+		Entry("at the end of the window",
+			date(2026, 10, 24, 6, 0),
+			false, date(2026, 10, 31, 2, 0)),
+	)
+})
@@ -0,0 +1,282 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package ignition
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/netplan"
+)
+
+const (
+	// NetworkdDir is the directory where the systemd-networkd units for the
+	// VM's network interfaces are written.
+	NetworkdDir = "/etc/systemd/network"
+
+	// HostnamePath is the path of the file that contains the guest's hostname.
+	HostnamePath = "/etc/hostname"
+
+	// networkdUnitPrefix is the prefix of the systemd-networkd units written
+	// for the VM's network interfaces. The low number ensures these units are
+	// preferred over any units that ship with the guest.
+	networkdUnitPrefix = "00-vmoperator-"
+
+	// fileMode is the mode of the files merged into the Ignition config. JSON
+	// does not support octal, so this is 0644.
+	fileMode = 420
+)
+
+// ErrConfigNotObject is returned when the Ignition config is not a JSON
+// object.
+var ErrConfigNotObject = errors.New("ignition config must be a JSON object")
+
+// File is a file that is merged into an Ignition config.
+type File struct {
+	// Path is the absolute path of the file in the guest.
+	Path string
+
+	// Contents are the plain-text contents of the file.
+	Contents string
+}
+
+// NetworkFiles returns the systemd-networkd units for the ethernet devices in
+// the provided netplan config, and the file /etc/hostname when fqdn is not
+// empty.
+//
+// The networkd units are generated from the netplan config so the guest ends
+// up with the same network configuration it would have received if it was
+// bootstrapped with Cloud-Init.
+func NetworkFiles(fqdn string, netPlan *netplan.Network) []File {
+	var files []File
+
+	if fqdn != "" {
+		files = append(files, File{
+			Path:     HostnamePath,
+			Contents: fqdn + "\n",
+		})
+	}
+
+	if netPlan == nil {
+		return files
+	}
+
+	// Sort the device names so the generated config is stable and the
+	// bootstrap hash does not change needlessly.
+	names := make([]string, 0, len(netPlan.Ethernets))
+	for name := range netPlan.Ethernets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		eth := netPlan.Ethernets[name]
+
+		// Name the units after the device name in the guest when it is known.
+		unitName := name
+		if eth.SetName != nil && *eth.SetName != "" {
+			unitName = *eth.SetName
+
+			if getMacAddress(eth) != "" {
+				files = append(files, File{
+					Path:     NetworkdDir + "/" + networkdUnitPrefix + unitName + ".link",
+					Contents: networkdLinkUnit(eth),
+				})
+			}
+		}
+
+		files = append(files, File{
+			Path:     NetworkdDir + "/" + networkdUnitPrefix + unitName + ".network",
+			Contents: networkdNetworkUnit(name, eth),
+		})
+	}
+
+	return files
+}
+
+// MergeFiles returns the provided Ignition config with the files added to
+// storage.files. Files whose path is already present in the Ignition config
+// are not added, i.e. the Ignition config always takes precedence. Any other
+// fields in the Ignition config are preserved as-is.
+func MergeFiles(config []byte, files ...File) ([]byte, error) {
+	if len(files) == 0 {
+		return config, nil
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal(config, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ignition config: %w", err)
+	}
+	if obj == nil {
+		return nil, ErrConfigNotObject
+	}
+
+	storage, err := getObject(obj, "storage")
+	if err != nil {
+		return nil, err
+	}
+
+	var existingFiles []any
+	if v, ok := storage["files"]; ok && v != nil {
+		if existingFiles, ok = v.([]any); !ok {
+			return nil, fmt.Errorf("ignition config storage.files must be a list")
+		}
+	}
+
+	existingPaths := map[string]struct{}{}
+	for _, f := range existingFiles {
+		if m, ok := f.(map[string]any); ok {
+			if p, ok := m["path"].(string); ok {
+				existingPaths[p] = struct{}{}
+			}
+		}
+	}
+
+	for _, f := range files {
+		if _, ok := existingPaths[f.Path]; ok {
+			continue
+		}
+		existingFiles = append(existingFiles, map[string]any{
+			"path":      f.Path,
+			"mode":      fileMode,
+			"overwrite": true,
+			"contents": map[string]any{
+				"source": DataURL(f.Contents),
+			},
+		})
+	}
+
+	storage["files"] = existingFiles
+	obj["storage"] = storage
+
+	return json.Marshal(obj)
+}
+
+// DataURL returns the provided contents as a RFC 2397 data URL, which is how
+// Ignition expects inline file contents.
+func DataURL(contents string) string {
+	return "data:," + url.PathEscape(contents)
+}
+
+func getObject(obj map[string]any, key string) (map[string]any, error) {
+	v, ok := obj[key]
+	if !ok || v == nil {
+		return map[string]any{}, nil
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("ignition config %s must be an object", key)
+	}
+	return m, nil
+}
+
+func getMacAddress(eth netplan.Ethernet) string {
+	if eth.Match != nil && eth.Match.Macaddress != nil {
+		return *eth.Match.Macaddress
+	}
+	return ""
+}
+
+func networkdLinkUnit(eth netplan.Ethernet) string {
+	var sb strings.Builder
+
+	sb.WriteString("[Match]\n")
+	sb.WriteString("MACAddress=" + getMacAddress(eth) + "\n")
+	sb.WriteString("\n[Link]\n")
+	sb.WriteString("Name=" + *eth.SetName + "\n")
+
+	return sb.String()
+}
+
+func networkdNetworkUnit(name string, eth netplan.Ethernet) string {
+	var sb strings.Builder
+
+	sb.WriteString("[Match]\n")
+	if mac := getMacAddress(eth); mac != "" {
+		sb.WriteString("MACAddress=" + mac + "\n")
+	} else {
+		sb.WriteString("Name=" + name + "\n")
+	}
+
+	if eth.MTU != nil && *eth.MTU > 0 {
+		sb.WriteString("\n[Link]\n")
+		sb.WriteString("MTUBytes=" + strconv.FormatInt(*eth.MTU, 10) + "\n")
+	}
+
+	dhcp4 := eth.Dhcp4 != nil && *eth.Dhcp4
+	dhcp6 := eth.Dhcp6 != nil && *eth.Dhcp6
+
+	sb.WriteString("\n[Network]\n")
+	switch {
+	case dhcp4 && dhcp6:
+		sb.WriteString("DHCP=yes\n")
+	case dhcp4:
+		sb.WriteString("DHCP=ipv4\n")
+	case dhcp6:
+		sb.WriteString("DHCP=ipv6\n")
+	default:
+		sb.WriteString("DHCP=no\n")
+	}
+
+	if eth.AcceptRa != nil {
+		sb.WriteString("IPv6AcceptRA=" + yesNo(*eth.AcceptRa) + "\n")
+	}
+
+	for _, a := range eth.Addresses {
+		if a.String != nil {
+			sb.WriteString("Address=" + *a.String + "\n")
+		}
+	}
+
+	if eth.Gateway4 != nil && *eth.Gateway4 != "" {
+		sb.WriteString("Gateway=" + *eth.Gateway4 + "\n")
+	}
+	if eth.Gateway6 != nil && *eth.Gateway6 != "" {
+		sb.WriteString("Gateway=" + *eth.Gateway6 + "\n")
+	}
+
+	if ns := eth.Nameservers; ns != nil {
+		for _, a := range ns.Addresses {
+			sb.WriteString("DNS=" + a + "\n")
+		}
+		if len(ns.Search) > 0 {
+			sb.WriteString("Domains=" + strings.Join(ns.Search, " ") + "\n")
+		}
+	}
+
+	for _, r := range eth.Routes {
+		sb.WriteString("\n[Route]\n")
+		if r.To != nil && *r.To != "" {
+			to := *r.To
+			if to == "default" {
+				to = "0.0.0.0/0"
+				if r.Via != nil && strings.Contains(*r.Via, ":") {
+					to = "::/0"
+				}
+			}
+			sb.WriteString("Destination=" + to + "\n")
+		}
+		if r.Via != nil && *r.Via != "" {
+			sb.WriteString("Gateway=" + *r.Via + "\n")
+		}
+		if r.Metric != nil {
+			sb.WriteString("Metric=" + strconv.FormatInt(*r.Metric, 10) + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
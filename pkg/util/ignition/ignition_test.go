@@ -0,0 +1,165 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package ignition_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ignition"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/netplan"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
+)
+
+var _ = Describe("NetworkFiles", func() {
+	It("should return the networkd units and hostname", func() {
+		files := ignition.NetworkFiles("my-vm.local", &netplan.Network{
+			Version: 2,
+			Ethernets: map[string]netplan.Ethernet{
+				"eth1": {
+					Match: &netplan.Match{
+						Macaddress: ptr.To("00:50:56:aa:bb:cd"),
+					},
+					SetName: ptr.To("eth1"),
+					Dhcp4:   ptr.To(true),
+					Dhcp6:   ptr.To(false),
+				},
+				"eth0": {
+					Match: &netplan.Match{
+						Macaddress: ptr.To("00:50:56:aa:bb:cc"),
+					},
+					SetName: ptr.To("eth0"),
+					MTU:     ptr.To(int64(9000)),
+					Dhcp4:   ptr.To(false),
+					Dhcp6:   ptr.To(false),
+					Addresses: []netplan.Address{
+						{String: ptr.To("192.168.1.10/24")},
+						{String: ptr.To("fd00::10/64")},
+					},
+					Gateway4: ptr.To("192.168.1.1"),
+					Nameservers: &netplan.Nameserver{
+						Addresses: []string{"8.8.8.8"},
+						Search:    []string{"local", "example.com"},
+					},
+					Routes: []netplan.Route{
+						{
+							To:     ptr.To("10.0.0.0/8"),
+							Via:    ptr.To("192.168.1.254"),
+							Metric: ptr.To(int64(42)),
+						},
+					},
+				},
+			},
+		})
+
+		Expect(files).To(Equal([]ignition.File{
+			{
+				Path:     "/etc/hostname",
+				Contents: "my-vm.local\n",
+			},
+			{
+				Path:     "/etc/systemd/network/00-vmoperator-eth0.link",
+				Contents: "[Match]\nMACAddress=00:50:56:aa:bb:cc\n\n[Link]\nName=eth0\n",
+			},
+			{
+				Path: "/etc/systemd/network/00-vmoperator-eth0.network",
+				Contents: "[Match]\nMACAddress=00:50:56:aa:bb:cc\n" +
+					"\n[Link]\nMTUBytes=9000\n" +
+					"\n[Network]\nDHCP=no\n" +
+					"Address=192.168.1.10/24\nAddress=fd00::10/64\n" +
+					"Gateway=192.168.1.1\n" +
+					"DNS=8.8.8.8\nDomains=local example.com\n" +
+					"\n[Route]\nDestination=10.0.0.0/8\nGateway=192.168.1.254\nMetric=42\n",
+			},
+			{
+				Path:     "/etc/systemd/network/00-vmoperator-eth1.link",
+				Contents: "[Match]\nMACAddress=00:50:56:aa:bb:cd\n\n[Link]\nName=eth1\n",
+			},
+			{
+				Path:     "/etc/systemd/network/00-vmoperator-eth1.network",
+				Contents: "[Match]\nMACAddress=00:50:56:aa:bb:cd\n\n[Network]\nDHCP=ipv4\n",
+			},
+		}))
+	})
+
+	It("should return nothing when there is no hostname or network", func() {
+		Expect(ignition.NetworkFiles("", nil)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("MergeFiles", func() {
+	var (
+		config []byte
+		files  []ignition.File
+		out    []byte
+		err    error
+	)
+
+	BeforeEach(func() {
+		config = []byte(`{"ignition":{"version":"3.4.0"},"systemd":{"units":[{"name":"a.service"}]}}`)
+		files = []ignition.File{
+			{
+				Path:     "/etc/hostname",
+				Contents: "my vm\n",
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		out, err = ignition.MergeFiles(config, files...)
+	})
+
+	It("should add the files and preserve the rest of the config", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(MatchJSON(`{
+			"ignition":{"version":"3.4.0"},
+			"systemd":{"units":[{"name":"a.service"}]},
+			"storage":{"files":[{
+				"path":"/etc/hostname",
+				"mode":420,
+				"overwrite":true,
+				"contents":{"source":"data:,my%20vm%0A"}
+			}]}
+		}`))
+	})
+
+	When("the file already exists", func() {
+		BeforeEach(func() {
+			config = []byte(`{"ignition":{"version":"3.4.0"},"storage":{"files":[{"path":"/etc/hostname"}]}}`)
+		})
+		It("should not add the file", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(MatchJSON(config))
+		})
+	})
+
+	When("there are no files", func() {
+		BeforeEach(func() {
+			files = nil
+		})
+		It("should return the config as-is", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(Equal(config))
+		})
+	})
+
+	When("the config is not an object", func() {
+		BeforeEach(func() {
+			config = []byte(`null`)
+		})
+		It("should return an error", func() {
+			Expect(err).To(MatchError(ignition.ErrConfigNotObject))
+		})
+	})
+
+	When("the config storage.files is not a list", func() {
+		BeforeEach(func() {
+			config = []byte(`{"storage":{"files":{}}}`)
+		})
+		It("should return an error", func() {
+			Expect(err).To(MatchError("ignition config storage.files must be a list"))
+		})
+	})
+})
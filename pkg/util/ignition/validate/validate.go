@@ -0,0 +1,190 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	invalidJSON     = "value must be a JSON object"
+	invalidVersion  = "value must be a supported Ignition spec version, ex. 3.4.0"
+	invalidPath     = "value must be an absolute path"
+	invalidUnitName = "value must be a systemd unit name with a suffix, ex. my.service"
+	invalidUserName = "value must not be empty"
+	requiredVersion = "ignition.version is required"
+)
+
+// versionRx matches the Ignition spec versions supported by the Ignition
+// releases shipped with Fedora CoreOS and Flatcar Container Linux.
+var versionRx = regexp.MustCompile(`^3\.[0-9]+\.[0-9]+(-experimental)?$`)
+
+// unitNameRx matches a systemd unit name.
+var unitNameRx = regexp.MustCompile(`^[a-zA-Z0-9:_.\\@-]+\.[a-z]+$`)
+
+type config struct {
+	Ignition *struct {
+		Version string `json:"version"`
+	} `json:"ignition"`
+
+	Storage *struct {
+		Files       []node `json:"files"`
+		Directories []node `json:"directories"`
+		Links       []node `json:"links"`
+	} `json:"storage"`
+
+	Systemd *struct {
+		Units []struct {
+			Name string `json:"name"`
+		} `json:"units"`
+	} `json:"systemd"`
+
+	Passwd *struct {
+		Users []struct {
+			Name string `json:"name"`
+		} `json:"users"`
+	} `json:"passwd"`
+}
+
+type node struct {
+	Path string `json:"path"`
+}
+
+// Config returns any errors encountered when validating an Ignition config.
+//
+// Please note this is not a replacement for the validation performed by
+// Ignition itself, but it does catch the most common mistakes before the
+// config is sent into the guest, where errors are only visible from the
+// guest's console.
+func Config(
+	fieldPath *field.Path,
+	in []byte) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	var c config
+	if err := json.Unmarshal(in, &c); err != nil {
+		// The config is omitted from the error since it may contain
+		// sensitive data, ex. password hashes and keys.
+		return append(
+			allErrs,
+			field.Invalid(
+				fieldPath,
+				field.OmitValueType{},
+				jsonErrorDetail(err)))
+	}
+
+	if c.Ignition == nil || c.Ignition.Version == "" {
+		allErrs = append(
+			allErrs,
+			field.Required(
+				fieldPath.Child("ignition", "version"),
+				requiredVersion))
+	} else if !versionRx.MatchString(c.Ignition.Version) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(
+				fieldPath.Child("ignition", "version"),
+				c.Ignition.Version,
+				invalidVersion))
+	}
+
+	if s := c.Storage; s != nil {
+		storagePath := fieldPath.Child("storage")
+		paths := map[string]struct{}{}
+
+		allErrs = append(allErrs, validateNodes(storagePath.Child("files"), s.Files, paths)...)
+		allErrs = append(allErrs, validateNodes(storagePath.Child("directories"), s.Directories, paths)...)
+		allErrs = append(allErrs, validateNodes(storagePath.Child("links"), s.Links, paths)...)
+	}
+
+	if s := c.Systemd; s != nil {
+		unitsPath := fieldPath.Child("systemd", "units")
+		for i := range s.Units {
+			if !unitNameRx.MatchString(s.Units[i].Name) {
+				allErrs = append(
+					allErrs,
+					field.Invalid(
+						unitsPath.Index(i).Child("name"),
+						s.Units[i].Name,
+						invalidUnitName))
+			}
+		}
+	}
+
+	if p := c.Passwd; p != nil {
+		usersPath := fieldPath.Child("passwd", "users")
+		for i := range p.Users {
+			if p.Users[i].Name == "" {
+				allErrs = append(
+					allErrs,
+					field.Invalid(
+						usersPath.Index(i).Child("name"),
+						p.Users[i].Name,
+						invalidUserName))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateNodes(
+	fieldPath *field.Path,
+	in []node,
+	paths map[string]struct{}) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	for i := range in {
+		p := in[i].Path
+		fieldPath := fieldPath.Index(i).Child("path")
+
+		if !path.IsAbs(p) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					fieldPath,
+					p,
+					invalidPath))
+			continue
+		}
+
+		p = path.Clean(p)
+		if _, ok := paths[p]; ok {
+			allErrs = append(
+				allErrs,
+				field.Duplicate(
+					fieldPath,
+					p))
+			continue
+		}
+		paths[p] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// jsonErrorDetail returns the detail of an error from unmarshaling a config,
+// including the offset at which the error occurred.
+func jsonErrorDetail(err error) string {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &syntaxErr):
+		return fmt.Sprintf("%s: %s at offset %d", invalidJSON, syntaxErr, syntaxErr.Offset)
+	case errors.As(err, &typeErr):
+		return fmt.Sprintf("%s: unexpected %s at offset %d", invalidJSON, typeErr.Value, typeErr.Offset)
+	}
+	return invalidJSON
+}
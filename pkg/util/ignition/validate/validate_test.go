@@ -0,0 +1,89 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validate_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ignitionvalidate "github.com/vmware-tanzu/vm-operator/pkg/util/ignition/validate"
+)
+
+var _ = DescribeTable("Validate Config",
+	func(in string, expErrs ...string) {
+		errs := ignitionvalidate.Config(
+			field.NewPath("spec", "bootstrap", "ignition", "config"),
+			[]byte(in))
+		if len(expErrs) == 0 {
+			Expect(errs).To(BeEmpty())
+			return
+		}
+		Expect(errs).To(HaveLen(len(expErrs)))
+		for i := range expErrs {
+			Expect(errs[i].Error()).To(Equal(expErrs[i]))
+		}
+	},
+	Entry(
+		"valid",
+		`{
+			"ignition":{"version":"3.4.0"},
+			"storage":{
+				"files":[{"path":"/etc/motd"}],
+				"directories":[{"path":"/opt/app"}],
+				"links":[{"path":"/usr/local/bin/app"}]
+			},
+			"systemd":{"units":[{"name":"app.service"},{"name":"getty@tty1.service"}]},
+			"passwd":{"users":[{"name":"core"}]}
+		}`,
+	),
+	Entry(
+		"valid experimental version",
+		`{"ignition":{"version":"3.6.0-experimental"}}`,
+	),
+	Entry(
+		"not json",
+		`not-json`,
+		`spec.bootstrap.ignition.config: Invalid value: value must be a JSON object: invalid character 'o' in literal null (expecting 'u') at offset 2`,
+	),
+	Entry(
+		"not a json object",
+		`["hunter2"]`,
+		`spec.bootstrap.ignition.config: Invalid value: value must be a JSON object: unexpected array at offset 1`,
+	),
+	Entry(
+		"missing version",
+		`{}`,
+		`spec.bootstrap.ignition.config.ignition.version: Required value: ignition.version is required`,
+	),
+	Entry(
+		"unsupported version",
+		`{"ignition":{"version":"2.3.0"}}`,
+		`spec.bootstrap.ignition.config.ignition.version: Invalid value: "2.3.0": value must be a supported Ignition spec version, ex. 3.4.0`,
+	),
+	Entry(
+		"relative and duplicate paths",
+		`{
+			"ignition":{"version":"3.4.0"},
+			"storage":{
+				"files":[{"path":"etc/motd"},{"path":"/opt/app/"}],
+				"directories":[{"path":"/opt/app"}]
+			}
+		}`,
+		`spec.bootstrap.ignition.config.storage.files[0].path: Invalid value: "etc/motd": value must be an absolute path`,
+		`spec.bootstrap.ignition.config.storage.directories[0].path: Duplicate value: "/opt/app"`,
+	),
+	Entry(
+		"invalid unit name and empty user name",
+		`{
+			"ignition":{"version":"3.4.0"},
+			"systemd":{"units":[{"name":"app"}]},
+			"passwd":{"users":[{"name":""}]}
+		}`,
+		`spec.bootstrap.ignition.config.systemd.units[0].name: Invalid value: "app": value must be a systemd unit name with a suffix, ex. my.service`,
+		`spec.bootstrap.ignition.config.passwd.users[0].name: Invalid value: "": value must not be empty`,
+	),
+)
@@ -0,0 +1,461 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package image
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrSignatureMissing is returned when an image does not have a manifest
+	// and certificate.
+	ErrSignatureMissing = errors.New("image is not signed")
+
+	// ErrSignatureInvalid is returned when an image's manifest, certificate,
+	// or signature is malformed, or when the signature or digests do not
+	// match the image's content.
+	ErrSignatureInvalid = errors.New("image signature is invalid")
+
+	// ErrSignatureUntrusted is returned when an image's signing certificate
+	// is not issued by one of the trust anchors.
+	ErrSignatureUntrusted = errors.New("image signature is not trusted")
+)
+
+const (
+	// OVFFileExtension is the extension of an OVF descriptor.
+	OVFFileExtension = ".ovf"
+
+	// ManifestFileExtension is the extension of an OVF manifest, which has
+	// the digests of the OVF package's files.
+	ManifestFileExtension = ".mf"
+
+	// CertificateFileExtension is the extension of an OVF certificate, which
+	// has the signature of the manifest and the signing certificate chain.
+	CertificateFileExtension = ".cert"
+
+	// maxSignatureFileSize is the largest manifest or certificate file that
+	// is read into memory.
+	maxSignatureFileSize = 1 << 20
+)
+
+// digestLineRegexp matches the lines in an OVF manifest and the first line of
+// an OVF certificate, ex. "SHA256(photon.ovf)= 0123abcd".
+var digestLineRegexp = regexp.MustCompile(`^(SHA1|SHA256|SHA512)\((.+)\)\s*=\s*([0-9a-fA-F]+)$`)
+
+// PackageFile is a file in an OVF package.
+type PackageFile struct {
+	// Name is the name of the file.
+	Name string
+
+	// ChecksumAlgorithm and Checksum are the file's digest and its algorithm,
+	// ex. "SHA256", if they are already known, ex. from the content library.
+	// Otherwise the file is read to compute its digest.
+	ChecksumAlgorithm string
+	Checksum          string
+}
+
+// OpenFileFunc returns a reader for the content of the OVF package's file
+// with the provided name.
+type OpenFileFunc func(name string) (io.ReadCloser, error)
+
+type digestLine struct {
+	algorithm string
+	fileName  string
+	value     []byte
+}
+
+// ParseTrustAnchors returns a certificate pool with the PEM encoded
+// certificates in the provided data, ex. the data of a ConfigMap. Each value
+// may contain more than one certificate.
+func ParseTrustAnchors(data map[string]string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var count int
+	for _, k := range keys {
+		certs, err := parsePEMCertificates([]byte(data[k]))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trust anchors in %q: %w", k, err)
+		}
+		for _, c := range certs {
+			pool.AddCert(c)
+		}
+		count += len(certs)
+	}
+
+	if count == 0 {
+		return nil, errors.New("no trust anchors")
+	}
+
+	return pool, nil
+}
+
+// TrustAnchorsHash returns a hash of the trust anchors in the provided data,
+// ex. the data of a ConfigMap, so a change to the trust anchors may be
+// detected.
+func TrustAnchorsHash(data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s\x00%s\x00", k, data[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// VerifySignature verifies the signature of an OVF package with the provided
+// files. The open function is used to read the manifest, the certificate, and
+// the files whose digests are not already known.
+//
+// The signature in the certificate file must be the manifest's signature
+// from the certificate's key, the certificate must chain to one of the roots
+// and be issued for code signing, the manifest must have the digest of each
+// of the package's files other than the manifest and certificate, and each
+// digest must match the file's content.
+func VerifySignature(
+	files []PackageFile,
+	open OpenFileFunc,
+	roots *x509.CertPool) error {
+
+	ovfNames, mfNames, certNames := findSignatureFiles(files)
+	if len(mfNames) == 0 || len(certNames) == 0 {
+		return fmt.Errorf("%w: the manifest or certificate file is missing", ErrSignatureMissing)
+	}
+	if len(certNames) > 1 {
+		return fmt.Errorf("%w: more than one certificate file: %s",
+			ErrSignatureInvalid, strings.Join(certNames, ", "))
+	}
+	certName := certNames[0]
+
+	certData, err := readSignatureFile(open, certName)
+	if err != nil {
+		return err
+	}
+	sig, chain, err := parseCertificateFile(certData)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrSignatureInvalid, certName, err)
+	}
+
+	// The certificate names the manifest it signs, so the manifest does not
+	// depend on the order of the files when there is more than one.
+	mfName := sig.fileName
+	if !slices.Contains(mfNames, mfName) {
+		return fmt.Errorf("%w: %s signs %q which is not a manifest in the package",
+			ErrSignatureInvalid, certName, mfName)
+	}
+
+	ovfName, err := findOVFDescriptor(ovfNames, mfName)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSignatureInvalid, err)
+	}
+
+	mfData, err := readSignatureFile(open, mfName)
+	if err != nil {
+		return err
+	}
+
+	leaf := chain[0]
+	sigAlg, err := signatureAlgorithm(leaf, sig.algorithm)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrSignatureInvalid, certName, err)
+	}
+	if err := leaf.CheckSignature(sigAlg, mfData, sig.value); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrSignatureInvalid, mfName, err)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, c := range chain[1:] {
+		intermediates.AddCert(c)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("%w: %w", ErrSignatureUntrusted, err)
+	}
+
+	digests, err := parseManifestFile(mfData)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrSignatureInvalid, mfName, err)
+	}
+
+	// Check the manifest covers exactly the package's files before reading
+	// any of them.
+	filesByName := make(map[string]PackageFile, len(files))
+	for _, f := range files {
+		filesByName[f.Name] = f
+	}
+	inManifest := make(map[string]struct{}, len(digests))
+	for _, d := range digests {
+		if _, ok := filesByName[d.fileName]; !ok {
+			return fmt.Errorf("%w: %s has the digest of %s which is not in the package",
+				ErrSignatureInvalid, mfName, d.fileName)
+		}
+		inManifest[d.fileName] = struct{}{}
+	}
+	if _, ok := inManifest[ovfName]; !ok {
+		return fmt.Errorf("%w: %s does not have the digest of %s",
+			ErrSignatureInvalid, mfName, ovfName)
+	}
+	for _, name := range sortedFileNames(files) {
+		if name == mfName || name == certName {
+			continue
+		}
+		if _, ok := inManifest[name]; !ok {
+			return fmt.Errorf("%w: %s does not have the digest of %s",
+				ErrSignatureInvalid, mfName, name)
+		}
+	}
+
+	for _, d := range digests {
+		if err := verifyDigest(filesByName[d.fileName], d, mfName, open); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyDigest verifies the file's content matches its digest in the
+// manifest. The file's known checksum is used when it has the same algorithm
+// as the manifest, otherwise the file is read to compute its digest.
+func verifyDigest(
+	f PackageFile,
+	d digestLine,
+	mfName string,
+	open OpenFileFunc) error {
+
+	if f.Checksum != "" && strings.EqualFold(f.ChecksumAlgorithm, d.algorithm) {
+		checksum, err := hex.DecodeString(f.Checksum)
+		if err == nil {
+			if !bytes.Equal(checksum, d.value) {
+				return fmt.Errorf("%w: the digest of %s does not match %s",
+					ErrSignatureInvalid, f.Name, mfName)
+			}
+			return nil
+		}
+	}
+
+	h, err := newHash(d.algorithm)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrSignatureInvalid, mfName, err)
+	}
+
+	r, err := open(f.Name)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", f.Name, err)
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	if _, err := io.Copy(h, r); err != nil {
+		return fmt.Errorf("failed to read %s: %w", f.Name, err)
+	}
+	if !bytes.Equal(h.Sum(nil), d.value) {
+		return fmt.Errorf("%w: the digest of %s does not match %s",
+			ErrSignatureInvalid, f.Name, mfName)
+	}
+
+	return nil
+}
+
+// readSignatureFile returns the content of the manifest or certificate file.
+func readSignatureFile(open OpenFileFunc, name string) ([]byte, error) {
+	r, err := open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	data, err := io.ReadAll(io.LimitReader(r, maxSignatureFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if len(data) > maxSignatureFileSize {
+		return nil, fmt.Errorf("%w: %s is larger than %d bytes",
+			ErrSignatureInvalid, name, maxSignatureFileSize)
+	}
+	return data, nil
+}
+
+// findSignatureFiles returns the sorted names of the OVF descriptors,
+// manifests, and certificates in the package's files.
+func findSignatureFiles(files []PackageFile) (ovfNames, mfNames, certNames []string) {
+	for _, name := range sortedFileNames(files) {
+		switch strings.ToLower(path.Ext(name)) {
+		case OVFFileExtension:
+			ovfNames = append(ovfNames, name)
+		case ManifestFileExtension:
+			mfNames = append(mfNames, name)
+		case CertificateFileExtension:
+			certNames = append(certNames, name)
+		}
+	}
+	return ovfNames, mfNames, certNames
+}
+
+// findOVFDescriptor returns the name of the OVF descriptor for the manifest,
+// which is the descriptor with the same base name as the manifest, or the
+// only descriptor in the package.
+func findOVFDescriptor(ovfNames []string, mfName string) (string, error) {
+	base := strings.TrimSuffix(mfName, path.Ext(mfName))
+	for _, name := range ovfNames {
+		if strings.TrimSuffix(name, path.Ext(name)) == base {
+			return name, nil
+		}
+	}
+	switch len(ovfNames) {
+	case 0:
+		return "", errors.New("the OVF descriptor is missing")
+	case 1:
+		return ovfNames[0], nil
+	}
+	return "", fmt.Errorf("none of the OVF descriptors %s match %s",
+		strings.Join(ovfNames, ", "), mfName)
+}
+
+func sortedFileNames(files []PackageFile) []string {
+	names := make([]string, len(files))
+	for i := range files {
+		names[i] = files[i].Name
+	}
+	slices.Sort(names)
+	return names
+}
+
+// parseCertificateFile parses an OVF certificate file, which is the
+// manifest's signature on the first line followed by the PEM encoded signing
+// certificate and any intermediate certificates.
+func parseCertificateFile(data []byte) (digestLine, []*x509.Certificate, error) {
+	first, rest, _ := bytes.Cut(data, []byte("\n"))
+
+	sig, err := parseDigestLine(string(first))
+	if err != nil {
+		return digestLine{}, nil, err
+	}
+
+	chain, err := parsePEMCertificates(rest)
+	if err != nil {
+		return digestLine{}, nil, err
+	}
+	if len(chain) == 0 {
+		return digestLine{}, nil, errors.New("no certificate")
+	}
+
+	return sig, chain, nil
+}
+
+// parseManifestFile returns the digests in an OVF manifest file.
+func parseManifestFile(data []byte) ([]digestLine, error) {
+	var digests []digestLine
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		d, err := parseDigestLine(line)
+		if err != nil {
+			return nil, err
+		}
+		digests = append(digests, d)
+	}
+	return digests, nil
+}
+
+func parseDigestLine(line string) (digestLine, error) {
+	m := digestLineRegexp.FindStringSubmatch(strings.TrimSpace(line))
+	if m == nil {
+		return digestLine{}, fmt.Errorf("invalid digest line %q", line)
+	}
+	value, err := hex.DecodeString(m[3])
+	if err != nil {
+		return digestLine{}, fmt.Errorf("invalid digest line %q: %w", line, err)
+	}
+	return digestLine{
+		algorithm: m[1],
+		fileName:  m[2],
+		value:     value,
+	}, nil
+}
+
+func parsePEMCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, c)
+	}
+}
+
+// signatureAlgorithm returns the x509 signature algorithm for the
+// certificate's key and the digest algorithm. SHA1 is not supported.
+func signatureAlgorithm(
+	c *x509.Certificate,
+	digestAlgorithm string) (x509.SignatureAlgorithm, error) {
+
+	switch c.PublicKeyAlgorithm {
+	case x509.RSA:
+		switch digestAlgorithm {
+		case "SHA256":
+			return x509.SHA256WithRSA, nil
+		case "SHA512":
+			return x509.SHA512WithRSA, nil
+		}
+	case x509.ECDSA:
+		switch digestAlgorithm {
+		case "SHA256":
+			return x509.ECDSAWithSHA256, nil
+		case "SHA512":
+			return x509.ECDSAWithSHA512, nil
+		}
+	}
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf(
+		"unsupported signature algorithm %s with %s", c.PublicKeyAlgorithm, digestAlgorithm)
+}
+
+func newHash(digestAlgorithm string) (hash.Hash, error) {
+	switch digestAlgorithm {
+	case "SHA256":
+		return sha256.New(), nil
+	case "SHA512":
+		return sha512.New(), nil
+	}
+	return nil, fmt.Errorf("unsupported digest algorithm %s", digestAlgorithm)
+}
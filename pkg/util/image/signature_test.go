@@ -0,0 +1,437 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package image_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	imgutil "github.com/vmware-tanzu/vm-operator/pkg/util/image"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+	pem  string
+}
+
+func newTestCert(
+	commonName string,
+	isCA bool,
+	parent *testCA,
+	extKeyUsage ...x509.ExtKeyUsage) testCA {
+
+	if len(extKeyUsage) == 0 {
+		extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		ExtKeyUsage:           extKeyUsage,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+
+	issuer, issuerKey := tmpl, crypto.Signer(key)
+	if parent != nil {
+		issuer, issuerKey = parent.cert, parent.key
+	}
+
+	data, err := x509.CreateCertificate(rand.Reader, tmpl, issuer, &key.PublicKey, issuerKey)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(data)
+	Expect(err).ToNot(HaveOccurred())
+
+	return testCA{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: data})),
+	}
+}
+
+// signOVFPackage returns an OVF package with the provided descriptor and a
+// disk, and the package's manifest signed by the signer.
+func signOVFPackage(ovf []byte, signer testCA) map[string][]byte {
+	files := map[string][]byte{
+		"photon.ovf":        ovf,
+		"photon-disk1.vmdk": []byte("disk"),
+	}
+
+	var mf string
+	for _, name := range []string{"photon.ovf", "photon-disk1.vmdk"} {
+		digest := sha256.Sum256(files[name])
+		mf += fmt.Sprintf("SHA256(%s)= %s\n", name, hex.EncodeToString(digest[:]))
+	}
+	files["photon.mf"] = []byte(mf)
+
+	files["photon.cert"] = signManifest("photon.mf", files["photon.mf"], signer)
+
+	return files
+}
+
+func signManifest(mfName string, mf []byte, signer testCA) []byte {
+	mfDigest := sha256.Sum256(mf)
+	sig, err := signer.key.Sign(rand.Reader, mfDigest[:], crypto.SHA256)
+	Expect(err).ToNot(HaveOccurred())
+
+	return []byte(fmt.Sprintf("SHA256(%s)= %s\n%s", mfName, hex.EncodeToString(sig), signer.pem))
+}
+
+// packageFiles returns the package's files without their checksums, and a
+// function that opens the files and records which files were opened.
+func packageFiles(
+	files map[string][]byte,
+	opened *[]string) ([]imgutil.PackageFile, imgutil.OpenFileFunc) {
+
+	var pkgFiles []imgutil.PackageFile
+	for name := range files {
+		pkgFiles = append(pkgFiles, imgutil.PackageFile{Name: name})
+	}
+
+	return pkgFiles, func(name string) (io.ReadCloser, error) {
+		data, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("%s not found", name)
+		}
+		*opened = append(*opened, name)
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+}
+
+var _ = Describe("ParseTrustAnchors", func() {
+
+	It("should return the certificates in each value", func() {
+		ca1 := newTestCert("ca1", true, nil)
+		ca2 := newTestCert("ca2", true, nil)
+		ca3 := newTestCert("ca3", true, nil)
+
+		pool, err := imgutil.ParseTrustAnchors(map[string]string{
+			"a.pem": ca1.pem + ca2.pem,
+			"b.pem": ca3.pem,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.Equal(func() *x509.CertPool {
+			p := x509.NewCertPool()
+			p.AddCert(ca1.cert)
+			p.AddCert(ca2.cert)
+			p.AddCert(ca3.cert)
+			return p
+		}())).To(BeTrue())
+	})
+
+	It("should return an error when there are no certificates", func() {
+		_, err := imgutil.ParseTrustAnchors(map[string]string{"a.pem": "hello"})
+		Expect(err).To(MatchError("no trust anchors"))
+	})
+
+	It("should return an error when a certificate is malformed", func() {
+		data := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("hello")}))
+		_, err := imgutil.ParseTrustAnchors(map[string]string{"a.pem": data})
+		Expect(err).To(MatchError(ContainSubstring(`failed to parse trust anchors in "a.pem"`)))
+	})
+})
+
+var _ = Describe("TrustAnchorsHash", func() {
+
+	It("should return the same hash for the same data", func() {
+		Expect(imgutil.TrustAnchorsHash(map[string]string{"a.pem": "a", "b.pem": "b"})).To(
+			Equal(imgutil.TrustAnchorsHash(map[string]string{"b.pem": "b", "a.pem": "a"})))
+	})
+
+	It("should return a different hash when the data changes", func() {
+		Expect(imgutil.TrustAnchorsHash(map[string]string{"a.pem": "a"})).ToNot(
+			Equal(imgutil.TrustAnchorsHash(map[string]string{"a.pem": "b"})))
+		Expect(imgutil.TrustAnchorsHash(map[string]string{"a.pem": "a"})).ToNot(
+			Equal(imgutil.TrustAnchorsHash(map[string]string{"b.pem": "a"})))
+	})
+})
+
+var _ = Describe("VerifySignature", func() {
+
+	var (
+		root     testCA
+		inter    testCA
+		signer   testCA
+		roots    *x509.CertPool
+		files    map[string][]byte
+		pkgFiles []imgutil.PackageFile
+		opened   []string
+		err      error
+	)
+
+	BeforeEach(func() {
+		root = newTestCert("root", true, nil)
+		inter = newTestCert("intermediate", true, &root)
+		signer = newTestCert("signer", false, &inter)
+
+		roots = x509.NewCertPool()
+		roots.AddCert(root.cert)
+
+		files = signOVFPackage([]byte("<Envelope/>"), signer)
+		files["photon.cert"] = append(files["photon.cert"], inter.pem...)
+
+		pkgFiles = nil
+		opened = nil
+	})
+
+	JustBeforeEach(func() {
+		var open imgutil.OpenFileFunc
+		if pkgFiles == nil {
+			pkgFiles, open = packageFiles(files, &opened)
+		} else {
+			_, open = packageFiles(files, &opened)
+		}
+		err = imgutil.VerifySignature(pkgFiles, open, roots)
+	})
+
+	It("should verify a package signed by a trusted certificate", func() {
+		Expect(err).ToNot(HaveOccurred())
+		Expect(opened).To(ConsistOf("photon.cert", "photon.mf", "photon.ovf", "photon-disk1.vmdk"))
+	})
+
+	When("the checksums of the files are known", func() {
+		BeforeEach(func() {
+			for _, name := range []string{"photon.ovf", "photon-disk1.vmdk"} {
+				digest := sha256.Sum256(files[name])
+				pkgFiles = append(pkgFiles, imgutil.PackageFile{
+					Name:              name,
+					ChecksumAlgorithm: "SHA256",
+					Checksum:          hex.EncodeToString(digest[:]),
+				})
+			}
+			pkgFiles = append(pkgFiles,
+				imgutil.PackageFile{Name: "photon.mf"},
+				imgutil.PackageFile{Name: "photon.cert"})
+		})
+
+		It("should not read the files", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(opened).To(ConsistOf("photon.cert", "photon.mf"))
+		})
+
+		When("a known checksum does not match the manifest", func() {
+			BeforeEach(func() {
+				pkgFiles[1].Checksum = hex.EncodeToString(make([]byte, 32))
+			})
+			It("should return ErrSignatureInvalid", func() {
+				Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+				Expect(err).To(MatchError(ContainSubstring("the digest of photon-disk1.vmdk does not match photon.mf")))
+			})
+		})
+
+		When("a known checksum uses a different algorithm than the manifest", func() {
+			BeforeEach(func() {
+				pkgFiles[1].ChecksumAlgorithm = "SHA1"
+				pkgFiles[1].Checksum = hex.EncodeToString(make([]byte, 20))
+			})
+			It("should read the file", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(opened).To(ConsistOf("photon.cert", "photon.mf", "photon-disk1.vmdk"))
+			})
+		})
+	})
+
+	When("the certificate file is missing", func() {
+		BeforeEach(func() {
+			delete(files, "photon.cert")
+		})
+		It("should return ErrSignatureMissing", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureMissing))
+		})
+	})
+
+	When("the manifest file is missing", func() {
+		BeforeEach(func() {
+			delete(files, "photon.mf")
+		})
+		It("should return ErrSignatureMissing", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureMissing))
+		})
+	})
+
+	When("the OVF descriptor was modified", func() {
+		BeforeEach(func() {
+			files["photon.ovf"] = []byte("<Envelope></Envelope>")
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+			Expect(err).To(MatchError(ContainSubstring("the digest of photon.ovf does not match photon.mf")))
+		})
+	})
+
+	When("a disk was modified", func() {
+		BeforeEach(func() {
+			files["photon-disk1.vmdk"] = []byte("modified")
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+			Expect(err).To(MatchError(ContainSubstring("the digest of photon-disk1.vmdk does not match photon.mf")))
+		})
+	})
+
+	When("the package has a file that is not in the manifest", func() {
+		BeforeEach(func() {
+			files["extra.iso"] = []byte("iso")
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+			Expect(err).To(MatchError(ContainSubstring("photon.mf does not have the digest of extra.iso")))
+		})
+	})
+
+	When("the manifest has the digest of a file that is not in the package", func() {
+		BeforeEach(func() {
+			delete(files, "photon-disk1.vmdk")
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+			Expect(err).To(MatchError(ContainSubstring("photon.mf has the digest of photon-disk1.vmdk which is not in the package")))
+		})
+	})
+
+	When("the manifest was modified", func() {
+		BeforeEach(func() {
+			files["photon.mf"] = append(files["photon.mf"], "SHA256(extra.iso)= 00\n"...)
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+		})
+	})
+
+	When("the package has more than one OVF descriptor and manifest", func() {
+		BeforeEach(func() {
+			// The certificate signs photon.mf, so the other manifest and
+			// descriptor are not used regardless of the order of the files.
+			files["another.ovf"] = []byte("<Envelope/>")
+			files["another.mf"] = []byte("SHA256(another.ovf)= 00\n")
+
+			digest := sha256.Sum256(files["another.ovf"])
+			files["photon.mf"] = append(files["photon.mf"],
+				fmt.Sprintf("SHA256(another.ovf)= %s\nSHA256(another.mf)= %s\n",
+					hex.EncodeToString(digest[:]),
+					func() string {
+						d := sha256.Sum256(files["another.mf"])
+						return hex.EncodeToString(d[:])
+					}())...)
+			files["photon.cert"] = append(signManifest("photon.mf", files["photon.mf"], signer), inter.pem...)
+		})
+		It("should verify the manifest signed by the certificate", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the package has more than one OVF descriptor and none match the manifest", func() {
+		BeforeEach(func() {
+			files["a.ovf"] = files["photon.ovf"]
+			files["b.ovf"] = files["photon.ovf"]
+			delete(files, "photon.ovf")
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+			Expect(err).To(MatchError(ContainSubstring("none of the OVF descriptors a.ovf, b.ovf match photon.mf")))
+		})
+	})
+
+	When("the package has more than one certificate", func() {
+		BeforeEach(func() {
+			files["another.cert"] = files["photon.cert"]
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+			Expect(err).To(MatchError(ContainSubstring("more than one certificate file: another.cert, photon.cert")))
+		})
+	})
+
+	When("the certificate signs a file that is not a manifest", func() {
+		BeforeEach(func() {
+			files["photon.cert"] = append(signManifest("photon.ovf", files["photon.mf"], signer), inter.pem...)
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+			Expect(err).To(MatchError(ContainSubstring(`photon.cert signs "photon.ovf" which is not a manifest in the package`)))
+		})
+	})
+
+	When("the manifest uses SHA1", func() {
+		BeforeEach(func() {
+			files["photon.cert"] = []byte("SHA1(photon.mf)= 00\n" + signer.pem)
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+			Expect(err).To(MatchError(ContainSubstring("unsupported signature algorithm")))
+		})
+	})
+
+	When("the certificate file does not have a certificate", func() {
+		BeforeEach(func() {
+			files["photon.cert"] = []byte("SHA256(photon.mf)= 00\n")
+		})
+		It("should return ErrSignatureInvalid", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureInvalid))
+		})
+	})
+
+	When("the intermediate certificate is missing", func() {
+		BeforeEach(func() {
+			files = signOVFPackage([]byte("<Envelope/>"), signer)
+		})
+		It("should return ErrSignatureUntrusted", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureUntrusted))
+		})
+	})
+
+	When("the package is signed by an untrusted certificate", func() {
+		BeforeEach(func() {
+			files = signOVFPackage([]byte("<Envelope/>"), newTestCert("self-signed", false, nil))
+		})
+		It("should return ErrSignatureUntrusted", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureUntrusted))
+		})
+	})
+
+	When("the signing certificate is not issued for code signing", func() {
+		BeforeEach(func() {
+			signer = newTestCert("signer", false, &inter, x509.ExtKeyUsageServerAuth)
+			files = signOVFPackage([]byte("<Envelope/>"), signer)
+			files["photon.cert"] = append(files["photon.cert"], inter.pem...)
+		})
+		It("should return ErrSignatureUntrusted", func() {
+			Expect(err).To(MatchError(imgutil.ErrSignatureUntrusted))
+		})
+	})
+
+	When("a file cannot be read", func() {
+		BeforeEach(func() {
+			pkgFiles, _ = packageFiles(files, &opened)
+			delete(files, "photon-disk1.vmdk")
+		})
+		It("should return an error that is not a signature error", func() {
+			Expect(err).To(MatchError(ContainSubstring("failed to read photon-disk1.vmdk")))
+			Expect(err).ToNot(MatchError(imgutil.ErrSignatureInvalid))
+		})
+	})
+})
@@ -18,6 +18,14 @@ type Network = schema.NetworkConfig
 
 type Ethernet = schema.EthernetConfig
 
+type VLAN = schema.VLANConfig
+
+type Bond = schema.BondConfig
+
+type BondParameters = schema.BondParameters
+
+type BondMode = schema.BondMode
+
 type Match = schema.MatchConfig
 
 type Nameserver = schema.NameserverConfig
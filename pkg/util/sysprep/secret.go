@@ -16,8 +16,18 @@ import (
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 )
 
+const (
+	// DefaultDomainJoinUsernameKey is the default key in the domain join
+	// credentials Secret for the domain user account.
+	DefaultDomainJoinUsernameKey = "username"
+
+	// DefaultDomainJoinPasswordKey is the default key in the domain join
+	// credentials Secret for the domain user account's password.
+	DefaultDomainJoinPasswordKey = "password"
+)
+
 type SecretData struct {
-	ProductID, Password, DomainPassword, ScriptText string
+	ProductID, Password, DomainUsername, DomainPassword, ScriptText string
 }
 
 func getSysprepSecretsImpl(
@@ -78,6 +88,25 @@ func getSysprepSecretsImpl(
 				return SecretData{}, nil, err
 			}
 		}
+
+		if dj := identification.DomainJoin; dj != nil {
+			creds := dj.Credentials
+			usernameKey := creds.UsernameKey
+			if usernameKey == "" {
+				usernameKey = DefaultDomainJoinUsernameKey
+			}
+			passwordKey := creds.PasswordKey
+			if passwordKey == "" {
+				passwordKey = DefaultDomainJoinPasswordKey
+			}
+
+			if err := getSecret(creds.Name, usernameKey, &secretData.DomainUsername); err != nil {
+				return SecretData{}, nil, err
+			}
+			if err := getSecret(creds.Name, passwordKey, &secretData.DomainPassword); err != nil {
+				return SecretData{}, nil, err
+			}
+		}
 	}
 
 	if scriptText := in.ScriptText; scriptText != nil {
@@ -230,6 +230,76 @@ var _ = Describe("GetSysprepSecretData", func() {
 		})
 	})
 
+	Context("for Identification.DomainJoin", func() {
+		credsSecretName := "domain_join_secret"
+
+		BeforeEach(func() {
+			inlineSysprep = vmopv1sysprep.Sysprep{
+				Identification: &vmopv1sysprep.Identification{
+					DomainJoin: &vmopv1sysprep.DomainJoin{
+						Credentials: vmopv1sysprep.DomainJoinCredentialsSecretKeySelector{
+							Name: credsSecretName,
+						},
+					},
+				},
+			}
+		})
+
+		When("secret is present", func() {
+			BeforeEach(func() {
+				initialObjects = append(initialObjects, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      credsSecretName,
+						Namespace: secretNamespace,
+					},
+					Data: map[string][]byte{
+						"username": []byte("joiner"),
+						"password": []byte("foo_bar_fizz123"),
+						"user":     []byte("other-joiner"),
+						"pass":     []byte("other_foo_bar"),
+					},
+				})
+			})
+
+			It("returns success with the default keys", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(sysprepSecretData.DomainUsername).To(Equal("joiner"))
+				Expect(sysprepSecretData.DomainPassword).To(Equal("foo_bar_fizz123"))
+			})
+
+			When("keys are specified", func() {
+				BeforeEach(func() {
+					inlineSysprep.Identification.DomainJoin.Credentials.UsernameKey = "user"
+					inlineSysprep.Identification.DomainJoin.Credentials.PasswordKey = "pass"
+				})
+
+				It("returns success", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(sysprepSecretData.DomainUsername).To(Equal("other-joiner"))
+					Expect(sysprepSecretData.DomainPassword).To(Equal("other_foo_bar"))
+				})
+			})
+
+			When("key from selector is not present", func() {
+				BeforeEach(func() {
+					inlineSysprep.Identification.DomainJoin.Credentials.PasswordKey = anotherKey
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal(fmt.Sprintf(`no data found for key "%s" for secret default/%s`, anotherKey, credsSecretName)))
+				})
+			})
+		})
+
+		When("secret is not present", func() {
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal(fmt.Sprintf(`secrets "%s" not found`, credsSecretName)))
+			})
+		})
+	})
+
 	Context("for ScriptText", func() {
 		scriptSecretName := "script-text-secret"
 
@@ -0,0 +1,70 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cron"
+)
+
+// GetMaintenanceWindow returns the maintenance window that applies to the VM.
+// The VM's spec.maintenanceWindow takes precedence over the window described
+// by the annotations on the VM's namespace. A nil window is returned if
+// neither the VM nor its namespace specify a maintenance window.
+func GetMaintenanceWindow(
+	ctx context.Context,
+	k8sClient ctrlclient.Client,
+	vm vmopv1.VirtualMachine) (*cron.Window, error) {
+
+	if mw := vm.Spec.MaintenanceWindow; mw != nil {
+		w, err := cron.ParseWindow(mw.Schedule, mw.Duration.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spec.maintenanceWindow: %w", err)
+		}
+		return &w, nil
+	}
+
+	var ns corev1.Namespace
+	if err := k8sClient.Get(
+		ctx,
+		ctrlclient.ObjectKey{Name: vm.Namespace},
+		&ns); err != nil {
+
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"failed to get namespace %s: %w", vm.Namespace, err)
+	}
+
+	schedule := ns.Annotations[vmopv1.MaintenanceWindowScheduleAnnotation]
+	duration := ns.Annotations[vmopv1.MaintenanceWindowDurationAnnotation]
+	if schedule == "" || duration == "" {
+		return nil, nil
+	}
+
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"invalid namespace annotation %s: %w",
+			vmopv1.MaintenanceWindowDurationAnnotation, err)
+	}
+
+	w, err := cron.ParseWindow(schedule, d)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"invalid namespace maintenance window: %w", err)
+	}
+
+	return &w, nil
+}
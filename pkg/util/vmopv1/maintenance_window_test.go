@@ -0,0 +1,141 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cron"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+var _ = Describe("GetMaintenanceWindow", func() {
+
+	const namespace = "my-namespace"
+
+	var (
+		client  ctrlclient.Client
+		ns      *corev1.Namespace
+		vm      *vmopv1.VirtualMachine
+		window  *cron.Window
+		err     error
+		startAt = time.Date(2026, 10, 24, 2, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        namespace,
+				Annotations: map[string]string{},
+			},
+		}
+		vm = builder.DummyVirtualMachine()
+		vm.Namespace = namespace
+	})
+
+	JustBeforeEach(func() {
+		client = fake.NewClientBuilder().
+			WithScheme(builder.NewScheme()).
+			WithObjects(ns).
+			Build()
+		window, err = vmopv1util.GetMaintenanceWindow(context.Background(), client, *vm)
+	})
+
+	When("neither the VM nor the namespace specify a window", func() {
+		It("returns nil", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(window).To(BeNil())
+		})
+	})
+
+	When("the VM specifies a window", func() {
+		BeforeEach(func() {
+			vm.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+				Schedule: "0 2 * * 6",
+				Duration: metav1.Duration{Duration: 4 * time.Hour},
+			}
+			ns.Annotations[vmopv1.MaintenanceWindowScheduleAnnotation] = "0 3 * * *"
+			ns.Annotations[vmopv1.MaintenanceWindowDurationAnnotation] = "1h"
+		})
+
+		It("returns the VM's window", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(window).ToNot(BeNil())
+			open, start := window.Open(startAt.Add(3 * time.Hour))
+			Expect(open).To(BeTrue())
+			Expect(start).To(Equal(startAt))
+		})
+
+		When("the window is invalid", func() {
+			BeforeEach(func() {
+				vm.Spec.MaintenanceWindow.Duration.Duration = 0
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(window).To(BeNil())
+			})
+		})
+	})
+
+	When("the namespace specifies a window", func() {
+		BeforeEach(func() {
+			ns.Annotations[vmopv1.MaintenanceWindowScheduleAnnotation] = "0 2 * * 6"
+			ns.Annotations[vmopv1.MaintenanceWindowDurationAnnotation] = "4h"
+		})
+
+		It("returns the namespace's window", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(window).ToNot(BeNil())
+			open, start := window.Open(startAt.Add(-time.Minute))
+			Expect(open).To(BeFalse())
+			Expect(start).To(Equal(startAt))
+		})
+
+		When("the duration is missing", func() {
+			BeforeEach(func() {
+				delete(ns.Annotations, vmopv1.MaintenanceWindowDurationAnnotation)
+			})
+
+			It("returns nil", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(window).To(BeNil())
+			})
+		})
+
+		When("the duration is invalid", func() {
+			BeforeEach(func() {
+				ns.Annotations[vmopv1.MaintenanceWindowDurationAnnotation] = "four hours"
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(window).To(BeNil())
+			})
+		})
+
+		When("the schedule is invalid", func() {
+			BeforeEach(func() {
+				ns.Annotations[vmopv1.MaintenanceWindowScheduleAnnotation] = "0 2 * *"
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(window).To(BeNil())
+			})
+		})
+	})
+})
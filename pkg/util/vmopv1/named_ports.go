@@ -0,0 +1,109 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+)
+
+// NamedPort is a port declared by a VM with the NamedPortsAnnotation.
+type NamedPort struct {
+	Name     string
+	Port     int32
+	Protocol corev1.Protocol
+}
+
+// ParseNamedPorts parses the value of the NamedPortsAnnotation, a
+// comma-separated list of name=port[/protocol] entries. The protocol defaults
+// to TCP.
+func ParseNamedPorts(value string) ([]NamedPort, error) {
+	var (
+		namedPorts []NamedPort
+		seen       = map[NamedPort]struct{}{}
+	)
+
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, portAndProto, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid named port %q: must be name=port[/protocol]", entry)
+		}
+
+		name = strings.TrimSpace(name)
+		if errs := validation.IsValidPortName(name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid named port %q: %s", entry, strings.Join(errs, ", "))
+		}
+
+		portStr, proto, hasProto := strings.Cut(strings.TrimSpace(portAndProto), "/")
+		port, err := strconv.ParseInt(portStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid named port %q: %w", entry, err)
+		}
+		if errs := validation.IsValidPortNum(int(port)); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid named port %q: %s", entry, strings.Join(errs, ", "))
+		}
+
+		protocol := corev1.ProtocolTCP
+		if hasProto {
+			protocol = corev1.Protocol(strings.ToUpper(proto))
+			switch protocol {
+			case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+			default:
+				return nil, fmt.Errorf("invalid named port %q: unsupported protocol %q", entry, proto)
+			}
+		}
+
+		key := NamedPort{Name: name, Protocol: protocol}
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("duplicate named port %s/%s", name, protocol)
+		}
+		seen[key] = struct{}{}
+
+		namedPorts = append(namedPorts, NamedPort{
+			Name:     name,
+			Port:     int32(port),
+			Protocol: protocol,
+		})
+	}
+
+	return namedPorts, nil
+}
+
+// GetNamedPort returns the number of the port the VM declares with the given
+// name and protocol using the NamedPortsAnnotation.
+func GetNamedPort(
+	vm vmopv1.VirtualMachine,
+	name string,
+	protocol corev1.Protocol) (int32, error) {
+
+	value, ok := vm.Annotations[vmopv1.NamedPortsAnnotation]
+	if !ok {
+		return 0, fmt.Errorf("vm does not declare any named ports")
+	}
+
+	namedPorts, err := ParseNamedPorts(value)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, np := range namedPorts {
+		if np.Name == name && np.Protocol == protocol {
+			return np.Port, nil
+		}
+	}
+
+	return 0, fmt.Errorf("vm does not declare named port %s/%s", name, protocol)
+}
@@ -0,0 +1,73 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package vmopv1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
+)
+
+var _ = DescribeTable("ParseNamedPorts",
+	func(value string, expected []vmopv1util.NamedPort, expectedErr string) {
+		namedPorts, err := vmopv1util.ParseNamedPorts(value)
+		if expectedErr != "" {
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(namedPorts).To(Equal(expected))
+	},
+	Entry("empty", "", nil, ""),
+	Entry("default protocol", "http=8080", []vmopv1util.NamedPort{
+		{Name: "http", Port: 8080, Protocol: corev1.ProtocolTCP},
+	}, ""),
+	Entry("multiple ports", "http=8080, dns=53/udp,dns=53/TCP", []vmopv1util.NamedPort{
+		{Name: "http", Port: 8080, Protocol: corev1.ProtocolTCP},
+		{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
+		{Name: "dns", Port: 53, Protocol: corev1.ProtocolTCP},
+	}, ""),
+	Entry("missing port", "http", nil, `invalid named port "http": must be name=port[/protocol]`),
+	Entry("invalid name", "HTTP_1=80", nil, `invalid named port "HTTP_1=80"`),
+	Entry("invalid port", "http=80000", nil, `invalid named port "http=80000"`),
+	Entry("invalid protocol", "http=80/ICMP", nil, `unsupported protocol "ICMP"`),
+	Entry("duplicate port", "http=80,http=8080", nil, "duplicate named port http/TCP"),
+)
+
+var _ = Describe("GetNamedPort", func() {
+	var vm vmopv1.VirtualMachine
+
+	BeforeEach(func() {
+		vm = vmopv1.VirtualMachine{}
+		vm.Annotations = map[string]string{
+			vmopv1.NamedPortsAnnotation: "http=8080,dns=53/UDP",
+		}
+	})
+
+	It("returns the port with the name and protocol", func() {
+		port, err := vmopv1util.GetNamedPort(vm, "http", corev1.ProtocolTCP)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(int32(8080)))
+
+		port, err = vmopv1util.GetNamedPort(vm, "dns", corev1.ProtocolUDP)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(port).To(Equal(int32(53)))
+	})
+
+	It("returns an error when the protocol does not match", func() {
+		_, err := vmopv1util.GetNamedPort(vm, "dns", corev1.ProtocolTCP)
+		Expect(err).To(MatchError("vm does not declare named port dns/TCP"))
+	})
+
+	It("returns an error when the VM does not declare named ports", func() {
+		vm.Annotations = nil
+		_, err := vmopv1util.GetNamedPort(vm, "http", corev1.ProtocolTCP)
+		Expect(err).To(MatchError("vm does not declare any named ports"))
+	})
+})
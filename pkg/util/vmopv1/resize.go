@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 )
 
 const (
@@ -194,3 +195,21 @@ func ResizeNeeded(
 
 	return false
 }
+
+// IsResizePending returns true if the VM's VirtualMachineClassConfigurationSynced
+// condition indicates the VM will be resized the next time it is reconfigured
+// while powered off.
+func IsResizePending(vm vmopv1.VirtualMachine) bool {
+	if !conditions.IsFalse(&vm, vmopv1.VirtualMachineClassConfigurationSynced) {
+		return false
+	}
+
+	// A change to the class itself is only applied to the VM with the opt-in
+	// annotation.
+	if conditions.GetReason(&vm, vmopv1.VirtualMachineClassConfigurationSynced) == "ClassUpdated" {
+		_, ok := vm.Annotations[vmopv1.VirtualMachineSameVMClassResizeAnnotation]
+		return ok
+	}
+
+	return true
+}
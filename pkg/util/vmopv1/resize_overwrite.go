@@ -6,8 +6,10 @@ package vmopv1
 
 import (
 	"context"
+	"math"
 
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
@@ -53,6 +55,135 @@ func OverwriteAlwaysResizeConfigSpec(
 	return nil
 }
 
+// OverwriteCPUMemoryResizeConfigSpec applies the VM's spec.hardware.cpus and
+// spec.hardware.memory overrides to the ConfigSpec. When the VM's drift policy
+// is Revert, the number of CPUs and memory from the VM's class are also
+// applied if the VM has been synced to the class. When the VM is powered on,
+// only the changes that can be hot-added are applied. True is returned if
+// there are changes that cannot be applied until the VM is power cycled.
+func OverwriteCPUMemoryResizeConfigSpec(
+	vm vmopv1.VirtualMachine,
+	vmClass *vmopv1.VirtualMachineClass,
+	ci vimtypes.VirtualMachineConfigInfo,
+	cs *vimtypes.VirtualMachineConfigSpec,
+	poweredOn bool) bool {
+
+	var cpus *int64
+	var memory *resource.Quantity
+
+	if vm.Spec.DriftPolicy == vmopv1.VirtualMachineDriftPolicyRevert {
+		cpus, memory = ExpectedCPUMemory(vm, vmClass)
+	} else if hw := vm.Spec.Hardware; hw != nil {
+		cpus, memory = hw.CPUs, hw.Memory
+	}
+
+	var pending bool
+
+	if cpus != nil {
+		desired := int32(*cpus) //nolint:gosec // disable G115
+		current := ci.Hardware.NumCPU
+
+		switch {
+		case !poweredOn:
+			overwrite(&cs.NumCPUs, desired, current)
+		case desired == current:
+			cs.NumCPUs = 0
+		case desired > current && ptr.Deref(ci.CpuHotAddEnabled),
+			desired < current && ptr.Deref(ci.CpuHotRemoveEnabled):
+			cs.NumCPUs = desired
+		default:
+			pending = true
+		}
+	}
+
+	if memory != nil {
+		desired := MemoryQuantityToMB(*memory)
+		current := int64(ci.Hardware.MemoryMB)
+
+		switch {
+		case !poweredOn:
+			overwrite(&cs.MemoryMB, desired, current)
+		case desired == current:
+			cs.MemoryMB = 0
+		case canHotAddMemory(ci, current, desired):
+			cs.MemoryMB = desired
+		default:
+			pending = true
+		}
+	}
+
+	return pending
+}
+
+// ExpectedCPUMemory returns the number of CPUs and memory the VM is expected
+// to have based on its spec and class. The spec.hardware.cpus and
+// spec.hardware.memory overrides take precedence over the values from the
+// class. The values from the class are only returned if the VM was last
+// resized to the current version of its class, otherwise nil is returned
+// since the VM may not yet reflect its class.
+func ExpectedCPUMemory(
+	vm vmopv1.VirtualMachine,
+	vmClass *vmopv1.VirtualMachineClass) (*int64, *resource.Quantity) {
+
+	var (
+		cpus   *int64
+		memory *resource.Quantity
+	)
+
+	if hw := vm.Spec.Hardware; hw != nil {
+		cpus, memory = hw.CPUs, hw.Memory
+	}
+
+	if vmClass != nil && vmClass.Name != "" && vmClass.Name == vm.Spec.ClassName {
+		if lra, ok := getLastResizeAnnotation(vm); ok &&
+			lra.Name == vmClass.Name &&
+			lra.UID == vmClass.UID &&
+			lra.Generation == vmClass.Generation {
+
+			if cpus == nil && vmClass.Spec.Hardware.Cpus > 0 {
+				cpus = ptr.To(vmClass.Spec.Hardware.Cpus)
+			}
+			if memory == nil && !vmClass.Spec.Hardware.Memory.IsZero() {
+				memory = ptr.To(vmClass.Spec.Hardware.Memory)
+			}
+		}
+	}
+
+	return cpus, memory
+}
+
+// IsCPUMemoryResizePending returns true if the VM's spec.hardware.cpus or
+// spec.hardware.memory overrides cannot be applied to the powered on VM.
+func IsCPUMemoryResizePending(
+	vm vmopv1.VirtualMachine,
+	ci vimtypes.VirtualMachineConfigInfo) bool {
+
+	var cs vimtypes.VirtualMachineConfigSpec
+	return OverwriteCPUMemoryResizeConfigSpec(vm, nil, ci, &cs, true)
+}
+
+func canHotAddMemory(
+	ci vimtypes.VirtualMachineConfigInfo,
+	current, desired int64) bool {
+
+	// Memory cannot be hot-removed.
+	if desired < current || !ptr.Deref(ci.MemoryHotAddEnabled) {
+		return false
+	}
+	if l := ci.HotPlugMemoryLimit; l > 0 && desired > l {
+		return false
+	}
+	if i := ci.HotPlugMemoryIncrementSize; i > 0 && (desired-current)%i != 0 {
+		return false
+	}
+	return true
+}
+
+// MemoryQuantityToMB returns the given memory quantity in MiB, rounded up.
+func MemoryQuantityToMB(q resource.Quantity) int64 {
+	return int64(math.Ceil(float64(q.Value()) / float64(1024*1024)))
+}
+
 func overwriteGuestID(
 	vm vmopv1.VirtualMachine,
 	ci vimtypes.VirtualMachineConfigInfo,
@@ -13,10 +13,12 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/constants"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
@@ -425,3 +427,249 @@ var _ = Describe("OverwriteResizeConfigSpec", func() {
 		})
 	})
 })
+
+var _ = Describe("OverwriteCPUMemoryResizeConfigSpec", func() {
+
+	type ConfigSpec = vimtypes.VirtualMachineConfigSpec
+	type ConfigInfo = vimtypes.VirtualMachineConfigInfo
+
+	truePtr := vimtypes.NewBool(true)
+
+	vmHardware := func(cpus int64, memory string) vmopv1.VirtualMachine {
+		vm := builder.DummyVirtualMachine()
+		vm.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{}
+		if cpus > 0 {
+			vm.Spec.Hardware.CPUs = &cpus
+		}
+		if memory != "" {
+			q := resource.MustParse(memory)
+			vm.Spec.Hardware.Memory = &q
+		}
+		return *vm
+	}
+
+	configInfo := func(numCPU, memoryMB int32) ConfigInfo {
+		return ConfigInfo{
+			Hardware: vimtypes.VirtualHardware{
+				NumCPU:   numCPU,
+				MemoryMB: memoryMB,
+			},
+		}
+	}
+
+	withHotAdd := func(ci ConfigInfo) ConfigInfo {
+		ci.CpuHotAddEnabled = truePtr
+		ci.MemoryHotAddEnabled = truePtr
+		return ci
+	}
+
+	DescribeTable("CPU and memory overrides",
+		func(vm vmopv1.VirtualMachine,
+			ci ConfigInfo,
+			poweredOn bool,
+			cs, expectedCS ConfigSpec,
+			expectedPending bool) {
+
+			pending := vmopv1util.OverwriteCPUMemoryResizeConfigSpec(vm, nil, ci, &cs, poweredOn)
+			Expect(pending).To(Equal(expectedPending))
+			Expect(reflect.DeepEqual(cs, expectedCS)).To(BeTrue(), cmp.Diff(cs, expectedCS))
+		},
+
+		Entry("No overrides",
+			vmopv1.VirtualMachine{},
+			configInfo(2, 4096),
+			false,
+			ConfigSpec{NumCPUs: 4, MemoryMB: 8192},
+			ConfigSpec{NumCPUs: 4, MemoryMB: 8192},
+			false),
+		Entry("Powered off with overrides",
+			vmHardware(4, "8Gi"),
+			configInfo(2, 4096),
+			false,
+			ConfigSpec{},
+			ConfigSpec{NumCPUs: 4, MemoryMB: 8192},
+			false),
+		Entry("Powered off with overrides that take precedence over the class",
+			vmHardware(2, "4Gi"),
+			configInfo(2, 4096),
+			false,
+			ConfigSpec{NumCPUs: 8, MemoryMB: 16384},
+			ConfigSpec{},
+			false),
+		Entry("Powered on with overrides that match the VM",
+			vmHardware(2, "4Gi"),
+			configInfo(2, 4096),
+			true,
+			ConfigSpec{},
+			ConfigSpec{},
+			false),
+		Entry("Powered on without hot-add",
+			vmHardware(4, "8Gi"),
+			configInfo(2, 4096),
+			true,
+			ConfigSpec{},
+			ConfigSpec{},
+			true),
+		Entry("Powered on with hot-add",
+			vmHardware(4, "8Gi"),
+			withHotAdd(configInfo(2, 4096)),
+			true,
+			ConfigSpec{},
+			ConfigSpec{NumCPUs: 4, MemoryMB: 8192},
+			false),
+		Entry("Powered on with hot-add and decrease",
+			vmHardware(1, "2Gi"),
+			withHotAdd(configInfo(2, 4096)),
+			true,
+			ConfigSpec{},
+			ConfigSpec{},
+			true),
+		Entry("Powered on with hot-remove and CPU decrease",
+			vmHardware(1, ""),
+			func() ConfigInfo {
+				ci := configInfo(2, 4096)
+				ci.CpuHotRemoveEnabled = truePtr
+				return ci
+			}(),
+			true,
+			ConfigSpec{},
+			ConfigSpec{NumCPUs: 1},
+			false),
+		Entry("Powered on with hot-add and memory above the hot-plug limit",
+			vmHardware(0, "8Gi"),
+			func() ConfigInfo {
+				ci := withHotAdd(configInfo(2, 4096))
+				ci.HotPlugMemoryLimit = 6144
+				return ci
+			}(),
+			true,
+			ConfigSpec{},
+			ConfigSpec{},
+			true),
+		Entry("Powered on with hot-add and memory not a multiple of the hot-plug increment",
+			vmHardware(0, "5000Mi"),
+			func() ConfigInfo {
+				ci := withHotAdd(configInfo(2, 4096))
+				ci.HotPlugMemoryIncrementSize = 128
+				return ci
+			}(),
+			true,
+			ConfigSpec{},
+			ConfigSpec{},
+			true),
+	)
+
+	Context("Drift policy", func() {
+
+		var (
+			vm      vmopv1.VirtualMachine
+			vmClass *vmopv1.VirtualMachineClass
+		)
+
+		BeforeEach(func() {
+			vmClass = builder.DummyVirtualMachineClass("my-class")
+			vmClass.UID = "my-uid"
+			vmClass.Generation = 2
+			vmClass.Spec.Hardware.Cpus = 2
+			vmClass.Spec.Hardware.Memory = resource.MustParse("4Gi")
+
+			vm = *builder.DummyVirtualMachine()
+			vm.Spec.ClassName = vmClass.Name
+			vmopv1util.MustSetLastResizedAnnotation(&vm, *vmClass)
+		})
+
+		When("the policy is Report", func() {
+			It("does not revert the class values", func() {
+				var cs ConfigSpec
+				pending := vmopv1util.OverwriteCPUMemoryResizeConfigSpec(vm, vmClass, configInfo(4, 8192), &cs, false)
+				Expect(pending).To(BeFalse())
+				Expect(cs).To(Equal(ConfigSpec{}))
+			})
+		})
+
+		When("the policy is Revert", func() {
+			BeforeEach(func() {
+				vm.Spec.DriftPolicy = vmopv1.VirtualMachineDriftPolicyRevert
+			})
+
+			It("reverts the class values when powered off", func() {
+				var cs ConfigSpec
+				pending := vmopv1util.OverwriteCPUMemoryResizeConfigSpec(vm, vmClass, configInfo(4, 8192), &cs, false)
+				Expect(pending).To(BeFalse())
+				Expect(cs).To(Equal(ConfigSpec{NumCPUs: 2, MemoryMB: 4096}))
+			})
+
+			It("reports the memory decrease as pending when powered on", func() {
+				var cs ConfigSpec
+				pending := vmopv1util.OverwriteCPUMemoryResizeConfigSpec(vm, vmClass, configInfo(2, 8192), &cs, true)
+				Expect(pending).To(BeTrue())
+				Expect(cs).To(Equal(ConfigSpec{}))
+			})
+
+			It("does not revert the class values when the VM is not synced to the class", func() {
+				vmClass.Generation = 3
+				var cs ConfigSpec
+				pending := vmopv1util.OverwriteCPUMemoryResizeConfigSpec(vm, vmClass, configInfo(4, 8192), &cs, false)
+				Expect(pending).To(BeFalse())
+				Expect(cs).To(Equal(ConfigSpec{}))
+			})
+		})
+	})
+})
+
+var _ = Describe("ExpectedCPUMemory", func() {
+
+	var (
+		vm      vmopv1.VirtualMachine
+		vmClass *vmopv1.VirtualMachineClass
+	)
+
+	BeforeEach(func() {
+		vmClass = builder.DummyVirtualMachineClass("my-class")
+		vmClass.UID = "my-uid"
+		vmClass.Generation = 1
+		vmClass.Spec.Hardware.Cpus = 2
+		vmClass.Spec.Hardware.Memory = resource.MustParse("4Gi")
+
+		vm = *builder.DummyVirtualMachine()
+		vm.Spec.ClassName = vmClass.Name
+		vm.Spec.Hardware = nil
+	})
+
+	When("the VM was last resized to the class", func() {
+		BeforeEach(func() {
+			vmopv1util.MustSetLastResizedAnnotation(&vm, *vmClass)
+		})
+
+		It("returns the class values", func() {
+			cpus, memory := vmopv1util.ExpectedCPUMemory(vm, vmClass)
+			Expect(cpus).To(HaveValue(BeEquivalentTo(2)))
+			Expect(memory).To(HaveValue(Equal(resource.MustParse("4Gi"))))
+		})
+
+		It("returns the overrides when set", func() {
+			vm.Spec.Hardware = &vmopv1.VirtualMachineHardwareSpec{
+				CPUs: ptr.To[int64](4),
+			}
+			cpus, memory := vmopv1util.ExpectedCPUMemory(vm, vmClass)
+			Expect(cpus).To(HaveValue(BeEquivalentTo(4)))
+			Expect(memory).To(HaveValue(Equal(resource.MustParse("4Gi"))))
+		})
+	})
+
+	When("the VM was not last resized to the class", func() {
+		It("returns nil", func() {
+			cpus, memory := vmopv1util.ExpectedCPUMemory(vm, vmClass)
+			Expect(cpus).To(BeNil())
+			Expect(memory).To(BeNil())
+		})
+	})
+
+	When("the class is nil", func() {
+		It("returns nil", func() {
+			cpus, memory := vmopv1util.ExpectedCPUMemory(vm, nil)
+			Expect(cpus).To(BeNil())
+			Expect(memory).To(BeNil())
+		})
+	})
+})
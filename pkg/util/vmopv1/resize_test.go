@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
@@ -247,3 +248,60 @@ var _ = Describe("ResizeNeeded", func() {
 		})
 	})
 })
+
+var _ = Describe("IsResizePending", func() {
+
+	var vm *vmopv1.VirtualMachine
+
+	BeforeEach(func() {
+		vm = builder.DummyVirtualMachine()
+	})
+
+	When("the synced condition is not present", func() {
+		It("returns false", func() {
+			Expect(vmopv1util.IsResizePending(*vm)).To(BeFalse())
+		})
+	})
+
+	When("the synced condition is true", func() {
+		BeforeEach(func() {
+			conditions.MarkTrue(vm, vmopv1.VirtualMachineClassConfigurationSynced)
+		})
+
+		It("returns false", func() {
+			Expect(vmopv1util.IsResizePending(*vm)).To(BeFalse())
+		})
+	})
+
+	When("the class name changed", func() {
+		BeforeEach(func() {
+			conditions.MarkFalse(vm, vmopv1.VirtualMachineClassConfigurationSynced, "ClassNameChanged", "")
+		})
+
+		It("returns true", func() {
+			Expect(vmopv1util.IsResizePending(*vm)).To(BeTrue())
+		})
+	})
+
+	When("the class was updated", func() {
+		BeforeEach(func() {
+			conditions.MarkFalse(vm, vmopv1.VirtualMachineClassConfigurationSynced, "ClassUpdated", "")
+		})
+
+		When("same-class annotation is not present", func() {
+			It("returns false", func() {
+				Expect(vmopv1util.IsResizePending(*vm)).To(BeFalse())
+			})
+		})
+
+		When("same-class annotation is present", func() {
+			BeforeEach(func() {
+				vm.Annotations[vmopv1.VirtualMachineSameVMClassResizeAnnotation] = ""
+			})
+
+			It("returns true", func() {
+				Expect(vmopv1util.IsResizePending(*vm)).To(BeTrue())
+			})
+		})
+	})
+})
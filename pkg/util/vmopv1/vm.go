@@ -390,6 +390,153 @@ func EncryptionClassToVirtualMachineMapper(
 	}
 }
 
+// BootstrapSecretToVirtualMachineMapper returns a mapper function used to
+// enqueue reconcile requests for VMs in response to an event on a Secret
+// referenced by the VMs' bootstrap spec, ex. the Sysprep domain join
+// credentials or a CloudInit user data part, so the VMs use the Secret's new
+// data.
+//
+// Since Secrets are not cached, the object may also be the Secret's metadata.
+func BootstrapSecretToVirtualMachineMapper(
+	ctx context.Context,
+	k8sClient client.Client) handler.MapFunc {
+
+	return bootstrapResourceToVirtualMachineMapper(
+		ctx, k8sClient, "Secret", bootstrapReferencesSecret)
+}
+
+// BootstrapConfigMapToVirtualMachineMapper returns a mapper function used to
+// enqueue reconcile requests for VMs in response to an event on a ConfigMap
+// referenced by the VMs' bootstrap spec, ex. a CloudInit user data part, so
+// the VMs use the ConfigMap's new data.
+//
+// Since ConfigMaps are not cached, the object may also be the ConfigMap's
+// metadata.
+func BootstrapConfigMapToVirtualMachineMapper(
+	ctx context.Context,
+	k8sClient client.Client) handler.MapFunc {
+
+	return bootstrapResourceToVirtualMachineMapper(
+		ctx, k8sClient, "ConfigMap", bootstrapReferencesConfigMap)
+}
+
+func bootstrapResourceToVirtualMachineMapper(
+	ctx context.Context,
+	k8sClient client.Client,
+	kind string,
+	referencesFn func(*vmopv1.VirtualMachineBootstrapSpec, string) bool) handler.MapFunc {
+
+	if ctx == nil {
+		panic("context is nil")
+	}
+	if k8sClient == nil {
+		panic("k8sClient is nil")
+	}
+
+	// For a given resource, return reconcile requests for VMs that reference
+	// the resource in their bootstrap spec.
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		if ctx == nil {
+			panic("context is nil")
+		}
+		if o == nil {
+			panic("object is nil")
+		}
+
+		logger := pkglog.FromContextOrDefault(ctx).
+			WithValues("name", o.GetName(), "namespace", o.GetNamespace())
+		logger.V(4).Info("Reconciling all VMs referencing a bootstrap " + kind)
+
+		vmList := &vmopv1.VirtualMachineList{}
+		if err := k8sClient.List(
+			ctx,
+			vmList,
+			client.InNamespace(o.GetNamespace())); err != nil {
+
+			if !apierrors.IsNotFound(err) {
+				logger.Error(
+					err,
+					"Failed to list VirtualMachines for "+
+						"reconciliation due to bootstrap "+kind+" watch")
+			}
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range vmList.Items {
+			vm := vmList.Items[i]
+			if referencesFn(vm.Spec.Bootstrap, o.GetName()) {
+				requests = append(
+					requests,
+					reconcile.Request{
+						NamespacedName: client.ObjectKey{
+							Namespace: vm.Namespace,
+							Name:      vm.Name,
+						},
+					})
+			}
+		}
+
+		if len(requests) > 0 {
+			logger.V(4).Info(
+				"Reconciling VMs due to bootstrap "+kind+" watch",
+				"requests", requests)
+		}
+
+		return requests
+	}
+}
+
+// bootstrapReferencesSecret returns true if the bootstrap spec references the
+// named Secret with data that is reread when the Secret changes.
+func bootstrapReferencesSecret(
+	bootstrap *vmopv1.VirtualMachineBootstrapSpec,
+	secretName string) bool {
+
+	if bootstrap == nil {
+		return false
+	}
+
+	if sp := bootstrap.Sysprep; sp != nil && sp.Sysprep != nil {
+		if id := sp.Sysprep.Identification; id != nil && id.DomainJoin != nil {
+			if id.DomainJoin.Credentials.Name == secretName {
+				return true
+			}
+		}
+	}
+
+	if ci := bootstrap.CloudInit; ci != nil {
+		for i := range ci.UserDataParts {
+			if s := ci.UserDataParts[i].Secret; s != nil && s.Name == secretName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// bootstrapReferencesConfigMap returns true if the bootstrap spec references
+// the named ConfigMap with data that is reread when the ConfigMap changes.
+func bootstrapReferencesConfigMap(
+	bootstrap *vmopv1.VirtualMachineBootstrapSpec,
+	configMapName string) bool {
+
+	if bootstrap == nil || bootstrap.CloudInit == nil {
+		return false
+	}
+
+	for i := range bootstrap.CloudInit.UserDataParts {
+		if cm := bootstrap.CloudInit.UserDataParts[i].ConfigMap; cm != nil &&
+			cm.Name == configMapName {
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // CnsRegisterVolumeToVirtualMachineMapper returns a mapper function used to
 // enqueue reconcile requests for VMs in response to an event on the
 // CnsRegisterVolume resource.
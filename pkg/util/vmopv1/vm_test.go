@@ -25,12 +25,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
+	vmopv1sysprep "github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep"
 	byokv1 "github.com/vmware-tanzu/vm-operator/external/byok/api/v1alpha1"
 	cnsv1alpha1 "github.com/vmware-tanzu/vm-operator/external/vsphere-csi-driver/api/v1alpha1"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
 	spqutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube/spq"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	vmopv1util "github.com/vmware-tanzu/vm-operator/pkg/util/vmopv1"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
@@ -1012,6 +1015,233 @@ var _ = DescribeTable("ConvertPowerState",
 	),
 )
 
+var _ = Describe("BootstrapSecretToVirtualMachineMapper", func() {
+	const (
+		secretName    = "my-secret"
+		namespaceName = "fake"
+	)
+
+	var (
+		ctx       context.Context
+		k8sClient ctrlclient.Client
+		withObjs  []ctrlclient.Object
+		withFuncs interceptor.Funcs
+		mapFnObj  ctrlclient.Object
+		reqs      []reconcile.Request
+	)
+
+	newVM := func(name, credentialsName string) *vmopv1.VirtualMachine {
+		vm := &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      name,
+			},
+		}
+		if credentialsName != "" {
+			vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+					Sysprep: &vmopv1sysprep.Sysprep{
+						Identification: &vmopv1sysprep.Identification{
+							DomainJoin: &vmopv1sysprep.DomainJoin{
+								Credentials: vmopv1sysprep.DomainJoinCredentialsSecretKeySelector{
+									Name: credentialsName,
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		reqs = nil
+		withObjs = nil
+		withFuncs = interceptor.Funcs{}
+		ctx = context.Background()
+
+		mapFnObj = &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      secretName,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = builder.NewFakeClientWithInterceptors(withFuncs, withObjs...)
+		mapFn := vmopv1util.BootstrapSecretToVirtualMachineMapper(ctx, k8sClient)
+		Expect(mapFn).ToNot(BeNil())
+		reqs = mapFn(ctx, mapFnObj)
+	})
+
+	When("there is an error listing vms", func() {
+		BeforeEach(func() {
+			withFuncs.List = func(
+				ctx context.Context,
+				client ctrlclient.WithWatch,
+				list ctrlclient.ObjectList,
+				opts ...ctrlclient.ListOption) error {
+
+				return errors.New("fake")
+			}
+		})
+		Specify("no reconcile requests should be returned", func() {
+			Expect(reqs).To(BeEmpty())
+		})
+	})
+
+	When("there are vms that reference the secret", func() {
+		BeforeEach(func() {
+			withObjs = append(withObjs,
+				newVM("vm-1", ""),
+				newVM("vm-2", secretName+"1"),
+				newVM("vm-3", secretName),
+				&vmopv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespaceName,
+						Name:      "vm-4",
+					},
+					Spec: vmopv1.VirtualMachineSpec{
+						Bootstrap: &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+									{
+										Name: "part",
+										Secret: &vmopv1common.SecretKeySelector{
+											Name: secretName,
+											Key:  "data",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			)
+		})
+		Specify("a reconcile request should be returned for each vm", func() {
+			Expect(reqs).To(ConsistOf(
+				reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: namespaceName,
+						Name:      "vm-3",
+					},
+				},
+				reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: namespaceName,
+						Name:      "vm-4",
+					},
+				},
+			))
+		})
+	})
+})
+
+var _ = Describe("BootstrapConfigMapToVirtualMachineMapper", func() {
+	const (
+		configMapName = "my-configmap"
+		namespaceName = "fake"
+	)
+
+	var (
+		ctx       context.Context
+		k8sClient ctrlclient.Client
+		withObjs  []ctrlclient.Object
+		withFuncs interceptor.Funcs
+		mapFnObj  ctrlclient.Object
+		reqs      []reconcile.Request
+	)
+
+	newVM := func(name, partConfigMapName string) *vmopv1.VirtualMachine {
+		vm := &vmopv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      name,
+			},
+		}
+		if partConfigMapName != "" {
+			vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+				CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+					UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+						{
+							Name:  "inline",
+							Value: ptr.To("#cloud-config\n"),
+						},
+						{
+							Name: "part",
+							ConfigMap: &vmopv1common.ConfigMapKeySelector{
+								Name: partConfigMapName,
+								Key:  "data",
+							},
+						},
+					},
+				},
+			}
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		reqs = nil
+		withObjs = nil
+		withFuncs = interceptor.Funcs{}
+		ctx = context.Background()
+
+		mapFnObj = &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespaceName,
+				Name:      configMapName,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		k8sClient = builder.NewFakeClientWithInterceptors(withFuncs, withObjs...)
+		mapFn := vmopv1util.BootstrapConfigMapToVirtualMachineMapper(ctx, k8sClient)
+		Expect(mapFn).ToNot(BeNil())
+		reqs = mapFn(ctx, mapFnObj)
+	})
+
+	When("there is an error listing vms", func() {
+		BeforeEach(func() {
+			withFuncs.List = func(
+				ctx context.Context,
+				client ctrlclient.WithWatch,
+				list ctrlclient.ObjectList,
+				opts ...ctrlclient.ListOption) error {
+
+				return errors.New("fake")
+			}
+		})
+		Specify("no reconcile requests should be returned", func() {
+			Expect(reqs).To(BeEmpty())
+		})
+	})
+
+	When("there are vms that reference the configmap", func() {
+		BeforeEach(func() {
+			withObjs = append(withObjs,
+				newVM("vm-1", ""),
+				newVM("vm-2", configMapName+"1"),
+				newVM("vm-3", configMapName),
+			)
+		})
+		Specify("a reconcile request should be returned for each vm", func() {
+			Expect(reqs).To(ConsistOf(
+				reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: namespaceName,
+						Name:      "vm-3",
+					},
+				},
+			))
+		})
+	})
+})
+
 var _ = Describe("CnsRegisterVolumeToVirtualMachineMapper", func() {
 	var (
 		ctx        context.Context
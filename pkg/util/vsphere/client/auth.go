@@ -0,0 +1,112 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/sts"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+// LoginFn logs a client into vCenter.
+type LoginFn func(ctx context.Context) error
+
+// UsesCertificate returns true if the config uses a client certificate to
+// login to vCenter.
+func (c Config) UsesCertificate() bool {
+	return c.Certificate != "" && c.PrivateKey != ""
+}
+
+// newSoapLoginFn returns a function that logs the session manager into
+// vCenter using the authentication method from the config.
+func newSoapLoginFn(
+	config Config,
+	vimClient *vim25.Client,
+	sm *session.Manager) (LoginFn, error) {
+
+	if !config.UsesCertificate() {
+		userInfo := url.UserPassword(config.Username, config.Password)
+		return func(ctx context.Context) error {
+			return sm.Login(ctx, userInfo)
+		}, nil
+	}
+
+	cert, err := tls.X509KeyPair([]byte(config.Certificate), []byte(config.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+
+	return func(ctx context.Context) error {
+		signer, err := issueToken(ctx, vimClient, &cert, config.Token)
+		if err != nil {
+			return err
+		}
+		return sm.LoginByToken(vimClient.WithHeader(ctx, soap.Header{Security: signer}))
+	}, nil
+}
+
+// newRestLoginFn returns a function that logs the REST client into vCenter
+// using the authentication method from the config.
+func newRestLoginFn(
+	config Config,
+	vimClient *vim25.Client,
+	restClient *rest.Client) (LoginFn, error) {
+
+	if !config.UsesCertificate() {
+		userInfo := url.UserPassword(config.Username, config.Password)
+		return func(ctx context.Context) error {
+			return restClient.Login(ctx, userInfo)
+		}, nil
+	}
+
+	cert, err := tls.X509KeyPair([]byte(config.Certificate), []byte(config.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+
+	return func(ctx context.Context) error {
+		signer, err := issueToken(ctx, vimClient, &cert, config.Token)
+		if err != nil {
+			return err
+		}
+		return restClient.LoginByToken(restClient.WithSigner(ctx, signer))
+	}, nil
+}
+
+// issueToken requests a holder-of-key token bound to the provided certificate
+// from the vCenter Single Sign-On service. If a token is provided, it is
+// exchanged for the holder-of-key token.
+//
+// A new token is issued for each login so that a client whose session has
+// expired is able to login again after the previous token has expired.
+func issueToken(
+	ctx context.Context,
+	vimClient *vim25.Client,
+	cert *tls.Certificate,
+	token string) (*sts.Signer, error) {
+
+	stsClient, err := sts.NewClient(ctx, vimClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sts client: %w", err)
+	}
+
+	signer, err := stsClient.Issue(ctx, sts.TokenRequest{
+		Certificate: cert,
+		Token:       token,
+		Delegatable: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue holder-of-key token: %w", err)
+	}
+
+	return signer, nil
+}
@@ -34,6 +34,16 @@ type Config struct {
 	CAFilePath string
 	Insecure   bool
 	Datacenter string
+
+	// Certificate and PrivateKey are the PEM-encoded client certificate and
+	// private key of a solution user. When set, they are used to login
+	// instead of Username and Password.
+	Certificate string
+	PrivateKey  string
+
+	// Token is an optional SAML token that is exchanged for a holder-of-key
+	// token bound to Certificate.
+	Token string
 }
 
 type Client struct {
@@ -94,13 +104,29 @@ func SoapKeepAliveHandlerFn(
 	sm *session.Manager,
 	userInfo *url.Userinfo) func() error {
 
+	return SoapKeepAliveHandlerWithLoginFn(
+		ctx,
+		sc,
+		func(ctx context.Context) error {
+			return sm.Login(ctx, userInfo)
+		})
+}
+
+// SoapKeepAliveHandlerWithLoginFn is like SoapKeepAliveHandlerFn, but uses
+// the provided function to re-login the client. This allows the handler to
+// work with any of the supported authentication methods.
+func SoapKeepAliveHandlerWithLoginFn(
+	ctx context.Context,
+	sc *soap.Client,
+	login LoginFn) func() error {
+
 	log := pkglog.FromContextOrDefault(ctx).WithName("SoapKeepAliveHandlerFn")
 
 	return func() error {
 		ctx := context.Background()
 		if _, err := methods.GetCurrentTime(ctx, sc); err != nil && IsNotAuthenticatedError(err) {
 			log.Info("Re-authenticating vim client")
-			if err = sm.Login(ctx, userInfo); err != nil {
+			if err = login(ctx); err != nil {
 				if IsInvalidLogin(err) {
 					log.Error(err, "Invalid login in keepalive handler", "url", sc.URL())
 					return err
@@ -123,6 +149,22 @@ func RestKeepAliveHandlerFn(
 	c *rest.Client,
 	userInfo *url.Userinfo) func() error {
 
+	return RestKeepAliveHandlerWithLoginFn(
+		ctx,
+		c,
+		func(ctx context.Context) error {
+			return c.Login(ctx, userInfo)
+		})
+}
+
+// RestKeepAliveHandlerWithLoginFn is like RestKeepAliveHandlerFn, but uses
+// the provided function to re-login the client. This allows the handler to
+// work with any of the supported authentication methods.
+func RestKeepAliveHandlerWithLoginFn(
+	ctx context.Context,
+	c *rest.Client,
+	login LoginFn) func() error {
+
 	log := pkglog.FromContextOrDefault(ctx).WithName("RestKeepAliveHandlerFn")
 
 	return func() error {
@@ -130,7 +172,7 @@ func RestKeepAliveHandlerFn(
 		if sess, err := c.Session(ctx); err == nil && sess == nil {
 			// session is Unauthorized.
 			log.Info("Re-authenticating REST client")
-			if err = c.Login(ctx, userInfo); err != nil {
+			if err = login(ctx); err != nil {
 				log.Error(err, "Invalid login in keepalive handler", "url", c.URL())
 				return err
 			}
@@ -153,16 +195,19 @@ func newRestClient(
 	log.Info("Creating new REST Client", "VcPNID", config.Host, "VcPort", config.Port)
 	restClient := rest.NewClient(vimClient)
 
-	userInfo := url.UserPassword(config.Username, config.Password)
+	login, err := newRestLoginFn(config, vimClient, restClient)
+	if err != nil {
+		return nil, err
+	}
 
 	// Set a custom keepalive handler function
 	restClient.Transport = keepalive.NewHandlerREST(
 		restClient,
 		keepAliveIdleTime,
-		RestKeepAliveHandlerFn(ctx, restClient, userInfo))
+		RestKeepAliveHandlerWithLoginFn(ctx, restClient, login))
 
 	// Initial login. This will also start the keepalive.
-	if err := restClient.Login(ctx, userInfo); err != nil {
+	if err := login(ctx); err != nil {
 		// Log message used by VMC LINT. Refer to before making changes
 		return nil, fmt.Errorf("login failed for url: %v: %w", vimClient.URL(), err)
 	}
@@ -205,17 +250,21 @@ func NewVimClient(
 			"error setting vim client version for url: %v: %w", soapURL, err)
 	}
 
-	userInfo := url.UserPassword(config.Username, config.Password)
 	sm := session.NewManager(vimClient)
 
+	login, err := newSoapLoginFn(config, vimClient, sm)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Set a custom keepalive handler function
 	vimClient.RoundTripper = keepalive.NewHandlerSOAP(
 		soapClient,
 		keepAliveIdleTime,
-		SoapKeepAliveHandlerFn(ctx, soapClient, sm, userInfo))
+		SoapKeepAliveHandlerWithLoginFn(ctx, soapClient, login))
 
 	// Initial login. This will also start the keepalive.
-	if err = sm.Login(ctx, userInfo); err != nil {
+	if err = login(ctx); err != nil {
 		// Log message used by VMC LINT. Refer to before making changes
 		return nil, nil, fmt.Errorf(
 			"login failed for url: %v: %w", soapURL, err)
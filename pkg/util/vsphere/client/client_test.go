@@ -9,6 +9,7 @@ import (
 	"crypto/tls"
 	"net"
 	"net/url"
+	"sync/atomic"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -16,11 +17,14 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/vmware/govmomi"
-	_ "github.com/vmware/govmomi/pbm/simulator" // load PBM simulator
+	_ "github.com/vmware/govmomi/lookup/simulator" // load Lookup Service simulator
+	_ "github.com/vmware/govmomi/pbm/simulator"    // load PBM simulator
 	"github.com/vmware/govmomi/session"
 	"github.com/vmware/govmomi/session/keepalive"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/simulator/sim25"
+	"github.com/vmware/govmomi/sts"
+	_ "github.com/vmware/govmomi/sts/simulator" // load STS simulator
 	"github.com/vmware/govmomi/vapi/rest"
 	_ "github.com/vmware/govmomi/vapi/simulator" // load VAPI simulator
 	"github.com/vmware/govmomi/vim25"
@@ -28,6 +32,7 @@ import (
 
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
 const (
@@ -117,6 +122,44 @@ var _ = Describe("Client", Label(testlabels.VCSim), func() {
 			})
 		})
 
+		When("client certificate and private key are set", func() {
+			JustBeforeEach(func() {
+				crt, key, err := builder.GenerateSelfSignedCertificate("vmop-solution-user")
+				Expect(err).ToNot(HaveOccurred())
+				config.Username = ""
+				config.Password = ""
+				config.Certificate = string(crt)
+				config.PrivateKey = string(key)
+			})
+			It("should connect with a holder-of-key token", func() {
+				c, err := client.NewClient(ctx, config)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(c).ToNot(BeNil())
+
+				s, err := session.NewManager(c.VimClient()).UserSession(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(s).ToNot(BeNil())
+
+				rs, err := c.RestClient().Session(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rs).ToNot(BeNil())
+			})
+
+			When("the private key does not match the certificate", func() {
+				JustBeforeEach(func() {
+					_, key, err := builder.GenerateSelfSignedCertificate("other")
+					Expect(err).ToNot(HaveOccurred())
+					config.PrivateKey = string(key)
+				})
+				It("should fail to load the client certificate", func() {
+					c, err := client.NewClient(ctx, config)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(HavePrefix("failed to load client certificate"))
+					Expect(c).To(BeNil())
+				})
+			})
+		})
+
 		When("username and password are invalid", func() {
 			JustBeforeEach(func() {
 				config.Username = invalid
@@ -361,6 +404,65 @@ var _ = Describe("Client", Label(testlabels.VCSim), func() {
 					})
 				})
 
+				Context("and handler is called with a token login function", func() {
+					It("log back into the session with a new token", func() {
+						simulator.Test(func(ctx context.Context, c *vim25.Client) {
+							Expect(sim25.SetSessionTimeout(ctx, c, sessionIdleTimeout)).To(Succeed())
+
+							newC, err := govmomi.NewClient(ctx, c.URL(), true)
+							ExpectWithOffset(1, err).NotTo(HaveOccurred())
+							c = newC.Client
+
+							crt, key, err := builder.GenerateSelfSignedCertificate("vmop-solution-user")
+							Expect(err).NotTo(HaveOccurred())
+							cert, err := tls.X509KeyPair(crt, key)
+							Expect(err).NotTo(HaveOccurred())
+
+							m1 := session.NewManager(c)
+							var numLogins atomic.Int32
+							login := func(ctx context.Context) error {
+								numLogins.Add(1)
+								stsClient, err := sts.NewClient(ctx, c)
+								if err != nil {
+									return err
+								}
+								signer, err := stsClient.Issue(ctx, sts.TokenRequest{Certificate: &cert})
+								if err != nil {
+									return err
+								}
+								return m1.LoginByToken(c.WithHeader(ctx, soap.Header{Security: signer}))
+							}
+
+							// Orchestrator session
+							m2 := getNewSessionManager(c.URL())
+
+							// set the keepalive handler
+							c.RoundTripper = keepalive.NewHandlerSOAP(
+								c.RoundTripper,
+								keepAliveIdle,
+								client.SoapKeepAliveHandlerWithLoginFn(ctx, c.Client, login))
+
+							// Start the handler
+							Expect(login(ctx)).To(Succeed())
+							assertSoapSessionValid(ctx, m1)
+
+							// Terminate session to emulate NotAuthenticated Error
+							sess, err := m1.UserSession(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(sess).NotTo(BeNil())
+
+							By("terminating the session")
+							Expect(m2.TerminateSession(ctx, []string{sess.Key})).To(Succeed())
+
+							time.Sleep(sessionCheckPause)
+
+							// keepalive handler must have re-logged in with a new token
+							assertSoapSessionValid(ctx, m1)
+							Expect(numLogins.Load()).To(BeNumerically(">", 1))
+						})
+					})
+				})
+
 				Context("and handler is called with wrong userInfo", func() {
 					It("fails to log back into the session", func() {
 						simulator.Test(func(ctx context.Context, c *vim25.Client) {
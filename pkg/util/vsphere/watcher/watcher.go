@@ -79,6 +79,15 @@ func DefaultWatchedPropertyPaths() []string {
 	}
 }
 
+// DefaultStatsPropertyPaths returns the default set of property paths used to
+// export the statistics of VMs.
+func DefaultStatsPropertyPaths() []string {
+	return []string{
+		"layoutEx",
+		"summary.quickStats",
+	}
+}
+
 const extraConfigNamespacedNameKey = "vmservice.namespacedName"
 
 // defaultIgnoredExtraConfigKeys returns the default set of extra config keys to
@@ -243,6 +252,49 @@ type Result struct {
 	VerifiedObj any
 }
 
+// StatsResult is sent to a StatsFn when the statistics of a VM are updated.
+type StatsResult struct {
+	// Namespace is the namespace to which the VirtualMachine resource belongs.
+	// This field is empty when Removed is true.
+	Namespace string
+
+	// Name is the name of the VirtualMachine resource. This field is empty when
+	// Removed is true.
+	Name string
+
+	// Ref is the ManagedObjectReference for the VM in vSphere.
+	Ref moRef
+
+	// VCenter is the PNID of the vCenter with the VM. It is empty for the
+	// vCenter from the provider ConfigMap. Since a ManagedObjectReference is
+	// only unique within a vCenter, a VM is identified by both VCenter and
+	// Ref.
+	VCenter string
+
+	// Removed is true when the VM is no longer being watched.
+	Removed bool
+
+	// Props contains the updated values of the stats property paths.
+	Props map[string]any
+}
+
+// StatsFn is invoked when the statistics of a VM are updated.
+type StatsFn func(ctx context.Context, result StatsResult)
+
+// StatsOptions are used to configure the watcher to also receive the
+// statistics of VMs. Changes to the stats property paths never cause a Result
+// to be sent, as they would otherwise cause the VMs to be reconciled
+// constantly.
+type StatsOptions struct {
+	// PropertyPaths are the stats property paths. If nil,
+	// DefaultStatsPropertyPaths will be used. Paths that are also watched
+	// property paths are ignored.
+	PropertyPaths []string
+
+	// Fn is invoked when the statistics of a VM are updated.
+	Fn StatsFn
+}
+
 type Watcher struct {
 	err        error
 	errMu      sync.RWMutex
@@ -252,6 +304,13 @@ type Watcher struct {
 
 	client *vim25.Client
 
+	// vcPNID is the PNID of the vCenter being watched. It is empty for the
+	// default vCenter.
+	vcPNID string
+
+	// vms is the set of VMs in the scope of the property filter.
+	vms map[moRef]struct{}
+
 	pc *property.Collector
 	pf *property.Filter
 	vm *view.Manager
@@ -269,6 +328,9 @@ type Watcher struct {
 	ignoredExtraConfigKeys map[string]struct{}
 	lookupNamespacedName   lookupNamespacedNameFn
 
+	statsPropertyPaths map[string]struct{}
+	statsFn            StatsFn
+
 	closeOnce sync.Once
 }
 
@@ -302,7 +364,8 @@ func newWatcher(
 	watchedPropertyPaths []string,
 	additionalIgnoredExtraConfigKeys []string,
 	lookupNamespacedName lookupNamespacedNameFn,
-	containerRefsWithIDs map[moRef][]string) (*Watcher, error) {
+	containerRefsWithIDs map[moRef][]string,
+	stats *StatsOptions) (*Watcher, error) {
 
 	if watchedPropertyPaths == nil {
 		watchedPropertyPaths = DefaultWatchedPropertyPaths()
@@ -311,6 +374,27 @@ func newWatcher(
 		defaultIgnoredExtraConfigKeys,
 		additionalIgnoredExtraConfigKeys)
 
+	var (
+		statsFn             StatsFn
+		statsPropertyPaths  []string
+		filterPropertyPaths = watchedPropertyPaths
+	)
+	if stats != nil && stats.Fn != nil {
+		statsFn = stats.Fn
+		statsPropertyPaths = stats.PropertyPaths
+		if statsPropertyPaths == nil {
+			statsPropertyPaths = DefaultStatsPropertyPaths()
+		}
+		statsPropertyPaths = slices.DeleteFunc(
+			slices.Clone(statsPropertyPaths),
+			func(p string) bool {
+				return slices.Contains(watchedPropertyPaths, p)
+			})
+		filterPropertyPaths = slices.Concat(
+			watchedPropertyPaths,
+			statsPropertyPaths)
+	}
+
 	// Get the view manager.
 	vm := view.NewManager(client)
 
@@ -337,7 +421,7 @@ func newWatcher(
 	// Create a new property filter that uses the list view created up above.
 	pf, err := pc.CreateFilter(
 		ctx,
-		viewToVM(lv.Reference(), watchedPropertyPaths))
+		viewToVM(lv.Reference(), filterPropertyPaths))
 	if err != nil {
 		return nil, err
 	}
@@ -346,6 +430,8 @@ func newWatcher(
 		chanDone:               make(chan struct{}),
 		chanResult:             make(chan Result),
 		client:                 client,
+		vcPNID:                 vCenterFromContext(ctx),
+		vms:                    map[moRef]struct{}{},
 		pc:                     pc,
 		pf:                     pf,
 		vm:                     vm,
@@ -354,6 +440,8 @@ func newWatcher(
 		cvr:                    cvr,
 		ignoredExtraConfigKeys: toSet(ignoredExtraConfigKeys),
 		lookupNamespacedName:   lookupNamespacedName,
+		statsPropertyPaths:     toSet(statsPropertyPaths),
+		statsFn:                statsFn,
 	}, nil
 }
 
@@ -369,20 +457,25 @@ func (w *Watcher) close() {
 			for _, cv := range w.cv {
 				_ = cv.Destroy(context.Background())
 			}
+
+			metricFilterContainers.WithLabelValues(w.vcPNID).Set(0)
+			metricFilterVMs.WithLabelValues(w.vcPNID).Set(0)
 		})
 }
 
 // Start begins watching a vSphere server for updates to VM Service managed VMs.
 // If watchedPropertyPaths is nil, DefaultWatchedPropertyPaths will be used.
 // The containerRefsWithIDs parameter may be used to start the watcher with an
-// initial list of entities to watch.
+// initial list of entities to watch. If stats is non-nil, the watcher also
+// sends the statistics of the watched VMs to stats.Fn.
 func Start(
 	ctx context.Context,
 	client *vim25.Client,
 	watchedPropertyPaths []string,
 	additionalIgnoredExtraConfigKeys []string,
 	lookupNamespacedName lookupNamespacedNameFn,
-	containerRefsWithIDs map[moRef][]string) (*Watcher, error) {
+	containerRefsWithIDs map[moRef][]string,
+	stats *StatsOptions) (*Watcher, error) {
 
 	logger := pkglog.FromContextOrDefault(ctx).WithName("vSphereWatcher")
 
@@ -394,7 +487,8 @@ func Start(
 		watchedPropertyPaths,
 		additionalIgnoredExtraConfigKeys,
 		lookupNamespacedName,
-		containerRefsWithIDs)
+		containerRefsWithIDs,
+		stats)
 	if err != nil {
 		return nil, err
 	}
@@ -402,6 +496,10 @@ func Start(
 	// Update the context with this watcher.
 	setContext(ctx, w)
 
+	metricStarts.WithLabelValues(w.vcPNID).Inc()
+	metricFilterContainers.WithLabelValues(w.vcPNID).Set(float64(len(w.cv)))
+	metricFilterVMs.WithLabelValues(w.vcPNID).Set(0)
+
 	var (
 		cancel  context.CancelFunc
 		version string
@@ -416,7 +514,7 @@ func Start(
 
 			// Remove this watcher from the context. While there is no watcher
 			// in the context, calls to Add/Remove will fail.
-			setContext(ctx, nil)
+			clearContext(ctx, w)
 
 			w.close()
 		}()
@@ -444,6 +542,8 @@ func Start(
 				return
 			}
 
+			receivedAt := time.Now()
+
 			set := res.Returnval
 			if set == nil {
 				if req.Options != nil && req.Options.MaxWaitSeconds != nil {
@@ -457,7 +557,7 @@ func Start(
 			req.Version = version
 
 			for _, fs := range set.FilterSet {
-				if w.onUpdate(ctx, fs.ObjectSet) {
+				if w.onUpdate(ctx, fs.ObjectSet, receivedAt) {
 					return
 				}
 			}
@@ -550,7 +650,8 @@ type objUpdate struct {
 
 func (w *Watcher) onUpdate(
 	ctx context.Context,
-	ou []vimtypes.ObjectUpdate) bool {
+	ou []vimtypes.ObjectUpdate,
+	receivedAt time.Time) bool {
 
 	logger := pkglog.FromContextOrDefault(ctx)
 	logger.V(4).Info("OnUpdate", "objectUpdates", ou)
@@ -559,6 +660,19 @@ func (w *Watcher) onUpdate(
 
 	for i := range ou {
 		oui := ou[i]
+		switch oui.Kind {
+		case vimtypes.ObjectUpdateKindEnter:
+			w.vms[oui.Obj] = struct{}{}
+		case vimtypes.ObjectUpdateKindLeave:
+			delete(w.vms, oui.Obj)
+		}
+		if oui.Kind == vimtypes.ObjectUpdateKindLeave && w.statsFn != nil {
+			w.statsFn(ctx, StatsResult{
+				Ref:     oui.Obj,
+				VCenter: w.vcPNID,
+				Removed: true,
+			})
+		}
 		if oui.Kind != vimtypes.ObjectUpdateKindLeave {
 			if v, ok := updates[oui.Obj]; !ok {
 				updates[oui.Obj] = objUpdate{
@@ -572,15 +686,26 @@ func (w *Watcher) onUpdate(
 		}
 	}
 
+	metricFilterVMs.WithLabelValues(w.vcPNID).Set(float64(len(w.vms)))
+
+	var numProcessed int
 	for obj, update := range updates {
 		if err := w.onObject(
 			ctx,
 			obj,
-			update); err != nil {
+			update,
+			receivedAt); err != nil {
+
+			// The update that caused the error and the ones that were not
+			// yet processed are dropped.
+			metricDroppedUpdates.
+				WithLabelValues(w.vcPNID, droppedReasonError).
+				Add(float64(len(updates) - numProcessed))
 
 			w.setErr(err)
 			return true
 		}
+		numProcessed++
 	}
 
 	return false
@@ -590,7 +715,8 @@ func (w *Watcher) onUpdate(
 func (w *Watcher) onObject(
 	ctx context.Context,
 	obj moRef,
-	update objUpdate) error {
+	update objUpdate,
+	receivedAt time.Time) error {
 
 	logger := pkglog.FromContextOrDefault(ctx).
 		WithName("onObject").
@@ -603,6 +729,7 @@ func (w *Watcher) onObject(
 		verifiedObj any
 		deleted     bool
 		props       = map[string]string{}
+		statsProps  = map[string]any{}
 	)
 
 	// This update will be skipped if after removing all of the changes for
@@ -612,6 +739,12 @@ func (w *Watcher) onObject(
 			val any
 			c   = update.changes[i]
 		)
+		if _, ok := w.statsPropertyPaths[c.Name]; ok {
+			if !pkgnil.IsNil(c.Val) {
+				statsProps[c.Name] = c.Val
+			}
+			continue
+		}
 		switch c.Name {
 		case extraConfigPropPath:
 			if tval, ok := c.Val.(vimtypes.ArrayOfOptionValue); ok {
@@ -669,6 +802,15 @@ func (w *Watcher) onObject(
 		logger = logger.WithValues("vmName", vmName)
 	}
 
+	if len(statsProps) > 0 {
+		w.onStats(ctx, obj, namespace, name, statsProps)
+		if len(props) == 0 {
+			// Only the statistics were updated, so there is nothing else to
+			// do for this object.
+			return nil
+		}
+	}
+
 	var areChanges bool
 	if cachedProps, ok := Cache.Get(obj); !ok {
 		Cache.Add(obj, props)
@@ -780,12 +922,46 @@ func (w *Watcher) onObject(
 
 		go func(r Result) {
 			w.chanResult <- r
+			metricUpdateLag.
+				WithLabelValues(w.vcPNID).
+				Observe(time.Since(receivedAt).Seconds())
 		}(r)
+	} else {
+		logger.V(4).Info("Dropping update", "reason", "unknown vm")
+		metricDroppedUpdates.
+			WithLabelValues(w.vcPNID, droppedReasonUnknownVM).
+			Inc()
 	}
 
 	return nil
 }
 
+// onStats sends the statistics of a VM to the stats function. The statistics
+// of VMs without a known namespace and name are dropped.
+func (w *Watcher) onStats(
+	ctx context.Context,
+	obj moRef,
+	namespace, name string,
+	props map[string]any) {
+
+	if (namespace == "" || name == "") && w.lookupNamespacedName != nil {
+		r := w.lookupNamespacedName(ctx, obj, namespace, name)
+		namespace, name = r.Namespace, r.Name
+	}
+
+	if namespace == "" || name == "" {
+		return
+	}
+
+	w.statsFn(ctx, StatsResult{
+		Namespace: namespace,
+		Name:      name,
+		Ref:       obj,
+		VCenter:   w.vcPNID,
+		Props:     props,
+	})
+}
+
 func checkExtraConfig(
 	aov vimtypes.ArrayOfOptionValue,
 	ignoredKeys map[string]struct{}) (
@@ -857,6 +1033,8 @@ func (w *Watcher) add(ctx context.Context, ref moRef, id string) error {
 	}
 	w.cvr[ref][id] = struct{}{}
 
+	metricFilterContainers.WithLabelValues(w.vcPNID).Set(float64(len(w.cv)))
+
 	return nil
 }
 
@@ -885,6 +1063,8 @@ func (w *Watcher) remove(_ context.Context, ref moRef, id string) error {
 	delete(w.cv, ref)
 	delete(w.cvr, ref)
 
+	metricFilterContainers.WithLabelValues(w.vcPNID).Set(float64(len(w.cv)))
+
 	return nil
 }
 
@@ -7,6 +7,7 @@ package watcher
 import (
 	"context"
 	"errors"
+	"maps"
 
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	ctxgen "github.com/vmware-tanzu/vm-operator/pkg/context/generic"
@@ -16,17 +17,56 @@ type contextKeyType uint8
 
 const contextKeyValue contextKeyType = 0
 
-type contextValueType = *Watcher
+// contextValueType maps the PNID of a vCenter to its watcher. The watcher for
+// the default vCenter is keyed by an empty string.
+type contextValueType = map[string]*Watcher
 
-// setContext assigns the add/remove functions to the context.
+type vCenterContextKeyType struct{}
+
+// WithVCenter returns a context that scopes the Start, Add, Remove, and Close
+// functions to the watcher for the vCenter with the specified PNID. Contexts
+// without a vCenter use the watcher for the default vCenter.
+func WithVCenter(parent context.Context, vcPNID string) context.Context {
+	return context.WithValue(parent, vCenterContextKeyType{}, vcPNID)
+}
+
+// vCenterFromContext returns the PNID of the vCenter from the context.
+func vCenterFromContext(ctx context.Context) string {
+	vcPNID, _ := ctx.Value(vCenterContextKeyType{}).(string)
+	return vcPNID
+}
+
+// setContext assigns the watcher for the context's vCenter.
 func setContext(
 	parent context.Context,
-	newVal contextValueType) {
+	newVal *Watcher) {
+	vcPNID := vCenterFromContext(parent)
 	ctxgen.SetContext(
 		parent,
 		contextKeyValue,
 		func(curVal contextValueType) contextValueType {
-			return newVal
+			if curVal == nil {
+				curVal = contextValueType{}
+			}
+			curVal[vcPNID] = newVal
+			return curVal
+		})
+}
+
+// clearContext removes the watcher for the context's vCenter if it is still
+// the provided watcher.
+func clearContext(
+	parent context.Context,
+	oldVal *Watcher) {
+	vcPNID := vCenterFromContext(parent)
+	ctxgen.SetContext(
+		parent,
+		contextKeyValue,
+		func(curVal contextValueType) contextValueType {
+			if curVal[vcPNID] == oldVal {
+				delete(curVal, vcPNID)
+			}
+			return curVal
 		})
 }
 
@@ -36,7 +76,7 @@ func WithContext(parent context.Context) context.Context {
 		parent,
 		contextKeyValue,
 		func() contextValueType {
-			return nil
+			return contextValueType{}
 		})
 }
 
@@ -62,7 +102,7 @@ func JoinContext(left, right context.Context) context.Context {
 		right,
 		contextKeyValue,
 		func(dst, src contextValueType) contextValueType {
-			return src
+			return maps.Clone(src)
 		})
 }
 
@@ -85,8 +125,8 @@ func Add(ctx context.Context, ref moRef, id string) (err error) {
 	ctxgen.ExecWithContext(
 		ctx,
 		contextKeyValue,
-		func(w contextValueType) {
-			if w == nil {
+		func(m contextValueType) {
+			if w := m[vCenterFromContext(ctx)]; w == nil {
 				err = ErrNoWatcher
 			} else {
 				err = w.add(ctx, ref, id)
@@ -104,8 +144,8 @@ func Remove(ctx context.Context, ref moRef, id string) (err error) {
 	ctxgen.ExecWithContext(
 		ctx,
 		contextKeyValue,
-		func(w contextValueType) {
-			if w == nil {
+		func(m contextValueType) {
+			if w := m[vCenterFromContext(ctx)]; w == nil {
 				err = ErrNoWatcher
 			} else {
 				err = w.remove(ctx, ref, id)
@@ -122,8 +162,8 @@ func Close(ctx context.Context) (err error) {
 	ctxgen.ExecWithContext(
 		ctx,
 		contextKeyValue,
-		func(w contextValueType) {
-			if w == nil {
+		func(m contextValueType) {
+			if w := m[vCenterFromContext(ctx)]; w == nil {
 				err = ErrNoWatcher
 			} else {
 				w.close()
@@ -0,0 +1,80 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package watcher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// If this changes, the metrics collection configs (e.g. telegraf) will
+	// need to be updated as well.
+	metricsNamespace = "vmservice"
+
+	vCenterLabel = "vcenter"
+	reasonLabel  = "reason"
+
+	// droppedReasonUnknownVM is the reason an update is dropped when the
+	// namespace and name of the VM's Kubernetes resource cannot be determined.
+	droppedReasonUnknownVM = "UnknownVM"
+
+	// droppedReasonError is the reason an update is dropped when the watcher
+	// fails before the update is processed.
+	droppedReasonError = "Error"
+)
+
+var (
+	metricStarts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "vm_watcher_starts_total",
+			Help:      "Number of times the VM watcher was started, including restarts"},
+		[]string{vCenterLabel},
+	)
+
+	metricUpdateLag = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "vm_watcher_update_lag_seconds",
+			Help:      "Time from receiving an update from vSphere until the result is received from the VM watcher",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10)},
+		[]string{vCenterLabel},
+	)
+
+	metricDroppedUpdates = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "vm_watcher_dropped_updates_total",
+			Help:      "Number of VM updates received from vSphere that did not result in a VM watcher result"},
+		[]string{vCenterLabel, reasonLabel},
+	)
+
+	metricFilterContainers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "vm_watcher_filter_containers",
+			Help:      "Number of containers in the VM watcher's property filter"},
+		[]string{vCenterLabel},
+	)
+
+	metricFilterVMs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "vm_watcher_filter_vms",
+			Help:      "Number of VMs in the scope of the VM watcher's property filter"},
+		[]string{vCenterLabel},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		metricStarts,
+		metricUpdateLag,
+		metricDroppedUpdates,
+		metricFilterContainers,
+		metricFilterVMs,
+	)
+}
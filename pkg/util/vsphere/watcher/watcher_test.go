@@ -11,6 +11,7 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/watcher"
@@ -57,6 +59,10 @@ var _ = Describe("Start", func() {
 
 		lookupFnVerified bool
 		lookupFnDeleted  bool
+
+		stats        *watcher.StatsOptions
+		statsMu      sync.Mutex
+		statsResults []watcher.StatsResult
 	)
 
 	addNamespaceName := func(
@@ -90,6 +96,9 @@ var _ = Describe("Start", func() {
 
 		lookupFnVerified = false
 
+		stats = nil
+		statsResults = nil
+
 		model = simulator.VPX()
 		model.Datacenter = 1
 		model.Cluster = 2
@@ -183,6 +192,7 @@ var _ = Describe("Start", func() {
 			map[vimtypes.ManagedObjectReference][]string{
 				cluster1.Reference(): {idStr(0)},
 			},
+			stats,
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(w).ToNot(BeNil())
@@ -301,6 +311,22 @@ var _ = Describe("Start", func() {
 		})
 	})
 
+	When("the watcher is for a vCenter other than the default", func() {
+		var (
+			vcCtx context.Context
+		)
+		BeforeEach(func() {
+			vcCtx = watcher.WithVCenter(ctx, "vc-2")
+			ctx = vcCtx
+		})
+		Specify("containers may only be added for that vCenter", func() {
+			defaultCtx := watcher.WithVCenter(ctx, "")
+			Expect(watcher.Add(defaultCtx, cluster2.Reference(), idStr(0))).To(MatchError(watcher.ErrNoWatcher))
+			Expect(watcher.Add(vcCtx, cluster2.Reference(), idStr(0))).To(Succeed())
+			assertNoError()
+		})
+	})
+
 	When("one vm has namespace/name information", func() {
 		BeforeEach(func() {
 			addNamespaceName(cluster1vm1, "my-namespace-1", "my-name-1")
@@ -693,6 +719,129 @@ var _ = Describe("Start", func() {
 		})
 	})
 
+	When("metrics are gathered", func() {
+		const vcPNID = "metrics-vc"
+
+		getMetric := func(name string, labels map[string]string) float64 {
+			families, err := ctrlmetrics.Registry.Gather()
+			Expect(err).ToNot(HaveOccurred())
+			for _, f := range families {
+				if f.GetName() != name {
+					continue
+				}
+				for _, m := range f.GetMetric() {
+					match := true
+					for _, l := range m.GetLabel() {
+						if v, ok := labels[l.GetName()]; ok && v != l.GetValue() {
+							match = false
+						}
+					}
+					if !match {
+						continue
+					}
+					switch {
+					case m.GetCounter() != nil:
+						return m.GetCounter().GetValue()
+					case m.GetGauge() != nil:
+						return m.GetGauge().GetValue()
+					case m.GetHistogram() != nil:
+						return float64(m.GetHistogram().GetSampleCount())
+					}
+				}
+			}
+			return 0
+		}
+
+		BeforeEach(func() {
+			ctx = watcher.WithVCenter(ctx, vcPNID)
+			addNamespaceName(cluster1vm1, "my-namespace-1", "my-name-1")
+		})
+
+		Specify("the metrics should describe the watcher", func() {
+			assertResult(cluster1vm1, "my-namespace-1", "my-name-1")
+
+			vcLabels := map[string]string{"vcenter": vcPNID}
+			Expect(getMetric("vmservice_vm_watcher_starts_total", vcLabels)).To(BeNumerically(">=", 1))
+			Expect(getMetric("vmservice_vm_watcher_filter_containers", vcLabels)).To(Equal(float64(1)))
+			Expect(getMetric("vmservice_vm_watcher_filter_vms", vcLabels)).To(Equal(float64(2)))
+			Eventually(func() float64 {
+				return getMetric("vmservice_vm_watcher_update_lag_seconds", vcLabels)
+			}).Should(BeNumerically(">=", 1))
+
+			// The other VM in the container does not have namespace/name
+			// information, so its update is dropped.
+			Expect(getMetric("vmservice_vm_watcher_dropped_updates_total", map[string]string{
+				"vcenter": vcPNID,
+				"reason":  "UnknownVM",
+			})).To(BeNumerically(">=", 1))
+
+			Expect(watcher.Add(ctx, cluster2.Reference(), idStr(0))).To(Succeed())
+			Expect(getMetric("vmservice_vm_watcher_filter_containers", vcLabels)).To(Equal(float64(2)))
+			Eventually(func() float64 {
+				return getMetric("vmservice_vm_watcher_filter_vms", vcLabels)
+			}).Should(Equal(float64(4)))
+
+			assertNoError()
+		})
+	})
+
+	When("stats are enabled", func() {
+		var (
+			getStatsResults = func() []watcher.StatsResult {
+				statsMu.Lock()
+				defer statsMu.Unlock()
+				return slices.Clone(statsResults)
+			}
+		)
+
+		BeforeEach(func() {
+			addNamespaceName(cluster1vm1, "my-namespace-1", "my-name-1")
+			stats = &watcher.StatsOptions{
+				Fn: func(_ context.Context, r watcher.StatsResult) {
+					statsMu.Lock()
+					defer statsMu.Unlock()
+					statsResults = append(statsResults, r)
+				},
+			}
+		})
+
+		Specify("the stats function should receive the vm's statistics", func() {
+			// Assert that a result is still signaled due to the VM entering
+			// the scope of the watcher.
+			assertResult(cluster1vm1, "my-namespace-1", "my-name-1")
+
+			Eventually(getStatsResults).Should(ContainElement(SatisfyAll(
+				HaveField("Namespace", "my-namespace-1"),
+				HaveField("Name", "my-name-1"),
+				HaveField("Ref", cluster1vm1.Reference()),
+				HaveField("Removed", false),
+				HaveField("Props", HaveKey("summary.quickStats")),
+			)))
+
+			// Assert that the stats do not cause more results to be signaled.
+			assertNoResult()
+			assertNoError()
+		})
+
+		When("the vm is destroyed", func() {
+			Specify("the stats function should be notified the vm was removed", func() {
+				assertResult(cluster1vm1, "my-namespace-1", "my-name-1")
+
+				t, err := cluster1vm1.Destroy(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(t.Wait(ctx)).To(Succeed())
+
+				Eventually(getStatsResults).Should(ContainElement(
+					watcher.StatsResult{
+						Ref:     cluster1vm1.Reference(),
+						Removed: true,
+					},
+				))
+				assertNoError()
+			})
+		})
+	})
+
 	When("a vm is a member of a container not being watched", func() {
 		Specify("no result or error should be received", func() {
 			assertNoResult()
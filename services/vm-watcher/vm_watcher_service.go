@@ -6,6 +6,7 @@ package vmwatcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"slices"
@@ -15,6 +16,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/vmware/govmomi/property"
 	vimtypes "github.com/vmware/govmomi/vim25/types"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -27,7 +29,9 @@ import (
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	pkglog "github.com/vmware-tanzu/vm-operator/pkg/log"
+	"github.com/vmware-tanzu/vm-operator/pkg/metrics"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers"
+	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/kube/cource"
 	vsphereclient "github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/client"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/vsphere/watcher"
@@ -102,8 +106,58 @@ func (s Service) Start(ctx context.Context) error {
 
 	logger.Info("Starting VM watcher service")
 
+	// Watchers for the vCenters that back zones other than the default
+	// vCenter are started in the background.
+	go s.startVCenterWatchers(ctx)
+
+	s.run(ctx, "")
+
+	return ctx.Err()
+}
+
+// errNoZonesForVCenter is returned from waitForChanges when there are no
+// longer any zones backed by the vCenter.
+var errNoZonesForVCenter = errors.New("no zones for vCenter")
+
+// vCenterResyncInterval is how often the service checks for vCenters that
+// back zones and do not yet have a watcher.
+const vCenterResyncInterval = 1 * time.Minute
+
+const (
+	// restartBackoffInitial is how long the service waits before restarting
+	// a watcher that stopped.
+	restartBackoffInitial = 100 * time.Millisecond
+
+	// restartBackoffMax is the longest the service waits before restarting a
+	// watcher that stopped. The wait is reset once a watcher has run for at
+	// least this long.
+	restartBackoffMax = 5 * time.Second
+)
+
+// run starts the watcher for the vCenter with the specified PNID and restarts
+// it until the context is cancelled. An empty PNID refers to the default
+// vCenter. For any other vCenter, this function returns when no zones are
+// backed by the vCenter.
+//
+// The watcher is restarted with an exponential backoff, and every restart
+// resyncs the VMs for the vCenter since any changes that occurred while the
+// watcher was stopped were missed.
+func (s Service) run(ctx context.Context, vcPNID string) {
+	var (
+		logger  = pkglog.FromContextOrDefault(ctx)
+		backoff = restartBackoffInitial
+		resync  bool
+	)
+
 	for ctx.Err() == nil {
-		if err := s.waitForChanges(ctx); err != nil {
+		started := time.Now()
+
+		if err := s.waitForChanges(ctx, vcPNID, resync); err != nil {
+
+			if errors.Is(err, errNoZonesForVCenter) {
+				logger.Info("Stopping vm watcher for vCenter without zones")
+				return
+			}
 
 			// If waitForChanges failed because of an invalid login or auth
 			// error, then do not treat the error as fatal. This allows the
@@ -122,17 +176,109 @@ func (s Service) Start(ctx context.Context) error {
 					err,
 					"Unexpected error trying to start vm watcher service")
 			}
+		}
+
+		if time.Since(started) >= restartBackoffMax {
+			backoff = restartBackoffInitial
+		}
+
+		logger.V(4).Info("Restarting vm watcher", "backoff", backoff)
 
-			// TODO: We might want to sleep a bit here so we don't spin if like we
-			// cannot get a VC client.
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, restartBackoffMax)
+		resync = true
+	}
+}
+
+// startVCenterWatchers periodically starts a watcher for each vCenter that
+// backs zones, other than the default vCenter, and does not have a watcher.
+func (s Service) startVCenterWatchers(ctx context.Context) {
+	var (
+		logger  = pkglog.FromContextOrDefault(ctx)
+		mu      sync.Mutex
+		running = map[string]struct{}{}
+		ticker  = time.NewTicker(vCenterResyncInterval)
+	)
+
+	defer ticker.Stop()
+
+	for {
+		zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, s.Client)
+		if err != nil {
+			logger.Error(err, "Failed to get vCenters for zones")
+		}
+
+		for vc := range zonesByVCenter {
+			if vc == (topology.VCenter{}) {
+				continue
+			}
+
+			mu.Lock()
+			_, ok := running[vc.PNID]
+			if !ok {
+				running[vc.PNID] = struct{}{}
+			}
+			mu.Unlock()
+
+			if ok {
+				continue
+			}
+
+			go func(vcPNID string) {
+				defer func() {
+					mu.Lock()
+					delete(running, vcPNID)
+					mu.Unlock()
+				}()
+
+				vcCtx := watcher.WithVCenter(ctx, vcPNID)
+				vcCtx = logr.NewContext(vcCtx, logger.WithValues("vCenter", vcPNID))
+
+				s.run(vcCtx, vcPNID)
+			}(vc.PNID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
 		}
 	}
-	return ctx.Err()
+}
+
+// getVSphereClient returns the client for the vCenter with the specified PNID.
+// An empty PNID refers to the default vCenter.
+func (s Service) getVSphereClient(
+	ctx context.Context,
+	vcPNID string) (*vsphereclient.Client, error) {
+
+	if vcPNID == "" {
+		return s.provider.VSphereClient(ctx)
+	}
+
+	zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, s.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	for vc, zoneNames := range zonesByVCenter {
+		if vc.PNID == vcPNID && len(zoneNames) > 0 {
+			return s.provider.VSphereClientForZone(ctx, zoneNames[0])
+		}
+	}
+
+	return nil, errNoZonesForVCenter
 }
 
 func (s Service) vmFolderMoRefWithIDs(
 	ctx context.Context,
-	vcClient *vsphereclient.Client) (map[vimtypes.ManagedObjectReference][]string, error) {
+	vcClient *vsphereclient.Client,
+	vcPNID string) (map[vimtypes.ManagedObjectReference][]string, error) {
 
 	// Get a list of all the folders that can contain VM Service VMs.
 	var (
@@ -146,9 +292,25 @@ func (s Service) vmFolderMoRefWithIDs(
 		return nil, err
 	}
 
+	// Only watch the folders of the zones backed by this watcher's vCenter.
+	zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, s.Client)
+	if err != nil {
+		return nil, err
+	}
+	zoneVCenters := map[string]string{}
+	for vc, zoneNames := range zonesByVCenter {
+		for _, zoneName := range zoneNames {
+			zoneVCenters[zoneName] = vc.PNID
+		}
+	}
+
 	for i := range zones.Items {
 		z := zones.Items[i]
 
+		if zoneVCenters[z.Name] != vcPNID {
+			continue
+		}
+
 		if v := z.Spec.ManagedVMs.FolderMoID; v != "" {
 
 			// If a zone is being deleted and it does not have any
@@ -232,26 +394,37 @@ func (s Service) vmFolderMoRefWithIDs(
 
 var emptyResult watcher.Result
 
-func (s Service) waitForChanges(ctx context.Context) error {
+func (s Service) waitForChanges(
+	ctx context.Context,
+	vcPNID string,
+	resync bool) error {
 
 	var (
 		logger     = pkglog.FromContextOrDefault(ctx)
 		chanSource = cource.FromContextWithBuffer(ctx, "VirtualMachine", 100)
 	)
 
-	vcClient, err := s.provider.VSphereClient(ctx)
+	vcClient, err := s.getVSphereClient(ctx, vcPNID)
 	if err != nil {
 		return err
 	}
 	logger.Info("Got vsphere client")
 
-	moRefWithIDs, err := s.vmFolderMoRefWithIDs(ctx, vcClient)
+	moRefWithIDs, err := s.vmFolderMoRefWithIDs(ctx, vcClient, vcPNID)
 	if err != nil {
 		return err
 	}
 	logger.Info("Got vm service folders",
 		"refs", slices.Collect(maps.Keys(moRefWithIDs)))
 
+	// Export the statistics of the watched VMs if enabled.
+	var stats *watcher.StatsOptions
+	if pkgcfg.FromContext(ctx).VMStatsExporter.Enabled {
+		stats = &watcher.StatsOptions{
+			Fn: metrics.NewVMStatsMetrics().OnStats,
+		}
+	}
+
 	// Start the watcher.
 	w, err := watcher.Start(
 		ctx,
@@ -259,11 +432,18 @@ func (s Service) waitForChanges(ctx context.Context) error {
 		nil,
 		nil,
 		s.lookupNamespacedName,
-		moRefWithIDs)
+		moRefWithIDs,
+		stats)
 	if err != nil {
 		return err
 	}
 
+	if resync {
+		if err := s.resyncVMs(ctx, vcPNID, chanSource); err != nil {
+			logger.Error(err, "Failed to resync VMs after restarting watcher")
+		}
+	}
+
 	for {
 		select {
 		case result := <-w.Result():
@@ -321,6 +501,50 @@ func (s Service) waitForChanges(ctx context.Context) error {
 	}
 }
 
+// resyncVMs enqueues a reconcile request for every VM in a zone backed by the
+// vCenter with the specified PNID. VMs without a zone are considered to be
+// backed by the default vCenter.
+func (s Service) resyncVMs(
+	ctx context.Context,
+	vcPNID string,
+	chanSource chan<- event.GenericEvent) error {
+
+	zonesByVCenter, err := topology.GetAvailabilityZonesByVCenter(ctx, s.Client)
+	if err != nil {
+		return err
+	}
+	zoneVCenters := map[string]string{}
+	for vc, zoneNames := range zonesByVCenter {
+		for _, zoneName := range zoneNames {
+			zoneVCenters[zoneName] = vc.PNID
+		}
+	}
+
+	var list vmopv1.VirtualMachineList
+	if err := s.Client.List(ctx, &list); err != nil {
+		return err
+	}
+
+	var n int
+	for i := range list.Items {
+		vm := &list.Items[i]
+		if zoneVCenters[vm.Labels[corev1.LabelTopologyZone]] != vcPNID {
+			continue
+		}
+		select {
+		case chanSource <- event.GenericEvent{Object: vm}:
+			n++
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	pkglog.FromContextOrDefault(ctx).Info("Resynced VMs after restarting watcher",
+		"count", n)
+
+	return nil
+}
+
 // lookupNamespacedName looks up the namespace and name for a given MoRef using
 // the Kubernetes client's cache, where the "status.uniqueID" field of VMs are
 // indexed for fast lookup.
@@ -356,6 +356,30 @@ var _ = Describe(
 					Expect(e.Object.GetName()).To(Equal(vmName))
 				})
 
+				When("the watcher is restarted", func() {
+					Specify("the vm should be resynced", func() {
+						chanSource := cource.FromContext(ctx, "VirtualMachine")
+						var e1 event.GenericEvent
+						Eventually(chanSource).Should(Receive(&e1))
+						Expect(e1.Object.GetName()).To(Equal(vmName))
+
+						By("log out the client session", func() {
+							vsClientMu.Lock()
+							defer vsClientMu.Unlock()
+
+							vsClient.Logout(vcSimCtx)
+						})
+
+						// The VM did not change while the watcher was stopped,
+						// but it is still reconciled once the watcher restarts.
+						var e2 event.GenericEvent
+						Eventually(chanSource).Should(Receive(&e2))
+						Expect(e2.Object.GetNamespace()).To(Equal(vcSimCtx.NSInfo.Namespace))
+						Expect(e2.Object.GetName()).To(Equal(vmName))
+						Expect(atomic.LoadInt32(&numNewClientCalls)).To(BeNumerically(">=", int32(2)))
+					})
+				})
+
 				When("a Zone that is being deleted", func() {
 					When("the zone has the zone controller finalizer", func() {
 						Specify("a reconcile request should be received", func() {
@@ -696,6 +696,76 @@ func DummyVirtualMachineSnapshot(namespace, name, vmName string) *vmopv1.Virtual
 	}
 }
 
+func DummyVirtualMachineCheck(namespace, name, key string) *vmopv1.VirtualMachineCheck {
+	return &vmopv1.VirtualMachineCheck{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VirtualMachineCheck",
+			APIVersion: vmopv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: vmopv1.VirtualMachineCheckSpec{
+			Key:  key,
+			Type: vmopv1.VirtualMachineCheckTypePowerOn,
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": "my-app",
+				},
+			},
+			Condition: vmopv1.VirtualMachineCheckCondition{
+				ConfigMap: &vmopv1.VirtualMachineCheckConfigMapCondition{
+					Name: "my-approvals",
+					Key:  key,
+				},
+			},
+		},
+	}
+}
+
+func DummyVirtualMachineClassRecommendation(namespace, name, vmName string) *vmopv1.VirtualMachineClassRecommendation {
+	return &vmopv1.VirtualMachineClassRecommendation{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VirtualMachineClassRecommendation",
+			APIVersion: vmopv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: vmopv1.VirtualMachineClassRecommendationSpec{
+			VirtualMachineName: vmName,
+			SampleInterval:     &metav1.Duration{Duration: 5 * time.Minute},
+			Window:             &metav1.Duration{Duration: 720 * time.Hour},
+			Percentile:         95,
+			MinSamples:         288,
+		},
+	}
+}
+
+func DummyVirtualMachineIPPool(namespace, name string) *vmopv1.VirtualMachineIPPool {
+	return &vmopv1.VirtualMachineIPPool{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VirtualMachineIPPool",
+			APIVersion: vmopv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: vmopv1.VirtualMachineIPPoolSpec{
+			Ranges: []vmopv1.VirtualMachineIPPoolRange{
+				{
+					CIDR:    "192.168.1.0/24",
+					Gateway: "192.168.1.1",
+				},
+			},
+			MACAddrPrefix: "00:50:56",
+		},
+	}
+}
+
 func DummyVirtualMachineSnapshotWithMemory(namespace, name, vmName string) *vmopv1.VirtualMachineSnapshot {
 	return &vmopv1.VirtualMachineSnapshot{
 		TypeMeta: metav1.TypeMeta{
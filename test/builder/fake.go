@@ -15,6 +15,7 @@ import (
 	imgregv1a1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha1"
 	imgregv1 "github.com/vmware-tanzu/image-registry-operator-api/api/v1alpha2"
 	netopv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	nsxv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/legacy/v1alpha1"
 	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 
 	appv1a1 "github.com/vmware-tanzu/vm-operator/external/appplatform/api/v1alpha1"
@@ -66,6 +67,9 @@ func KnownObjectTypes() []client.Object {
 		&vmopv1.VirtualMachineImageCache{},
 		&vmopv1.VirtualMachineWebConsoleRequest{},
 		&vmopv1.VirtualMachineSnapshot{},
+		&vmopv1.VirtualMachineCheck{},
+		&vmopv1.VirtualMachineIPPool{},
+		&vmopv1.VirtualMachineClassRecommendation{},
 		&vmopv1a1.WebConsoleRequest{},
 		&cnsv1alpha1.CnsNodeVmAttachment{},
 		&cnsv1alpha1.CnsNodeVMBatchAttachment{},
@@ -106,6 +110,7 @@ func NewScheme() *runtime.Scheme {
 	_ = topologyv1.AddToScheme(scheme)
 	_ = imgregv1a1.AddToScheme(scheme)
 	_ = imgregv1.AddToScheme(scheme)
+	_ = nsxv1alpha1.AddToScheme(scheme)
 	_ = vpcv1alpha1.AddToScheme(scheme)
 	_ = vspherepolv1.AddToScheme(scheme)
 	return scheme
@@ -121,3 +121,39 @@ func generatePKIToolchain() (pkiToolchain, error) {
 		privateKeyPEM:   certPrivateKeyPEM.Bytes(),
 	}, nil
 }
+
+// GenerateSelfSignedCertificate returns the PEM-encoded certificate and
+// private key for a self-signed client certificate with the provided common
+// name. The certificate expires in one hour from time of creation.
+func GenerateSelfSignedCertificate(commonName string) ([]byte, []byte, error) {
+	notBefore := time.Now()
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(time.Hour * 1),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := x509.CreateCertificate(rand.Reader, cert, cert, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: data,
+	})
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+
+	return certPEM, privateKeyPEM, nil
+}
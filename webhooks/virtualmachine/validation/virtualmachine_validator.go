@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/netip"
 	"reflect"
 	"regexp"
 	"slices"
@@ -36,15 +37,20 @@ import (
 	vpcv1alpha1 "github.com/vmware-tanzu/nsx-operator/pkg/apis/vpc/v1alpha1"
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	vmopv1common "github.com/vmware-tanzu/vm-operator/api/v1alpha5/common"
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep"
 	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
 	"github.com/vmware-tanzu/vm-operator/pkg/providers/vsphere/config"
 	"github.com/vmware-tanzu/vm-operator/pkg/topology"
 	pkgutil "github.com/vmware-tanzu/vm-operator/pkg/util"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit"
 	cloudinitvalidate "github.com/vmware-tanzu/vm-operator/pkg/util/cloudinit/validate"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cron"
+	ignitionvalidate "github.com/vmware-tanzu/vm-operator/pkg/util/ignition/validate"
 	kubeutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube"
 	spqutil "github.com/vmware-tanzu/vm-operator/pkg/util/kube/spq"
 	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
@@ -99,11 +105,31 @@ const (
 	labelSelectorCanNotContainVMOperatorLabels = "label selector can not contain VM Operator managed labels (vmoperator.vmware.com)"
 	guestCustomizationVCDParityNotEnabled      = "VC guest customization VCD parity capability is not enabled"
 	bootstrapProviderTypeCannotBeChanged       = "bootstrap provider type cannot be changed"
+	mustBePositive                             = "must be greater than zero"
+	exceedsNamespaceLimitFmt                   = "must be less than or equal to %s, the limit for the namespace"
+	exceededQuotaFmt                           = "exceeded quota: %s, requested: %s=%s, used: %s=%s, limited: %s=%s"
+	classDeprecatedFmt                         = "VirtualMachineClass %s is deprecated"
+	classDeprecatedReplacementFmt              = ", use %s instead"
+	adapterTypeRequiresMemoryReservationFmt    = "%s adapter type requires the memory of VirtualMachineClass %s to be fully reserved"
+	physicalFunctionRequiresSRIOV              = "physicalFunction may only be specified with the SRIOV adapter type"
+	ipPoolNameNotSupportedFmt                  = "ipPoolName is available only with the following network providers: %s"
+	ipPoolNameMutuallyExclusiveFmt             = "%s may not be specified with ipPoolName"
+	firewallBootstrapNotSupportedFmt           = "firewall is available only with the following bootstrap providers: %s"
+	firewallRawSysprepNotSupported             = "firewall is not available with rawSysprep"
+	firewallSysprepRequiresAutoLogon           = "firewall requires sysprep.guiUnattended.autoLogon since the rules are applied by guiRunOnce commands when the Administrator logs on"
+	firewallRawCloudConfigMIMENotSupported     = "firewall is not available with a rawCloudConfig that is a MIME multi-part document, use userDataParts instead"
+	firewallEndPortRequiresPort                = "endPort may only be specified with port"
+	firewallEndPortLessThanPort                = "must be greater than or equal to port"
+	invalidFirewallCIDR                        = "must be a valid IPv4 or IPv6 network in CIDR notation"
+	imageSignatureNotVerifiedFmt               = "%s %s does not have a verified signature, which is required by the namespace"
 )
 
 // +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha5-virtualmachine,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachines,versions=v1alpha5,name=default.validating.virtualmachine.v1alpha5.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines,verbs=get;list
 // +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachines/status,verbs=get
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclasses,verbs=get;list
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineimages;clustervirtualmachineimages,verbs=get;list
+// +kubebuilder:rbac:groups="",resources=namespaces;resourcequotas,verbs=get;list
 
 // AddToManager adds the webhook to the provided manager.
 func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
@@ -158,6 +184,7 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 
 	fieldErrs = append(fieldErrs, v.validateAvailabilityZone(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateImageOnCreate(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateImageSignature(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateClassOnCreate(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateStorageClass(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateCrypto(ctx, vm)...)
@@ -169,6 +196,7 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateAdvanced(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validatePowerStateOnCreate(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateNextRestartTimeOnCreate(ctx, vm)...)
+	fieldErrs = append(fieldErrs, v.validateMaintenanceWindow(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateAnnotation(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateLabel(ctx, vm, nil)...)
 	fieldErrs = append(fieldErrs, v.validateNetworkHostAndDomainName(ctx, vm, nil)...)
@@ -182,12 +210,15 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateVMAffinity(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateBiosUUID(ctx, vm)...)
 
+	warnings, classDeprecationErrs := v.validateClassDeprecation(ctx, vm, nil)
+	fieldErrs = append(fieldErrs, classDeprecationErrs...)
+
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
 		validationErrs = append(validationErrs, fieldErr.Error())
 	}
 
-	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+	return common.BuildValidationResponse(ctx, warnings, validationErrs, nil)
 }
 
 func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
@@ -273,6 +304,7 @@ func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateReadinessProbe(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateAdvanced(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateNextRestartTimeOnUpdate(ctx, vm, oldVM)...)
+	fieldErrs = append(fieldErrs, v.validateMaintenanceWindow(ctx, vm)...)
 	fieldErrs = append(fieldErrs, v.validateAnnotation(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateMinHardwareVersion(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateLabel(ctx, vm, oldVM)...)
@@ -284,12 +316,15 @@ func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.R
 	fieldErrs = append(fieldErrs, v.validateSnapshot(ctx, vm, oldVM)...)
 	fieldErrs = append(fieldErrs, v.validateGroupName(ctx, vm)...)
 
+	warnings, classDeprecationErrs := v.validateClassDeprecation(ctx, vm, oldVM)
+	fieldErrs = append(fieldErrs, classDeprecationErrs...)
+
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
 		validationErrs = append(validationErrs, fieldErr.Error())
 	}
 
-	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+	return common.BuildValidationResponse(ctx, warnings, validationErrs, nil)
 }
 
 func (v validator) validateBootstrapProviderImmutable(
@@ -322,6 +357,8 @@ func (v validator) validateBootstrapProviderImmutable(
 		return append(fieldErrs, field.Forbidden(p.Child("linuxPrep"), bootstrapProviderTypeCannotBeChanged))
 	case oldBS.Sysprep != nil && bs.Sysprep == nil:
 		return append(fieldErrs, field.Forbidden(p.Child("sysprep"), bootstrapProviderTypeCannotBeChanged))
+	case oldBS.Ignition != nil && bs.Ignition == nil:
+		return append(fieldErrs, field.Forbidden(p.Child("ignition"), bootstrapProviderTypeCannotBeChanged))
 	}
 
 	return nil
@@ -340,6 +377,7 @@ func (v validator) validateBootstrap(
 		linuxPrep  *vmopv1.VirtualMachineBootstrapLinuxPrepSpec
 		sysPrep    *vmopv1.VirtualMachineBootstrapSysprepSpec
 		vAppConfig *vmopv1.VirtualMachineBootstrapVAppConfigSpec
+		ignition   *vmopv1.VirtualMachineBootstrapIgnitionSpec
 	)
 
 	if vm.Spec.Bootstrap != nil {
@@ -347,12 +385,13 @@ func (v validator) validateBootstrap(
 		linuxPrep = vm.Spec.Bootstrap.LinuxPrep
 		sysPrep = vm.Spec.Bootstrap.Sysprep
 		vAppConfig = vm.Spec.Bootstrap.VAppConfig
+		ignition = vm.Spec.Bootstrap.Ignition
 	}
 
 	if cloudInit != nil {
 		p := bootstrapPath.Child("cloudInit")
 
-		if linuxPrep != nil || sysPrep != nil || vAppConfig != nil {
+		if linuxPrep != nil || sysPrep != nil || vAppConfig != nil || ignition != nil {
 			allErrs = append(allErrs, field.Forbidden(p,
 				"CloudInit may not be used with any other bootstrap provider"))
 		}
@@ -365,6 +404,13 @@ func (v validator) validateBootstrap(
 			allErrs = append(allErrs, cloudinitvalidate.CloudConfigJSONRawMessage(p, *v)...)
 		}
 
+		if v := cloudInit.UserDataParts; len(v) > 0 {
+			if cloudInit.CloudConfig != nil || cloudInit.RawCloudConfig != nil {
+				allErrs = append(allErrs, field.Invalid(p, "cloudInit",
+					"userDataParts is mutually exclusive with cloudConfig and rawCloudConfig"))
+			}
+			allErrs = append(allErrs, cloudinitvalidate.UserDataParts(p, v)...)
+		}
 	}
 
 	if linuxPrep != nil {
@@ -447,6 +493,27 @@ func (v validator) validateBootstrap(
 
 	}
 
+	if ignition != nil {
+		p := bootstrapPath.Child("ignition")
+
+		if cloudInit != nil || linuxPrep != nil || sysPrep != nil || vAppConfig != nil {
+			allErrs = append(allErrs, field.Forbidden(p,
+				"Ignition may not be used with any other bootstrap provider"))
+		}
+
+		if ignition.Config != "" && ignition.RawConfig != nil {
+			allErrs = append(allErrs, field.Invalid(p, "ignition",
+				"config and rawConfig are mutually exclusive"))
+		} else if ignition.Config == "" && ignition.RawConfig == nil {
+			allErrs = append(allErrs, field.Invalid(p, "ignition",
+				"either config or rawConfig must be provided"))
+		}
+
+		if ignition.Config != "" {
+			allErrs = append(allErrs, ignitionvalidate.Config(p.Child("config"), []byte(ignition.Config))...)
+		}
+	}
+
 	return allErrs
 }
 
@@ -481,7 +548,7 @@ func (v validator) validateInlineSysprep(
 				"spec.network.domainName and joinWorkgroup are mutually exclusive"))
 		}
 
-		if domainName != "" {
+		if domainName != "" && identification.DomainJoin == nil {
 			if identification.DomainAdmin == "" ||
 				identification.DomainAdminPassword == nil ||
 				identification.DomainAdminPassword.Name == "" {
@@ -490,6 +557,24 @@ func (v validator) validateInlineSysprep(
 			}
 		}
 
+		if domainJoin := identification.DomainJoin; domainJoin != nil {
+			djPath := s.Child("identification", "domainJoin")
+
+			if identification.DomainAdmin != "" || identification.DomainAdminPassword != nil || identification.JoinWorkgroup != "" {
+				allErrs = append(allErrs, field.Invalid(s, "identification",
+					"domainJoin and domainAdmin/domainAdminPassword/joinWorkgroup are mutually exclusive"))
+			}
+
+			if domainName == "" {
+				allErrs = append(allErrs, field.Invalid(djPath, "domainJoin",
+					"domainJoin requires spec.network.domainName to be set"))
+			}
+
+			if domainJoin.Credentials.Name == "" {
+				allErrs = append(allErrs, field.Required(djPath.Child("credentials", "name"), ""))
+			}
+		}
+
 		if identification.JoinWorkgroup != "" {
 			if identification.DomainAdmin != "" || identification.DomainAdminPassword != nil || identification.DomainOU != "" {
 				allErrs = append(allErrs, field.Invalid(s, "identification",
@@ -565,6 +650,70 @@ func (v validator) validateImageOnCreate(
 	return allErrs
 }
 
+// validateImageSignature returns an error if the VM's namespace requires
+// verified images with the RequireVerifiedImagesAnnotation and the VM's image
+// does not have a True SignatureVerified condition.
+func (v validator) validateImageSignature(
+	ctx *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	if vm.Spec.Image == nil || vm.Spec.Image.Name == "" {
+		return nil
+	}
+
+	var (
+		allErrs field.ErrorList
+		f       = field.NewPath("spec", "image")
+	)
+
+	ns := &corev1.Namespace{}
+	if err := v.client.Get(ctx, ctrlclient.ObjectKey{Name: vm.Namespace}, ns); err != nil {
+		if !apierrors.IsNotFound(err) {
+			allErrs = append(allErrs, field.InternalError(f, err))
+		}
+		return allErrs
+	}
+
+	val, ok := ns.Annotations[vmopv1.RequireVerifiedImagesAnnotation]
+	if !ok {
+		return nil
+	}
+	required, err := strconv.ParseBool(val)
+	if err != nil {
+		return append(allErrs, field.InternalError(f,
+			fmt.Errorf("invalid namespace annotation %s: %w", vmopv1.RequireVerifiedImagesAnnotation, err)))
+	}
+	if !required {
+		return nil
+	}
+
+	var (
+		obj ctrlclient.Object
+		key = ctrlclient.ObjectKey{Name: vm.Spec.Image.Name}
+	)
+	switch vm.Spec.Image.Kind {
+	case vmiKind:
+		obj = &vmopv1.VirtualMachineImage{}
+		key.Namespace = vm.Namespace
+	case cvmiKind:
+		obj = &vmopv1.ClusterVirtualMachineImage{}
+	default:
+		// The image kind is validated by validateImageOnCreate.
+		return nil
+	}
+
+	if err := v.client.Get(ctx, key, obj); err != nil && !apierrors.IsNotFound(err) {
+		return append(allErrs, field.InternalError(f, err))
+	}
+
+	if !pkgcnd.IsTrue(obj.(pkgcnd.Getter), vmopv1.VirtualMachineImageSignatureVerifiedCondition) {
+		allErrs = append(allErrs, field.Forbidden(f.Child("name"),
+			fmt.Sprintf(imageSignatureNotVerifiedFmt, vm.Spec.Image.Kind, vm.Spec.Image.Name)))
+	}
+
+	return allErrs
+}
+
 func (v validator) validateClassOnCreate(
 	ctx *pkgctx.WebhookRequestContext,
 	vm *vmopv1.VirtualMachine) field.ErrorList {
@@ -669,6 +818,48 @@ func (v validator) validateClassOnUpdate(ctx *pkgctx.WebhookRequestContext, vm,
 	return allErrs
 }
 
+// validateClassDeprecation returns a warning, or an error if the class's
+// deprecation policy is Deny, when a VM is created with or updated to use a
+// deprecated VirtualMachineClass.
+func (v validator) validateClassDeprecation(
+	ctx *pkgctx.WebhookRequestContext,
+	vm, oldVM *vmopv1.VirtualMachine) (admission.Warnings, field.ErrorList) {
+
+	if vm.Spec.ClassName == "" {
+		return nil, nil
+	}
+	if oldVM != nil && oldVM.Spec.ClassName == vm.Spec.ClassName {
+		return nil, nil
+	}
+
+	vmClass := &vmopv1.VirtualMachineClass{}
+	if err := v.client.Get(
+		ctx,
+		ctrlclient.ObjectKey{Name: vm.Spec.ClassName, Namespace: vm.Namespace},
+		vmClass); err != nil {
+
+		// A missing class is reported by the VM's conditions.
+		return nil, nil
+	}
+
+	d := vmClass.Spec.Deprecation
+	if d == nil {
+		return nil, nil
+	}
+
+	msg := fmt.Sprintf(classDeprecatedFmt, vm.Spec.ClassName)
+	if d.ReplacementClassName != "" {
+		msg += fmt.Sprintf(classDeprecatedReplacementFmt, d.ReplacementClassName)
+	}
+
+	p := field.NewPath("spec", "className")
+	if d.Policy == vmopv1.VirtualMachineClassDeprecationPolicyDeny && !ctx.IsPrivilegedAccount {
+		return nil, field.ErrorList{field.Invalid(p, vm.Spec.ClassName, msg)}
+	}
+
+	return admission.Warnings{p.String() + ": " + msg}, nil
+}
+
 func (v validator) validateStorageClass(
 	ctx *pkgctx.WebhookRequestContext,
 	vm *vmopv1.VirtualMachine) field.ErrorList {
@@ -781,9 +972,15 @@ func (v validator) validateNetwork(
 		for i, interfaceSpec := range networkSpec.Interfaces {
 			allErrs = append(allErrs, v.validateNetworkInterfaceSpec(p.Index(i), interfaceSpec, vm.Name)...)
 			allErrs = append(allErrs, v.validateNetworkInterfaceSpecWithBootstrap(ctx, p.Index(i), interfaceSpec, vm)...)
+			allErrs = append(allErrs, v.validateNetworkInterfaceIPPool(ctx, p.Index(i), interfaceSpec)...)
 		}
+
+		allErrs = append(allErrs, v.validateNetworkInterfaceAdapterTypes(ctx, p, vm, oldVM)...)
 	}
 
+	allErrs = append(allErrs, v.validateNetworkGuestDevices(networkPath, vm)...)
+	allErrs = append(allErrs, v.validateNetworkFirewall(ctx, networkPath, vm)...)
+
 	if oldVM != nil {
 		if pkgcfg.FromContext(ctx).Features.MutableNetworks {
 			allErrs = append(allErrs, v.validateNetworkInterfaceMacAddressNotChanged(ctx, vm, oldVM)...)
@@ -793,6 +990,378 @@ func (v validator) validateNetwork(
 	return allErrs
 }
 
+// validateNetworkInterfaceIPPool validates that an interface that references
+// a VirtualMachineIPPool does not also specify its addresses or use DHCP,
+// since its addresses are allocated by the pool.
+func (v validator) validateNetworkInterfaceIPPool(
+	ctx *pkgctx.WebhookRequestContext,
+	interfacePath *field.Path,
+	interfaceSpec vmopv1.VirtualMachineNetworkInterfaceSpec) field.ErrorList {
+
+	if interfaceSpec.IPPoolName == "" {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	switch pkgcfg.FromContext(ctx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeVDS, pkgcfg.NetworkProviderTypeNamed:
+	default:
+		allErrs = append(allErrs, field.Invalid(interfacePath.Child("ipPoolName"), interfaceSpec.IPPoolName,
+			fmt.Sprintf(ipPoolNameNotSupportedFmt,
+				strings.Join([]string{
+					string(pkgcfg.NetworkProviderTypeVDS),
+					string(pkgcfg.NetworkProviderTypeNamed),
+				}, ","))))
+	}
+
+	if len(interfaceSpec.Addresses) > 0 {
+		allErrs = append(allErrs, field.Forbidden(interfacePath.Child("addresses"),
+			fmt.Sprintf(ipPoolNameMutuallyExclusiveFmt, "addresses")))
+	}
+	if interfaceSpec.DHCP4 {
+		allErrs = append(allErrs, field.Forbidden(interfacePath.Child("dhcp4"),
+			fmt.Sprintf(ipPoolNameMutuallyExclusiveFmt, "dhcp4")))
+	}
+	if interfaceSpec.DHCP6 {
+		allErrs = append(allErrs, field.Forbidden(interfacePath.Child("dhcp6"),
+			fmt.Sprintf(ipPoolNameMutuallyExclusiveFmt, "dhcp6")))
+	}
+
+	return allErrs
+}
+
+// validateNetworkFirewall validates the firewall rules. With the VDS and named
+// network providers, the rules are rendered into the guest, so the VM must use
+// a bootstrap provider that supports them. With Sysprep, the rules are applied
+// by GuiRunOnce commands that run only when the Administrator logs on, so
+// AutoLogon must be enabled.
+func (v validator) validateNetworkFirewall(
+	ctx *pkgctx.WebhookRequestContext,
+	networkPath *field.Path,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	firewall := vm.Spec.Network.Firewall
+	if firewall == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	firewallPath := networkPath.Child("firewall")
+
+	switch pkgcfg.FromContext(ctx).NetworkProviderType {
+	case pkgcfg.NetworkProviderTypeVDS, pkgcfg.NetworkProviderTypeNamed:
+		bootstrap := vm.Spec.Bootstrap
+		switch {
+		case bootstrap != nil && bootstrap.CloudInit != nil:
+			if raw := bootstrap.CloudInit.RawCloudConfig; raw != nil {
+				isMIME, err := v.isRawCloudConfigMIMEMultiPart(ctx, vm.Namespace, raw)
+				switch {
+				case err != nil:
+					allErrs = append(allErrs, field.InternalError(firewallPath, err))
+				case isMIME:
+					allErrs = append(allErrs, field.Invalid(
+						firewallPath, "firewall", firewallRawCloudConfigMIMENotSupported))
+				}
+			}
+		case bootstrap != nil && bootstrap.Sysprep != nil:
+			inline := bootstrap.Sysprep.Sysprep
+			switch {
+			case bootstrap.Sysprep.RawSysprep != nil:
+				allErrs = append(allErrs, field.Invalid(
+					firewallPath, "firewall", firewallRawSysprepNotSupported))
+			case inline == nil || inline.GUIUnattended == nil || !inline.GUIUnattended.AutoLogon:
+				allErrs = append(allErrs, field.Invalid(
+					firewallPath, "firewall", firewallSysprepRequiresAutoLogon))
+			}
+		default:
+			allErrs = append(allErrs, field.Invalid(
+				firewallPath, "firewall",
+				fmt.Sprintf(firewallBootstrapNotSupportedFmt, "CloudInit,Sysprep")))
+		}
+	}
+
+	ingressPath := firewallPath.Child("ingress")
+	for i, rule := range firewall.Ingress {
+		rulePath := ingressPath.Index(i)
+
+		if rule.EndPort != nil {
+			switch {
+			case rule.Port == nil:
+				allErrs = append(allErrs, field.Forbidden(
+					rulePath.Child("endPort"), firewallEndPortRequiresPort))
+			case *rule.EndPort < *rule.Port:
+				allErrs = append(allErrs, field.Invalid(
+					rulePath.Child("endPort"), *rule.EndPort, firewallEndPortLessThanPort))
+			}
+		}
+
+		for j, cidr := range rule.CIDRs {
+			if _, err := netip.ParsePrefix(cidr); err != nil {
+				allErrs = append(allErrs, field.Invalid(
+					rulePath.Child("cidrs").Index(j), cidr, invalidFirewallCIDR))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// isRawCloudConfigMIMEMultiPart returns true if the user data in the raw
+// cloud-config's Secret is a MIME multi-part document, since the firewall's
+// user data part cannot be added to it. False is returned if the Secret does
+// not exist yet.
+func (v validator) isRawCloudConfigMIMEMultiPart(
+	ctx *pkgctx.WebhookRequestContext,
+	namespace string,
+	raw *vmopv1common.SecretKeySelector) (bool, error) {
+
+	secret, err := pkgutil.GetSecretResource(ctx, v.client, namespace, raw.Name)
+	if err != nil {
+		return false, ctrlclient.IgnoreNotFound(err)
+	}
+
+	// The same keys are checked as when the VM is bootstrapped.
+	for _, key := range []string{raw.Key, "user-data", "value"} {
+		if data := secret.Data[key]; len(data) > 0 {
+			plainText, err := pkgutil.TryToDecodeBase64Gzip(data)
+			if err != nil {
+				return false, err
+			}
+			return cloudinit.IsMIMEMultiPart(plainText), nil
+		}
+	}
+
+	return false, nil
+}
+
+// validateNetworkInterfaceAdapterTypes validates that the VM's class fully
+// reserves the VM's memory when any of the VM's network interfaces use an
+// SR-IOV or DirectPath adapter, since these adapters cannot be powered on
+// otherwise.
+func (v validator) validateNetworkInterfaceAdapterTypes(
+	ctx *pkgctx.WebhookRequestContext,
+	interfacesPath *field.Path,
+	vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	if oldVM != nil &&
+		oldVM.Spec.ClassName == vm.Spec.ClassName &&
+		oldVM.Spec.Network != nil &&
+		slices.EqualFunc(
+			oldVM.Spec.Network.Interfaces,
+			vm.Spec.Network.Interfaces,
+			func(a, b vmopv1.VirtualMachineNetworkInterfaceSpec) bool {
+				return a.Name == b.Name && a.AdapterType == b.AdapterType
+			}) {
+
+		// Neither the class nor the adapter types have changed.
+		return nil
+	}
+
+	var (
+		allErrs  field.ErrorList
+		vmClass  *vmopv1.VirtualMachineClass
+		reserved bool
+	)
+
+	for i, interfaceSpec := range vm.Spec.Network.Interfaces {
+		switch interfaceSpec.AdapterType {
+		case vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV,
+			vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath:
+		default:
+			continue
+		}
+
+		if vm.Spec.ClassName == "" {
+			// The memory reservation of a VM without a class cannot be
+			// determined here.
+			return nil
+		}
+
+		if vmClass == nil {
+			vmClass = &vmopv1.VirtualMachineClass{}
+			if err := v.client.Get(
+				ctx,
+				ctrlclient.ObjectKey{Name: vm.Spec.ClassName, Namespace: vm.Namespace},
+				vmClass); err != nil {
+
+				// A missing class is reported by the VM's conditions.
+				return nil
+			}
+			reserved = isClassMemoryFullyReserved(vmClass)
+		}
+
+		if !reserved {
+			allErrs = append(allErrs, field.Invalid(
+				interfacesPath.Index(i).Child("adapterType"),
+				interfaceSpec.AdapterType,
+				fmt.Sprintf(adapterTypeRequiresMemoryReservationFmt, interfaceSpec.AdapterType, vm.Spec.ClassName)))
+		}
+	}
+
+	return allErrs
+}
+
+// isClassMemoryFullyReserved returns true if the VM class reserves all of the
+// memory of the VMs deployed from it, either via its ConfigSpec or its
+// resource policy.
+func isClassMemoryFullyReserved(vmClass *vmopv1.VirtualMachineClass) bool {
+	if len(vmClass.Spec.ConfigSpec) > 0 {
+		configSpec, err := pkgutil.UnmarshalConfigSpecFromJSON(vmClass.Spec.ConfigSpec)
+		if err == nil {
+			if ptr.Deref(configSpec.MemoryReservationLockedToMax) {
+				return true
+			}
+			if a := configSpec.MemoryAllocation; a != nil && a.Reservation != nil &&
+				configSpec.MemoryMB > 0 && *a.Reservation >= configSpec.MemoryMB {
+				return true
+			}
+		}
+	}
+
+	hw := vmClass.Spec.Hardware
+	rsv := vmClass.Spec.Policies.Resources.Requests.Memory
+	return !hw.Memory.IsZero() && rsv.Cmp(hw.Memory) >= 0
+}
+
+// validateNetworkGuestDevices validates the VLANs and bonds, including that
+// they reference existing network interfaces and bonds.
+//
+//nolint:gocyclo
+func (v validator) validateNetworkGuestDevices(
+	networkPath *field.Path,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	networkSpec := vm.Spec.Network
+	if len(networkSpec.VLANs) == 0 && len(networkSpec.Bonds) == 0 {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	vlansPath := networkPath.Child("vlans")
+	bondsPath := networkPath.Child("bonds")
+
+	// VLANs and bonds are rendered only into netplan.
+	if vm.Spec.Bootstrap == nil || vm.Spec.Bootstrap.CloudInit == nil {
+		if len(networkSpec.VLANs) > 0 {
+			allErrs = append(allErrs, field.Invalid(
+				vlansPath,
+				"vlans",
+				"vlans is available only with the following bootstrap providers: CloudInit",
+			))
+		}
+		if len(networkSpec.Bonds) > 0 {
+			allErrs = append(allErrs, field.Invalid(
+				bondsPath,
+				"bonds",
+				"bonds is available only with the following bootstrap providers: CloudInit",
+			))
+		}
+	}
+
+	interfaces := map[string]vmopv1.VirtualMachineNetworkInterfaceSpec{}
+	deviceNames := sets.New[string]()
+	for _, interfaceSpec := range networkSpec.Interfaces {
+		interfaces[interfaceSpec.Name] = interfaceSpec
+		deviceNames.Insert(interfaceSpec.Name)
+		if interfaceSpec.GuestDeviceName != "" {
+			deviceNames.Insert(interfaceSpec.GuestDeviceName)
+		}
+	}
+
+	bondNames := sets.New[string]()
+	for i, bond := range networkSpec.Bonds {
+		if deviceNames.Has(bond.Name) {
+			allErrs = append(allErrs, field.Duplicate(bondsPath.Index(i).Child("name"), bond.Name))
+		}
+		deviceNames.Insert(bond.Name)
+		bondNames.Insert(bond.Name)
+	}
+	for i, vlan := range networkSpec.VLANs {
+		if deviceNames.Has(vlan.Name) {
+			allErrs = append(allErrs, field.Duplicate(vlansPath.Index(i).Child("name"), vlan.Name))
+		}
+		deviceNames.Insert(vlan.Name)
+	}
+
+	bondMembers := sets.New[string]()
+	for i, bond := range networkSpec.Bonds {
+		p := bondsPath.Index(i)
+
+		for j, name := range bond.Interfaces {
+			ip := p.Child("interfaces").Index(j)
+
+			interfaceSpec, ok := interfaces[name]
+			if !ok {
+				allErrs = append(allErrs, field.NotFound(ip, name))
+				continue
+			}
+
+			if bondMembers.Has(name) {
+				allErrs = append(allErrs, field.Invalid(ip, name,
+					"network interface may be a member of only one bond"))
+				continue
+			}
+			bondMembers.Insert(name)
+
+			if len(interfaceSpec.Addresses) > 0 || interfaceSpec.DHCP4 || interfaceSpec.DHCP6 ||
+				interfaceSpec.Gateway4 != "" || interfaceSpec.Gateway6 != "" ||
+				len(interfaceSpec.Nameservers) > 0 || len(interfaceSpec.Routes) > 0 ||
+				len(interfaceSpec.SearchDomains) > 0 {
+
+				allErrs = append(allErrs, field.Invalid(ip, name,
+					"network interface that is a member of a bond may not have addresses, dhcp4, dhcp6, "+
+						"gateway4, gateway6, nameservers, routes, or searchDomains"))
+			}
+		}
+
+		if bond.Primary != "" {
+			if !slices.Contains(bond.Interfaces, bond.Primary) {
+				allErrs = append(allErrs, field.Invalid(p.Child("primary"), bond.Primary,
+					"must be one of the bond's interfaces"))
+			}
+			if bond.Mode != "" && bond.Mode != vmopv1.VirtualMachineNetworkBondModeActiveBackup {
+				allErrs = append(allErrs, field.Invalid(p.Child("primary"), bond.Primary,
+					fmt.Sprintf("primary is available only with bond mode %s",
+						vmopv1.VirtualMachineNetworkBondModeActiveBackup)))
+			}
+		}
+
+		allErrs = append(allErrs, v.validateNetworkIPConfig(p, bond.VirtualMachineNetworkGuestDeviceIPSpec)...)
+	}
+
+	vlanIDs := map[string]sets.Set[int32]{}
+	for i, vlan := range networkSpec.VLANs {
+		p := vlansPath.Index(i)
+
+		switch {
+		case bondNames.Has(vlan.Link):
+		case bondMembers.Has(vlan.Link):
+			allErrs = append(allErrs, field.Invalid(p.Child("link"), vlan.Link,
+				"may not be a network interface that is a member of a bond"))
+		default:
+			if _, ok := interfaces[vlan.Link]; !ok {
+				allErrs = append(allErrs, field.Invalid(p.Child("link"), vlan.Link,
+					"must be the name of a network interface or bond"))
+			}
+		}
+
+		if vlanIDs[vlan.Link] == nil {
+			vlanIDs[vlan.Link] = sets.New[int32]()
+		}
+		if vlanIDs[vlan.Link].Has(vlan.ID) {
+			allErrs = append(allErrs, field.Duplicate(p.Child("id"), vlan.ID))
+		}
+		vlanIDs[vlan.Link].Insert(vlan.ID)
+
+		allErrs = append(allErrs, v.validateNetworkIPConfig(p, vlan.VirtualMachineNetworkGuestDeviceIPSpec)...)
+	}
+
+	return allErrs
+}
+
 // validateNetworkInterfaceMacAddressNotChanged tries to check that the MAC address
 // for an interface is not changed. From the webhook this is best-effort: one could
 // always just remove and then quickly add back the interface with just different
@@ -885,6 +1454,13 @@ func (v validator) validateNetworkInterfaceSpec(
 		allErrs = append(allErrs, field.Invalid(interfacePath.Child("name"), networkIfCRName, "is the resulting network interface name: "+msg))
 	}
 
+	if interfaceSpec.PhysicalFunction != "" &&
+		interfaceSpec.AdapterType != vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV {
+
+		allErrs = append(allErrs, field.Invalid(interfacePath.Child("physicalFunction"),
+			interfaceSpec.PhysicalFunction, physicalFunctionRequiresSRIOV))
+	}
+
 	if interfaceSpec.MACAddr != "" {
 		if !slices.Contains(macAddressSupportNetworkGroups, networkGV.Group) {
 			allErrs = append(allErrs, field.Invalid(interfacePath.Child("macAddr"), interfaceSpec.MACAddr,
@@ -893,12 +1469,37 @@ func (v validator) validateNetworkInterfaceSpec(
 		}
 	}
 
+	allErrs = append(allErrs, v.validateNetworkIPConfig(
+		interfacePath,
+		vmopv1.VirtualMachineNetworkGuestDeviceIPSpec{
+			Addresses:     interfaceSpec.Addresses,
+			DHCP4:         interfaceSpec.DHCP4,
+			DHCP6:         interfaceSpec.DHCP6,
+			Gateway4:      interfaceSpec.Gateway4,
+			Gateway6:      interfaceSpec.Gateway6,
+			MTU:           interfaceSpec.MTU,
+			Nameservers:   interfaceSpec.Nameservers,
+			Routes:        interfaceSpec.Routes,
+			SearchDomains: interfaceSpec.SearchDomains,
+		})...)
+
+	return allErrs
+}
+
+// validateNetworkIPConfig validates the IP configuration of a network
+// interface, VLAN, or bond.
+func (v validator) validateNetworkIPConfig(
+	ipPath *field.Path,
+	ipSpec vmopv1.VirtualMachineNetworkGuestDeviceIPSpec) field.ErrorList {
+
+	var allErrs field.ErrorList
+
 	var ipv4Addrs, ipv6Addrs []string
-	for i, ipCIDR := range interfaceSpec.Addresses {
+	for i, ipCIDR := range ipSpec.Addresses {
 		// NOTE: VPC SubnetPort only takes the IP address so we might want to make this more flexible.
 		ip, _, err := net.ParseCIDR(ipCIDR)
 		if err != nil {
-			p := interfacePath.Child("addresses").Index(i)
+			p := ipPath.Child("addresses").Index(i)
 			allErrs = append(allErrs, field.Invalid(p, ipCIDR, err.Error()))
 			continue
 		}
@@ -910,8 +1511,8 @@ func (v validator) validateNetworkInterfaceSpec(
 		}
 	}
 
-	if ipv4 := interfaceSpec.Gateway4; ipv4 != "" && ipv4 != "None" {
-		p := interfacePath.Child("gateway4")
+	if ipv4 := ipSpec.Gateway4; ipv4 != "" && ipv4 != "None" {
+		p := ipPath.Child("gateway4")
 
 		if len(ipv4Addrs) == 0 {
 			allErrs = append(allErrs, field.Invalid(p, ipv4, "gateway4 must have an IPv4 address in the addresses field"))
@@ -922,8 +1523,8 @@ func (v validator) validateNetworkInterfaceSpec(
 		}
 	}
 
-	if ipv6 := interfaceSpec.Gateway6; ipv6 != "" && ipv6 != "None" {
-		p := interfacePath.Child("gateway6")
+	if ipv6 := ipSpec.Gateway6; ipv6 != "" && ipv6 != "None" {
+		p := ipPath.Child("gateway6")
 
 		if len(ipv6Addrs) == 0 {
 			allErrs = append(allErrs, field.Invalid(p, ipv6, "gateway6 must have an IPv6 address in the addresses field"))
@@ -934,43 +1535,43 @@ func (v validator) validateNetworkInterfaceSpec(
 		}
 	}
 
-	if interfaceSpec.DHCP4 {
+	if ipSpec.DHCP4 {
 		if len(ipv4Addrs) > 0 {
-			p := interfacePath.Child("dhcp4")
+			p := ipPath.Child("dhcp4")
 			allErrs = append(allErrs, field.Invalid(p, strings.Join(ipv4Addrs, ","),
 				"dhcp4 cannot be used with IPv4 addresses in addresses field"))
 		}
 
-		if gw := interfaceSpec.Gateway4; gw != "" {
-			p := interfacePath.Child("gateway4")
+		if gw := ipSpec.Gateway4; gw != "" {
+			p := ipPath.Child("gateway4")
 			allErrs = append(allErrs, field.Invalid(p, gw, "gateway4 is mutually exclusive with dhcp4"))
 		}
 	}
 
-	if interfaceSpec.DHCP6 {
+	if ipSpec.DHCP6 {
 		if len(ipv6Addrs) > 0 {
-			p := interfacePath.Child("dhcp6")
+			p := ipPath.Child("dhcp6")
 			allErrs = append(allErrs, field.Invalid(p, strings.Join(ipv6Addrs, ","),
 				"dhcp6 cannot be used with IPv6 addresses in addresses field"))
 		}
 
-		if gw := interfaceSpec.Gateway6; gw != "" {
-			p := interfacePath.Child("gateway6")
+		if gw := ipSpec.Gateway6; gw != "" {
+			p := ipPath.Child("gateway6")
 			allErrs = append(allErrs, field.Invalid(p, gw, "gateway6 is mutually exclusive with dhcp6"))
 		}
 	}
 
-	for i, n := range interfaceSpec.Nameservers {
+	for i, n := range ipSpec.Nameservers {
 		if net.ParseIP(n) == nil {
 			allErrs = append(allErrs,
-				field.Invalid(interfacePath.Child("nameservers").Index(i), n, "must be an IPv4 or IPv6 address"))
+				field.Invalid(ipPath.Child("nameservers").Index(i), n, "must be an IPv4 or IPv6 address"))
 		}
 	}
 
-	if len(interfaceSpec.Routes) > 0 {
-		p := interfacePath.Child("routes")
+	if len(ipSpec.Routes) > 0 {
+		p := ipPath.Child("routes")
 
-		for i, r := range interfaceSpec.Routes {
+		for i, r := range ipSpec.Routes {
 			var toIP net.IP
 			if r.To != "default" {
 				ip, _, err := net.ParseCIDR(r.To)
@@ -1618,6 +2219,33 @@ func (v validator) validateNextRestartTimeOnUpdate(
 	return allErrs
 }
 
+func (v validator) validateMaintenanceWindow(
+	_ *pkgctx.WebhookRequestContext,
+	vm *vmopv1.VirtualMachine) field.ErrorList {
+
+	mw := vm.Spec.MaintenanceWindow
+	if mw == nil {
+		return nil
+	}
+
+	var (
+		allErrs field.ErrorList
+		mwPath  = field.NewPath("spec", "maintenanceWindow")
+	)
+
+	if mw.Schedule == "" {
+		allErrs = append(allErrs, field.Required(mwPath.Child("schedule"), ""))
+	} else if _, err := cron.Parse(mw.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(mwPath.Child("schedule"), mw.Schedule, err.Error()))
+	}
+
+	if mw.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(mwPath.Child("duration"), mw.Duration.Duration.String(), mustBePositive))
+	}
+
+	return allErrs
+}
+
 func (v validator) validatePowerStateOnCreate(
 	_ *pkgctx.WebhookRequestContext,
 	vm *vmopv1.VirtualMachine) field.ErrorList {
@@ -1801,6 +2429,7 @@ func (v validator) validateHardware(
 
 	allErrs = append(allErrs, v.validateCdrom(ctx, newVM, oldVM)...)
 	allErrs = append(allErrs, v.validateControllers(ctx, newVM, oldVM)...)
+	allErrs = append(allErrs, v.validateCPUMemory(ctx, newVM, oldVM)...)
 
 	return allErrs
 }
@@ -1817,6 +2446,229 @@ func (v validator) validateHardwareWhenPoweredOn(
 	return allErrs
 }
 
+func (v validator) validateCPUMemory(
+	ctx *pkgctx.WebhookRequestContext,
+	newVM, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	var (
+		newCPUs, oldCPUs     *int64
+		newMemory, oldMemory *resource.Quantity
+	)
+	if hw := newVM.Spec.Hardware; hw != nil {
+		newCPUs, newMemory = hw.CPUs, hw.Memory
+	}
+	if oldVM != nil && oldVM.Spec.Hardware != nil {
+		oldCPUs, oldMemory = oldVM.Spec.Hardware.CPUs, oldVM.Spec.Hardware.Memory
+	}
+
+	// Only validate the overrides when they change so that existing VMs are
+	// not affected by a change to the namespace's limits or quota.
+	cpusChanged := !ptr.Equal(newCPUs, oldCPUs)
+	memoryChanged := !equality.Semantic.DeepEqual(newMemory, oldMemory)
+	if !cpusChanged && !memoryChanged {
+		return nil
+	}
+
+	var (
+		allErrs    field.ErrorList
+		cpusPath   = field.NewPath("spec", "hardware", "cpus")
+		memoryPath = field.NewPath("spec", "hardware", "memory")
+		features   = pkgcfg.FromContext(ctx).Features
+	)
+
+	if !features.VMResize && !features.VMResizeCPUMemory {
+		if cpusChanged && newCPUs != nil {
+			allErrs = append(allErrs, field.Forbidden(cpusPath, fmt.Sprintf(featureNotEnabled, "VM Resize")))
+		}
+		if memoryChanged && newMemory != nil {
+			allErrs = append(allErrs, field.Forbidden(memoryPath, fmt.Sprintf(featureNotEnabled, "VM Resize")))
+		}
+		return allErrs
+	}
+
+	if memoryChanged && newMemory != nil && newMemory.Sign() <= 0 {
+		return append(allErrs, field.Invalid(memoryPath, newMemory.String(), mustBePositive))
+	}
+
+	if builder.IsVMOperatorServiceAccount(ctx.WebhookContext, ctx.UserInfo) {
+		// VM Operator only changes these fields when it adopts drift, and
+		// the adopted values are what the VM is already using in vSphere.
+		return allErrs
+	}
+
+	ns := &corev1.Namespace{}
+	if err := v.client.Get(ctx, ctrlclient.ObjectKey{Name: newVM.Namespace}, ns); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return append(allErrs, field.InternalError(field.NewPath("spec", "hardware"), err))
+		}
+	}
+
+	if cpusChanged && newCPUs != nil {
+		if val, ok := ns.Annotations[vmopv1.MaxCPUsAnnotation]; ok {
+			maxCPUs, err := strconv.ParseInt(val, 10, 64)
+			if err != nil {
+				allErrs = append(allErrs, field.InternalError(cpusPath,
+					fmt.Errorf("invalid namespace annotation %s: %w", vmopv1.MaxCPUsAnnotation, err)))
+			} else if *newCPUs > maxCPUs {
+				allErrs = append(allErrs, field.Invalid(cpusPath, *newCPUs,
+					fmt.Sprintf(exceedsNamespaceLimitFmt, val)))
+			}
+		}
+	}
+
+	if memoryChanged && newMemory != nil {
+		if val, ok := ns.Annotations[vmopv1.MaxMemoryAnnotation]; ok {
+			maxMemory, err := resource.ParseQuantity(val)
+			if err != nil {
+				allErrs = append(allErrs, field.InternalError(memoryPath,
+					fmt.Errorf("invalid namespace annotation %s: %w", vmopv1.MaxMemoryAnnotation, err)))
+			} else if newMemory.Cmp(maxMemory) > 0 {
+				allErrs = append(allErrs, field.Invalid(memoryPath, newMemory.String(),
+					fmt.Sprintf(exceedsNamespaceLimitFmt, val)))
+			}
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	return v.validateCPUMemoryQuota(ctx, newVM, oldVM)
+}
+
+// validateCPUMemoryQuota returns an error if increasing the VM's CPU or memory
+// would exceed the limits.cpu or limits.memory of a ResourceQuota in the VM's
+// namespace. The CPU and memory of every VM in the namespace count against the
+// quota, in addition to the usage already recorded by the quota.
+func (v validator) validateCPUMemoryQuota(
+	ctx *pkgctx.WebhookRequestContext,
+	newVM, oldVM *vmopv1.VirtualMachine) field.ErrorList {
+
+	quotaList := &corev1.ResourceQuotaList{}
+	if err := v.client.List(ctx, quotaList, ctrlclient.InNamespace(newVM.Namespace)); err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "hardware"), err)}
+	}
+
+	var quotas []corev1.ResourceQuota
+	for _, q := range quotaList.Items {
+		_, hasCPU := q.Spec.Hard[corev1.ResourceLimitsCPU]
+		_, hasMemory := q.Spec.Hard[corev1.ResourceLimitsMemory]
+		if hasCPU || hasMemory {
+			quotas = append(quotas, q)
+		}
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	classList := &vmopv1.VirtualMachineClassList{}
+	if err := v.client.List(ctx, classList, ctrlclient.InNamespace(newVM.Namespace)); err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "hardware"), err)}
+	}
+	classes := make(map[string]vmopv1.VirtualMachineClass, len(classList.Items))
+	for _, c := range classList.Items {
+		classes[c.Name] = c
+	}
+
+	newUsage := vmCPUMemoryUsage(*newVM, classes)
+	oldUsage := corev1.ResourceList{}
+	if oldVM != nil {
+		oldUsage = vmCPUMemoryUsage(*oldVM, classes)
+	}
+
+	// The usage of the VM only counts against the quota if it increases.
+	var requested []corev1.ResourceName
+	for _, name := range []corev1.ResourceName{corev1.ResourceLimitsCPU, corev1.ResourceLimitsMemory} {
+		newQ, oldQ := newUsage[name], oldUsage[name]
+		if newQ.Cmp(oldQ) > 0 {
+			requested = append(requested, name)
+		}
+	}
+	if len(requested) == 0 {
+		return nil
+	}
+
+	vmList := &vmopv1.VirtualMachineList{}
+	if err := v.client.List(ctx, vmList, ctrlclient.InNamespace(newVM.Namespace)); err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "hardware"), err)}
+	}
+
+	vmsUsage := corev1.ResourceList{}
+	for _, vm := range vmList.Items {
+		if vm.Name == newVM.Name {
+			continue
+		}
+		for name, q := range vmCPUMemoryUsage(vm, classes) {
+			total := vmsUsage[name]
+			total.Add(q)
+			vmsUsage[name] = total
+		}
+	}
+
+	var allErrs field.ErrorList
+
+	for _, quota := range quotas {
+		for _, name := range requested {
+			hard, ok := quota.Spec.Hard[name]
+			if !ok {
+				continue
+			}
+
+			used := quota.Status.Used[name].DeepCopy()
+			used.Add(vmsUsage[name])
+
+			total := used.DeepCopy()
+			total.Add(newUsage[name])
+			if total.Cmp(hard) <= 0 {
+				continue
+			}
+
+			p := field.NewPath("spec", "hardware", "cpus")
+			if name == corev1.ResourceLimitsMemory {
+				p = field.NewPath("spec", "hardware", "memory")
+			}
+			requestedQ := newUsage[name]
+			allErrs = append(allErrs, field.Forbidden(p, fmt.Sprintf(exceededQuotaFmt,
+				quota.Name,
+				name, requestedQ.String(),
+				name, used.String(),
+				name, hard.String())))
+		}
+	}
+
+	return allErrs
+}
+
+// vmCPUMemoryUsage returns the CPU and memory of the VM that count against a
+// ResourceQuota. The VM's spec.hardware overrides take precedence over the
+// values from the VM's class.
+func vmCPUMemoryUsage(
+	vm vmopv1.VirtualMachine,
+	classes map[string]vmopv1.VirtualMachineClass) corev1.ResourceList {
+
+	var (
+		cpus   int64
+		memory resource.Quantity
+	)
+	if c, ok := classes[vm.Spec.ClassName]; ok {
+		cpus = c.Spec.Hardware.Cpus
+		memory = c.Spec.Hardware.Memory
+	}
+	if hw := vm.Spec.Hardware; hw != nil {
+		if hw.CPUs != nil {
+			cpus = *hw.CPUs
+		}
+		if hw.Memory != nil {
+			memory = *hw.Memory
+		}
+	}
+
+	return corev1.ResourceList{
+		corev1.ResourceLimitsCPU:    *resource.NewQuantity(cpus, resource.DecimalSI),
+		corev1.ResourceLimitsMemory: memory,
+	}
+}
+
 func (v validator) validateImmutableFields(
 	ctx *pkgctx.WebhookRequestContext,
 	vm, oldVM *vmopv1.VirtualMachine) field.ErrorList {
@@ -2031,6 +2883,15 @@ func (v validator) validateAnnotation(ctx *pkgctx.WebhookRequestContext, vm, old
 		}
 	}
 
+	if namedPorts, ok := vm.Annotations[vmopv1.NamedPortsAnnotation]; ok {
+		if _, err := vmopv1util.ParseNamedPorts(namedPorts); err != nil {
+			allErrs = append(allErrs, field.Invalid(
+				annotationPath.Key(vmopv1.NamedPortsAnnotation),
+				namedPorts,
+				err.Error()))
+		}
+	}
+
 	if ctx.IsPrivilegedAccount {
 		return allErrs
 	}
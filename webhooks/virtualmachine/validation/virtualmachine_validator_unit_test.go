@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	vimtypes "github.com/vmware/govmomi/vim25/types"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -34,6 +36,7 @@ import (
 	"github.com/vmware-tanzu/vm-operator/api/v1alpha5/sysprep"
 	topologyv1 "github.com/vmware-tanzu/vm-operator/external/tanzu-topology/api/v1alpha1"
 	pkgbuilder "github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgcnd "github.com/vmware-tanzu/vm-operator/pkg/conditions"
 	pkgcfg "github.com/vmware-tanzu/vm-operator/pkg/config"
 	pkgconst "github.com/vmware-tanzu/vm-operator/pkg/constants"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
@@ -1456,6 +1459,23 @@ func unitTestsValidateCreate() {
 						`metadata.annotations[vsphere-cluster-module-group]: Forbidden: cluster module assignment requires spec.reserved.resourcePolicyName to specify a VirtualMachineSetResourcePolicy`),
 				},
 			),
+			Entry("should allow creating VM with named ports",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Annotations[vmopv1.NamedPortsAnnotation] = "http=8080,dns=53/UDP"
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should disallow creating VM with invalid named ports",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Annotations[vmopv1.NamedPortsAnnotation] = "http"
+					},
+					validate: doValidateWithMsg(
+						`metadata.annotations[vmoperator.vmware.com/named-ports]: Invalid value: "http": invalid named port "http": must be name=port[/protocol]`),
+				},
+			),
 		)
 
 		getProtectedAnnotationTableAllowCreate := func() []any {
@@ -1930,6 +1950,102 @@ func unitTestsValidateCreate() {
 					),
 				},
 			),
+			Entry("allow CloudInit with UserDataParts",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+									{
+										Name:  "base",
+										Value: ptr.To("#cloud-config\nruncmd:\n- echo hello\n"),
+									},
+									{
+										Name:        "app",
+										ContentType: vmopv1.VirtualMachineBootstrapCloudInitUserDataPartContentTypeShellScript,
+										ConfigMap: &common.ConfigMapKeySelector{
+											Name: "my-configmap",
+											Key:  "app.sh",
+										},
+									},
+									{
+										Name: "secrets",
+										Secret: &common.SecretKeySelector{
+											Name: "my-secret",
+											Key:  "user-data",
+										},
+									},
+								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow CloudInit mixing UserDataParts and RawCloudConfig",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								RawCloudConfig: &common.SecretKeySelector{},
+								UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+									{
+										Name:  "base",
+										Value: ptr.To("#cloud-config\n"),
+									},
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.cloudInit: Invalid value: "cloudInit": userDataParts is mutually exclusive with cloudConfig and rawCloudConfig`,
+					),
+				},
+			),
+			Entry("disallow CloudInit UserDataParts with more than one source",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+									{
+										Name:  "base",
+										Value: ptr.To("#cloud-config\n"),
+										Secret: &common.SecretKeySelector{
+											Name: "my-secret",
+											Key:  "user-data",
+										},
+									},
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.cloudInit.userDataParts[base]: Invalid value: "base": exactly one of value, configMap, or secret must be specified`,
+					),
+				},
+			),
+			Entry("disallow CloudInit UserDataParts with invalid inline CloudConfig",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+								UserDataParts: []vmopv1.VirtualMachineBootstrapCloudInitUserDataPart{
+									{
+										Name:  "base",
+										Value: ptr.To("#cloud-config\nruncmd: hello\n"),
+									},
+								},
+							},
+						}
+					},
+					validate: func(response admission.Response) {
+						Expect(response.Allowed).To(BeFalse())
+						Expect(string(response.Result.Reason)).To(ContainSubstring(
+							`spec.bootstrap.cloudInit.userDataParts[base].value: Invalid value:`))
+					},
+				},
+			),
 			Entry("disallow Sysprep mixing inline Sysprep and RawSysprep",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -1945,6 +2061,87 @@ func unitTestsValidateCreate() {
 					),
 				},
 			),
+			Entry("allow Ignition bootstrap with inline config",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: `{"ignition":{"version":"3.4.0"}}`,
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("allow Ignition bootstrap with raw config",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								RawConfig: &common.SecretKeySelector{},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow empty Ignition bootstrap",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.ignition: Invalid value: "ignition": either config or rawConfig must be provided`,
+					),
+				},
+			),
+			Entry("disallow Ignition mixing inline Config and RawConfig",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config:    `{"ignition":{"version":"3.4.0"}}`,
+								RawConfig: &common.SecretKeySelector{},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.ignition: Invalid value: "ignition": config and rawConfig are mutually exclusive`,
+					),
+				},
+			),
+			Entry("disallow Ignition with an invalid inline config",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								Config: `{"ignition":{"version":"2.3.0"}}`,
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.ignition.config.ignition.version: Invalid value: "2.3.0": value must be a supported Ignition spec version, ex. 3.4.0`,
+					),
+				},
+			),
+			Entry("disallow CloudInit and Ignition specified at the same time",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{
+								RawConfig: &common.SecretKeySelector{},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.cloudInit: Forbidden: CloudInit may not be used with any other bootstrap provider`,
+						`spec.bootstrap.ignition: Forbidden: Ignition may not be used with any other bootstrap provider`,
+					),
+				},
+			),
 			Entry("disallow LinuxPrep mixing ScriptText Value From Secret and direct String pointer",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -2030,6 +2227,52 @@ func unitTestsValidateCreate() {
 					),
 				},
 			),
+			Entry("allow Sysprep domainJoin",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							DomainName: "foo-domain",
+						}
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+								Sysprep: &sysprep.Sysprep{
+									Identification: &sysprep.Identification{
+										DomainOU: "foo-ou",
+										DomainJoin: &sysprep.DomainJoin{
+											Credentials: sysprep.DomainJoinCredentialsSecretKeySelector{
+												Name: "foo-creds",
+											},
+										},
+									},
+								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("disallow Sysprep domainJoin mixed with domainAdmin and joinWorkgroup",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+								Sysprep: &sysprep.Sysprep{
+									Identification: &sysprep.Identification{
+										DomainAdmin:   "admin@os.local",
+										JoinWorkgroup: "foo-wg",
+										DomainJoin:    &sysprep.DomainJoin{},
+									},
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.bootstrap.sysprep.sysprep: Invalid value: "identification": domainJoin and domainAdmin/domainAdminPassword/joinWorkgroup are mutually exclusive`,
+						`spec.bootstrap.sysprep.sysprep.identification.domainJoin: Invalid value: "domainJoin": domainJoin requires spec.network.domainName to be set`,
+						`spec.bootstrap.sysprep.sysprep.identification.domainJoin.credentials.name: Required value`,
+					),
+				},
+			),
 			Entry("disallow vAppConfig mixing inline Properties and RawProperties",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -2414,6 +2657,146 @@ func unitTestsValidateCreate() {
 				},
 			),
 
+			Entry("allow vlans and bonds when bootstrap is CloudInit",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+						}
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{Name: "eth0"},
+								{Name: "eth1"},
+								{Name: "eth2"},
+							},
+							Bonds: []vmopv1.VirtualMachineNetworkBondSpec{
+								{
+									Name:       "bond0",
+									Interfaces: []string{"eth0", "eth1"},
+									Mode:       vmopv1.VirtualMachineNetworkBondModeActiveBackup,
+									Primary:    "eth0",
+									VirtualMachineNetworkGuestDeviceIPSpec: vmopv1.VirtualMachineNetworkGuestDeviceIPSpec{
+										DHCP4: true,
+									},
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "bond0.100",
+									ID:   100,
+									Link: "bond0",
+									VirtualMachineNetworkGuestDeviceIPSpec: vmopv1.VirtualMachineNetworkGuestDeviceIPSpec{
+										Addresses: []string{"192.168.100.10/24"},
+										Gateway4:  "192.168.100.1",
+									},
+								},
+								{
+									Name: "eth2.200",
+									ID:   200,
+									Link: "eth2",
+								},
+							},
+						}
+					},
+					expectAllowed: true,
+				},
+			),
+
+			Entry("disallows vlans and bonds without CloudInit",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{Name: "eth0"},
+							},
+							Bonds: []vmopv1.VirtualMachineNetworkBondSpec{
+								{
+									Name:       "bond0",
+									Interfaces: []string{"eth0"},
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "bond0.100",
+									ID:   100,
+									Link: "bond0",
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.vlans: Invalid value: "vlans": vlans is available only with the following bootstrap providers: CloudInit`,
+						`spec.network.bonds: Invalid value: "bonds": bonds is available only with the following bootstrap providers: CloudInit`,
+					),
+				},
+			),
+
+			Entry("validate vlan and bond references",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+						}
+						ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+							Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+								{Name: "eth0"},
+								{Name: "eth1", DHCP4: true},
+							},
+							Bonds: []vmopv1.VirtualMachineNetworkBondSpec{
+								{
+									Name:       "bond0",
+									Interfaces: []string{"eth0", "eth1", "eth9"},
+									Mode:       vmopv1.VirtualMachineNetworkBondModeBalanceRR,
+									Primary:    "eth2",
+								},
+								{
+									Name:       "bond1",
+									Interfaces: []string{"eth0"},
+								},
+							},
+							VLANs: []vmopv1.VirtualMachineNetworkVLANSpec{
+								{
+									Name: "bond0.100",
+									ID:   100,
+									Link: "bond0",
+								},
+								{
+									Name: "eth1",
+									ID:   100,
+									Link: "bond0",
+								},
+								{
+									Name: "eth0.100",
+									ID:   100,
+									Link: "eth0",
+								},
+								{
+									Name: "eth9.100",
+									ID:   100,
+									Link: "eth9",
+									VirtualMachineNetworkGuestDeviceIPSpec: vmopv1.VirtualMachineNetworkGuestDeviceIPSpec{
+										Addresses: []string{"192.168.100.10/24"},
+										DHCP4:     true,
+									},
+								},
+							},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.network.bonds[0].interfaces[1]: Invalid value: "eth1": network interface that is a member of a bond may not have addresses, dhcp4, dhcp6, gateway4, gateway6, nameservers, routes, or searchDomains`,
+						`spec.network.bonds[0].interfaces[2]: Not found: "eth9"`,
+						`spec.network.bonds[0].primary: Invalid value: "eth2": must be one of the bond's interfaces`,
+						`spec.network.bonds[0].primary: Invalid value: "eth2": primary is available only with bond mode active-backup`,
+						`spec.network.bonds[1].interfaces[0]: Invalid value: "eth0": network interface may be a member of only one bond`,
+						`spec.network.vlans[1].name: Duplicate value: "eth1"`,
+						`spec.network.vlans[1].id: Duplicate value: 100`,
+						`spec.network.vlans[2].link: Invalid value: "eth0": may not be a network interface that is a member of a bond`,
+						`spec.network.vlans[3].link: Invalid value: "eth9": must be the name of a network interface or bond`,
+						`spec.network.vlans[3].dhcp4: Invalid value: "192.168.100.10/24": dhcp4 cannot be used with IPv4 addresses in addresses field`,
+					),
+				},
+			),
+
 			Entry("allow dhcp",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
@@ -4216,28 +4599,830 @@ func unitTestsValidateCreate() {
 		)
 	})
 
-	Context("spec.biosUUID", func() {
-		DescribeTable("create", doTest,
-			Entry("should allow when VM specifies valid UUID",
+	Context("MaintenanceWindow", func() {
+		DescribeTable("validateMaintenanceWindow", doTest,
+			Entry("allow a valid spec.maintenanceWindow",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.BiosUUID = uuid.NewString()
+						ctx.vm.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+							Schedule: "0 2 * * 6",
+							Duration: metav1.Duration{Duration: 4 * time.Hour},
+						}
 					},
 					expectAllowed: true,
 				},
 			),
-			Entry("should not allow when VM specifies invalid UUID",
+			Entry("disallow an empty schedule",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
-						ctx.vm.Spec.BiosUUID = "invalid UUID"
+						ctx.vm.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+							Duration: metav1.Duration{Duration: 4 * time.Hour},
+						}
 					},
-					expectAllowed: false,
 					validate: doValidateWithMsg(
-						field.Invalid(field.NewPath("spec", "biosUUID"), "invalid UUID", "must provide a valid UUID").Error(),
-					),
+						field.Required(field.NewPath("spec", "maintenanceWindow", "schedule"), "").Error()),
+					expectAllowed: false,
 				},
 			),
-			Entry("should allow when VM specifies no UUID",
+			Entry("disallow an invalid schedule",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+							Schedule: "0 25 * * *",
+							Duration: metav1.Duration{Duration: 4 * time.Hour},
+						}
+					},
+					validate: doValidateWithMsg(
+						`spec.maintenanceWindow.schedule: Invalid value: "0 25 * * *": value 25 in hour field is not between 0 and 23`),
+					expectAllowed: false,
+				},
+			),
+			Entry("disallow a non-positive duration",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.MaintenanceWindow = &vmopv1.VirtualMachineMaintenanceWindow{
+							Schedule: "0 2 * * 6",
+						}
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(field.NewPath("spec", "maintenanceWindow", "duration"), "0s", "must be greater than zero").Error()),
+					expectAllowed: false,
+				},
+			),
+		)
+	})
+
+	Context("spec.hardware.cpus and spec.hardware.memory", func() {
+		cpusPath := field.NewPath("spec", "hardware", "cpus")
+		memoryPath := field.NewPath("spec", "hardware", "memory")
+
+		setOverrides := func(ctx *unitValidatingWebhookContext, cpus int64, memory string) {
+			ctx.vm.Spec.Hardware.CPUs = ptr.To(cpus)
+			ctx.vm.Spec.Hardware.Memory = ptr.To(resource.MustParse(memory))
+		}
+
+		enableResize := func(ctx *unitValidatingWebhookContext) {
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.Features.VMResize = true
+			})
+		}
+
+		createNamespace := func(ctx *unitValidatingWebhookContext, annotations map[string]string) {
+			Expect(ctx.Client.Create(ctx, &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        ctx.vm.Namespace,
+					Annotations: annotations,
+				},
+			})).To(Succeed())
+		}
+
+		createQuota := func(ctx *unitValidatingWebhookContext, hard, used corev1.ResourceList) {
+			Expect(ctx.Client.Create(ctx, &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "compute",
+					Namespace: ctx.vm.Namespace,
+				},
+				Spec: corev1.ResourceQuotaSpec{
+					Hard: hard,
+				},
+				Status: corev1.ResourceQuotaStatus{
+					Hard: hard,
+					Used: used,
+				},
+			})).To(Succeed())
+		}
+
+		DescribeTable("create", doTest,
+			Entry("should deny when resize is not enabled",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setOverrides(ctx, 4, "8Gi")
+					},
+					validate: doValidateWithMsg(
+						field.Forbidden(cpusPath, "the VM Resize feature is not enabled").Error(),
+						field.Forbidden(memoryPath, "the VM Resize feature is not enabled").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow overrides",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						enableResize(ctx)
+						setOverrides(ctx, 4, "8Gi")
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny a non-positive memory",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						enableResize(ctx)
+						setOverrides(ctx, 4, "0")
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(memoryPath, "0", "must be greater than zero").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow overrides within the namespace limits",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						enableResize(ctx)
+						setOverrides(ctx, 4, "8Gi")
+						createNamespace(ctx, map[string]string{
+							vmopv1.MaxCPUsAnnotation:   "4",
+							vmopv1.MaxMemoryAnnotation: "8Gi",
+						})
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny overrides that exceed the namespace limits",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						enableResize(ctx)
+						setOverrides(ctx, 8, "16Gi")
+						createNamespace(ctx, map[string]string{
+							vmopv1.MaxCPUsAnnotation:   "4",
+							vmopv1.MaxMemoryAnnotation: "8Gi",
+						})
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(cpusPath, int64(8), "must be less than or equal to 4, the limit for the namespace").Error(),
+						field.Invalid(memoryPath, "16Gi", "must be less than or equal to 8Gi, the limit for the namespace").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow VM Operator service account to exceed the namespace limits",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						enableResize(ctx)
+						setOverrides(ctx, 8, "16Gi")
+						createNamespace(ctx, map[string]string{
+							vmopv1.MaxCPUsAnnotation:   "4",
+							vmopv1.MaxMemoryAnnotation: "8Gi",
+						})
+						ctx.IsPrivilegedAccount = false
+						ctx.UserInfo.Username = strings.Join(
+							[]string{
+								"system",
+								"serviceaccount",
+								ctx.Namespace,
+								ctx.ServiceAccountName,
+							}, ":")
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow overrides within the quota",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						enableResize(ctx)
+						setOverrides(ctx, 4, "8Gi")
+						createQuota(ctx,
+							corev1.ResourceList{
+								corev1.ResourceLimitsCPU:    resource.MustParse("8"),
+								corev1.ResourceLimitsMemory: resource.MustParse("16Gi"),
+							},
+							corev1.ResourceList{
+								corev1.ResourceLimitsCPU:    resource.MustParse("4"),
+								corev1.ResourceLimitsMemory: resource.MustParse("8Gi"),
+							})
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny overrides that exceed the quota",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						enableResize(ctx)
+						setOverrides(ctx, 4, "8Gi")
+						createQuota(ctx,
+							corev1.ResourceList{
+								corev1.ResourceLimitsCPU:    resource.MustParse("8"),
+								corev1.ResourceLimitsMemory: resource.MustParse("16Gi"),
+							},
+							nil)
+
+						other := builder.DummyVirtualMachine()
+						other.Name = "other-vm"
+						other.Namespace = ctx.vm.Namespace
+						other.Spec.Hardware.CPUs = ptr.To(int64(6))
+						other.Spec.Hardware.Memory = ptr.To(resource.MustParse("4Gi"))
+						Expect(ctx.Client.Create(ctx, other)).To(Succeed())
+					},
+					validate: doValidateWithMsg(
+						field.Forbidden(cpusPath, "exceeded quota: compute, requested: limits.cpu=4, used: limits.cpu=6, limited: limits.cpu=8").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+		)
+	})
+
+	Context("spec.className deprecation", func() {
+		classNamePath := field.NewPath("spec", "className")
+
+		createDeprecatedClass := func(
+			ctx *unitValidatingWebhookContext,
+			policy vmopv1.VirtualMachineClassDeprecationPolicy) {
+
+			ctx.vm.Spec.ClassName = newVMClass
+			Expect(ctx.Client.Create(ctx, &vmopv1.VirtualMachineClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      newVMClass,
+					Namespace: ctx.vm.Namespace,
+				},
+				Spec: vmopv1.VirtualMachineClassSpec{
+					Deprecation: &vmopv1.VirtualMachineClassDeprecation{
+						ReplacementClassName: oldVMClass,
+						Policy:               policy,
+					},
+				},
+			})).To(Succeed())
+		}
+
+		DescribeTable("create", doTest,
+			Entry("should allow with a warning when the class is deprecated",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createDeprecatedClass(ctx, vmopv1.VirtualMachineClassDeprecationPolicyWarn)
+					},
+					validate: func(response admission.Response) {
+						Expect(response.Warnings).To(ConsistOf(
+							"spec.className: VirtualMachineClass " + newVMClass + " is deprecated, use " + oldVMClass + " instead"))
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny when the class is deprecated with the Deny policy",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createDeprecatedClass(ctx, vmopv1.VirtualMachineClassDeprecationPolicyDeny)
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(classNamePath, newVMClass,
+							"VirtualMachineClass "+newVMClass+" is deprecated, use "+oldVMClass+" instead").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow privileged users when the class is deprecated with the Deny policy",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.IsPrivilegedAccount = true
+						createDeprecatedClass(ctx, vmopv1.VirtualMachineClassDeprecationPolicyDeny)
+					},
+					expectAllowed: true,
+				},
+			),
+		)
+	})
+
+	Context("spec.network.interfaces[].adapterType", func() {
+		adapterTypePath := field.NewPath("spec", "network", "interfaces").Index(0).Child("adapterType")
+
+		setAdapterType := func(
+			ctx *unitValidatingWebhookContext,
+			adapterType vmopv1.VirtualMachineNetworkInterfaceAdapterType,
+			physicalFunction string) {
+
+			ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+				Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{
+					{
+						Name:             "eth0",
+						AdapterType:      adapterType,
+						PhysicalFunction: physicalFunction,
+					},
+				},
+			}
+		}
+
+		createClass := func(
+			ctx *unitValidatingWebhookContext,
+			memory, reservation string) {
+
+			ctx.vm.Spec.ClassName = newVMClass
+			Expect(ctx.Client.Create(ctx, &vmopv1.VirtualMachineClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      newVMClass,
+					Namespace: ctx.vm.Namespace,
+				},
+				Spec: vmopv1.VirtualMachineClassSpec{
+					Hardware: vmopv1.VirtualMachineClassHardware{
+						Memory: resource.MustParse(memory),
+					},
+					Policies: vmopv1.VirtualMachineClassPolicies{
+						Resources: vmopv1.VirtualMachineClassResources{
+							Requests: vmopv1.VirtualMachineResourceSpec{
+								Memory: resource.MustParse(reservation),
+							},
+						},
+					},
+				},
+			})).To(Succeed())
+		}
+
+		DescribeTable("create", doTest,
+			Entry("should allow SRIOV when the class fully reserves memory",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setAdapterType(ctx, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV, "0000:3b:00.0")
+						createClass(ctx, "4Gi", "4Gi")
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny SRIOV when the class does not fully reserve memory",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setAdapterType(ctx, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV, "")
+						createClass(ctx, "4Gi", "2Gi")
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(adapterTypePath, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeSRIOV,
+							"SRIOV adapter type requires the memory of VirtualMachineClass "+newVMClass+" to be fully reserved").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should deny DirectPath when the class does not fully reserve memory",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setAdapterType(ctx, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath, "")
+						createClass(ctx, "4Gi", "0")
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(adapterTypePath, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath,
+							"DirectPath adapter type requires the memory of VirtualMachineClass "+newVMClass+" to be fully reserved").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow Vmxnet3 when the class does not fully reserve memory",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setAdapterType(ctx, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeVmxnet3, "")
+						createClass(ctx, "4Gi", "0")
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny physicalFunction without the SRIOV adapter type",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setAdapterType(ctx, vmopv1.VirtualMachineNetworkInterfaceAdapterTypeDirectPath, "0000:3b:00.0")
+						createClass(ctx, "4Gi", "4Gi")
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(field.NewPath("spec", "network", "interfaces").Index(0).Child("physicalFunction"),
+							"0000:3b:00.0", "physicalFunction may only be specified with the SRIOV adapter type").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+		)
+	})
+
+	Context("spec.network.interfaces[].ipPoolName", func() {
+		interfacePath := field.NewPath("spec", "network", "interfaces").Index(0)
+
+		setIPPool := func(
+			ctx *unitValidatingWebhookContext,
+			networkProviderType pkgcfg.NetworkProviderType,
+			mutateFn func(*vmopv1.VirtualMachineNetworkInterfaceSpec)) {
+
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = networkProviderType
+			})
+
+			interfaceSpec := vmopv1.VirtualMachineNetworkInterfaceSpec{
+				Name:       "eth0",
+				IPPoolName: "my-ip-pool",
+			}
+			if mutateFn != nil {
+				mutateFn(&interfaceSpec)
+			}
+			ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+				Interfaces: []vmopv1.VirtualMachineNetworkInterfaceSpec{interfaceSpec},
+			}
+		}
+
+		DescribeTable("create", doTest,
+			Entry("should allow with the VDS network provider",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setIPPool(ctx, pkgcfg.NetworkProviderTypeVDS, nil)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow with the named network provider",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setIPPool(ctx, pkgcfg.NetworkProviderTypeNamed, nil)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny with the VPC network provider",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setIPPool(ctx, pkgcfg.NetworkProviderTypeVPC, nil)
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(interfacePath.Child("ipPoolName"), "my-ip-pool",
+							"ipPoolName is available only with the following network providers: VSPHERE_NETWORK,NAMED").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should deny with addresses",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setIPPool(ctx, pkgcfg.NetworkProviderTypeVDS, func(s *vmopv1.VirtualMachineNetworkInterfaceSpec) {
+							s.Addresses = []string{"192.168.1.10/24"}
+						})
+					},
+					validate: doValidateWithMsg(
+						field.Forbidden(interfacePath.Child("addresses"), "addresses may not be specified with ipPoolName").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should deny with DHCP",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setIPPool(ctx, pkgcfg.NetworkProviderTypeVDS, func(s *vmopv1.VirtualMachineNetworkInterfaceSpec) {
+							s.DHCP4 = true
+							s.DHCP6 = true
+						})
+					},
+					validate: doValidateWithMsg(
+						field.Forbidden(interfacePath.Child("dhcp4"), "dhcp4 may not be specified with ipPoolName").Error(),
+						field.Forbidden(interfacePath.Child("dhcp6"), "dhcp6 may not be specified with ipPoolName").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+		)
+	})
+
+	Context("spec.image signature", func() {
+		imageNamePath := field.NewPath("spec", "image", "name")
+
+		createNamespace := func(ctx *unitValidatingWebhookContext, requireVerifiedImages string) {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ctx.vm.Namespace,
+				},
+			}
+			if requireVerifiedImages != "" {
+				ns.Annotations = map[string]string{
+					vmopv1.RequireVerifiedImagesAnnotation: requireVerifiedImages,
+				}
+			}
+			Expect(ctx.Client.Create(ctx, ns)).To(Succeed())
+		}
+
+		createImage := func(ctx *unitValidatingWebhookContext, kind string, verified bool) {
+			var (
+				obj    client.Object
+				status *vmopv1.VirtualMachineImageStatus
+			)
+			switch kind {
+			case vmiKind:
+				vmi := builder.DummyVirtualMachineImage(ctx.vm.Spec.Image.Name)
+				vmi.Namespace = ctx.vm.Namespace
+				obj, status = vmi, &vmi.Status
+			case cvmiKind:
+				cvmi := builder.DummyClusterVirtualMachineImage(ctx.vm.Spec.Image.Name)
+				obj, status = cvmi, &cvmi.Status
+			}
+			if verified {
+				pkgcnd.MarkTrue(status, vmopv1.VirtualMachineImageSignatureVerifiedCondition)
+			} else {
+				pkgcnd.MarkFalse(status, vmopv1.VirtualMachineImageSignatureVerifiedCondition,
+					vmopv1.VirtualMachineImageSignatureUntrustedReason, "unknown authority")
+			}
+			Expect(ctx.Client.Create(ctx, obj)).To(Succeed())
+			ctx.vm.Spec.Image.Kind = kind
+		}
+
+		notVerifiedMsg := func(kind string) string {
+			return field.Forbidden(imageNamePath,
+				fmt.Sprintf("%s %s does not have a verified signature, which is required by the namespace",
+					kind, builder.DummyVMIName)).Error()
+		}
+
+		DescribeTable("create", doTest,
+			Entry("should allow when the namespace does not require verified images",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createNamespace(ctx, "")
+						createImage(ctx, vmiKind, false)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow when the namespace does not require verified images with false",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createNamespace(ctx, "false")
+						createImage(ctx, vmiKind, false)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow a verified VirtualMachineImage",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createNamespace(ctx, "true")
+						createImage(ctx, vmiKind, true)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow a verified ClusterVirtualMachineImage",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createNamespace(ctx, "true")
+						createImage(ctx, cvmiKind, true)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny an unverified VirtualMachineImage",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createNamespace(ctx, "true")
+						createImage(ctx, vmiKind, false)
+					},
+					validate:      doValidateWithMsg(notVerifiedMsg(vmiKind)),
+					expectAllowed: false,
+				},
+			),
+			Entry("should deny an unverified ClusterVirtualMachineImage",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createNamespace(ctx, "true")
+						createImage(ctx, cvmiKind, false)
+					},
+					validate:      doValidateWithMsg(notVerifiedMsg(cvmiKind)),
+					expectAllowed: false,
+				},
+			),
+			Entry("should deny when the image does not exist",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createNamespace(ctx, "true")
+					},
+					validate:      doValidateWithMsg(notVerifiedMsg(vmiKind)),
+					expectAllowed: false,
+				},
+			),
+			Entry("should deny when the namespace annotation is invalid",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						createNamespace(ctx, "maybe")
+						createImage(ctx, vmiKind, true)
+					},
+					validate: doValidateWithMsg(
+						field.InternalError(field.NewPath("spec", "image"),
+							fmt.Errorf("invalid namespace annotation %s: %w",
+								vmopv1.RequireVerifiedImagesAnnotation,
+								&strconv.NumError{Func: "ParseBool", Num: "maybe", Err: strconv.ErrSyntax})).Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+		)
+	})
+
+	Context("spec.network.firewall", func() {
+		firewallPath := field.NewPath("spec", "network", "firewall")
+
+		setFirewall := func(
+			ctx *unitValidatingWebhookContext,
+			networkProviderType pkgcfg.NetworkProviderType,
+			bootstrap *vmopv1.VirtualMachineBootstrapSpec,
+			rules ...vmopv1.VirtualMachineNetworkFirewallRule) {
+
+			pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+				config.NetworkProviderType = networkProviderType
+			})
+
+			if len(rules) == 0 {
+				rules = []vmopv1.VirtualMachineNetworkFirewallRule{
+					{
+						Protocol: vmopv1.VirtualMachineNetworkFirewallProtocolTCP,
+						Port:     ptr.To[int32](22),
+						CIDRs:    []string{"192.168.1.0/24", "fd00::/64"},
+					},
+				}
+			}
+			ctx.vm.Spec.Bootstrap = bootstrap
+			ctx.vm.Spec.Network = &vmopv1.VirtualMachineNetworkSpec{
+				Firewall: &vmopv1.VirtualMachineNetworkFirewallSpec{
+					Ingress: rules,
+				},
+			}
+		}
+
+		cloudInit := &vmopv1.VirtualMachineBootstrapSpec{
+			CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{},
+		}
+
+		rawCloudConfig := &vmopv1.VirtualMachineBootstrapSpec{
+			CloudInit: &vmopv1.VirtualMachineBootstrapCloudInitSpec{
+				RawCloudConfig: &common.SecretKeySelector{
+					Name: "my-user-data",
+					Key:  "user-data",
+				},
+			},
+		}
+
+		createUserDataSecret := func(ctx *unitValidatingWebhookContext, userData string) {
+			Expect(ctx.Client.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ctx.vm.Namespace,
+					Name:      rawCloudConfig.CloudInit.RawCloudConfig.Name,
+				},
+				Data: map[string][]byte{
+					rawCloudConfig.CloudInit.RawCloudConfig.Key: []byte(userData),
+				},
+			})).To(Succeed())
+		}
+
+		DescribeTable("create", doTest,
+			Entry("should allow with the VDS network provider and CloudInit",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVDS, cloudInit)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow with the VPC network provider and no bootstrap provider",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVPC, nil)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny with the named network provider and no bootstrap provider",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeNamed, nil)
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(firewallPath, "firewall",
+							"firewall is available only with the following bootstrap providers: CloudInit,Sysprep").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow with the VDS network provider and a rawCloudConfig",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVDS, rawCloudConfig)
+						createUserDataSecret(ctx, "#cloud-config\nruncmd:\n- echo hello\n")
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should allow with the VDS network provider and a rawCloudConfig whose Secret does not exist",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVDS, rawCloudConfig)
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny with the VDS network provider and a rawCloudConfig that is a MIME document",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVDS, rawCloudConfig)
+						createUserDataSecret(ctx,
+							"Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\nMIME-Version: 1.0\n\n--MIMEBOUNDARY--\n")
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(firewallPath, "firewall",
+							"firewall is not available with a rawCloudConfig that is a MIME multi-part document, use userDataParts instead").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should deny with the VDS network provider and rawSysprep",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVDS, &vmopv1.VirtualMachineBootstrapSpec{
+							Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+								RawSysprep: &common.SecretKeySelector{},
+							},
+						})
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(firewallPath, "firewall", "firewall is not available with rawSysprep").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should allow with the VDS network provider and Sysprep with autoLogon",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVDS, &vmopv1.VirtualMachineBootstrapSpec{
+							Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+								Sysprep: &sysprep.Sysprep{
+									GUIUnattended: &sysprep.GUIUnattended{
+										AutoLogon:      true,
+										AutoLogonCount: 1,
+										Password: &sysprep.PasswordSecretKeySelector{
+											Name: "my-password",
+											Key:  "password",
+										},
+									},
+								},
+							},
+						})
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should deny with the VDS network provider and Sysprep without autoLogon",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVDS, &vmopv1.VirtualMachineBootstrapSpec{
+							Sysprep: &vmopv1.VirtualMachineBootstrapSysprepSpec{
+								Sysprep: &sysprep.Sysprep{
+									GUIUnattended: &sysprep.GUIUnattended{
+										TimeZone: 85,
+									},
+								},
+							},
+						})
+					},
+					validate: doValidateWithMsg(
+						field.Invalid(firewallPath, "firewall",
+							"firewall requires sysprep.guiUnattended.autoLogon since the rules are applied by guiRunOnce commands when the Administrator logs on").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+			Entry("should deny invalid ports and CIDRs",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						setFirewall(ctx, pkgcfg.NetworkProviderTypeVPC, nil,
+							vmopv1.VirtualMachineNetworkFirewallRule{
+								EndPort: ptr.To[int32](80),
+								CIDRs:   []string{"192.168.1.0/24", "192.168.1.1"},
+							},
+							vmopv1.VirtualMachineNetworkFirewallRule{
+								Port:    ptr.To[int32](8080),
+								EndPort: ptr.To[int32](8000),
+							},
+						)
+					},
+					validate: doValidateWithMsg(
+						field.Forbidden(firewallPath.Child("ingress").Index(0).Child("endPort"),
+							"endPort may only be specified with port").Error(),
+						field.Invalid(firewallPath.Child("ingress").Index(0).Child("cidrs").Index(1), "192.168.1.1",
+							"must be a valid IPv4 or IPv6 network in CIDR notation").Error(),
+						field.Invalid(firewallPath.Child("ingress").Index(1).Child("endPort"), int32(8000),
+							"must be greater than or equal to port").Error(),
+					),
+					expectAllowed: false,
+				},
+			),
+		)
+	})
+
+	Context("spec.biosUUID", func() {
+		DescribeTable("create", doTest,
+			Entry("should allow when VM specifies valid UUID",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.BiosUUID = uuid.NewString()
+					},
+					expectAllowed: true,
+				},
+			),
+			Entry("should not allow when VM specifies invalid UUID",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.vm.Spec.BiosUUID = "invalid UUID"
+					},
+					expectAllowed: false,
+					validate: doValidateWithMsg(
+						field.Invalid(field.NewPath("spec", "biosUUID"), "invalid UUID", "must provide a valid UUID").Error(),
+					),
+				},
+			),
+			Entry("should allow when VM specifies no UUID",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
 						ctx.vm.Spec.BiosUUID = ""
@@ -4731,6 +5916,52 @@ func unitTestsValidateUpdate() { //nolint:gocyclo
 				expectAllowed: true,
 			},
 		),
+		Entry("should deny className change to a class deprecated with the Deny policy",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.oldVM.Spec.ClassName = oldVMClass
+					ctx.vm.Spec.ClassName = newVMClass
+					Expect(ctx.Client.Create(ctx, &vmopv1.VirtualMachineClass{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      newVMClass,
+							Namespace: ctx.vm.Namespace,
+						},
+						Spec: vmopv1.VirtualMachineClassSpec{
+							Deprecation: &vmopv1.VirtualMachineClassDeprecation{
+								Policy: vmopv1.VirtualMachineClassDeprecationPolicyDeny,
+							},
+						},
+					})).To(Succeed())
+					pkgcfg.SetContext(ctx, func(config *pkgcfg.Config) {
+						config.Features.VMResize = true
+					})
+				},
+				validate: doValidateWithMsg(
+					field.Invalid(field.NewPath("spec", "className"), newVMClass,
+						"VirtualMachineClass "+newVMClass+" is deprecated").Error(),
+				),
+			},
+		),
+		Entry("should allow an update that does not change a className that is deprecated with the Deny policy",
+			testParams{
+				setup: func(ctx *unitValidatingWebhookContext) {
+					ctx.oldVM.Spec.ClassName = newVMClass
+					ctx.vm.Spec.ClassName = newVMClass
+					Expect(ctx.Client.Create(ctx, &vmopv1.VirtualMachineClass{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      newVMClass,
+							Namespace: ctx.vm.Namespace,
+						},
+						Spec: vmopv1.VirtualMachineClassSpec{
+							Deprecation: &vmopv1.VirtualMachineClassDeprecation{
+								Policy: vmopv1.VirtualMachineClassDeprecationPolicyDeny,
+							},
+						},
+					})).To(Succeed())
+				},
+				expectAllowed: true,
+			},
+		),
 	)
 
 	Context("Annotations", func() {
@@ -5112,6 +6343,17 @@ func unitTestsValidateUpdate() { //nolint:gocyclo
 					validate: doValidateWithMsg(`spec.bootstrap.sysprep: Forbidden: bootstrap provider type cannot be changed`),
 				},
 			),
+			Entry("disallow unsetting Ignition",
+				testParams{
+					setup: func(ctx *unitValidatingWebhookContext) {
+						ctx.oldVM.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{
+							Ignition: &vmopv1.VirtualMachineBootstrapIgnitionSpec{},
+						}
+						ctx.vm.Spec.Bootstrap = &vmopv1.VirtualMachineBootstrapSpec{}
+					},
+					validate: doValidateWithMsg(`spec.bootstrap.ignition: Forbidden: bootstrap provider type cannot be changed`),
+				},
+			),
 			Entry("disallow changing bootstrap providers",
 				testParams{
 					setup: func(ctx *unitValidatingWebhookContext) {
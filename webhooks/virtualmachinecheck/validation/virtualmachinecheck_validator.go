@@ -0,0 +1,237 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+
+	restrictedToPrivUsers   = "restricted to privileged users"
+	exactlyOneCondition     = "exactly one of job, configMap, or http must be specified"
+	invalidHTTPURL          = "must be an http or https URL with a host"
+	invalidAnnotationKeyFmt = "results in an invalid annotation key %q: %s"
+	duplicateKeyFmt         = "annotation key %q is already used by VirtualMachineCheck %s"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha5-virtualmachinecheck,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachinechecks,versions=v1alpha5,name=default.validating.virtualmachinecheck.v1alpha5.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinechecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachinechecks/status,verbs=get
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineCheck validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(client client.Client) builder.Validator {
+	return validator{
+		client:    client,
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	client    client.Client
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineCheck{}).Name())
+}
+
+// ValidateCreate makes sure the VirtualMachineCheck create request is valid.
+//
+// Since a VirtualMachineCheck adds and removes check annotations that only
+// privileged users may add to or remove from existing VMs, only privileged
+// users may create or update a VirtualMachineCheck.
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	check, err := v.checkFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	if !ctx.IsPrivilegedAccount {
+		fieldErrs = append(fieldErrs, field.Forbidden(field.NewPath("spec"), restrictedToPrivUsers))
+	}
+	fieldErrs = append(fieldErrs, v.validateSpec(check)...)
+	fieldErrs = append(fieldErrs, v.validateUniqueKey(ctx, check)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+// ValidateUpdate validates if the VirtualMachineCheck update is valid.
+// - The key and type may not be changed, otherwise the annotations applied
+// for the previous key and type would be orphaned.
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	check, err := v.checkFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	oldCheck, err := v.checkFromUnstructured(ctx.OldObj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	if !ctx.IsPrivilegedAccount && !reflect.DeepEqual(check.Spec, oldCheck.Spec) {
+		fieldErrs = append(fieldErrs, field.Forbidden(field.NewPath("spec"), restrictedToPrivUsers))
+	}
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(check.Spec.Key, oldCheck.Spec.Key, field.NewPath("spec", "key"))...)
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(check.Spec.Type, oldCheck.Spec.Type, field.NewPath("spec", "type"))...)
+	fieldErrs = append(fieldErrs, v.validateSpec(check)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateSpec(check *vmopv1.VirtualMachineCheck) field.ErrorList {
+	var (
+		allErrs  field.ErrorList
+		specPath = field.NewPath("spec")
+	)
+
+	keyPath := specPath.Child("key")
+	if check.Spec.Key == "" {
+		allErrs = append(allErrs, field.Required(keyPath, ""))
+	} else if errs := utilvalidation.IsQualifiedName(check.AnnotationKey()); len(errs) > 0 {
+		allErrs = append(allErrs, field.Invalid(keyPath, check.Spec.Key,
+			fmt.Sprintf(invalidAnnotationKeyFmt, check.AnnotationKey(), errs[0])))
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(
+		&check.Spec.Selector,
+		metav1validation.LabelSelectorValidationOptions{},
+		specPath.Child("selector"))...)
+
+	allErrs = append(allErrs, v.validateCondition(specPath.Child("condition"), check.Spec.Condition)...)
+
+	return allErrs
+}
+
+// validateUniqueKey returns an error if another VirtualMachineCheck in the
+// namespace manages the same annotation key, otherwise both checks would add
+// and remove the annotation from the same VMs. The key and type are
+// immutable, so this is only validated on create.
+func (v validator) validateUniqueKey(
+	ctx *pkgctx.WebhookRequestContext,
+	check *vmopv1.VirtualMachineCheck) field.ErrorList {
+
+	var (
+		allErrs field.ErrorList
+		keyPath = field.NewPath("spec", "key")
+	)
+
+	list := &vmopv1.VirtualMachineCheckList{}
+	if err := v.client.List(ctx, list, client.InNamespace(check.Namespace)); err != nil {
+		return append(allErrs, field.InternalError(keyPath, err))
+	}
+
+	for i := range list.Items {
+		other := &list.Items[i]
+		if other.Name != check.Name && other.AnnotationKey() == check.AnnotationKey() {
+			allErrs = append(allErrs, field.Invalid(keyPath, check.Spec.Key,
+				fmt.Sprintf(duplicateKeyFmt, check.AnnotationKey(), other.Name)))
+			break
+		}
+	}
+
+	return allErrs
+}
+
+func (v validator) validateCondition(
+	condPath *field.Path,
+	cond vmopv1.VirtualMachineCheckCondition) field.ErrorList {
+
+	var (
+		allErrs field.ErrorList
+		count   int
+	)
+
+	if c := cond.Job; c != nil {
+		count++
+		if c.Name == "" {
+			allErrs = append(allErrs, field.Required(condPath.Child("job", "name"), ""))
+		}
+	}
+
+	if c := cond.ConfigMap; c != nil {
+		count++
+		if c.Name == "" {
+			allErrs = append(allErrs, field.Required(condPath.Child("configMap", "name"), ""))
+		}
+		if c.Key == "" {
+			allErrs = append(allErrs, field.Required(condPath.Child("configMap", "key"), ""))
+		}
+	}
+
+	if c := cond.HTTP; c != nil {
+		count++
+		u, err := url.Parse(c.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(condPath.Child("http", "url"), c.URL, invalidHTTPURL))
+		}
+	}
+
+	if count != 1 {
+		allErrs = append(allErrs, field.Invalid(condPath, "", exactlyOneCondition))
+	}
+
+	return allErrs
+}
+
+// checkFromUnstructured returns the VirtualMachineCheck from the unstructured
+// object.
+func (v validator) checkFromUnstructured(obj runtime.Unstructured) (*vmopv1.VirtualMachineCheck, error) {
+	check := &vmopv1.VirtualMachineCheck{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), check); err != nil {
+		return nil, err
+	}
+	return check, nil
+}
@@ -0,0 +1,108 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateUpdate,
+	)
+}
+
+type intgValidatingWebhookContext struct {
+	builder.IntegrationTestContext
+	check *vmopv1.VirtualMachineCheck
+}
+
+func newIntgValidatingWebhookContext() *intgValidatingWebhookContext {
+	ctx := &intgValidatingWebhookContext{
+		IntegrationTestContext: *suite.NewIntegrationTestContext(),
+	}
+
+	ctx.check = builder.DummyVirtualMachineCheck(ctx.Namespace, "dummy-check", "approval")
+
+	return ctx
+}
+
+func intgTestsValidateCreate() {
+	var (
+		ctx *intgValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the VirtualMachineCheck is valid", func() {
+		It("should allow the request", func() {
+			Expect(ctx.Client.Create(ctx, ctx.check)).To(Succeed())
+		})
+	})
+
+	When("the VirtualMachineCheck has no condition", func() {
+		It("should deny the request", func() {
+			ctx.check.Spec.Condition = vmopv1.VirtualMachineCheckCondition{}
+			err := ctx.Client.Create(ctx, ctx.check)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one of job, configMap, or http must be specified"))
+		})
+	})
+}
+
+func intgTestsValidateUpdate() {
+	var (
+		ctx *intgValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+		Expect(ctx.Client.Create(ctx, ctx.check)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(ctx.Client.Delete(ctx, ctx.check)).To(Succeed())
+		ctx = nil
+	})
+
+	When("the key is updated", func() {
+		It("should deny the request", func() {
+			ctx.check.Spec.Key = "other"
+			err := ctx.Client.Update(ctx, ctx.check)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("field is immutable"))
+		})
+	})
+}
@@ -0,0 +1,317 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	check    *vmopv1.VirtualMachineCheck
+	oldCheck *vmopv1.VirtualMachineCheck
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	check := builder.DummyVirtualMachineCheck("dummy-ns", "dummy-check", "approval")
+	obj, err := builder.ToUnstructured(check)
+	Expect(err).ToNot(HaveOccurred())
+
+	var oldCheck *vmopv1.VirtualMachineCheck
+	var oldObj *unstructured.Unstructured
+
+	if isUpdate {
+		oldCheck = check.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldCheck)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	ctx := &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj),
+		check:                               check,
+		oldCheck:                            oldCheck,
+	}
+	ctx.IsPrivilegedAccount = true
+
+	return ctx
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	DescribeTable("create table",
+		func(mutateFn func(ctx *unitValidatingWebhookContext), expectedAllowed bool, expectedReason string) {
+			if mutateFn != nil {
+				mutateFn(ctx)
+			}
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.check)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(Equal(expectedAllowed))
+			if expectedReason != "" {
+				Expect(string(response.Result.Reason)).To(ContainSubstring(expectedReason))
+			}
+		},
+		Entry("should allow valid", nil, true, ""),
+		Entry("should allow a Job condition",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Condition = vmopv1.VirtualMachineCheckCondition{
+					Job: &vmopv1.VirtualMachineCheckJobCondition{Name: "my-job"},
+				}
+			},
+			true,
+			"",
+		),
+		Entry("should allow an HTTP condition",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Condition = vmopv1.VirtualMachineCheckCondition{
+					HTTP: &vmopv1.VirtualMachineCheckHTTPCondition{URL: "https://approvals.local/vm"},
+				}
+			},
+			true,
+			"",
+		),
+		Entry("should deny non-privileged users",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.IsPrivilegedAccount = false
+			},
+			false,
+			`spec: Forbidden: restricted to privileged users`,
+		),
+		Entry("should allow the key of another check with a different type",
+			func(ctx *unitValidatingWebhookContext) {
+				other := builder.DummyVirtualMachineCheck(ctx.check.Namespace, "other-check", ctx.check.Spec.Key)
+				other.Spec.Type = vmopv1.VirtualMachineCheckTypeDelete
+				Expect(ctx.Client.Create(ctx, other)).To(Succeed())
+			},
+			true,
+			"",
+		),
+		Entry("should deny the key of another check",
+			func(ctx *unitValidatingWebhookContext) {
+				other := builder.DummyVirtualMachineCheck(ctx.check.Namespace, "other-check", ctx.check.Spec.Key)
+				Expect(ctx.Client.Create(ctx, other)).To(Succeed())
+			},
+			false,
+			`spec.key: Invalid value: "approval": annotation key "poweron.check.vmoperator.vmware.com/approval" is already used by VirtualMachineCheck other-check`,
+		),
+		Entry("should deny an empty key",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Key = ""
+			},
+			false,
+			`spec.key: Required value`,
+		),
+		Entry("should deny an invalid key",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Key = "not/valid"
+			},
+			false,
+			`spec.key: Invalid value: "not/valid": results in an invalid annotation key`,
+		),
+		Entry("should deny an invalid selector",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Selector = metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "app", Operator: "Bogus"},
+					},
+				}
+			},
+			false,
+			`spec.selector.matchExpressions[0].operator: Invalid value: "Bogus"`,
+		),
+		Entry("should deny no condition",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Condition = vmopv1.VirtualMachineCheckCondition{}
+			},
+			false,
+			`spec.condition: Invalid value: "": exactly one of job, configMap, or http must be specified`,
+		),
+		Entry("should deny multiple conditions",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Condition.Job = &vmopv1.VirtualMachineCheckJobCondition{Name: "my-job"}
+			},
+			false,
+			`spec.condition: Invalid value: "": exactly one of job, configMap, or http must be specified`,
+		),
+		Entry("should deny a ConfigMap condition without a key",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Condition.ConfigMap.Key = ""
+			},
+			false,
+			`spec.condition.configMap.key: Required value`,
+		),
+		Entry("should deny a Job condition without a name",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Condition = vmopv1.VirtualMachineCheckCondition{
+					Job: &vmopv1.VirtualMachineCheckJobCondition{},
+				}
+			},
+			false,
+			`spec.condition.job.name: Required value`,
+		),
+		Entry("should deny an HTTP condition with an invalid URL",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Condition = vmopv1.VirtualMachineCheckCondition{
+					HTTP: &vmopv1.VirtualMachineCheckHTTPCondition{URL: "ftp://approvals.local"},
+				}
+			},
+			false,
+			`spec.condition.http.url: Invalid value: "ftp://approvals.local": must be an http or https URL with a host`,
+		),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	DescribeTable("update table",
+		func(mutateFn func(ctx *unitValidatingWebhookContext), expectedAllowed bool, expectedReason string) {
+			if mutateFn != nil {
+				mutateFn(ctx)
+			}
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.check)
+			Expect(err).ToNot(HaveOccurred())
+			ctx.WebhookRequestContext.OldObj, err = builder.ToUnstructured(ctx.oldCheck)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(Equal(expectedAllowed))
+			if expectedReason != "" {
+				Expect(string(response.Result.Reason)).To(ContainSubstring(expectedReason))
+			}
+		},
+		Entry("should allow updating the selector",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Selector.MatchLabels = map[string]string{"app": "other-app"}
+			},
+			true,
+			"",
+		),
+		Entry("should allow non-privileged users to update metadata",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.IsPrivilegedAccount = false
+				ctx.check.Labels = map[string]string{"foo": "bar"}
+			},
+			true,
+			"",
+		),
+		Entry("should deny non-privileged users updating the spec",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.IsPrivilegedAccount = false
+				ctx.check.Spec.Reason = "my reason"
+			},
+			false,
+			`spec: Forbidden: restricted to privileged users`,
+		),
+		Entry("should deny updating the key",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Key = "other"
+			},
+			false,
+			`spec.key: Invalid value: "other": field is immutable`,
+		),
+		Entry("should deny updating the type",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.check.Spec.Type = vmopv1.VirtualMachineCheckTypeDelete
+			},
+			false,
+			`spec.type: Invalid value: "Delete": field is immutable`,
+		),
+	)
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+		ctx.IsPrivilegedAccount = false
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+}
@@ -31,6 +31,7 @@ const (
 
 	invalidCPUReqMsg    = "CPU request must not be larger than the CPU limit"
 	invalidMemoryReqMsg = "memory request must not be larger than the memory limit"
+	replacementIsSelf   = "must not be the name of the deprecated class"
 )
 
 // +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha5-virtualmachineclass,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachineclasses,versions=v1alpha5,name=default.validating.virtualmachineclass.v1alpha5.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
@@ -72,6 +73,7 @@ func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.R
 	var fieldErrs field.ErrorList
 
 	fieldErrs = append(fieldErrs, v.validatePolicies(ctx, vmClass, field.NewPath("spec", "policies"))...)
+	fieldErrs = append(fieldErrs, v.validateDeprecation(ctx, vmClass, field.NewPath("spec", "deprecation"))...)
 
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
@@ -86,7 +88,15 @@ func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Respo
 }
 
 func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	vmClass, err := v.vmClassFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
 	var fieldErrs field.ErrorList
+
+	fieldErrs = append(fieldErrs, v.validateDeprecation(ctx, vmClass, field.NewPath("spec", "deprecation"))...)
+
 	validationErrs := make([]string, 0, len(fieldErrs))
 	for _, fieldErr := range fieldErrs {
 		validationErrs = append(validationErrs, fieldErr.Error())
@@ -129,3 +139,27 @@ func (v validator) vmClassFromUnstructured(obj runtime.Unstructured) (*vmopv1.Vi
 func isRequestLimitValid(request, limit resource.Quantity) bool {
 	return request.IsZero() || limit.IsZero() || request.Value() <= limit.Value()
 }
+
+func (v validator) validateDeprecation(
+	_ *pkgctx.WebhookRequestContext,
+	vmClass *vmopv1.VirtualMachineClass,
+	fieldPath *field.Path) field.ErrorList {
+
+	d := vmClass.Spec.Deprecation
+	if d == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	if d.ReplacementClassName != "" && d.ReplacementClassName == vmClass.Name {
+		allErrs = append(allErrs, field.Invalid(
+			fieldPath.Child("replacementClassName"), d.ReplacementClassName, replacementIsSelf))
+	}
+
+	if d.Migrate && d.ReplacementClassName == "" {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("replacementClassName"), ""))
+	}
+
+	return allErrs
+}
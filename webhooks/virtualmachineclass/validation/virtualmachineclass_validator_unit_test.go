@@ -90,6 +90,8 @@ func unitTestsValidateCreate() {
 		invalidMemoryRequest bool
 		noCPULimit           bool
 		noMemoryLimit        bool
+		replacementIsSelf    bool
+		migrateNoReplacement bool
 	}
 
 	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -109,6 +111,17 @@ func unitTestsValidateCreate() {
 		if args.noMemoryLimit {
 			ctx.vmClass.Spec.Policies.Resources.Limits.Memory = resource.MustParse("0")
 		}
+		if args.replacementIsSelf {
+			ctx.vmClass.Name = "my-vm-class"
+			ctx.vmClass.Spec.Deprecation = &vmopv1.VirtualMachineClassDeprecation{
+				ReplacementClassName: "my-vm-class",
+			}
+		}
+		if args.migrateNoReplacement {
+			ctx.vmClass.Spec.Deprecation = &vmopv1.VirtualMachineClassDeprecation{
+				Migrate: true,
+			}
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmClass)
 		Expect(err).ToNot(HaveOccurred())
@@ -133,12 +146,17 @@ func unitTestsValidateCreate() {
 	reqPath := field.NewPath("spec", "policies", "resources", "requests")
 	invalidCPUField := field.Invalid(reqPath.Child("cpu"), "2Gi", "CPU request must not be larger than the CPU limit")
 	invalidMemField := field.Invalid(reqPath.Child("memory"), "2Gi", "memory request must not be larger than the memory limit")
+	deprecationPath := field.NewPath("spec", "deprecation")
+	invalidReplacementField := field.Invalid(deprecationPath.Child("replacementClassName"), "my-vm-class", "must not be the name of the deprecated class")
+	requiredReplacementField := field.Required(deprecationPath.Child("replacementClassName"), "")
 	DescribeTable("create table", validateCreate,
 		Entry("should allow valid", createArgs{}, true, nil, nil),
 		Entry("should allow no cpu limit", createArgs{noCPULimit: true}, true, nil, nil),
 		Entry("should allow no memory limit", createArgs{noMemoryLimit: true}, true, nil, nil),
 		Entry("should deny invalid cpu request", createArgs{invalidCPURequest: true}, false, invalidCPUField.Error(), nil),
 		Entry("should deny invalid memory request", createArgs{invalidMemoryRequest: true}, false, invalidMemField.Error(), nil),
+		Entry("should deny deprecation replaced by itself", createArgs{replacementIsSelf: true}, false, invalidReplacementField.Error(), nil),
+		Entry("should deny deprecation migration without a replacement", createArgs{migrateNoReplacement: true}, false, requiredReplacementField.Error(), nil),
 	)
 }
 
@@ -153,6 +171,8 @@ func unitTestsValidateUpdate() {
 		changeHwMemory bool
 		changeCPU      bool
 		changeMemory   bool
+		deprecate      bool
+		deprecateSelf  bool
 	}
 
 	validateUpdate := func(args updateArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -172,6 +192,18 @@ func unitTestsValidateUpdate() {
 			ctx.vmClass.Spec.Policies.Resources.Requests.Memory = resource.MustParse("5Gi")
 			ctx.vmClass.Spec.Policies.Resources.Limits.Memory = resource.MustParse("10Gi")
 		}
+		if args.deprecate {
+			ctx.vmClass.Spec.Deprecation = &vmopv1.VirtualMachineClassDeprecation{
+				ReplacementClassName: "my-new-vm-class",
+				Migrate:              true,
+			}
+		}
+		if args.deprecateSelf {
+			ctx.vmClass.Name = "my-vm-class"
+			ctx.vmClass.Spec.Deprecation = &vmopv1.VirtualMachineClassDeprecation{
+				ReplacementClassName: "my-vm-class",
+			}
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmClass)
 		Expect(err).ToNot(HaveOccurred())
@@ -201,6 +233,8 @@ func unitTestsValidateUpdate() {
 		Entry("should allow hw memory change", updateArgs{changeHwMemory: true}, true, nil, nil),
 		Entry("should allow policy cpu change", updateArgs{changeCPU: true}, true, nil, nil),
 		Entry("should allow policy memory change", updateArgs{changeMemory: true}, true, nil, nil),
+		Entry("should allow deprecation", updateArgs{deprecate: true}, true, nil, nil),
+		Entry("should deny deprecation replaced by itself", updateArgs{deprecateSelf: true}, false, nil, nil),
 	)
 
 	DescribeTable("update table", validateUpdate,
@@ -0,0 +1,173 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/cron"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+
+	mustBePositive = "must be greater than zero"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha5-virtualmachineclassrecommendation,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachineclassrecommendations,versions=v1alpha5,name=default.validating.virtualmachineclassrecommendation.v1alpha5.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclassrecommendations,verbs=get;list
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineclassrecommendations/status,verbs=get
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineClassRecommendation validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(_ client.Client) builder.Validator {
+	return validator{
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineClassRecommendation{}).Name())
+}
+
+// ValidateCreate makes sure the VirtualMachineClassRecommendation create
+// request is valid.
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	rec, err := v.recommendationFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	fieldErrs := v.validateSpec(rec)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+// ValidateUpdate validates if the VirtualMachineClassRecommendation update is
+// valid.
+// - The name of the VM may not be changed, otherwise the samples collected
+// for the previous VM would be attributed to the new VM.
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	rec, err := v.recommendationFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	oldRec, err := v.recommendationFromUnstructured(ctx.OldObj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	var fieldErrs field.ErrorList
+
+	fieldErrs = append(fieldErrs, validation.ValidateImmutableField(
+		rec.Spec.VirtualMachineName,
+		oldRec.Spec.VirtualMachineName,
+		field.NewPath("spec", "virtualMachineName"))...)
+	fieldErrs = append(fieldErrs, v.validateSpec(rec)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateSpec(rec *vmopv1.VirtualMachineClassRecommendation) field.ErrorList {
+	var (
+		allErrs  field.ErrorList
+		specPath = field.NewPath("spec")
+	)
+
+	if rec.Spec.VirtualMachineName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("virtualMachineName"), ""))
+	}
+
+	if d := rec.Spec.SampleInterval; d != nil && d.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("sampleInterval"), d.Duration.String(), mustBePositive))
+	}
+
+	if d := rec.Spec.Window; d != nil && d.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("window"), d.Duration.String(), mustBePositive))
+	}
+
+	if a := rec.Spec.Apply; a != nil {
+		allErrs = append(allErrs, validateMaintenanceWindow(
+			specPath.Child("apply", "maintenanceWindow"),
+			a.MaintenanceWindow)...)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceWindow(
+	mwPath *field.Path,
+	mw vmopv1.VirtualMachineMaintenanceWindow) field.ErrorList {
+
+	var allErrs field.ErrorList
+
+	if mw.Schedule == "" {
+		allErrs = append(allErrs, field.Required(mwPath.Child("schedule"), ""))
+	} else if _, err := cron.Parse(mw.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(mwPath.Child("schedule"), mw.Schedule, err.Error()))
+	}
+
+	if mw.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(mwPath.Child("duration"), mw.Duration.Duration.String(), mustBePositive))
+	}
+
+	return allErrs
+}
+
+// recommendationFromUnstructured returns the VirtualMachineClassRecommendation
+// from the unstructured object.
+func (v validator) recommendationFromUnstructured(
+	obj runtime.Unstructured) (*vmopv1.VirtualMachineClassRecommendation, error) {
+
+	rec := &vmopv1.VirtualMachineClassRecommendation{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
@@ -0,0 +1,112 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateUpdate,
+	)
+}
+
+type intgValidatingWebhookContext struct {
+	builder.IntegrationTestContext
+	rec *vmopv1.VirtualMachineClassRecommendation
+}
+
+func newIntgValidatingWebhookContext() *intgValidatingWebhookContext {
+	ctx := &intgValidatingWebhookContext{
+		IntegrationTestContext: *suite.NewIntegrationTestContext(),
+	}
+
+	ctx.rec = builder.DummyVirtualMachineClassRecommendation(ctx.Namespace, "dummy-rec", "dummy-vm")
+
+	return ctx
+}
+
+func intgTestsValidateCreate() {
+	var (
+		ctx *intgValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the VirtualMachineClassRecommendation is valid", func() {
+		It("should allow the request", func() {
+			Expect(ctx.Client.Create(ctx, ctx.rec)).To(Succeed())
+		})
+	})
+
+	When("the maintenance window has an invalid schedule", func() {
+		It("should deny the request", func() {
+			ctx.rec.Spec.Apply = &vmopv1.VirtualMachineClassRecommendationApply{
+				MaintenanceWindow: vmopv1.VirtualMachineMaintenanceWindow{
+					Schedule: "not a schedule",
+				},
+			}
+			err := ctx.Client.Create(ctx, ctx.rec)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.apply.maintenanceWindow.schedule"))
+		})
+	})
+}
+
+func intgTestsValidateUpdate() {
+	var (
+		ctx *intgValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+		Expect(ctx.Client.Create(ctx, ctx.rec)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(ctx.Client.Delete(ctx, ctx.rec)).To(Succeed())
+		ctx = nil
+	})
+
+	When("the VM name is updated", func() {
+		It("should deny the request", func() {
+			ctx.rec.Spec.VirtualMachineName = "other-vm"
+			err := ctx.Client.Update(ctx, ctx.rec)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("field is immutable"))
+		})
+	})
+}
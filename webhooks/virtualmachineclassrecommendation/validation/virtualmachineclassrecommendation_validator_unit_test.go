@@ -0,0 +1,255 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	rec    *vmopv1.VirtualMachineClassRecommendation
+	oldRec *vmopv1.VirtualMachineClassRecommendation
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	rec := builder.DummyVirtualMachineClassRecommendation("dummy-ns", "dummy-rec", "dummy-vm")
+	rec.Spec.Apply = &vmopv1.VirtualMachineClassRecommendationApply{
+		MaintenanceWindow: vmopv1.VirtualMachineMaintenanceWindow{
+			Schedule: "0 2 * * 6",
+			Duration: metav1.Duration{Duration: 4 * time.Hour},
+		},
+	}
+	obj, err := builder.ToUnstructured(rec)
+	Expect(err).ToNot(HaveOccurred())
+
+	var oldRec *vmopv1.VirtualMachineClassRecommendation
+	var oldObj *unstructured.Unstructured
+
+	if isUpdate {
+		oldRec = rec.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldRec)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj),
+		rec:                                 rec,
+		oldRec:                              oldRec,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	DescribeTable("create table",
+		func(mutateFn func(ctx *unitValidatingWebhookContext), expectedAllowed bool, expectedReason string) {
+			if mutateFn != nil {
+				mutateFn(ctx)
+			}
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.rec)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(Equal(expectedAllowed))
+			if expectedReason != "" {
+				Expect(string(response.Result.Reason)).To(ContainSubstring(expectedReason))
+			}
+		},
+		Entry("should allow valid", nil, true, ""),
+		Entry("should allow no apply",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.Apply = nil
+			},
+			true,
+			"",
+		),
+		Entry("should deny an empty VM name",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.VirtualMachineName = ""
+			},
+			false,
+			`spec.virtualMachineName: Required value`,
+		),
+		Entry("should deny a zero sample interval",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.SampleInterval = &metav1.Duration{}
+			},
+			false,
+			`spec.sampleInterval: Invalid value: "0s": must be greater than zero`,
+		),
+		Entry("should deny a negative window",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.Window = &metav1.Duration{Duration: -time.Hour}
+			},
+			false,
+			`spec.window: Invalid value: "-1h0m0s": must be greater than zero`,
+		),
+		Entry("should deny an empty schedule",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.Apply.MaintenanceWindow.Schedule = ""
+			},
+			false,
+			`spec.apply.maintenanceWindow.schedule: Required value`,
+		),
+		Entry("should deny an invalid schedule",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.Apply.MaintenanceWindow.Schedule = "0 25 * * *"
+			},
+			false,
+			`spec.apply.maintenanceWindow.schedule: Invalid value: "0 25 * * *": value 25 in hour field is not between 0 and 23`,
+		),
+		Entry("should deny a zero duration",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.Apply.MaintenanceWindow.Duration = metav1.Duration{}
+			},
+			false,
+			`spec.apply.maintenanceWindow.duration: Invalid value: "0s": must be greater than zero`,
+		),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	DescribeTable("update table",
+		func(mutateFn func(ctx *unitValidatingWebhookContext), expectedAllowed bool, expectedReason string) {
+			if mutateFn != nil {
+				mutateFn(ctx)
+			}
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.rec)
+			Expect(err).ToNot(HaveOccurred())
+			ctx.WebhookRequestContext.OldObj, err = builder.ToUnstructured(ctx.oldRec)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(Equal(expectedAllowed))
+			if expectedReason != "" {
+				Expect(string(response.Result.Reason)).To(ContainSubstring(expectedReason))
+			}
+		},
+		Entry("should allow updating the percentile",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.Percentile = 99
+			},
+			true,
+			"",
+		),
+		Entry("should allow removing apply",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.Apply = nil
+			},
+			true,
+			"",
+		),
+		Entry("should deny updating the VM name",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.VirtualMachineName = "other-vm"
+			},
+			false,
+			`spec.virtualMachineName: Invalid value: "other-vm": field is immutable`,
+		),
+		Entry("should deny an invalid schedule",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.rec.Spec.Apply.MaintenanceWindow.Schedule = "@daily"
+			},
+			false,
+			`spec.apply.maintenanceWindow.schedule: Invalid value: "@daily"`,
+		),
+	)
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+}
@@ -0,0 +1,239 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/builder"
+	pkgctx "github.com/vmware-tanzu/vm-operator/pkg/context"
+	"github.com/vmware-tanzu/vm-operator/webhooks/common"
+)
+
+const (
+	webHookName = "default"
+
+	invalidCIDR           = "must be a valid IPv4 or IPv6 network in CIDR notation"
+	invalidGateway        = "must be a valid IP address"
+	gatewayNotInCIDRFmt   = "must be within %s"
+	gatewayIsNetworkAddr  = "must not be the network address"
+	overlappingRangeFmt   = "must not overlap with %s"
+	overlappingPoolFmt    = "must not overlap with %s in VirtualMachineIPPool %s"
+	duplicateMACPrefixFmt = "must not be the same as the prefix of VirtualMachineIPPool %s"
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha5-virtualmachineippool,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachineippools,versions=v1alpha5,name=default.validating.virtualmachineippool.v1alpha5.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineippools,verbs=get;list
+// +kubebuilder:rbac:groups=vmoperator.vmware.com,resources=virtualmachineippools/status,verbs=get
+
+// AddToManager adds the webhook to the provided manager.
+func AddToManager(ctx *pkgctx.ControllerManagerContext, mgr ctrlmgr.Manager) error {
+	hook, err := builder.NewValidatingWebhook(ctx, mgr, webHookName, NewValidator(mgr.GetClient()))
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachineIPPool validation webhook: %w", err)
+	}
+	mgr.GetWebhookServer().Register(hook.Path, hook)
+
+	return nil
+}
+
+// NewValidator returns the package's Validator.
+func NewValidator(client client.Client) builder.Validator {
+	return validator{
+		client:    client,
+		converter: runtime.DefaultUnstructuredConverter,
+	}
+}
+
+type validator struct {
+	client    client.Client
+	converter runtime.UnstructuredConverter
+}
+
+func (v validator) For() schema.GroupVersionKind {
+	return vmopv1.GroupVersion.WithKind(reflect.TypeOf(vmopv1.VirtualMachineIPPool{}).Name())
+}
+
+// ValidateCreate makes sure the VirtualMachineIPPool create request is valid.
+func (v validator) ValidateCreate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	pool, err := v.poolFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	fieldErrs := v.validateSpec(pool)
+	fieldErrs = append(fieldErrs, v.validateNoOverlapWithOtherPools(ctx, pool, nil)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) ValidateDelete(*pkgctx.WebhookRequestContext) admission.Response {
+	return admission.Allowed("")
+}
+
+// ValidateUpdate validates if the VirtualMachineIPPool update is valid.
+//
+// Addresses that have already been allocated are not released when the
+// ranges or MAC address prefix are updated, so they may be changed as long as
+// they do not overlap with another pool.
+func (v validator) ValidateUpdate(ctx *pkgctx.WebhookRequestContext) admission.Response {
+	pool, err := v.poolFromUnstructured(ctx.Obj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	oldPool, err := v.poolFromUnstructured(ctx.OldObj)
+	if err != nil {
+		return webhook.Errored(http.StatusBadRequest, err)
+	}
+
+	fieldErrs := v.validateSpec(pool)
+	fieldErrs = append(fieldErrs, v.validateNoOverlapWithOtherPools(ctx, pool, oldPool)...)
+
+	validationErrs := make([]string, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		validationErrs = append(validationErrs, fieldErr.Error())
+	}
+
+	return common.BuildValidationResponse(ctx, nil, validationErrs, nil)
+}
+
+func (v validator) validateSpec(pool *vmopv1.VirtualMachineIPPool) field.ErrorList {
+	var (
+		allErrs    field.ErrorList
+		rangesPath = field.NewPath("spec", "ranges")
+	)
+
+	for i, r := range pool.Spec.Ranges {
+		rangePath := rangesPath.Index(i)
+		cidrPath := rangePath.Child("cidr")
+
+		prefix, err := netip.ParsePrefix(r.CIDR)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(cidrPath, r.CIDR, invalidCIDR))
+			continue
+		}
+		prefix = prefix.Masked()
+
+		for _, prev := range pool.Spec.Ranges[:i] {
+			if p, err := netip.ParsePrefix(prev.CIDR); err == nil && p.Overlaps(prefix) {
+				allErrs = append(allErrs, field.Invalid(cidrPath, r.CIDR,
+					fmt.Sprintf(overlappingRangeFmt, prev.CIDR)))
+				break
+			}
+		}
+
+		if r.Gateway != "" {
+			gatewayPath := rangePath.Child("gateway")
+			gw, err := netip.ParseAddr(r.Gateway)
+			switch {
+			case err != nil:
+				allErrs = append(allErrs, field.Invalid(gatewayPath, r.Gateway, invalidGateway))
+			case !prefix.Contains(gw):
+				allErrs = append(allErrs, field.Invalid(gatewayPath, r.Gateway,
+					fmt.Sprintf(gatewayNotInCIDRFmt, prefix)))
+			case gw == prefix.Addr():
+				allErrs = append(allErrs, field.Invalid(gatewayPath, r.Gateway, gatewayIsNetworkAddr))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateNoOverlapWithOtherPools validates that the pool's ranges and MAC
+// address prefix do not overlap with those of the other pools in the
+// namespace, since a VM could otherwise be allocated the same IP or MAC
+// address from two different pools. On update, only the ranges or MAC address
+// prefix that were changed from the oldPool are validated.
+func (v validator) validateNoOverlapWithOtherPools(
+	ctx *pkgctx.WebhookRequestContext,
+	pool, oldPool *vmopv1.VirtualMachineIPPool) field.ErrorList {
+
+	var (
+		allErrs    field.ErrorList
+		rangesPath = field.NewPath("spec", "ranges")
+		macPrefix  = pool.Spec.MACAddrPrefix
+	)
+
+	checkRanges := oldPool == nil || !equality.Semantic.DeepEqual(pool.Spec.Ranges, oldPool.Spec.Ranges)
+	checkMACPrefix := macPrefix != "" && (oldPool == nil || !strings.EqualFold(macPrefix, oldPool.Spec.MACAddrPrefix))
+	if !checkRanges && !checkMACPrefix {
+		return nil
+	}
+
+	list := &vmopv1.VirtualMachineIPPoolList{}
+	if err := v.client.List(ctx, list, client.InNamespace(pool.Namespace)); err != nil {
+		return append(allErrs, field.InternalError(rangesPath, err))
+	}
+
+	if checkRanges {
+		for i, r := range pool.Spec.Ranges {
+			prefix, err := netip.ParsePrefix(r.CIDR)
+			if err != nil {
+				// Invalid CIDRs are reported by validateSpec.
+				continue
+			}
+			prefix = prefix.Masked()
+
+		otherPools:
+			for j := range list.Items {
+				other := &list.Items[j]
+				if other.Name == pool.Name {
+					continue
+				}
+				for _, otherRange := range other.Spec.Ranges {
+					if p, err := netip.ParsePrefix(otherRange.CIDR); err == nil && p.Overlaps(prefix) {
+						allErrs = append(allErrs, field.Invalid(rangesPath.Index(i).Child("cidr"), r.CIDR,
+							fmt.Sprintf(overlappingPoolFmt, otherRange.CIDR, other.Name)))
+						break otherPools
+					}
+				}
+			}
+		}
+	}
+
+	if checkMACPrefix {
+		for i := range list.Items {
+			other := &list.Items[i]
+			if other.Name != pool.Name && strings.EqualFold(other.Spec.MACAddrPrefix, macPrefix) {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "macAddrPrefix"), macPrefix,
+					fmt.Sprintf(duplicateMACPrefixFmt, other.Name)))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// poolFromUnstructured returns the VirtualMachineIPPool from the unstructured
+// object.
+func (v validator) poolFromUnstructured(obj runtime.Unstructured) (*vmopv1.VirtualMachineIPPool, error) {
+	pool := &vmopv1.VirtualMachineIPPool{}
+	if err := v.converter.FromUnstructured(obj.UnstructuredContent(), pool); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
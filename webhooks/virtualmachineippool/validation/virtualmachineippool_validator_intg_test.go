@@ -0,0 +1,121 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func intgTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.EnvTest,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		intgTestsValidateUpdate,
+	)
+}
+
+type intgValidatingWebhookContext struct {
+	builder.IntegrationTestContext
+	pool *vmopv1.VirtualMachineIPPool
+}
+
+func newIntgValidatingWebhookContext() *intgValidatingWebhookContext {
+	ctx := &intgValidatingWebhookContext{
+		IntegrationTestContext: *suite.NewIntegrationTestContext(),
+	}
+
+	ctx.pool = builder.DummyVirtualMachineIPPool(ctx.Namespace, "dummy-pool")
+
+	return ctx
+}
+
+func intgTestsValidateCreate() {
+	var (
+		ctx *intgValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the VirtualMachineIPPool is valid", func() {
+		It("should allow the request", func() {
+			Expect(ctx.Client.Create(ctx, ctx.pool)).To(Succeed())
+		})
+	})
+
+	When("the VirtualMachineIPPool has overlapping ranges", func() {
+		It("should deny the request", func() {
+			ctx.pool.Spec.Ranges = append(ctx.pool.Spec.Ranges, vmopv1.VirtualMachineIPPoolRange{
+				CIDR: "192.168.1.128/25",
+			})
+			err := ctx.Client.Create(ctx, ctx.pool)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must not overlap with 192.168.1.0/24"))
+		})
+	})
+
+	When("the VirtualMachineIPPool overlaps with another pool in the namespace", func() {
+		It("should deny the request", func() {
+			otherPool := builder.DummyVirtualMachineIPPool(ctx.Namespace, "other-pool")
+			Expect(ctx.Client.Create(ctx, otherPool)).To(Succeed())
+
+			err := ctx.Client.Create(ctx, ctx.pool)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must not overlap with 192.168.1.0/24 in VirtualMachineIPPool other-pool"))
+		})
+	})
+}
+
+func intgTestsValidateUpdate() {
+	var (
+		ctx *intgValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newIntgValidatingWebhookContext()
+		Expect(ctx.Client.Create(ctx, ctx.pool)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(ctx.Client.Delete(ctx, ctx.pool)).To(Succeed())
+		ctx = nil
+	})
+
+	When("the gateway is updated to an address outside of the CIDR", func() {
+		It("should deny the request", func() {
+			ctx.pool.Spec.Ranges[0].Gateway = "10.0.0.1"
+			err := ctx.Client.Update(ctx, ctx.pool)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be within 192.168.1.0/24"))
+		})
+	})
+}
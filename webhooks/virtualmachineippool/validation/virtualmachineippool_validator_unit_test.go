@@ -0,0 +1,325 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
+	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/test/builder"
+)
+
+func unitTests() {
+	Describe(
+		"Create",
+		Label(
+			testlabels.Create,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateCreate,
+	)
+	Describe(
+		"Update",
+		Label(
+			testlabels.Update,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateUpdate,
+	)
+	Describe(
+		"Delete",
+		Label(
+			testlabels.Delete,
+			testlabels.API,
+			testlabels.Validation,
+			testlabels.Webhook,
+		),
+		unitTestsValidateDelete,
+	)
+}
+
+type unitValidatingWebhookContext struct {
+	builder.UnitTestContextForValidatingWebhook
+	pool    *vmopv1.VirtualMachineIPPool
+	oldPool *vmopv1.VirtualMachineIPPool
+}
+
+func newUnitTestContextForValidatingWebhook(isUpdate bool) *unitValidatingWebhookContext {
+	pool := builder.DummyVirtualMachineIPPool("dummy-ns", "dummy-pool")
+
+	otherPool := builder.DummyVirtualMachineIPPool("dummy-ns", "other-pool")
+	otherPool.Spec.Ranges = []vmopv1.VirtualMachineIPPoolRange{
+		{
+			CIDR:    "10.10.0.0/24",
+			Gateway: "10.10.0.1",
+		},
+	}
+	otherPool.Spec.MACAddrPrefix = "00:50:57"
+
+	otherNamespacePool := builder.DummyVirtualMachineIPPool("other-ns", "other-pool")
+	otherNamespacePool.Spec.Ranges = []vmopv1.VirtualMachineIPPoolRange{
+		{
+			CIDR:    "10.20.0.0/24",
+			Gateway: "10.20.0.1",
+		},
+	}
+	obj, err := builder.ToUnstructured(pool)
+	Expect(err).ToNot(HaveOccurred())
+
+	var oldPool *vmopv1.VirtualMachineIPPool
+	var oldObj *unstructured.Unstructured
+
+	if isUpdate {
+		oldPool = pool.DeepCopy()
+		oldObj, err = builder.ToUnstructured(oldPool)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	return &unitValidatingWebhookContext{
+		UnitTestContextForValidatingWebhook: *suite.NewUnitTestContextForValidatingWebhook(obj, oldObj, otherPool, otherNamespacePool),
+		pool:                                pool,
+		oldPool:                             oldPool,
+	}
+}
+
+func unitTestsValidateCreate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	addRange := func(cidr, gateway string) func(*unitValidatingWebhookContext) {
+		return func(ctx *unitValidatingWebhookContext) {
+			ctx.pool.Spec.Ranges = append(ctx.pool.Spec.Ranges, vmopv1.VirtualMachineIPPoolRange{
+				CIDR:    cidr,
+				Gateway: gateway,
+			})
+		}
+	}
+
+	setMACAddrPrefix := func(ctx *unitValidatingWebhookContext, namespace, name, macAddrPrefix string) {
+		other := &vmopv1.VirtualMachineIPPool{}
+		Expect(ctx.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, other)).To(Succeed())
+		other.Spec.MACAddrPrefix = macAddrPrefix
+		Expect(ctx.Client.Update(ctx, other)).To(Succeed())
+	}
+
+	DescribeTable("create table",
+		func(mutateFn func(ctx *unitValidatingWebhookContext), expectedAllowed bool, expectedReason string) {
+			if mutateFn != nil {
+				mutateFn(ctx)
+			}
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.pool)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateCreate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(Equal(expectedAllowed))
+			if expectedReason != "" {
+				Expect(string(response.Result.Reason)).To(ContainSubstring(expectedReason))
+			}
+		},
+		Entry("should allow valid", nil, true, ""),
+		Entry("should allow no ranges",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.Ranges = nil
+			},
+			true,
+			"",
+		),
+		Entry("should allow an IPv6 range",
+			addRange("fd00::/64", "fd00::1"),
+			true,
+			"",
+		),
+		Entry("should allow a range without a gateway",
+			addRange("192.168.2.0/24", ""),
+			true,
+			"",
+		),
+		Entry("should deny an invalid CIDR",
+			addRange("192.168.2.0", ""),
+			false,
+			`spec.ranges[1].cidr: Invalid value: "192.168.2.0": must be a valid IPv4 or IPv6 network in CIDR notation`,
+		),
+		Entry("should deny overlapping ranges",
+			addRange("192.168.0.0/16", ""),
+			false,
+			`spec.ranges[1].cidr: Invalid value: "192.168.0.0/16": must not overlap with 192.168.1.0/24`,
+		),
+		Entry("should deny a range that overlaps with another pool",
+			addRange("10.10.0.128/25", ""),
+			false,
+			`spec.ranges[1].cidr: Invalid value: "10.10.0.128/25": must not overlap with 10.10.0.0/24 in VirtualMachineIPPool other-pool`,
+		),
+		Entry("should allow a range that overlaps with a pool in another namespace",
+			addRange("10.20.0.0/24", "10.20.0.1"),
+			true,
+			"",
+		),
+		Entry("should deny a MAC address prefix used by another pool",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.MACAddrPrefix = "00:50:57"
+			},
+			false,
+			`spec.macAddrPrefix: Invalid value: "00:50:57": must not be the same as the prefix of VirtualMachineIPPool other-pool`,
+		),
+		Entry("should deny a MAC address prefix used by another pool with different case",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.MACAddrPrefix = "0A:50:57"
+				setMACAddrPrefix(ctx, "dummy-ns", "other-pool", "0a:50:57")
+			},
+			false,
+			`spec.macAddrPrefix: Invalid value: "0A:50:57": must not be the same as the prefix of VirtualMachineIPPool other-pool`,
+		),
+		Entry("should allow a MAC address prefix used by a pool in another namespace",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.MACAddrPrefix = "00:50:58"
+				setMACAddrPrefix(ctx, "other-ns", "other-pool", "00:50:58")
+			},
+			true,
+			"",
+		),
+		Entry("should deny an invalid gateway",
+			addRange("192.168.2.0/24", "bogus"),
+			false,
+			`spec.ranges[1].gateway: Invalid value: "bogus": must be a valid IP address`,
+		),
+		Entry("should deny a gateway outside of the CIDR",
+			addRange("192.168.2.0/24", "192.168.3.1"),
+			false,
+			`spec.ranges[1].gateway: Invalid value: "192.168.3.1": must be within 192.168.2.0/24`,
+		),
+		Entry("should deny a gateway that is the network address",
+			addRange("192.168.2.0/24", "192.168.2.0"),
+			false,
+			`spec.ranges[1].gateway: Invalid value: "192.168.2.0": must not be the network address`,
+		),
+	)
+}
+
+func unitTestsValidateUpdate() {
+	var (
+		ctx *unitValidatingWebhookContext
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(true)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	DescribeTable("update table",
+		func(mutateFn func(ctx *unitValidatingWebhookContext), expectedAllowed bool, expectedReason string) {
+			if mutateFn != nil {
+				mutateFn(ctx)
+			}
+
+			var err error
+			ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.pool)
+			Expect(err).ToNot(HaveOccurred())
+			ctx.WebhookRequestContext.OldObj, err = builder.ToUnstructured(ctx.oldPool)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := ctx.ValidateUpdate(&ctx.WebhookRequestContext)
+			Expect(response.Allowed).To(Equal(expectedAllowed))
+			if expectedReason != "" {
+				Expect(string(response.Result.Reason)).To(ContainSubstring(expectedReason))
+			}
+		},
+		Entry("should allow updating the ranges",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.Ranges[0].CIDR = "10.0.0.0/24"
+				ctx.pool.Spec.Ranges[0].Gateway = "10.0.0.1"
+			},
+			true,
+			"",
+		),
+		Entry("should deny an invalid CIDR",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.Ranges[0].CIDR = "bogus"
+			},
+			false,
+			`spec.ranges[0].cidr: Invalid value: "bogus"`,
+		),
+		Entry("should deny updating a range to overlap with another pool",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.Ranges[0].CIDR = "10.10.0.0/16"
+				ctx.pool.Spec.Ranges[0].Gateway = "10.10.1.1"
+			},
+			false,
+			`spec.ranges[0].cidr: Invalid value: "10.10.0.0/16": must not overlap with 10.10.0.0/24 in VirtualMachineIPPool other-pool`,
+		),
+		Entry("should allow updating the MAC address prefix",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.MACAddrPrefix = "00:50:58"
+			},
+			true,
+			"",
+		),
+		Entry("should deny updating the MAC address prefix to one used by another pool",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.MACAddrPrefix = "00:50:57"
+			},
+			false,
+			`spec.macAddrPrefix: Invalid value: "00:50:57": must not be the same as the prefix of VirtualMachineIPPool other-pool`,
+		),
+		Entry("should allow updating a pool that overlaps with another pool when the ranges are unchanged",
+			func(ctx *unitValidatingWebhookContext) {
+				ctx.pool.Spec.Ranges[0].CIDR = "10.10.0.0/16"
+				ctx.pool.Spec.Ranges[0].Gateway = "10.10.1.1"
+				ctx.oldPool.Spec.Ranges = ctx.pool.Spec.Ranges
+				ctx.pool.Spec.MACAddrPrefix = ""
+			},
+			true,
+			"",
+		),
+	)
+}
+
+func unitTestsValidateDelete() {
+	var (
+		ctx      *unitValidatingWebhookContext
+		response admission.Response
+	)
+
+	BeforeEach(func() {
+		ctx = newUnitTestContextForValidatingWebhook(false)
+	})
+
+	AfterEach(func() {
+		ctx = nil
+	})
+
+	When("the delete is performed", func() {
+		JustBeforeEach(func() {
+			response = ctx.ValidateDelete(&ctx.WebhookRequestContext)
+		})
+
+		It("should allow the request", func() {
+			Expect(response.Allowed).To(BeTrue())
+		})
+	})
+}
@@ -46,6 +46,17 @@ var (
 		string(corev1.ProtocolUDP),
 		string(corev1.ProtocolSCTP),
 	)
+
+	supportedIPFamilies = sets.NewString(
+		string(vmopv1.VirtualMachineServiceIPFamilyIPv4),
+		string(vmopv1.VirtualMachineServiceIPFamilyIPv6),
+	)
+
+	supportedIPFamilyPolicies = sets.NewString(
+		string(vmopv1.VirtualMachineServiceIPFamilyPolicySingleStack),
+		string(vmopv1.VirtualMachineServiceIPFamilyPolicyPreferDualStack),
+		string(vmopv1.VirtualMachineServiceIPFamilyPolicyRequireDualStack),
+	)
 )
 
 // +kubebuilder:webhook:verbs=create;update,path=/default-validate-vmoperator-vmware-com-v1alpha5-virtualmachineservice,mutating=false,failurePolicy=fail,groups=vmoperator.vmware.com,resources=virtualmachineservices,versions=v1alpha5,name=default.validating.virtualmachineservice.v1alpha5.vmoperator.vmware.com,sideEffects=None,admissionReviewVersions=v1;v1beta1
@@ -164,6 +175,11 @@ func (v validator) validateSpec(ctx *pkgctx.WebhookRequestContext, vmService *vm
 	}
 
 	allErrs = append(allErrs, validatePorts(vmService, specPath)...)
+	allErrs = append(allErrs, validateIPFamilies(vmService, specPath)...)
+
+	if interfaceName := vmService.Spec.InterfaceName; interfaceName != "" {
+		allErrs = append(allErrs, ValidateDNS1123Label(interfaceName, specPath.Child("interfaceName"))...)
+	}
 
 	if vmService.Spec.Selector != nil {
 		allErrs = append(allErrs, unversionedvalidation.ValidateLabels(vmService.Spec.Selector, specPath.Child("selector"))...)
@@ -239,6 +255,48 @@ func validatePorts(vmService *vmopv1.VirtualMachineService, specPath *field.Path
 	return allErrs
 }
 
+func validateIPFamilies(vmService *vmopv1.VirtualMachineService, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	ipFamiliesPath := specPath.Child("ipFamilies")
+	ipFamilyPolicyPath := specPath.Child("ipFamilyPolicy")
+
+	if vmService.Spec.Type == vmopv1.VirtualMachineServiceTypeExternalName {
+		if len(vmService.Spec.IPFamilies) > 0 {
+			allErrs = append(allErrs, field.Forbidden(ipFamiliesPath, "may not be set for ExternalName services"))
+		}
+		if vmService.Spec.IPFamilyPolicy != nil {
+			allErrs = append(allErrs, field.Forbidden(ipFamilyPolicyPath, "may not be set for ExternalName services"))
+		}
+		return allErrs
+	}
+
+	if len(vmService.Spec.IPFamilies) > 2 {
+		allErrs = append(allErrs, field.TooMany(ipFamiliesPath, len(vmService.Spec.IPFamilies), 2))
+	}
+
+	seen := sets.Set[vmopv1.VirtualMachineServiceIPFamily]{}
+	for i, family := range vmService.Spec.IPFamilies {
+		if !supportedIPFamilies.Has(string(family)) {
+			allErrs = append(allErrs, field.NotSupported(ipFamiliesPath.Index(i), family, supportedIPFamilies.List()))
+		} else if seen.Has(family) {
+			allErrs = append(allErrs, field.Duplicate(ipFamiliesPath.Index(i), family))
+		}
+		seen.Insert(family)
+	}
+
+	if policy := vmService.Spec.IPFamilyPolicy; policy != nil {
+		switch {
+		case !supportedIPFamilyPolicies.Has(string(*policy)):
+			allErrs = append(allErrs, field.NotSupported(ipFamilyPolicyPath, *policy, supportedIPFamilyPolicies.List()))
+		case *policy == vmopv1.VirtualMachineServiceIPFamilyPolicySingleStack && len(vmService.Spec.IPFamilies) > 1:
+			allErrs = append(allErrs, field.Invalid(ipFamiliesPath, vmService.Spec.IPFamilies,
+				"may not have more than one family when `ipFamilyPolicy` is 'SingleStack'"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateServicePort(sp *vmopv1.VirtualMachineServicePort, requireName bool, allNames *sets.Set[string], fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -263,8 +321,17 @@ func validateServicePort(sp *vmopv1.VirtualMachineServicePort, requireName bool,
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), sp.Protocol, supportedPortProtocols.List()))
 	}
 
-	for _, msg := range validation.IsValidPortNum(int(sp.TargetPort)) {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetPort"), sp.TargetPort, msg))
+	switch {
+	case sp.TargetPortName != "" && sp.TargetPort != 0:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("targetPort"), "may not be set when `targetPortName` is set"))
+	case sp.TargetPortName != "":
+		for _, msg := range validation.IsValidPortName(sp.TargetPortName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetPortName"), sp.TargetPortName, msg))
+		}
+	default:
+		for _, msg := range validation.IsValidPortNum(int(sp.TargetPort)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetPort"), sp.TargetPort, msg))
+		}
 	}
 
 	return allErrs
@@ -285,6 +352,15 @@ func (v validator) validateAllowedChanges(ctx *pkgctx.WebhookRequestContext, vmS
 		allErrs = append(allErrs, field.Forbidden(specPath.Child("clusterIP"), "field is immutable"))
 	}
 
+	// Like with a Service, the primary IP family cannot be changed once set since
+	// the ClusterIP is allocated from it.
+	if oldFamilies := oldVMService.Spec.IPFamilies; len(oldFamilies) > 0 {
+		if families := vmService.Spec.IPFamilies; len(families) == 0 || families[0] != oldFamilies[0] {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("ipFamilies").Index(0),
+				"may not change the primary IP family"))
+		}
+	}
+
 	return allErrs
 }
 
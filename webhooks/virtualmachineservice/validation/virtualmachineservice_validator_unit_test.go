@@ -14,6 +14,7 @@ import (
 
 	vmopv1 "github.com/vmware-tanzu/vm-operator/api/v1alpha5"
 	"github.com/vmware-tanzu/vm-operator/pkg/constants/testlabels"
+	"github.com/vmware-tanzu/vm-operator/pkg/util/ptr"
 	"github.com/vmware-tanzu/vm-operator/test/builder"
 )
 
@@ -91,6 +92,11 @@ func unitTestsValidateCreate() {
 		invalidClusterIP      bool
 		invalidLBSourceRanges bool
 		invalidExternalName   bool
+		dualStack             bool
+		duplicateIPFamilies   bool
+		singleStackDualFamily bool
+		externalNameIPFamily  bool
+		invalidInterfaceName  bool
 	}
 
 	validateCreate := func(args createArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
@@ -123,6 +129,32 @@ func unitTestsValidateCreate() {
 			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeExternalName
 			ctx.vmService.Spec.ExternalName = "InValid!"
 		}
+		if args.dualStack {
+			ctx.vmService.Spec.InterfaceName = "eth1"
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv6, vmopv1.VirtualMachineServiceIPFamilyIPv4,
+			}
+			ctx.vmService.Spec.IPFamilyPolicy = ptr.To(vmopv1.VirtualMachineServiceIPFamilyPolicyRequireDualStack)
+		}
+		if args.duplicateIPFamilies {
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4, vmopv1.VirtualMachineServiceIPFamilyIPv4,
+			}
+		}
+		if args.singleStackDualFamily {
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4, vmopv1.VirtualMachineServiceIPFamilyIPv6,
+			}
+			ctx.vmService.Spec.IPFamilyPolicy = ptr.To(vmopv1.VirtualMachineServiceIPFamilyPolicySingleStack)
+		}
+		if args.externalNameIPFamily {
+			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeExternalName
+			ctx.vmService.Spec.ExternalName = "example.com"
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{vmopv1.VirtualMachineServiceIPFamilyIPv4}
+		}
+		if args.invalidInterfaceName {
+			ctx.vmService.Spec.InterfaceName = "ETH_1"
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmService)
 		Expect(err).ToNot(HaveOccurred())
@@ -154,6 +186,11 @@ func unitTestsValidateCreate() {
 		Entry("should deny invalid ClusterIP", createArgs{invalidClusterIP: true}, false, "spec.clusterIP: Invalid value: \"100.1000.1.1\": must be a valid IP address", nil),
 		Entry("should deny invalid LoadBalancerSourceRanges", createArgs{invalidLBSourceRanges: true}, false, `spec.loadBalancerSourceRanges[0]: Invalid value: "10.1.1.1/42": must be compatible with https://pkg.go.dev/net#ParseCIDR`, nil),
 		Entry("should deny invalid ExternalName", createArgs{invalidExternalName: true}, false, "spec.externalName: Invalid value: \"InValid!\": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters", nil),
+		Entry("should allow dual-stack with interface", createArgs{dualStack: true}, true, nil, nil),
+		Entry("should deny duplicate IP families", createArgs{duplicateIPFamilies: true}, false, `spec.ipFamilies[1]: Duplicate value: "IPv4"`, nil),
+		Entry("should deny SingleStack with two IP families", createArgs{singleStackDualFamily: true}, false, "spec.ipFamilies: Invalid value: ", nil),
+		Entry("should deny IP families for ExternalName", createArgs{externalNameIPFamily: true}, false, "spec.ipFamilies: Forbidden: may not be set for ExternalName services", nil),
+		Entry("should deny invalid interface name", createArgs{invalidInterfaceName: true}, false, `spec.interfaceName: Invalid value: "ETH_1"`, nil),
 	)
 
 	validatePortCreate := func(expectedReason string, ports []vmopv1.VirtualMachineServicePort) {
@@ -205,6 +242,31 @@ func unitTestsValidateCreate() {
 				},
 			},
 		),
+		Entry("should allow valid target port name", "",
+			[]vmopv1.VirtualMachineServicePort{
+				{
+					Name:           "http",
+					Protocol:       "TCP",
+					Port:           80,
+					TargetPortName: "http",
+				},
+			},
+		),
+		Entry("should deny invalid target port name", "spec.ports[0].targetPortName: Invalid value: \"HTTP_PORT\"",
+			[]vmopv1.VirtualMachineServicePort{
+				{
+					TargetPortName: "HTTP_PORT",
+				},
+			},
+		),
+		Entry("should deny target port with target port name", "spec.ports[0].targetPort: Forbidden: may not be set when `targetPortName` is set",
+			[]vmopv1.VirtualMachineServicePort{
+				{
+					TargetPort:     8080,
+					TargetPortName: "http",
+				},
+			},
+		),
 		Entry("should deny invalid target port", "spec.ports[0].targetPort: Invalid value: 200000:",
 			[]vmopv1.VirtualMachineServicePort{
 				{
@@ -228,7 +290,7 @@ func unitTestsValidateCreate() {
 				},
 			},
 		),
-		Entry("should deny duplicate protocol/port", `spec.ports[1]: Duplicate value: {"name":"","protocol":"TCP","port":80,"targetPort":0}`,
+		Entry("should deny duplicate protocol/port", `spec.ports[1]: Duplicate value: {"name":"","protocol":"TCP","port":80}`,
 			[]vmopv1.VirtualMachineServicePort{
 				{
 					Name:       "port1",
@@ -254,19 +316,45 @@ func unitTestsValidateUpdate() {
 	)
 
 	type updateArgs struct {
-		updateType      bool
-		updateClusterIP bool
+		updateType            bool
+		updateClusterIP       bool
+		addSecondaryIPFamily  bool
+		updatePrimaryIPFamily bool
+		removeIPFamilies      bool
 	}
 
 	validateUpdate := func(args updateArgs, expectedAllowed bool, expectedReason string, expectedErr error) {
 		var err error
 
+		if args.addSecondaryIPFamily || args.updatePrimaryIPFamily || args.removeIPFamilies {
+			oldVMService := ctx.vmService.DeepCopy()
+			oldVMService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4,
+			}
+			ctx.WebhookRequestContext.OldObj, err = builder.ToUnstructured(oldVMService)
+			Expect(err).ToNot(HaveOccurred())
+			ctx.vmService.Spec.IPFamilies = oldVMService.Spec.IPFamilies
+		}
+
 		if args.updateType {
 			ctx.vmService.Spec.Type = vmopv1.VirtualMachineServiceTypeClusterIP
 		}
 		if args.updateClusterIP {
 			ctx.vmService.Spec.ClusterIP = "9.9.9.9"
 		}
+		if args.addSecondaryIPFamily {
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv4, vmopv1.VirtualMachineServiceIPFamilyIPv6,
+			}
+		}
+		if args.updatePrimaryIPFamily {
+			ctx.vmService.Spec.IPFamilies = []vmopv1.VirtualMachineServiceIPFamily{
+				vmopv1.VirtualMachineServiceIPFamilyIPv6, vmopv1.VirtualMachineServiceIPFamilyIPv4,
+			}
+		}
+		if args.removeIPFamilies {
+			ctx.vmService.Spec.IPFamilies = nil
+		}
 
 		ctx.WebhookRequestContext.Obj, err = builder.ToUnstructured(ctx.vmService)
 		Expect(err).ToNot(HaveOccurred())
@@ -292,6 +380,9 @@ func unitTestsValidateUpdate() {
 		Entry("should allow", updateArgs{}, true, nil, nil),
 		Entry("should deny Type change", updateArgs{updateType: true}, false, "spec.type: Forbidden: field is immutable", nil),
 		Entry("should deny ClusterIP change", updateArgs{updateClusterIP: true}, false, "spec.clusterIP: Forbidden: field is immutable", nil),
+		Entry("should allow adding a secondary IP family", updateArgs{addSecondaryIPFamily: true}, true, nil, nil),
+		Entry("should deny primary IP family change", updateArgs{updatePrimaryIPFamily: true}, false, "spec.ipFamilies[0]: Forbidden: may not change the primary IP family", nil),
+		Entry("should deny removing the IP families", updateArgs{removeIPFamilies: true}, false, "spec.ipFamilies[0]: Forbidden: may not change the primary IP family", nil),
 	)
 
 	When("the update is performed while object deletion", func() {